@@ -0,0 +1,70 @@
+package cmds
+
+import (
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/tierklinik-dobersberg/apis/pkg/cli"
+	rosterdv1 "github.com/tierklinik-dobersberg/rosterd/gen/go/rosterd/v1"
+)
+
+func AuditCommand(root *cli.Root) *cobra.Command {
+	var (
+		entity   string
+		entityId string
+		userId   string
+		actorId  string
+		from     string
+		to       string
+		limit    int64
+	)
+
+	cmd := &cobra.Command{
+		Use:   "audit",
+		Short: "List entries of the audit log",
+		Long: `List entries of the audit log, newest first.
+
+Supported entities are roster, roster-type, offtime-request, offtime-costs,
+offtime-rule, worktime, workshift, constraint, shift-swap, open-shift,
+local-holiday and calendar-feed.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			req := &rosterdv1.ListAuditEventsRequest{
+				Entity:   entity,
+				EntityId: entityId,
+				UserId:   userId,
+				ActorId:  actorId,
+				Limit:    limit,
+			}
+
+			if from != "" {
+				req.From = parseFormats(from, "2006-01-02", time.RFC3339)
+			}
+
+			if to != "" {
+				req.To = parseFormats(to, "2006-01-02", time.RFC3339)
+			}
+
+			res, err := rosterdAuditClient(root).ListAuditEvents(root.Context(), connect.NewRequest(req))
+			if err != nil {
+				logrus.Fatalf("failed to list audit events: %s", err)
+			}
+
+			root.Print(res.Msg)
+		},
+	}
+
+	f := cmd.Flags()
+	{
+		f.StringVar(&entity, "entity", "", "Only list events for this entity type")
+		f.StringVar(&entityId, "id", "", "Only list events for the entity with this ID")
+		f.StringVar(&userId, "user", "", "Only list events that affect this user")
+		f.StringVar(&actorId, "actor", "", "Only list events performed by this user")
+		f.StringVar(&from, "from", "", "Only list events at or after this time")
+		f.StringVar(&to, "to", "", "Only list events before this time")
+		f.Int64Var(&limit, "limit", 100, "Maximum number of events to list, 0 for all")
+	}
+
+	return cmd
+}
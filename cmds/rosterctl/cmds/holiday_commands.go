@@ -0,0 +1,219 @@
+package cmds
+
+import (
+	"strings"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/tierklinik-dobersberg/apis/pkg/cli"
+	rosterdv1 "github.com/tierklinik-dobersberg/rosterd/gen/go/rosterd/v1"
+)
+
+var holidayKinds = map[string]rosterdv1.LocalHolidayKind{
+	"closure":  rosterdv1.LocalHolidayKind_LOCAL_HOLIDAY_KIND_CLOSURE,
+	"half-day": rosterdv1.LocalHolidayKind_LOCAL_HOLIDAY_KIND_HALF_DAY,
+	"regional": rosterdv1.LocalHolidayKind_LOCAL_HOLIDAY_KIND_REGIONAL,
+	"other":    rosterdv1.LocalHolidayKind_LOCAL_HOLIDAY_KIND_OTHER,
+}
+
+func parseHolidayKind(value string) rosterdv1.LocalHolidayKind {
+	kind, ok := holidayKinds[strings.ToLower(value)]
+	if !ok {
+		logrus.Fatalf("invalid value for --kind: %q", value)
+	}
+
+	return kind
+}
+
+func HolidayCommand(root *cli.Root) *cobra.Command {
+	var (
+		from      string
+		to        string
+		effective bool
+	)
+
+	cmd := &cobra.Command{
+		Use:     "holidays",
+		Aliases: []string{"holiday"},
+		Short:   "Manage clinic specific holidays and closure days",
+		Long: `Manage clinic specific holidays and closure days.
+
+Local holidays are merged with the public holidays of the calendar service.
+Use --effective to list the merged holidays.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			client := rosterdHolidayClient(root)
+
+			if effective {
+				if from == "" || to == "" {
+					logrus.Fatalf("--from and --to are required together with --effective")
+				}
+
+				res, err := client.ListEffectiveHolidays(root.Context(), connect.NewRequest(&rosterdv1.ListEffectiveHolidaysRequest{
+					From: from,
+					To:   to,
+				}))
+				if err != nil {
+					logrus.Fatalf("failed to list holidays: %s", err)
+				}
+
+				root.Print(res.Msg)
+
+				return
+			}
+
+			res, err := client.ListLocalHolidays(root.Context(), connect.NewRequest(&rosterdv1.ListLocalHolidaysRequest{
+				From: from,
+				To:   to,
+			}))
+			if err != nil {
+				logrus.Fatalf("failed to list local holidays: %s", err)
+			}
+
+			root.Print(res.Msg)
+		},
+	}
+
+	f := cmd.Flags()
+	{
+		f.StringVar(&from, "from", "", "Only list holidays on or after this day (YYYY-MM-DD)")
+		f.StringVar(&to, "to", "", "Only list holidays on or before this day (YYYY-MM-DD)")
+		f.BoolVar(&effective, "effective", false, "List the public holidays merged with the local holidays")
+	}
+
+	cmd.AddCommand(
+		CreateHolidayCommand(root),
+		UpdateHolidayCommand(root),
+		DeleteHolidayCommand(root),
+	)
+
+	return cmd
+}
+
+// holidayFlags registers the flags to configure a local holiday.
+func holidayFlags(f *pflag.FlagSet, hd *rosterdv1.LocalHoliday, kind *string) {
+	f.StringVar(&hd.Date, "date", "", "The date (YYYY-MM-DD) of the holiday")
+	f.StringVar(&hd.Name, "name", "", "The name of the holiday")
+	f.StringVar(&hd.Description, "description", "", "An optional description")
+	f.StringVar(kind, "kind", "other", "The kind of the holiday. One of closure, half-day, regional or other")
+	f.BoolVar(&hd.CountsAsWorkday, "counts-as-workday", false, "Whether or not the day counts as a working day for the expected work time")
+	f.Float64Var(&hd.WorkdayFraction, "workday-fraction", 0, "The part of a regular working day that is expected if the day counts as a working day (e.g. 0.5 for half days, 0 for a full day)")
+	f.BoolVar(&hd.HolidayShifts, "holiday-shifts", false, "Whether or not work-shifts marked for holidays apply on this day")
+}
+
+func CreateHolidayCommand(root *cli.Root) *cobra.Command {
+	var (
+		hd   = new(rosterdv1.LocalHoliday)
+		kind string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a new local holiday or closure day",
+		Run: func(cmd *cobra.Command, args []string) {
+			hd.Kind = parseHolidayKind(kind)
+
+			res, err := rosterdHolidayClient(root).CreateLocalHoliday(root.Context(), connect.NewRequest(&rosterdv1.CreateLocalHolidayRequest{
+				Holiday: hd,
+			}))
+			if err != nil {
+				logrus.Fatalf("failed to create local holiday: %s", err)
+			}
+
+			root.Print(res.Msg)
+		},
+	}
+
+	holidayFlags(cmd.Flags(), hd, &kind)
+
+	return cmd
+}
+
+func UpdateHolidayCommand(root *cli.Root) *cobra.Command {
+	var (
+		update = new(rosterdv1.LocalHoliday)
+		kind   string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "update [id]",
+		Short: "Update an existing local holiday",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			client := rosterdHolidayClient(root)
+
+			res, err := client.ListLocalHolidays(root.Context(), connect.NewRequest(new(rosterdv1.ListLocalHolidaysRequest)))
+			if err != nil {
+				logrus.Fatalf("failed to list local holidays: %s", err)
+			}
+
+			var hd *rosterdv1.LocalHoliday
+			for _, h := range res.Msg.Holidays {
+				if h.Id == args[0] {
+					hd = h
+					break
+				}
+			}
+
+			if hd == nil {
+				logrus.Fatalf("local holiday %q not found", args[0])
+			}
+
+			f := cmd.Flags()
+			if f.Changed("date") {
+				hd.Date = update.Date
+			}
+			if f.Changed("name") {
+				hd.Name = update.Name
+			}
+			if f.Changed("description") {
+				hd.Description = update.Description
+			}
+			if f.Changed("kind") {
+				hd.Kind = parseHolidayKind(kind)
+			}
+			if f.Changed("counts-as-workday") {
+				hd.CountsAsWorkday = update.CountsAsWorkday
+			}
+			if f.Changed("workday-fraction") {
+				hd.WorkdayFraction = update.WorkdayFraction
+			}
+			if f.Changed("holiday-shifts") {
+				hd.HolidayShifts = update.HolidayShifts
+			}
+
+			updated, err := client.UpdateLocalHoliday(root.Context(), connect.NewRequest(&rosterdv1.UpdateLocalHolidayRequest{
+				Holiday: hd,
+			}))
+			if err != nil {
+				logrus.Fatalf("failed to update local holiday: %s", err)
+			}
+
+			root.Print(updated.Msg)
+		},
+	}
+
+	holidayFlags(cmd.Flags(), update, &kind)
+
+	return cmd
+}
+
+func DeleteHolidayCommand(root *cli.Root) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete [id...]",
+		Short: "Delete local holidays",
+		Args:  cobra.MinimumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			for _, id := range args {
+				if _, err := rosterdHolidayClient(root).DeleteLocalHoliday(root.Context(), connect.NewRequest(&rosterdv1.DeleteLocalHolidayRequest{
+					Id: id,
+				})); err != nil {
+					logrus.Fatalf("failed to delete local holiday %s: %s", id, err)
+				}
+			}
+		},
+	}
+
+	return cmd
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/spf13/cobra"
 	rosterv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/roster/v1"
 	"github.com/tierklinik-dobersberg/apis/pkg/cli"
+	rosterdv1 "github.com/tierklinik-dobersberg/rosterd/gen/go/rosterd/v1"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -69,6 +70,8 @@ func OffTimeCommand(root *cli.Root) *cobra.Command {
 		AddOffTimeCostsCommand(root),
 		GetOffTimeCostsCommand(root),
 		DeleteOffTimeCostsCommand(root),
+		VacationBalanceCommand(root),
+		OffTimeRulesCommand(root),
 	)
 
 	return cmd
@@ -307,6 +310,34 @@ func ApproveOrRejectCommand(root *cli.Root) *cobra.Command {
 	return cmd
 }
 
+func VacationBalanceCommand(root *cli.Root) *cobra.Command {
+	var userIds []string
+
+	cmd := &cobra.Command{
+		Use:   "balance [offtime-id...]",
+		Short: "Show the vacation balance of users or the projected balance for off-time requests",
+		Run: func(cmd *cobra.Command, args []string) {
+			for idx, id := range userIds {
+				userIds[idx] = root.MustResolveUserToId(id)
+			}
+
+			res, err := rosterdOffTimeClient(root).GetVacationBalance(root.Context(), connect.NewRequest(&rosterdv1.GetVacationBalanceRequest{
+				UserIds:    userIds,
+				OfftimeIds: args,
+			}))
+			if err != nil {
+				logrus.Fatalf("failed to get vacation balance: %s", err)
+			}
+
+			root.Print(res.Msg)
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&userIds, "for-user", nil, "A list of users")
+
+	return cmd
+}
+
 func parseFormats(val string, formats ...string) *timestamppb.Timestamp {
 	for _, f := range formats {
 		t, err := time.ParseInLocation(f, val, time.Local)
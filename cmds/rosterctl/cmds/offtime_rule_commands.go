@@ -0,0 +1,160 @@
+package cmds
+
+import (
+	"github.com/bufbuild/connect-go"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/tierklinik-dobersberg/apis/pkg/cli"
+	rosterdv1 "github.com/tierklinik-dobersberg/rosterd/gen/go/rosterd/v1"
+)
+
+func OffTimeRulesCommand(root *cli.Root) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "rules",
+		Aliases: []string{"rule"},
+		Short:   "Manage off-time blackout periods and concurrency limits",
+		Run: func(cmd *cobra.Command, args []string) {
+			res, err := rosterdOffTimeClient(root).ListOffTimeRules(root.Context(), connect.NewRequest(new(rosterdv1.ListOffTimeRulesRequest)))
+			if err != nil {
+				logrus.Fatalf("failed to list off-time rules: %s", err)
+			}
+
+			root.Print(res.Msg)
+		},
+	}
+
+	cmd.AddCommand(
+		CreateOffTimeRuleCommand(root),
+		UpdateOffTimeRuleCommand(root),
+		DeleteOffTimeRuleCommand(root),
+	)
+
+	return cmd
+}
+
+// offTimeRuleFlags registers the flags to configure an off-time rule.
+func offTimeRuleFlags(f *pflag.FlagSet, rule *rosterdv1.OffTimeRule) {
+	f.StringVar(&rule.Name, "name", "", "The name of the rule")
+	f.StringVar(&rule.Description, "description", "", "An optional description")
+	f.StringVar(&rule.From, "from", "", "The first day (YYYY-MM-DD) the rule applies to")
+	f.StringVar(&rule.To, "to", "", "The last day (YYYY-MM-DD) the rule applies to")
+	f.StringSliceVar(&rule.RoleIds, "role", nil, "The IDs of the roles the rule applies to")
+	f.StringSliceVar(&rule.UserIds, "user", nil, "The users the rule applies to")
+	f.Int32Var(&rule.MaxConcurrent, "max-concurrent", 0, "The maximum number of absent users. Zero blocks all off-time requests")
+}
+
+func CreateOffTimeRuleCommand(root *cli.Root) *cobra.Command {
+	rule := new(rosterdv1.OffTimeRule)
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a new off-time rule",
+		Run: func(cmd *cobra.Command, args []string) {
+			for idx, u := range rule.UserIds {
+				rule.UserIds[idx] = root.MustResolveUserToId(u)
+			}
+
+			res, err := rosterdOffTimeClient(root).CreateOffTimeRule(root.Context(), connect.NewRequest(&rosterdv1.CreateOffTimeRuleRequest{
+				Rule: rule,
+			}))
+			if err != nil {
+				logrus.Fatalf("failed to create off-time rule: %s", err)
+			}
+
+			root.Print(res.Msg)
+		},
+	}
+
+	offTimeRuleFlags(cmd.Flags(), rule)
+
+	return cmd
+}
+
+func UpdateOffTimeRuleCommand(root *cli.Root) *cobra.Command {
+	update := new(rosterdv1.OffTimeRule)
+
+	cmd := &cobra.Command{
+		Use:   "update [id]",
+		Short: "Update an existing off-time rule",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			client := rosterdOffTimeClient(root)
+
+			res, err := client.ListOffTimeRules(root.Context(), connect.NewRequest(new(rosterdv1.ListOffTimeRulesRequest)))
+			if err != nil {
+				logrus.Fatalf("failed to list off-time rules: %s", err)
+			}
+
+			var rule *rosterdv1.OffTimeRule
+			for _, r := range res.Msg.Rules {
+				if r.Id == args[0] {
+					rule = r
+					break
+				}
+			}
+
+			if rule == nil {
+				logrus.Fatalf("off-time rule %q not found", args[0])
+			}
+
+			f := cmd.Flags()
+			if f.Changed("name") {
+				rule.Name = update.Name
+			}
+			if f.Changed("description") {
+				rule.Description = update.Description
+			}
+			if f.Changed("from") {
+				rule.From = update.From
+			}
+			if f.Changed("to") {
+				rule.To = update.To
+			}
+			if f.Changed("role") {
+				rule.RoleIds = update.RoleIds
+			}
+			if f.Changed("user") {
+				rule.UserIds = update.UserIds
+				for idx, u := range rule.UserIds {
+					rule.UserIds[idx] = root.MustResolveUserToId(u)
+				}
+			}
+			if f.Changed("max-concurrent") {
+				rule.MaxConcurrent = update.MaxConcurrent
+			}
+
+			updated, err := client.UpdateOffTimeRule(root.Context(), connect.NewRequest(&rosterdv1.UpdateOffTimeRuleRequest{
+				Rule: rule,
+			}))
+			if err != nil {
+				logrus.Fatalf("failed to update off-time rule: %s", err)
+			}
+
+			root.Print(updated.Msg)
+		},
+	}
+
+	offTimeRuleFlags(cmd.Flags(), update)
+
+	return cmd
+}
+
+func DeleteOffTimeRuleCommand(root *cli.Root) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete [id...]",
+		Short: "Delete off-time rules",
+		Args:  cobra.MinimumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			for _, id := range args {
+				if _, err := rosterdOffTimeClient(root).DeleteOffTimeRule(root.Context(), connect.NewRequest(&rosterdv1.DeleteOffTimeRuleRequest{
+					Id: id,
+				})); err != nil {
+					logrus.Fatalf("failed to delete off-time rule %s: %s", id, err)
+				}
+			}
+		},
+	}
+
+	return cmd
+}
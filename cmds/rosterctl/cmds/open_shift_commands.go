@@ -0,0 +1,168 @@
+package cmds
+
+import (
+	"strings"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/tierklinik-dobersberg/apis/pkg/cli"
+	rosterdv1 "github.com/tierklinik-dobersberg/rosterd/gen/go/rosterd/v1"
+)
+
+func OpenShiftCommand(root *cli.Root) *cobra.Command {
+	var (
+		rosterId string
+		states   []string
+	)
+
+	cmd := &cobra.Command{
+		Use:     "open-shifts",
+		Aliases: []string{"open-shift"},
+		Short:   "List and manage open shifts",
+		Run: func(cmd *cobra.Command, args []string) {
+			req := &rosterdv1.ListOpenShiftsRequest{
+				RosterId: rosterId,
+			}
+
+			for _, s := range states {
+				value, ok := rosterdv1.OpenShiftState_value["OPEN_SHIFT_STATE_"+strings.ToUpper(s)]
+				if !ok {
+					logrus.Fatalf("invalid open shift state %q", s)
+				}
+
+				req.States = append(req.States, rosterdv1.OpenShiftState(value))
+			}
+
+			res, err := rosterdOpenShiftClient(root).ListOpenShifts(root.Context(), connect.NewRequest(req))
+			if err != nil {
+				logrus.Fatalf("failed to list open shifts: %s", err)
+			}
+
+			root.Print(res.Msg)
+		},
+	}
+
+	cmd.Flags().StringVar(&rosterId, "roster", "", "Only list open shifts of the given roster")
+	cmd.Flags().StringSliceVar(&states, "state", nil, "Only list open shifts with the given states (open, filled, closed)")
+
+	cmd.AddCommand(
+		PublishOpenShiftsCommand(root),
+		ClaimOpenShiftCommand(root),
+		DecideOpenShiftClaimCommand(root, true),
+		DecideOpenShiftClaimCommand(root, false),
+		CloseOpenShiftCommand(root),
+	)
+
+	return cmd
+}
+
+func PublishOpenShiftsCommand(root *cli.Root) *cobra.Command {
+	var (
+		shifts              []string
+		requireConfirmation bool
+		comment             string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "publish [roster-id]",
+		Short: "Publish understaffed shifts of an approved roster",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			req := &rosterdv1.PublishOpenShiftsRequest{
+				RosterId:            args[0],
+				RequireConfirmation: requireConfirmation,
+				Comment:             comment,
+			}
+
+			for _, s := range shifts {
+				id, from, ok := strings.Cut(s, "@")
+				if !ok {
+					logrus.Fatalf("invalid shift %q, expected <work-shift-id>@<RFC3339 start>", s)
+				}
+
+				req.Shifts = append(req.Shifts, parseShiftReference(id, from))
+			}
+
+			res, err := rosterdOpenShiftClient(root).PublishOpenShifts(root.Context(), connect.NewRequest(req))
+			if err != nil {
+				logrus.Fatalf("failed to publish open shifts: %s", err)
+			}
+
+			root.Print(res.Msg)
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&shifts, "shift", nil, "Only publish the given shifts (<work-shift-id>@<RFC3339 start>)")
+	cmd.Flags().BoolVar(&requireConfirmation, "require-confirmation", false, "Require claims to be confirmed by a roster manager")
+	cmd.Flags().StringVar(&comment, "comment", "", "An optional comment")
+
+	return cmd
+}
+
+func ClaimOpenShiftCommand(root *cli.Root) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim [id]",
+		Short: "Claim an open shift",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			res, err := rosterdOpenShiftClient(root).ClaimOpenShift(root.Context(), connect.NewRequest(&rosterdv1.ClaimOpenShiftRequest{
+				Id: args[0],
+			}))
+			if err != nil {
+				logrus.Fatalf("failed to claim open shift: %s", err)
+			}
+
+			root.Print(res.Msg)
+		},
+	}
+
+	return cmd
+}
+
+func DecideOpenShiftClaimCommand(root *cli.Root, confirm bool) *cobra.Command {
+	use, short := "reject [id] [user]", "Reject a pending claim"
+	if confirm {
+		use, short = "confirm [id] [user]", "Confirm a pending claim and assign the user"
+	}
+
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		Args:  cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			res, err := rosterdOpenShiftClient(root).DecideOpenShiftClaim(root.Context(), connect.NewRequest(&rosterdv1.DecideOpenShiftClaimRequest{
+				Id:      args[0],
+				UserId:  root.MustResolveUserToId(args[1]),
+				Confirm: confirm,
+			}))
+			if err != nil {
+				logrus.Fatalf("failed to decide claim: %s", err)
+			}
+
+			root.Print(res.Msg)
+		},
+	}
+
+	return cmd
+}
+
+func CloseOpenShiftCommand(root *cli.Root) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "close [id]",
+		Short: "Close an open shift and reject all pending claims",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			res, err := rosterdOpenShiftClient(root).CloseOpenShift(root.Context(), connect.NewRequest(&rosterdv1.CloseOpenShiftRequest{
+				Id: args[0],
+			}))
+			if err != nil {
+				logrus.Fatalf("failed to close open shift: %s", err)
+			}
+
+			root.Print(res.Msg)
+		},
+	}
+
+	return cmd
+}
@@ -3,6 +3,7 @@ package cmds
 import (
 	"context"
 	"fmt"
+	"os"
 	"strings"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/spf13/cobra"
 	rosterv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/roster/v1"
 	"github.com/tierklinik-dobersberg/apis/pkg/cli"
+	rosterdv1 "github.com/tierklinik-dobersberg/rosterd/gen/go/rosterd/v1"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -24,10 +26,21 @@ func RosterCommand(root *cli.Root) *cobra.Command {
 
 	cmd.AddCommand(
 		AnalyzeWorkTimeCommand(root),
+		AnalyzeOvertimeCommand(root),
+		PayrollCommand(root),
+		UserRosterCommand(root),
 		RequiredShiftsCommmand(root),
 		WorkingStaffCommand(root),
 		RosterTypeCommand(root),
 		ReapplyShiftTimesCommand(root),
+		GenerateRosterCommand(root),
+		CompleteRosterCommand(root),
+		ValidateRosterCommand(root),
+		ApproveRosterCommand(root),
+		RosterVersionsCommand(root),
+		DiffRostersCommand(root),
+		ShiftSwapCommand(root),
+		OpenShiftCommand(root),
 	)
 
 	return cmd
@@ -229,6 +242,213 @@ func AnalyzeWorkTimeCommand(root *cli.Root) *cobra.Command {
 	return cmd
 }
 
+func AnalyzeOvertimeCommand(root *cli.Root) *cobra.Command {
+	var (
+		from             string
+		to               string
+		users            []string
+		timeTrackingOnly bool
+		pretty           bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "overtime",
+		Short: "Show the raw and the allowance-adjusted overtime per month",
+		Run: func(cmd *cobra.Command, args []string) {
+			res, err := rosterdClient(root).AnalyzeOvertime(root.Context(), connect.NewRequest(&rosterdv1.AnalyzeOvertimeRequest{
+				From: from,
+				To:   to,
+				Users: &rosterv1.UsersToAnalyze{
+					UserIds:  users,
+					AllUsers: len(users) == 0,
+				},
+				TimeTrackingOnly: timeTrackingOnly,
+			}))
+			if err != nil {
+				logrus.Fatal(err)
+			}
+
+			if !pretty {
+				root.Print(res.Msg)
+				return
+			}
+
+			userMap := getUserMap(root)
+
+			for _, user := range res.Msg.Results {
+				displayName := user.UserId
+				if profile, ok := userMap[user.UserId]; ok {
+					displayName = profile.User.DisplayName
+					if displayName == "" {
+						displayName = profile.User.Username
+					}
+				}
+
+				fmt.Println(text.Colors{text.Bold, text.Underline, text.FgGreen}.Sprint(displayName))
+
+				tbw := getTbWriter()
+				tbw.AppendHeader(table.Row{
+					"Month",
+					"Expected",
+					"Planned",
+					"Raw Overtime",
+					"Allowance",
+					"Overtime",
+				})
+
+				for _, month := range user.Months {
+					tbw.AppendRow(table.Row{
+						fmt.Sprintf("%04d-%02d", month.Year, month.Month),
+						shortDur(month.ExpectedTime),
+						shortDur(month.PlannedTime),
+						shortDur(month.RawOvertime),
+						shortDur(month.OvertimeAllowance),
+						shortDur(month.Overtime),
+					})
+				}
+
+				tbw.AppendRow(table.Row{
+					text.Underline.Sprint("Total"),
+					text.Underline.Sprint(shortDur(user.ExpectedTime)),
+					text.Underline.Sprint(shortDur(user.PlannedTime)),
+					text.Underline.Sprint(shortDur(user.RawOvertime)),
+					text.Underline.Sprint(shortDur(user.OvertimeAllowance)),
+					text.Underline.Sprint(shortDur(user.Overtime)),
+				})
+
+				tbw.Render()
+				fmt.Println()
+			}
+		},
+	}
+
+	f := cmd.Flags()
+	{
+		f.StringVar(&from, "from", "", "")
+		f.StringVar(&to, "to", "", "")
+		f.StringSliceVar(&users, "users", nil, "")
+		f.BoolVar(&timeTrackingOnly, "time-tracking-only", false, "Only include work-times with time-tracking enabled")
+		f.BoolVar(&pretty, "pretty", false, "")
+	}
+
+	return cmd
+}
+
+func UserRosterCommand(root *cli.Root) *cobra.Command {
+	var (
+		user   string
+		html   bool
+		output string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "personal [month]",
+		Short: "Export the personal roster of a user for the given month (YYYY-MM)",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			format := rosterdv1.UserRosterFormat_USER_ROSTER_FORMAT_PDF
+			if html {
+				format = rosterdv1.UserRosterFormat_USER_ROSTER_FORMAT_HTML
+			}
+
+			res, err := rosterdClient(root).ExportUserRoster(root.Context(), connect.NewRequest(&rosterdv1.ExportUserRosterRequest{
+				UserId: user,
+				Month:  args[0],
+				Format: format,
+			}))
+			if err != nil {
+				logrus.Fatal(err)
+			}
+
+			if output == "" {
+				output = res.Msg.FileName
+			}
+
+			if err := os.WriteFile(output, res.Msg.Payload, 0o600); err != nil {
+				logrus.Fatal(err)
+			}
+		},
+	}
+
+	f := cmd.Flags()
+	{
+		f.StringVar(&user, "user", "", "The ID of the user, defaults to the current user")
+		f.BoolVar(&html, "html", false, "Export HTML instead of PDF")
+		f.StringVarP(&output, "output", "o", "", "The output file, defaults to the file name returned by the server")
+	}
+
+	return cmd
+}
+
+func PayrollCommand(root *cli.Root) *cobra.Command {
+	var (
+		from   string
+		to     string
+		users  []string
+		night  string
+		format string
+		output string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "payroll",
+		Short: "Export planned, night, holiday and overtime hours per user and month",
+		Run: func(cmd *cobra.Command, args []string) {
+			var exportFormat rosterdv1.PayrollExportFormat
+			switch format {
+			case "csv":
+				exportFormat = rosterdv1.PayrollExportFormat_PAYROLL_EXPORT_FORMAT_CSV
+			case "json":
+				exportFormat = rosterdv1.PayrollExportFormat_PAYROLL_EXPORT_FORMAT_JSON
+			default:
+				logrus.Fatalf("unsupported format %q, expected csv or json", format)
+			}
+
+			res, err := rosterdClient(root).ExportPayroll(root.Context(), connect.NewRequest(&rosterdv1.ExportPayrollRequest{
+				From: from,
+				To:   to,
+				Users: &rosterv1.UsersToAnalyze{
+					UserIds:  users,
+					AllUsers: len(users) == 0,
+				},
+				NightWindow: night,
+				Format:      exportFormat,
+			}))
+			if err != nil {
+				logrus.Fatal(err)
+			}
+
+			if output == "" {
+				if _, err := os.Stdout.Write(res.Msg.Payload); err != nil {
+					logrus.Fatal(err)
+				}
+
+				return
+			}
+
+			if output == "." {
+				output = res.Msg.FileName
+			}
+
+			if err := os.WriteFile(output, res.Msg.Payload, 0o600); err != nil {
+				logrus.Fatal(err)
+			}
+		},
+	}
+
+	f := cmd.Flags()
+	{
+		f.StringVar(&from, "from", "", "The first month to export (YYYY-MM)")
+		f.StringVar(&to, "to", "", "The last month to export (YYYY-MM), defaults to --from")
+		f.StringSliceVar(&users, "users", nil, "Only export the given users")
+		f.StringVar(&night, "night", "", "Overwrite the configured night window (HH:MM-HH:MM)")
+		f.StringVar(&format, "format", "csv", "The export format, either csv or json")
+		f.StringVarP(&output, "output", "o", "", "Write the export to a file instead of stdout. Use . for the default file name")
+	}
+
+	return cmd
+}
+
 func RequiredShiftsCommmand(root *cli.Root) *cobra.Command {
 	var (
 		from string
@@ -256,6 +476,121 @@ func RequiredShiftsCommmand(root *cli.Root) *cobra.Command {
 	return cmd
 }
 
+func GenerateRosterCommand(root *cli.Root) *cobra.Command {
+	var (
+		from           string
+		to             string
+		onCall         bool
+		penaltyPerHour float64
+	)
+
+	cmd := &cobra.Command{
+		Use:   "generate [roster-type]",
+		Short: "Generate a draft duty roster",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			res, err := rosterdClient(root).GenerateRoster(root.Context(), connect.NewRequest(&rosterdv1.GenerateRosterRequest{
+				RosterTypeName:         args[0],
+				From:                   from,
+				To:                     to,
+				OnCall:                 onCall,
+				WorkTimePenaltyPerHour: penaltyPerHour,
+			}))
+			if err != nil {
+				logrus.Fatal(err)
+			}
+
+			root.Print(res.Msg)
+		},
+	}
+
+	f := cmd.Flags()
+	f.StringVar(&from, "from", "", "The first day of the roster (YYYY-MM-DD)")
+	f.StringVar(&to, "to", "", "The last day of the roster (YYYY-MM-DD)")
+	f.BoolVar(&onCall, "on-call", false, "Use the on-call tags of the roster type")
+	f.Float64Var(&penaltyPerHour, "work-time-penalty", 0, "The penalty for each hour of deviation from the expected work time")
+
+	return cmd
+}
+
+func CompleteRosterCommand(root *cli.Root) *cobra.Command {
+	var penaltyPerHour float64
+
+	cmd := &cobra.Command{
+		Use:   "complete [roster-id]",
+		Short: "Fill the remaining staffing gaps of a duty roster",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			res, err := rosterdClient(root).CompleteRoster(root.Context(), connect.NewRequest(&rosterdv1.CompleteRosterRequest{
+				RosterId:               args[0],
+				WorkTimePenaltyPerHour: penaltyPerHour,
+			}))
+			if err != nil {
+				logrus.Fatal(err)
+			}
+
+			root.Print(res.Msg)
+		},
+	}
+
+	cmd.Flags().Float64Var(&penaltyPerHour, "work-time-penalty", 0, "The penalty for each hour of deviation from the expected work time")
+
+	return cmd
+}
+
+func ValidateRosterCommand(root *cli.Root) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate [roster-id]",
+		Short: "Validate a duty roster and report all findings",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			res, err := rosterdClient(root).ValidateRoster(root.Context(), connect.NewRequest(&rosterdv1.ValidateRosterRequest{
+				Roster: &rosterdv1.ValidateRosterRequest_RosterId{
+					RosterId: args[0],
+				},
+			}))
+			if err != nil {
+				logrus.Fatal(err)
+			}
+
+			root.Print(res.Msg)
+		},
+	}
+
+	return cmd
+}
+
+func ApproveRosterCommand(root *cli.Root) *cobra.Command {
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "approve [roster-id]",
+		Short: "Validate and approve a duty roster",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			res, err := rosterdClient(root).ValidateAndApproveRoster(root.Context(), connect.NewRequest(&rosterdv1.ValidateAndApproveRosterRequest{
+				Approval: &rosterv1.ApproveRosterRequest{
+					Id: args[0],
+				},
+				Force: force,
+			}))
+			if err != nil {
+				logrus.Fatal(err)
+			}
+
+			root.Print(res.Msg)
+
+			if !res.Msg.Approved {
+				logrus.Fatal("roster has blocking errors and has not been approved, use --force to approve anyway")
+			}
+		},
+	}
+
+	cmd.Flags().BoolVar(&force, "force", false, "Approve the roster even if there are blocking errors")
+
+	return cmd
+}
+
 func ReapplyShiftTimesCommand(root *cli.Root) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "re-apply [roster-id]",
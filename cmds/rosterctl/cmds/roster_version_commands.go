@@ -0,0 +1,127 @@
+package cmds
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/tierklinik-dobersberg/apis/pkg/cli"
+	rosterdv1 "github.com/tierklinik-dobersberg/rosterd/gen/go/rosterd/v1"
+)
+
+// parseVersionRef parses a roster version reference in the format
+// <roster-id>[@<cas-index>].
+func parseVersionRef(value string) *rosterdv1.RosterVersionRef {
+	id, cas, found := strings.Cut(value, "@")
+
+	ref := &rosterdv1.RosterVersionRef{
+		RosterId: id,
+	}
+
+	if found {
+		idx, err := strconv.ParseUint(cas, 10, 64)
+		if err != nil {
+			logrus.Fatalf("invalid version reference %q: %s", value, err)
+		}
+
+		ref.CasIndex = idx
+	}
+
+	return ref
+}
+
+func RosterVersionsCommand(root *cli.Root) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "versions [roster-id]",
+		Short: "List the version history of a duty roster",
+		Long: `List the version history of a duty roster.
+
+Versions are referenced as <roster-id>[@<cas-index>]. Without a CAS index the
+latest version of the roster document is used.`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			res, err := rosterdClient(root).ListRosterVersions(root.Context(), connect.NewRequest(&rosterdv1.ListRosterVersionsRequest{
+				RosterId: args[0],
+			}))
+			if err != nil {
+				logrus.Fatalf("failed to list roster versions: %s", err)
+			}
+
+			root.Print(res.Msg)
+		},
+	}
+
+	cmd.AddCommand(
+		ShowRosterVersionCommand(root),
+		RestoreRosterVersionCommand(root),
+	)
+
+	return cmd
+}
+
+func ShowRosterVersionCommand(root *cli.Root) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show [version]",
+		Short: "Show a single version of a duty roster",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			res, err := rosterdClient(root).GetRosterVersion(root.Context(), connect.NewRequest(&rosterdv1.GetRosterVersionRequest{
+				Ref: parseVersionRef(args[0]),
+			}))
+			if err != nil {
+				logrus.Fatalf("failed to get roster version: %s", err)
+			}
+
+			root.Print(res.Msg)
+		},
+	}
+
+	return cmd
+}
+
+func DiffRostersCommand(root *cli.Root) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff [from] [to]",
+		Short: "Show the changes between two rosters or roster versions",
+		Long: `Show the changes between two rosters or roster versions covering the same period.
+
+Rosters are referenced as <roster-id>[@<cas-index>]. Without a CAS index the
+latest version of the roster document is used.`,
+		Args: cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			res, err := rosterdClient(root).DiffRosters(root.Context(), connect.NewRequest(&rosterdv1.DiffRostersRequest{
+				From: parseVersionRef(args[0]),
+				To:   parseVersionRef(args[1]),
+			}))
+			if err != nil {
+				logrus.Fatalf("failed to diff rosters: %s", err)
+			}
+
+			root.Print(res.Msg)
+		},
+	}
+
+	return cmd
+}
+
+func RestoreRosterVersionCommand(root *cli.Root) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "restore [version]",
+		Short: "Restore an older roster version as a new draft",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			res, err := rosterdClient(root).RestoreRosterVersion(root.Context(), connect.NewRequest(&rosterdv1.RestoreRosterVersionRequest{
+				Ref: parseVersionRef(args[0]),
+			}))
+			if err != nil {
+				logrus.Fatalf("failed to restore roster version: %s", err)
+			}
+
+			root.Print(res.Msg)
+		},
+	}
+
+	return cmd
+}
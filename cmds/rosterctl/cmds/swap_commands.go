@@ -0,0 +1,222 @@
+package cmds
+
+import (
+	"strings"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/tierklinik-dobersberg/apis/pkg/cli"
+	rosterdv1 "github.com/tierklinik-dobersberg/rosterd/gen/go/rosterd/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ShiftSwapCommand(root *cli.Root) *cobra.Command {
+	var (
+		rosterId string
+		userId   string
+		states   []string
+	)
+
+	cmd := &cobra.Command{
+		Use:     "swaps",
+		Aliases: []string{"swap"},
+		Short:   "List and manage shift swaps",
+		Run: func(cmd *cobra.Command, args []string) {
+			req := &rosterdv1.ListShiftSwapsRequest{
+				RosterId: rosterId,
+			}
+
+			if userId != "" {
+				req.UserId = root.MustResolveUserToId(userId)
+			}
+
+			for _, s := range states {
+				value, ok := rosterdv1.ShiftSwapState_value["SHIFT_SWAP_STATE_"+strings.ToUpper(s)]
+				if !ok {
+					logrus.Fatalf("invalid shift swap state %q", s)
+				}
+
+				req.States = append(req.States, rosterdv1.ShiftSwapState(value))
+			}
+
+			res, err := rosterdShiftSwapClient(root).ListShiftSwaps(root.Context(), connect.NewRequest(req))
+			if err != nil {
+				logrus.Fatalf("failed to list shift swaps: %s", err)
+			}
+
+			root.Print(res.Msg)
+		},
+	}
+
+	cmd.Flags().StringVar(&rosterId, "roster", "", "Only list swaps of the given roster")
+	cmd.Flags().StringVar(&userId, "user", "", "Only list swaps of the given user")
+	cmd.Flags().StringSliceVar(&states, "state", nil, "Only list swaps with the given states (offered, accepted, approved, rejected, declined, cancelled)")
+
+	cmd.AddCommand(
+		CreateShiftSwapCommand(root),
+		AcceptShiftSwapCommand(root),
+		DeclineShiftSwapCommand(root),
+		CancelShiftSwapCommand(root),
+		DecideShiftSwapCommand(root, true),
+		DecideShiftSwapCommand(root, false),
+	)
+
+	return cmd
+}
+
+func parseShiftReference(workShiftId, from string) *rosterdv1.ShiftReference {
+	t, err := time.ParseInLocation(time.RFC3339, from, time.Local)
+	if err != nil {
+		logrus.Fatalf("invalid shift start %q: %s", from, err)
+	}
+
+	return &rosterdv1.ShiftReference{
+		WorkShiftId: workShiftId,
+		From:        timestamppb.New(t),
+	}
+}
+
+func CreateShiftSwapCommand(root *cli.Root) *cobra.Command {
+	var (
+		shiftId         string
+		shiftFrom       string
+		targetUser      string
+		exchangeShiftId string
+		exchangeFrom    string
+		comment         string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "create [roster-id]",
+		Short: "Offer a shift or propose an exchange with a colleague",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			req := &rosterdv1.CreateShiftSwapRequest{
+				RosterId: args[0],
+				Shift:    parseShiftReference(shiftId, shiftFrom),
+				Comment:  comment,
+			}
+
+			if targetUser != "" {
+				req.TargetUserId = root.MustResolveUserToId(targetUser)
+			}
+
+			if exchangeShiftId != "" {
+				req.ExchangeShift = parseShiftReference(exchangeShiftId, exchangeFrom)
+			}
+
+			res, err := rosterdShiftSwapClient(root).CreateShiftSwap(root.Context(), connect.NewRequest(req))
+			if err != nil {
+				logrus.Fatalf("failed to create shift swap: %s", err)
+			}
+
+			root.Print(res.Msg)
+		},
+	}
+
+	f := cmd.Flags()
+	f.StringVar(&shiftId, "shift", "", "The work-shift ID of the shift to hand over")
+	f.StringVar(&shiftFrom, "from", "", "The start time (RFC3339) of the shift to hand over")
+	f.StringVar(&targetUser, "to", "", "The colleague to propose the swap to. If empty, the shift is offered to everyone")
+	f.StringVar(&exchangeShiftId, "exchange-shift", "", "The work-shift ID of the colleague's shift to take over in exchange")
+	f.StringVar(&exchangeFrom, "exchange-from", "", "The start time (RFC3339) of the colleague's shift")
+	f.StringVar(&comment, "comment", "", "An optional comment")
+
+	cmd.MarkFlagRequired("shift")
+	cmd.MarkFlagRequired("from")
+
+	return cmd
+}
+
+func AcceptShiftSwapCommand(root *cli.Root) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept [id]",
+		Short: "Accept an offered shift swap",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			res, err := rosterdShiftSwapClient(root).AcceptShiftSwap(root.Context(), connect.NewRequest(&rosterdv1.AcceptShiftSwapRequest{
+				Id: args[0],
+			}))
+			if err != nil {
+				logrus.Fatalf("failed to accept shift swap: %s", err)
+			}
+
+			root.Print(res.Msg)
+		},
+	}
+
+	return cmd
+}
+
+func DeclineShiftSwapCommand(root *cli.Root) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "decline [id]",
+		Short: "Decline a shift swap that has been proposed to you",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			res, err := rosterdShiftSwapClient(root).DeclineShiftSwap(root.Context(), connect.NewRequest(&rosterdv1.DeclineShiftSwapRequest{
+				Id: args[0],
+			}))
+			if err != nil {
+				logrus.Fatalf("failed to decline shift swap: %s", err)
+			}
+
+			root.Print(res.Msg)
+		},
+	}
+
+	return cmd
+}
+
+func CancelShiftSwapCommand(root *cli.Root) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel [id]",
+		Short: "Cancel a shift swap",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			res, err := rosterdShiftSwapClient(root).CancelShiftSwap(root.Context(), connect.NewRequest(&rosterdv1.CancelShiftSwapRequest{
+				Id: args[0],
+			}))
+			if err != nil {
+				logrus.Fatalf("failed to cancel shift swap: %s", err)
+			}
+
+			root.Print(res.Msg)
+		},
+	}
+
+	return cmd
+}
+
+func DecideShiftSwapCommand(root *cli.Root, approve bool) *cobra.Command {
+	var comment string
+
+	use, short := "reject [id]", "Reject an accepted shift swap"
+	if approve {
+		use, short = "approve [id]", "Approve an accepted shift swap and update the roster"
+	}
+
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			res, err := rosterdShiftSwapClient(root).DecideShiftSwap(root.Context(), connect.NewRequest(&rosterdv1.DecideShiftSwapRequest{
+				Id:      args[0],
+				Approve: approve,
+				Comment: comment,
+			}))
+			if err != nil {
+				logrus.Fatalf("failed to decide shift swap: %s", err)
+			}
+
+			root.Print(res.Msg)
+		},
+	}
+
+	cmd.Flags().StringVar(&comment, "comment", "", "An optional comment")
+
+	return cmd
+}
@@ -7,8 +7,20 @@ import (
 	"github.com/sirupsen/logrus"
 	idmv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/idm/v1"
 	"github.com/tierklinik-dobersberg/apis/pkg/cli"
+	"github.com/tierklinik-dobersberg/rosterd/gen/go/rosterd/v1/rosterdv1connect"
 )
 
+// rosterdClient returns a client for the rosterd specific roster service.
+func rosterdClient(root *cli.Root) rosterdv1connect.RosterServiceClient {
+	return rosterdv1connect.NewRosterServiceClient(root.HttpClient, root.Config().BaseURLS.Roster)
+}
+
+// rosterdWorkTimeClient returns a client for the rosterd specific work-time
+// service.
+func rosterdWorkTimeClient(root *cli.Root) rosterdv1connect.WorkTimeServiceClient {
+	return rosterdv1connect.NewWorkTimeServiceClient(root.HttpClient, root.Config().BaseURLS.Roster)
+}
+
 func getUserMap(root *cli.Root) map[string]*idmv1.Profile {
 	res, err := root.Users().ListUsers(context.Background(), connect.NewRequest(&idmv1.ListUsersRequest{}))
 
@@ -24,3 +36,29 @@ func getUserMap(root *cli.Root) map[string]*idmv1.Profile {
 
 	return m
 }
+
+// rosterdOffTimeClient returns a client for the rosterd specific off-time
+// service.
+func rosterdOffTimeClient(root *cli.Root) rosterdv1connect.OffTimeServiceClient {
+	return rosterdv1connect.NewOffTimeServiceClient(root.HttpClient, root.Config().BaseURLS.Roster)
+}
+
+// rosterdShiftSwapClient returns a client for the shift swap service.
+func rosterdShiftSwapClient(root *cli.Root) rosterdv1connect.ShiftSwapServiceClient {
+	return rosterdv1connect.NewShiftSwapServiceClient(root.HttpClient, root.Config().BaseURLS.Roster)
+}
+
+// rosterdOpenShiftClient returns a client for the open shift service.
+func rosterdOpenShiftClient(root *cli.Root) rosterdv1connect.OpenShiftServiceClient {
+	return rosterdv1connect.NewOpenShiftServiceClient(root.HttpClient, root.Config().BaseURLS.Roster)
+}
+
+// rosterdHolidayClient returns a client for the local holiday service.
+func rosterdHolidayClient(root *cli.Root) rosterdv1connect.HolidayServiceClient {
+	return rosterdv1connect.NewHolidayServiceClient(root.HttpClient, root.Config().BaseURLS.Roster)
+}
+
+// rosterdAuditClient returns a client for the audit log service.
+func rosterdAuditClient(root *cli.Root) rosterdv1connect.AuditServiceClient {
+	return rosterdv1connect.NewAuditServiceClient(root.HttpClient, root.Config().BaseURLS.Roster)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/spf13/cobra"
 	rosterv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/roster/v1"
 	"github.com/tierklinik-dobersberg/apis/pkg/cli"
+	rosterdv1 "github.com/tierklinik-dobersberg/rosterd/gen/go/rosterd/v1"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -29,6 +30,7 @@ func WorkTimeCommand(root *cli.Root) *cobra.Command {
 		GetVacationCreditsLeftCommand(root),
 		UpdateWorkTimeCommand(root),
 		DeleteWorkTimeCommand(root),
+		WorkTimeScheduleCommand(root),
 	)
 
 	return cmd
@@ -149,6 +151,87 @@ func UpdateWorkTimeCommand(root *cli.Root) *cobra.Command {
 	return cmd
 }
 
+func WorkTimeScheduleCommand(root *cli.Root) *cobra.Command {
+	var (
+		days           []string
+		hours          map[string]string
+		reset          bool
+		applicableFrom string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "schedule [work-time-id]",
+		Short: "Show the working weekdays of a work-time or create a new work-time with a schedule",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			cli := rosterdWorkTimeClient(root)
+
+			if !reset && len(days) == 0 && len(hours) == 0 {
+				res, err := cli.GetWorkTimeSchedule(root.Context(), connect.NewRequest(&rosterdv1.GetWorkTimeScheduleRequest{
+					WorkTimeId: args[0],
+				}))
+				if err != nil {
+					logrus.Fatalf("failed to get work-time schedule: %s", err)
+				}
+
+				root.Print(res.Msg)
+				return
+			}
+
+			if _, err := time.ParseInLocation("2006-01-02", applicableFrom, time.Local); err != nil {
+				logrus.Fatalf("invalid value for --from: %s", err)
+			}
+
+			schedule := &rosterdv1.WorkTimeSchedule{}
+
+			parsedDays, ok := parseDays(days)
+			if !ok {
+				logrus.Fatalf("invalid value for --days")
+			}
+			schedule.Days = parsedDays
+
+			if len(hours) > 0 {
+				schedule.TimePerWeekday = make(map[int32]*durationpb.Duration, len(hours))
+
+				for key, value := range hours {
+					day, ok := parseDay(key)
+					if !ok {
+						logrus.Fatalf("invalid weekday %q in --hours", key)
+					}
+
+					d, err := time.ParseDuration(value)
+					if err != nil {
+						logrus.Fatalf("invalid duration for %q in --hours: %s", key, err)
+					}
+
+					schedule.TimePerWeekday[int32(day)] = durationpb.New(d)
+				}
+			}
+
+			res, err := cli.SetWorkTimeSchedule(root.Context(), connect.NewRequest(&rosterdv1.SetWorkTimeScheduleRequest{
+				WorkTimeId:      args[0],
+				Schedule:        schedule,
+				ApplicableAfter: applicableFrom,
+			}))
+			if err != nil {
+				logrus.Fatalf("failed to set work-time schedule: %s", err)
+			}
+
+			root.Print(res.Msg)
+		},
+	}
+
+	f := cmd.Flags()
+	{
+		f.StringSliceVar(&days, "days", nil, "The weekdays the user is working on (mo, tu, we, ...)")
+		f.StringToStringVar(&hours, "hours", nil, "The work time per weekday (mo=8h,tu=4h)")
+		f.BoolVar(&reset, "reset", false, "Remove the schedule so the user works on all working days")
+		f.StringVar(&applicableFrom, "from", "", "After which date (YYYY-MM-DD) the new schedule is effective")
+	}
+
+	return cmd
+}
+
 func GetWorkTimesCommand(root *cli.Root) *cobra.Command {
 	var paths []string
 
@@ -13,6 +13,8 @@ func getRootCommand(root *cli.Root) {
 		cmds.WorkShiftCommand(root),
 		cmds.RosterCommand(root),
 		cmds.ConstraintCommand(root),
+		cmds.HolidayCommand(root),
+		cmds.AuditCommand(root),
 	)
 }
 
@@ -0,0 +1,976 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        (unknown)
+// source: rosterd/v1/holiday.proto
+
+package rosterdv1
+
+import (
+	_ "github.com/tierklinik-dobersberg/apis/gen/go/tkd/common/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type LocalHolidayKind int32
+
+const (
+	LocalHolidayKind_LOCAL_HOLIDAY_KIND_UNSPECIFIED LocalHolidayKind = 0
+	LocalHolidayKind_LOCAL_HOLIDAY_KIND_CLOSURE     LocalHolidayKind = 1
+	LocalHolidayKind_LOCAL_HOLIDAY_KIND_HALF_DAY    LocalHolidayKind = 2
+	LocalHolidayKind_LOCAL_HOLIDAY_KIND_REGIONAL    LocalHolidayKind = 3
+	LocalHolidayKind_LOCAL_HOLIDAY_KIND_OTHER       LocalHolidayKind = 4
+)
+
+// Enum value maps for LocalHolidayKind.
+var (
+	LocalHolidayKind_name = map[int32]string{
+		0: "LOCAL_HOLIDAY_KIND_UNSPECIFIED",
+		1: "LOCAL_HOLIDAY_KIND_CLOSURE",
+		2: "LOCAL_HOLIDAY_KIND_HALF_DAY",
+		3: "LOCAL_HOLIDAY_KIND_REGIONAL",
+		4: "LOCAL_HOLIDAY_KIND_OTHER",
+	}
+	LocalHolidayKind_value = map[string]int32{
+		"LOCAL_HOLIDAY_KIND_UNSPECIFIED": 0,
+		"LOCAL_HOLIDAY_KIND_CLOSURE":     1,
+		"LOCAL_HOLIDAY_KIND_HALF_DAY":    2,
+		"LOCAL_HOLIDAY_KIND_REGIONAL":    3,
+		"LOCAL_HOLIDAY_KIND_OTHER":       4,
+	}
+)
+
+func (x LocalHolidayKind) Enum() *LocalHolidayKind {
+	p := new(LocalHolidayKind)
+	*p = x
+	return p
+}
+
+func (x LocalHolidayKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LocalHolidayKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_rosterd_v1_holiday_proto_enumTypes[0].Descriptor()
+}
+
+func (LocalHolidayKind) Type() protoreflect.EnumType {
+	return &file_rosterd_v1_holiday_proto_enumTypes[0]
+}
+
+func (x LocalHolidayKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LocalHolidayKind.Descriptor instead.
+func (LocalHolidayKind) EnumDescriptor() ([]byte, []int) {
+	return file_rosterd_v1_holiday_proto_rawDescGZIP(), []int{0}
+}
+
+// LocalHoliday is a clinic specific holiday or closure day. Local holidays
+// are merged with the public holidays of the calendar service and take
+// precedence over them. There may only be one local holiday per date.
+type LocalHoliday struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Date is the date of the holiday in the format YYYY-MM-DD.
+	Date        string           `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	Name        string           `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description string           `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Kind        LocalHolidayKind `protobuf:"varint,5,opt,name=kind,proto3,enum=rosterd.v1.LocalHolidayKind" json:"kind,omitempty"`
+	// CountsAsWorkday is set if the day still counts as a working day when
+	// calculating the expected work time of employees.
+	CountsAsWorkday bool `protobuf:"varint,6,opt,name=counts_as_workday,json=countsAsWorkday,proto3" json:"counts_as_workday,omitempty"`
+	// HolidayShifts is set if work-shifts marked with on_holiday apply
+	// instead of the regular shifts of the weekday.
+	HolidayShifts bool                   `protobuf:"varint,7,opt,name=holiday_shifts,json=holidayShifts,proto3" json:"holiday_shifts,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatorId     string                 `protobuf:"bytes,9,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	// WorkdayFraction is the part of a regular working day that is expected
+	// from employees if counts_as_workday is set, e.g. 0.5 for half days.
+	// Zero counts as a full working day.
+	WorkdayFraction float64 `protobuf:"fixed64,10,opt,name=workday_fraction,json=workdayFraction,proto3" json:"workday_fraction,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *LocalHoliday) Reset() {
+	*x = LocalHoliday{}
+	mi := &file_rosterd_v1_holiday_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LocalHoliday) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocalHoliday) ProtoMessage() {}
+
+func (x *LocalHoliday) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_holiday_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocalHoliday.ProtoReflect.Descriptor instead.
+func (*LocalHoliday) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_holiday_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LocalHoliday) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LocalHoliday) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *LocalHoliday) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LocalHoliday) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *LocalHoliday) GetKind() LocalHolidayKind {
+	if x != nil {
+		return x.Kind
+	}
+	return LocalHolidayKind_LOCAL_HOLIDAY_KIND_UNSPECIFIED
+}
+
+func (x *LocalHoliday) GetCountsAsWorkday() bool {
+	if x != nil {
+		return x.CountsAsWorkday
+	}
+	return false
+}
+
+func (x *LocalHoliday) GetHolidayShifts() bool {
+	if x != nil {
+		return x.HolidayShifts
+	}
+	return false
+}
+
+func (x *LocalHoliday) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *LocalHoliday) GetCreatorId() string {
+	if x != nil {
+		return x.CreatorId
+	}
+	return ""
+}
+
+func (x *LocalHoliday) GetWorkdayFraction() float64 {
+	if x != nil {
+		return x.WorkdayFraction
+	}
+	return 0
+}
+
+// EffectiveHoliday describes a holiday after merging the public holidays of
+// the calendar service with the local holidays.
+type EffectiveHoliday struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Date  string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Local is set if the holiday is defined in the local holiday store.
+	Local           *LocalHoliday `protobuf:"bytes,3,opt,name=local,proto3" json:"local,omitempty"`
+	CountsAsWorkday bool          `protobuf:"varint,4,opt,name=counts_as_workday,json=countsAsWorkday,proto3" json:"counts_as_workday,omitempty"`
+	HolidayShifts   bool          `protobuf:"varint,5,opt,name=holiday_shifts,json=holidayShifts,proto3" json:"holiday_shifts,omitempty"`
+	// WorkdayFraction is the part of a regular working day that is expected
+	// on this day. It is zero for days off.
+	WorkdayFraction float64 `protobuf:"fixed64,6,opt,name=workday_fraction,json=workdayFraction,proto3" json:"workday_fraction,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *EffectiveHoliday) Reset() {
+	*x = EffectiveHoliday{}
+	mi := &file_rosterd_v1_holiday_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EffectiveHoliday) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EffectiveHoliday) ProtoMessage() {}
+
+func (x *EffectiveHoliday) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_holiday_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EffectiveHoliday.ProtoReflect.Descriptor instead.
+func (*EffectiveHoliday) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_holiday_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EffectiveHoliday) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *EffectiveHoliday) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EffectiveHoliday) GetLocal() *LocalHoliday {
+	if x != nil {
+		return x.Local
+	}
+	return nil
+}
+
+func (x *EffectiveHoliday) GetCountsAsWorkday() bool {
+	if x != nil {
+		return x.CountsAsWorkday
+	}
+	return false
+}
+
+func (x *EffectiveHoliday) GetHolidayShifts() bool {
+	if x != nil {
+		return x.HolidayShifts
+	}
+	return false
+}
+
+func (x *EffectiveHoliday) GetWorkdayFraction() float64 {
+	if x != nil {
+		return x.WorkdayFraction
+	}
+	return 0
+}
+
+type CreateLocalHolidayRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Holiday       *LocalHoliday          `protobuf:"bytes,1,opt,name=holiday,proto3" json:"holiday,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateLocalHolidayRequest) Reset() {
+	*x = CreateLocalHolidayRequest{}
+	mi := &file_rosterd_v1_holiday_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateLocalHolidayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLocalHolidayRequest) ProtoMessage() {}
+
+func (x *CreateLocalHolidayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_holiday_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLocalHolidayRequest.ProtoReflect.Descriptor instead.
+func (*CreateLocalHolidayRequest) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_holiday_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateLocalHolidayRequest) GetHoliday() *LocalHoliday {
+	if x != nil {
+		return x.Holiday
+	}
+	return nil
+}
+
+type CreateLocalHolidayResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Holiday       *LocalHoliday          `protobuf:"bytes,1,opt,name=holiday,proto3" json:"holiday,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateLocalHolidayResponse) Reset() {
+	*x = CreateLocalHolidayResponse{}
+	mi := &file_rosterd_v1_holiday_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateLocalHolidayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLocalHolidayResponse) ProtoMessage() {}
+
+func (x *CreateLocalHolidayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_holiday_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLocalHolidayResponse.ProtoReflect.Descriptor instead.
+func (*CreateLocalHolidayResponse) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_holiday_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateLocalHolidayResponse) GetHoliday() *LocalHoliday {
+	if x != nil {
+		return x.Holiday
+	}
+	return nil
+}
+
+type UpdateLocalHolidayRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Holiday replaces the local holiday with the same ID.
+	Holiday       *LocalHoliday `protobuf:"bytes,1,opt,name=holiday,proto3" json:"holiday,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateLocalHolidayRequest) Reset() {
+	*x = UpdateLocalHolidayRequest{}
+	mi := &file_rosterd_v1_holiday_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateLocalHolidayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLocalHolidayRequest) ProtoMessage() {}
+
+func (x *UpdateLocalHolidayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_holiday_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLocalHolidayRequest.ProtoReflect.Descriptor instead.
+func (*UpdateLocalHolidayRequest) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_holiday_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateLocalHolidayRequest) GetHoliday() *LocalHoliday {
+	if x != nil {
+		return x.Holiday
+	}
+	return nil
+}
+
+type UpdateLocalHolidayResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Holiday       *LocalHoliday          `protobuf:"bytes,1,opt,name=holiday,proto3" json:"holiday,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateLocalHolidayResponse) Reset() {
+	*x = UpdateLocalHolidayResponse{}
+	mi := &file_rosterd_v1_holiday_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateLocalHolidayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLocalHolidayResponse) ProtoMessage() {}
+
+func (x *UpdateLocalHolidayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_holiday_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLocalHolidayResponse.ProtoReflect.Descriptor instead.
+func (*UpdateLocalHolidayResponse) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_holiday_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateLocalHolidayResponse) GetHoliday() *LocalHoliday {
+	if x != nil {
+		return x.Holiday
+	}
+	return nil
+}
+
+type DeleteLocalHolidayRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteLocalHolidayRequest) Reset() {
+	*x = DeleteLocalHolidayRequest{}
+	mi := &file_rosterd_v1_holiday_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLocalHolidayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLocalHolidayRequest) ProtoMessage() {}
+
+func (x *DeleteLocalHolidayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_holiday_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLocalHolidayRequest.ProtoReflect.Descriptor instead.
+func (*DeleteLocalHolidayRequest) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_holiday_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteLocalHolidayRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteLocalHolidayResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteLocalHolidayResponse) Reset() {
+	*x = DeleteLocalHolidayResponse{}
+	mi := &file_rosterd_v1_holiday_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLocalHolidayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLocalHolidayResponse) ProtoMessage() {}
+
+func (x *DeleteLocalHolidayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_holiday_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLocalHolidayResponse.ProtoReflect.Descriptor instead.
+func (*DeleteLocalHolidayResponse) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_holiday_proto_rawDescGZIP(), []int{7}
+}
+
+type ListLocalHolidaysRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// From and To (YYYY-MM-DD, inclusive) limit the returned holidays. Empty
+	// values leave the range open.
+	From          string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLocalHolidaysRequest) Reset() {
+	*x = ListLocalHolidaysRequest{}
+	mi := &file_rosterd_v1_holiday_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLocalHolidaysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLocalHolidaysRequest) ProtoMessage() {}
+
+func (x *ListLocalHolidaysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_holiday_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLocalHolidaysRequest.ProtoReflect.Descriptor instead.
+func (*ListLocalHolidaysRequest) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_holiday_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListLocalHolidaysRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *ListLocalHolidaysRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type ListLocalHolidaysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Holidays      []*LocalHoliday        `protobuf:"bytes,1,rep,name=holidays,proto3" json:"holidays,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLocalHolidaysResponse) Reset() {
+	*x = ListLocalHolidaysResponse{}
+	mi := &file_rosterd_v1_holiday_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLocalHolidaysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLocalHolidaysResponse) ProtoMessage() {}
+
+func (x *ListLocalHolidaysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_holiday_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLocalHolidaysResponse.ProtoReflect.Descriptor instead.
+func (*ListLocalHolidaysResponse) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_holiday_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListLocalHolidaysResponse) GetHolidays() []*LocalHoliday {
+	if x != nil {
+		return x.Holidays
+	}
+	return nil
+}
+
+type ListEffectiveHolidaysRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// From and To (YYYY-MM-DD, inclusive) are required.
+	From          string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEffectiveHolidaysRequest) Reset() {
+	*x = ListEffectiveHolidaysRequest{}
+	mi := &file_rosterd_v1_holiday_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEffectiveHolidaysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEffectiveHolidaysRequest) ProtoMessage() {}
+
+func (x *ListEffectiveHolidaysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_holiday_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEffectiveHolidaysRequest.ProtoReflect.Descriptor instead.
+func (*ListEffectiveHolidaysRequest) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_holiday_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListEffectiveHolidaysRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *ListEffectiveHolidaysRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type ListEffectiveHolidaysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Holidays      []*EffectiveHoliday    `protobuf:"bytes,1,rep,name=holidays,proto3" json:"holidays,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEffectiveHolidaysResponse) Reset() {
+	*x = ListEffectiveHolidaysResponse{}
+	mi := &file_rosterd_v1_holiday_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEffectiveHolidaysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEffectiveHolidaysResponse) ProtoMessage() {}
+
+func (x *ListEffectiveHolidaysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_holiday_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEffectiveHolidaysResponse.ProtoReflect.Descriptor instead.
+func (*ListEffectiveHolidaysResponse) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_holiday_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListEffectiveHolidaysResponse) GetHolidays() []*EffectiveHoliday {
+	if x != nil {
+		return x.Holidays
+	}
+	return nil
+}
+
+var File_rosterd_v1_holiday_proto protoreflect.FileDescriptor
+
+var file_rosterd_v1_holiday_proto_rawDesc = string([]byte{
+	0x0a, 0x18, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2f, 0x76, 0x31, 0x2f, 0x68, 0x6f, 0x6c,
+	0x69, 0x64, 0x61, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x72, 0x6f, 0x73, 0x74,
+	0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x74, 0x6b, 0x64, 0x2f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf2, 0x02, 0x0a, 0x0c, 0x4c, 0x6f, 0x63, 0x61,
+	0x6c, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1c, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f,
+	0x63, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x5f, 0x61,
+	0x73, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x64, 0x61, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x41, 0x73, 0x57, 0x6f, 0x72, 0x6b, 0x64, 0x61, 0x79,
+	0x12, 0x25, 0x0a, 0x0e, 0x68, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x5f, 0x73, 0x68, 0x69, 0x66,
+	0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x68, 0x6f, 0x6c, 0x69, 0x64, 0x61,
+	0x79, 0x53, 0x68, 0x69, 0x66, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49,
+	0x64, 0x12, 0x29, 0x0a, 0x10, 0x77, 0x6f, 0x72, 0x6b, 0x64, 0x61, 0x79, 0x5f, 0x66, 0x72, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x77, 0x6f, 0x72,
+	0x6b, 0x64, 0x61, 0x79, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xe8, 0x01, 0x0a,
+	0x10, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61,
+	0x79, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65,
+	0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x69, 0x64,
+	0x61, 0x79, 0x52, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x12, 0x2a, 0x0a, 0x11, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x5f, 0x61, 0x73, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x64, 0x61, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x41, 0x73, 0x57, 0x6f,
+	0x72, 0x6b, 0x64, 0x61, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x68, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79,
+	0x5f, 0x73, 0x68, 0x69, 0x66, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x68,
+	0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x53, 0x68, 0x69, 0x66, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x10,
+	0x77, 0x6f, 0x72, 0x6b, 0x64, 0x61, 0x79, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x77, 0x6f, 0x72, 0x6b, 0x64, 0x61, 0x79, 0x46,
+	0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x4f, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x68, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x52,
+	0x07, 0x68, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x22, 0x50, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x68, 0x6f, 0x6c, 0x69, 0x64, 0x61,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61,
+	0x79, 0x52, 0x07, 0x68, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x22, 0x4f, 0x0a, 0x19, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x68, 0x6f, 0x6c, 0x69, 0x64,
+	0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65,
+	0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x69, 0x64,
+	0x61, 0x79, 0x52, 0x07, 0x68, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x22, 0x50, 0x0a, 0x1a, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x68, 0x6f, 0x6c,
+	0x69, 0x64, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x72, 0x6f, 0x73,
+	0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x48, 0x6f, 0x6c,
+	0x69, 0x64, 0x61, 0x79, 0x52, 0x07, 0x68, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x22, 0x2b, 0x0a,
+	0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x69,
+	0x64, 0x61, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1c, 0x0a, 0x1a, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3e, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74,
+	0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x22, 0x51, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74,
+	0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x68, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61,
+	0x79, 0x52, 0x08, 0x68, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x73, 0x22, 0x42, 0x0a, 0x1c, 0x4c,
+	0x69, 0x73, 0x74, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x48, 0x6f, 0x6c, 0x69,
+	0x64, 0x61, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12,
+	0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x22,
+	0x59, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x38, 0x0a, 0x08, 0x68, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79,
+	0x52, 0x08, 0x68, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x73, 0x2a, 0xb6, 0x01, 0x0a, 0x10, 0x4c,
+	0x6f, 0x63, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x4b, 0x69, 0x6e, 0x64, 0x12,
+	0x22, 0x0a, 0x1e, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x5f, 0x48, 0x4f, 0x4c, 0x49, 0x44, 0x41, 0x59,
+	0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x5f, 0x48, 0x4f, 0x4c,
+	0x49, 0x44, 0x41, 0x59, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x55, 0x52,
+	0x45, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x5f, 0x48, 0x4f, 0x4c,
+	0x49, 0x44, 0x41, 0x59, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x48, 0x41, 0x4c, 0x46, 0x5f, 0x44,
+	0x41, 0x59, 0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x5f, 0x48, 0x4f,
+	0x4c, 0x49, 0x44, 0x41, 0x59, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x52, 0x45, 0x47, 0x49, 0x4f,
+	0x4e, 0x41, 0x4c, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x5f, 0x48,
+	0x4f, 0x4c, 0x49, 0x44, 0x41, 0x59, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x4f, 0x54, 0x48, 0x45,
+	0x52, 0x10, 0x04, 0x32, 0xc7, 0x04, 0x0a, 0x0e, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x6a, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x12, 0x25, 0x2e, 0x72,
+	0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x69,
+	0x64, 0x61, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x05, 0xb2, 0x7e, 0x02,
+	0x08, 0x02, 0x12, 0x6a, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x63, 0x61,
+	0x6c, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x12, 0x25, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65,
+	0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x63, 0x61,
+	0x6c, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x26, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x05, 0xb2, 0x7e, 0x02, 0x08, 0x02, 0x12, 0x6a,
+	0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x48, 0x6f, 0x6c,
+	0x69, 0x64, 0x61, 0x79, 0x12, 0x25, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x48, 0x6f, 0x6c,
+	0x69, 0x64, 0x61, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x72, 0x6f,
+	0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c,
+	0x6f, 0x63, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x05, 0xb2, 0x7e, 0x02, 0x08, 0x02, 0x12, 0x67, 0x0a, 0x11, 0x4c, 0x69,
+	0x73, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x73, 0x12,
+	0x24, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x69,
+	0x64, 0x61, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x05, 0xb2, 0x7e,
+	0x02, 0x08, 0x01, 0x12, 0x73, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x66, 0x66, 0x65, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x73, 0x12, 0x28, 0x2e, 0x72,
+	0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x66,
+	0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x05, 0xb2, 0x7e, 0x02, 0x08, 0x01, 0x1a, 0x13, 0xba, 0x7e, 0x10, 0x0a, 0x0e, 0x72,
+	0x6f, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x42, 0x46, 0x5a,
+	0x44, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x69, 0x65, 0x72,
+	0x6b, 0x6c, 0x69, 0x6e, 0x69, 0x6b, 0x2d, 0x64, 0x6f, 0x62, 0x65, 0x72, 0x73, 0x62, 0x65, 0x72,
+	0x67, 0x2f, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f,
+	0x2f, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2f, 0x76, 0x31, 0x3b, 0x72, 0x6f, 0x73, 0x74,
+	0x65, 0x72, 0x64, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_rosterd_v1_holiday_proto_rawDescOnce sync.Once
+	file_rosterd_v1_holiday_proto_rawDescData []byte
+)
+
+func file_rosterd_v1_holiday_proto_rawDescGZIP() []byte {
+	file_rosterd_v1_holiday_proto_rawDescOnce.Do(func() {
+		file_rosterd_v1_holiday_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rosterd_v1_holiday_proto_rawDesc), len(file_rosterd_v1_holiday_proto_rawDesc)))
+	})
+	return file_rosterd_v1_holiday_proto_rawDescData
+}
+
+var file_rosterd_v1_holiday_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_rosterd_v1_holiday_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_rosterd_v1_holiday_proto_goTypes = []any{
+	(LocalHolidayKind)(0),                 // 0: rosterd.v1.LocalHolidayKind
+	(*LocalHoliday)(nil),                  // 1: rosterd.v1.LocalHoliday
+	(*EffectiveHoliday)(nil),              // 2: rosterd.v1.EffectiveHoliday
+	(*CreateLocalHolidayRequest)(nil),     // 3: rosterd.v1.CreateLocalHolidayRequest
+	(*CreateLocalHolidayResponse)(nil),    // 4: rosterd.v1.CreateLocalHolidayResponse
+	(*UpdateLocalHolidayRequest)(nil),     // 5: rosterd.v1.UpdateLocalHolidayRequest
+	(*UpdateLocalHolidayResponse)(nil),    // 6: rosterd.v1.UpdateLocalHolidayResponse
+	(*DeleteLocalHolidayRequest)(nil),     // 7: rosterd.v1.DeleteLocalHolidayRequest
+	(*DeleteLocalHolidayResponse)(nil),    // 8: rosterd.v1.DeleteLocalHolidayResponse
+	(*ListLocalHolidaysRequest)(nil),      // 9: rosterd.v1.ListLocalHolidaysRequest
+	(*ListLocalHolidaysResponse)(nil),     // 10: rosterd.v1.ListLocalHolidaysResponse
+	(*ListEffectiveHolidaysRequest)(nil),  // 11: rosterd.v1.ListEffectiveHolidaysRequest
+	(*ListEffectiveHolidaysResponse)(nil), // 12: rosterd.v1.ListEffectiveHolidaysResponse
+	(*timestamppb.Timestamp)(nil),         // 13: google.protobuf.Timestamp
+}
+var file_rosterd_v1_holiday_proto_depIdxs = []int32{
+	0,  // 0: rosterd.v1.LocalHoliday.kind:type_name -> rosterd.v1.LocalHolidayKind
+	13, // 1: rosterd.v1.LocalHoliday.created_at:type_name -> google.protobuf.Timestamp
+	1,  // 2: rosterd.v1.EffectiveHoliday.local:type_name -> rosterd.v1.LocalHoliday
+	1,  // 3: rosterd.v1.CreateLocalHolidayRequest.holiday:type_name -> rosterd.v1.LocalHoliday
+	1,  // 4: rosterd.v1.CreateLocalHolidayResponse.holiday:type_name -> rosterd.v1.LocalHoliday
+	1,  // 5: rosterd.v1.UpdateLocalHolidayRequest.holiday:type_name -> rosterd.v1.LocalHoliday
+	1,  // 6: rosterd.v1.UpdateLocalHolidayResponse.holiday:type_name -> rosterd.v1.LocalHoliday
+	1,  // 7: rosterd.v1.ListLocalHolidaysResponse.holidays:type_name -> rosterd.v1.LocalHoliday
+	2,  // 8: rosterd.v1.ListEffectiveHolidaysResponse.holidays:type_name -> rosterd.v1.EffectiveHoliday
+	3,  // 9: rosterd.v1.HolidayService.CreateLocalHoliday:input_type -> rosterd.v1.CreateLocalHolidayRequest
+	5,  // 10: rosterd.v1.HolidayService.UpdateLocalHoliday:input_type -> rosterd.v1.UpdateLocalHolidayRequest
+	7,  // 11: rosterd.v1.HolidayService.DeleteLocalHoliday:input_type -> rosterd.v1.DeleteLocalHolidayRequest
+	9,  // 12: rosterd.v1.HolidayService.ListLocalHolidays:input_type -> rosterd.v1.ListLocalHolidaysRequest
+	11, // 13: rosterd.v1.HolidayService.ListEffectiveHolidays:input_type -> rosterd.v1.ListEffectiveHolidaysRequest
+	4,  // 14: rosterd.v1.HolidayService.CreateLocalHoliday:output_type -> rosterd.v1.CreateLocalHolidayResponse
+	6,  // 15: rosterd.v1.HolidayService.UpdateLocalHoliday:output_type -> rosterd.v1.UpdateLocalHolidayResponse
+	8,  // 16: rosterd.v1.HolidayService.DeleteLocalHoliday:output_type -> rosterd.v1.DeleteLocalHolidayResponse
+	10, // 17: rosterd.v1.HolidayService.ListLocalHolidays:output_type -> rosterd.v1.ListLocalHolidaysResponse
+	12, // 18: rosterd.v1.HolidayService.ListEffectiveHolidays:output_type -> rosterd.v1.ListEffectiveHolidaysResponse
+	14, // [14:19] is the sub-list for method output_type
+	9,  // [9:14] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_rosterd_v1_holiday_proto_init() }
+func file_rosterd_v1_holiday_proto_init() {
+	if File_rosterd_v1_holiday_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rosterd_v1_holiday_proto_rawDesc), len(file_rosterd_v1_holiday_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_rosterd_v1_holiday_proto_goTypes,
+		DependencyIndexes: file_rosterd_v1_holiday_proto_depIdxs,
+		EnumInfos:         file_rosterd_v1_holiday_proto_enumTypes,
+		MessageInfos:      file_rosterd_v1_holiday_proto_msgTypes,
+	}.Build()
+	File_rosterd_v1_holiday_proto = out.File
+	file_rosterd_v1_holiday_proto_goTypes = nil
+	file_rosterd_v1_holiday_proto_depIdxs = nil
+}
@@ -0,0 +1,1208 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        (unknown)
+// source: rosterd/v1/offtime.proto
+
+package rosterdv1
+
+import (
+	_ "github.com/tierklinik-dobersberg/apis/gen/go/tkd/common/v1"
+	v1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/roster/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// VacationBalance describes the projected vacation credits of a user.
+type VacationBalance struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// OfftimeId is set if the balance has been projected for an off-time
+	// request.
+	OfftimeId string `protobuf:"bytes,2,opt,name=offtime_id,json=offtimeId,proto3" json:"offtime_id,omitempty"`
+	// Until is the time at which the balance has been calculated. For
+	// off-time requests this is the end of the request.
+	Until *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=until,proto3" json:"until,omitempty"`
+	// CreditsLeft holds the vacation credits that are left after all booked
+	// off-time costs.
+	CreditsLeft *durationpb.Duration `protobuf:"bytes,4,opt,name=credits_left,json=creditsLeft,proto3" json:"credits_left,omitempty"`
+	// Pending holds the costs of all other pending vacation requests.
+	Pending *durationpb.Duration `protobuf:"bytes,5,opt,name=pending,proto3" json:"pending,omitempty"`
+	// RequestCosts holds the costs of the off-time request. It is only set
+	// for pending vacation requests.
+	RequestCosts *durationpb.Duration `protobuf:"bytes,6,opt,name=request_costs,json=requestCosts,proto3" json:"request_costs,omitempty"`
+	// Projected holds the vacation credits that are left if all pending
+	// requests and the off-time request are approved.
+	Projected *durationpb.Duration `protobuf:"bytes,7,opt,name=projected,proto3" json:"projected,omitempty"`
+	// Exceeded is set if the off-time request exceeds the vacation credits.
+	Exceeded      bool `protobuf:"varint,8,opt,name=exceeded,proto3" json:"exceeded,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VacationBalance) Reset() {
+	*x = VacationBalance{}
+	mi := &file_rosterd_v1_offtime_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VacationBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VacationBalance) ProtoMessage() {}
+
+func (x *VacationBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_offtime_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VacationBalance.ProtoReflect.Descriptor instead.
+func (*VacationBalance) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_offtime_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *VacationBalance) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *VacationBalance) GetOfftimeId() string {
+	if x != nil {
+		return x.OfftimeId
+	}
+	return ""
+}
+
+func (x *VacationBalance) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+func (x *VacationBalance) GetCreditsLeft() *durationpb.Duration {
+	if x != nil {
+		return x.CreditsLeft
+	}
+	return nil
+}
+
+func (x *VacationBalance) GetPending() *durationpb.Duration {
+	if x != nil {
+		return x.Pending
+	}
+	return nil
+}
+
+func (x *VacationBalance) GetRequestCosts() *durationpb.Duration {
+	if x != nil {
+		return x.RequestCosts
+	}
+	return nil
+}
+
+func (x *VacationBalance) GetProjected() *durationpb.Duration {
+	if x != nil {
+		return x.Projected
+	}
+	return nil
+}
+
+func (x *VacationBalance) GetExceeded() bool {
+	if x != nil {
+		return x.Exceeded
+	}
+	return false
+}
+
+type GetVacationBalanceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UserIds holds the IDs of the users for which the current balance
+	// should be returned. Only administrators may request the balance of
+	// other users.
+	UserIds []string `protobuf:"bytes,1,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	// OfftimeIds holds the IDs of off-time requests for which the balance
+	// should be projected.
+	OfftimeIds    []string `protobuf:"bytes,2,rep,name=offtime_ids,json=offtimeIds,proto3" json:"offtime_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVacationBalanceRequest) Reset() {
+	*x = GetVacationBalanceRequest{}
+	mi := &file_rosterd_v1_offtime_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVacationBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVacationBalanceRequest) ProtoMessage() {}
+
+func (x *GetVacationBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_offtime_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVacationBalanceRequest.ProtoReflect.Descriptor instead.
+func (*GetVacationBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_offtime_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetVacationBalanceRequest) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *GetVacationBalanceRequest) GetOfftimeIds() []string {
+	if x != nil {
+		return x.OfftimeIds
+	}
+	return nil
+}
+
+type GetVacationBalanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*VacationBalance     `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVacationBalanceResponse) Reset() {
+	*x = GetVacationBalanceResponse{}
+	mi := &file_rosterd_v1_offtime_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVacationBalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVacationBalanceResponse) ProtoMessage() {}
+
+func (x *GetVacationBalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_offtime_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVacationBalanceResponse.ProtoReflect.Descriptor instead.
+func (*GetVacationBalanceResponse) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_offtime_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetVacationBalanceResponse) GetResults() []*VacationBalance {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type CheckOffTimeRequestRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Request is the off-time request that should be checked. It is not
+	// created.
+	Request       *v1.CreateOffTimeRequestRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckOffTimeRequestRequest) Reset() {
+	*x = CheckOffTimeRequestRequest{}
+	mi := &file_rosterd_v1_offtime_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckOffTimeRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckOffTimeRequestRequest) ProtoMessage() {}
+
+func (x *CheckOffTimeRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_offtime_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckOffTimeRequestRequest.ProtoReflect.Descriptor instead.
+func (*CheckOffTimeRequestRequest) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_offtime_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CheckOffTimeRequestRequest) GetRequest() *v1.CreateOffTimeRequestRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type CheckOffTimeRequestResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Balance holds the projected vacation balance of the requestor.
+	Balance *VacationBalance `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance,omitempty"`
+	// Warnings holds human readable warnings for the request.
+	Warnings []string `protobuf:"bytes,2,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	// Rejected is set if CreateOffTimeRequest would reject the request.
+	Rejected bool `protobuf:"varint,3,opt,name=rejected,proto3" json:"rejected,omitempty"`
+	// Conflicts holds all off-time rules that are violated by the request.
+	Conflicts     []*OffTimeRuleConflict `protobuf:"bytes,4,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckOffTimeRequestResponse) Reset() {
+	*x = CheckOffTimeRequestResponse{}
+	mi := &file_rosterd_v1_offtime_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckOffTimeRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckOffTimeRequestResponse) ProtoMessage() {}
+
+func (x *CheckOffTimeRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_offtime_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckOffTimeRequestResponse.ProtoReflect.Descriptor instead.
+func (*CheckOffTimeRequestResponse) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_offtime_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CheckOffTimeRequestResponse) GetBalance() *VacationBalance {
+	if x != nil {
+		return x.Balance
+	}
+	return nil
+}
+
+func (x *CheckOffTimeRequestResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+func (x *CheckOffTimeRequestResponse) GetRejected() bool {
+	if x != nil {
+		return x.Rejected
+	}
+	return false
+}
+
+func (x *CheckOffTimeRequestResponse) GetConflicts() []*OffTimeRuleConflict {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+// OffTimeRule restricts off-time requests of the affected users. A rule
+// either blocks all off-time requests (blackout) or limits the number of
+// users that may be absent at the same time.
+type OffTimeRule struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// From is the first day (YYYY-MM-DD) the rule applies to. If empty, the
+	// rule applies to all days before to.
+	From string `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	// To is the last day (YYYY-MM-DD, inclusive) the rule applies to. If
+	// empty, the rule applies to all days after from.
+	To string `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	// RoleIds and UserIds hold the roles and users the rule applies to. If
+	// both are empty the rule applies to all users.
+	RoleIds []string `protobuf:"bytes,6,rep,name=role_ids,json=roleIds,proto3" json:"role_ids,omitempty"`
+	UserIds []string `protobuf:"bytes,7,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	// MaxConcurrent is the maximum number of affected users that may be
+	// absent at the same time. Zero blocks all off-time requests.
+	MaxConcurrent int32                  `protobuf:"varint,8,opt,name=max_concurrent,json=maxConcurrent,proto3" json:"max_concurrent,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatorId     string                 `protobuf:"bytes,10,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OffTimeRule) Reset() {
+	*x = OffTimeRule{}
+	mi := &file_rosterd_v1_offtime_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OffTimeRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OffTimeRule) ProtoMessage() {}
+
+func (x *OffTimeRule) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_offtime_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OffTimeRule.ProtoReflect.Descriptor instead.
+func (*OffTimeRule) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_offtime_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *OffTimeRule) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *OffTimeRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OffTimeRule) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *OffTimeRule) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *OffTimeRule) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *OffTimeRule) GetRoleIds() []string {
+	if x != nil {
+		return x.RoleIds
+	}
+	return nil
+}
+
+func (x *OffTimeRule) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *OffTimeRule) GetMaxConcurrent() int32 {
+	if x != nil {
+		return x.MaxConcurrent
+	}
+	return 0
+}
+
+func (x *OffTimeRule) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *OffTimeRule) GetCreatorId() string {
+	if x != nil {
+		return x.CreatorId
+	}
+	return ""
+}
+
+// OffTimeRuleConflict describes an off-time rule that is violated by an
+// off-time request.
+type OffTimeRuleConflict struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Rule    *OffTimeRule           `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// ConflictingRequestIds holds the IDs of the approved off-time requests
+	// that cause the concurrency limit to be exceeded.
+	ConflictingRequestIds []string `protobuf:"bytes,3,rep,name=conflicting_request_ids,json=conflictingRequestIds,proto3" json:"conflicting_request_ids,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *OffTimeRuleConflict) Reset() {
+	*x = OffTimeRuleConflict{}
+	mi := &file_rosterd_v1_offtime_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OffTimeRuleConflict) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OffTimeRuleConflict) ProtoMessage() {}
+
+func (x *OffTimeRuleConflict) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_offtime_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OffTimeRuleConflict.ProtoReflect.Descriptor instead.
+func (*OffTimeRuleConflict) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_offtime_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *OffTimeRuleConflict) GetRule() *OffTimeRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+func (x *OffTimeRuleConflict) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *OffTimeRuleConflict) GetConflictingRequestIds() []string {
+	if x != nil {
+		return x.ConflictingRequestIds
+	}
+	return nil
+}
+
+// OffTimeRuleConflicts is attached as an error detail if an off-time request
+// is refused because of rule conflicts.
+type OffTimeRuleConflicts struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Conflicts     []*OffTimeRuleConflict `protobuf:"bytes,1,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OffTimeRuleConflicts) Reset() {
+	*x = OffTimeRuleConflicts{}
+	mi := &file_rosterd_v1_offtime_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OffTimeRuleConflicts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OffTimeRuleConflicts) ProtoMessage() {}
+
+func (x *OffTimeRuleConflicts) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_offtime_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OffTimeRuleConflicts.ProtoReflect.Descriptor instead.
+func (*OffTimeRuleConflicts) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_offtime_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *OffTimeRuleConflicts) GetConflicts() []*OffTimeRuleConflict {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+type CreateOffTimeRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *OffTimeRule           `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOffTimeRuleRequest) Reset() {
+	*x = CreateOffTimeRuleRequest{}
+	mi := &file_rosterd_v1_offtime_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOffTimeRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOffTimeRuleRequest) ProtoMessage() {}
+
+func (x *CreateOffTimeRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_offtime_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOffTimeRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateOffTimeRuleRequest) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_offtime_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreateOffTimeRuleRequest) GetRule() *OffTimeRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type CreateOffTimeRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *OffTimeRule           `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOffTimeRuleResponse) Reset() {
+	*x = CreateOffTimeRuleResponse{}
+	mi := &file_rosterd_v1_offtime_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOffTimeRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOffTimeRuleResponse) ProtoMessage() {}
+
+func (x *CreateOffTimeRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_offtime_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOffTimeRuleResponse.ProtoReflect.Descriptor instead.
+func (*CreateOffTimeRuleResponse) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_offtime_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CreateOffTimeRuleResponse) GetRule() *OffTimeRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type UpdateOffTimeRuleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Rule replaces the rule with the same ID.
+	Rule          *OffTimeRule `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOffTimeRuleRequest) Reset() {
+	*x = UpdateOffTimeRuleRequest{}
+	mi := &file_rosterd_v1_offtime_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOffTimeRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOffTimeRuleRequest) ProtoMessage() {}
+
+func (x *UpdateOffTimeRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_offtime_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOffTimeRuleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateOffTimeRuleRequest) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_offtime_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateOffTimeRuleRequest) GetRule() *OffTimeRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type UpdateOffTimeRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *OffTimeRule           `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOffTimeRuleResponse) Reset() {
+	*x = UpdateOffTimeRuleResponse{}
+	mi := &file_rosterd_v1_offtime_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOffTimeRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOffTimeRuleResponse) ProtoMessage() {}
+
+func (x *UpdateOffTimeRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_offtime_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOffTimeRuleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateOffTimeRuleResponse) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_offtime_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdateOffTimeRuleResponse) GetRule() *OffTimeRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type DeleteOffTimeRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteOffTimeRuleRequest) Reset() {
+	*x = DeleteOffTimeRuleRequest{}
+	mi := &file_rosterd_v1_offtime_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteOffTimeRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOffTimeRuleRequest) ProtoMessage() {}
+
+func (x *DeleteOffTimeRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_offtime_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteOffTimeRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteOffTimeRuleRequest) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_offtime_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeleteOffTimeRuleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteOffTimeRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteOffTimeRuleResponse) Reset() {
+	*x = DeleteOffTimeRuleResponse{}
+	mi := &file_rosterd_v1_offtime_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteOffTimeRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOffTimeRuleResponse) ProtoMessage() {}
+
+func (x *DeleteOffTimeRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_offtime_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteOffTimeRuleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteOffTimeRuleResponse) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_offtime_proto_rawDescGZIP(), []int{13}
+}
+
+type ListOffTimeRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOffTimeRulesRequest) Reset() {
+	*x = ListOffTimeRulesRequest{}
+	mi := &file_rosterd_v1_offtime_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOffTimeRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOffTimeRulesRequest) ProtoMessage() {}
+
+func (x *ListOffTimeRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_offtime_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOffTimeRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListOffTimeRulesRequest) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_offtime_proto_rawDescGZIP(), []int{14}
+}
+
+type ListOffTimeRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*OffTimeRule         `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOffTimeRulesResponse) Reset() {
+	*x = ListOffTimeRulesResponse{}
+	mi := &file_rosterd_v1_offtime_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOffTimeRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOffTimeRulesResponse) ProtoMessage() {}
+
+func (x *ListOffTimeRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_offtime_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOffTimeRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListOffTimeRulesResponse) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_offtime_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListOffTimeRulesResponse) GetRules() []*OffTimeRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+var File_rosterd_v1_offtime_proto protoreflect.FileDescriptor
+
+var file_rosterd_v1_offtime_proto_rawDesc = string([]byte{
+	0x0a, 0x18, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x66, 0x66,
+	0x74, 0x69, 0x6d, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x72, 0x6f, 0x73, 0x74,
+	0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x74, 0x6b, 0x64, 0x2f, 0x72, 0x6f, 0x73,
+	0x74, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x66, 0x66, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x74, 0x6b, 0x64, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2f, 0x76, 0x31, 0x2f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x83, 0x03, 0x0a, 0x0f, 0x56, 0x61, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x66, 0x66, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x66, 0x66, 0x74, 0x69, 0x6d, 0x65, 0x49, 0x64,
+	0x12, 0x30, 0x0a, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x75, 0x6e, 0x74,
+	0x69, 0x6c, 0x12, 0x3c, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x5f, 0x6c, 0x65,
+	0x66, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x4c, 0x65, 0x66, 0x74,
+	0x12, 0x33, 0x0a, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x3e, 0x0a, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x5f, 0x63, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x43, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x37, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x65, 0x78, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x65, 0x78, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x22, 0x57, 0x0a, 0x19, 0x47, 0x65,
+	0x74, 0x56, 0x61, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x66, 0x66, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x66, 0x66, 0x74, 0x69, 0x6d, 0x65,
+	0x49, 0x64, 0x73, 0x22, 0x53, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x35, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x56, 0x61, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52,
+	0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x62, 0x0a, 0x1a, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x4f, 0x66, 0x66, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x74, 0x6b, 0x64, 0x2e, 0x72, 0x6f,
+	0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x66,
+	0x66, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xcb, 0x01, 0x0a,
+	0x1b, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4f, 0x66, 0x66, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x07,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x3d, 0x0a, 0x09, 0x63,
+	0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x66, 0x66, 0x54,
+	0x69, 0x6d, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x52,
+	0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x22, 0xae, 0x02, 0x0a, 0x0b, 0x4f,
+	0x66, 0x66, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x74, 0x6f, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x73, 0x12,
+	0x19, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x61,
+	0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x22, 0x94, 0x01, 0x0a, 0x13,
+	0x4f, 0x66, 0x66, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x6c,
+	0x69, 0x63, 0x74, 0x12, 0x2b, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4f,
+	0x66, 0x66, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x63, 0x6f,
+	0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x15, 0x63, 0x6f, 0x6e,
+	0x66, 0x6c, 0x69, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
+	0x64, 0x73, 0x22, 0x55, 0x0a, 0x14, 0x4f, 0x66, 0x66, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x75, 0x6c,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x12, 0x3d, 0x0a, 0x09, 0x63, 0x6f,
+	0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x66, 0x66, 0x54, 0x69,
+	0x6d, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x52, 0x09,
+	0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x22, 0x47, 0x0a, 0x18, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x4f, 0x66, 0x66, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x75,
+	0x6c, 0x65, 0x22, 0x48, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x54,
+	0x69, 0x6d, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2b, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x66, 0x66, 0x54, 0x69,
+	0x6d, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x22, 0x47, 0x0a, 0x18,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x75, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x66, 0x66, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x52,
+	0x04, 0x72, 0x75, 0x6c, 0x65, 0x22, 0x48, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f,
+	0x66, 0x66, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x66,
+	0x66, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x22,
+	0x2a, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x54, 0x69, 0x6d, 0x65,
+	0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1b, 0x0a, 0x19, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x75, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74,
+	0x4f, 0x66, 0x66, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x49, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x66, 0x54, 0x69,
+	0x6d, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2d, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x66, 0x66, 0x54,
+	0x69, 0x6d, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x32, 0xa1,
+	0x05, 0x0a, 0x0e, 0x4f, 0x66, 0x66, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x6a, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x25, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26,
+	0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x56,
+	0x61, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x05, 0xb2, 0x7e, 0x02, 0x08, 0x01, 0x12, 0x6d, 0x0a,
+	0x13, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4f, 0x66, 0x66, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4f, 0x66, 0x66, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x72,
+	0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4f,
+	0x66, 0x66, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x05, 0xb2, 0x7e, 0x02, 0x08, 0x01, 0x12, 0x67, 0x0a, 0x11,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x75, 0x6c,
+	0x65, 0x12, 0x24, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x75, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x54, 0x69,
+	0x6d, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x05,
+	0xb2, 0x7e, 0x02, 0x08, 0x02, 0x12, 0x67, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f,
+	0x66, 0x66, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x24, 0x2e, 0x72, 0x6f, 0x73,
+	0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x66,
+	0x66, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x25, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x05, 0xb2, 0x7e, 0x02, 0x08, 0x02, 0x12, 0x67,
+	0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x54, 0x69, 0x6d, 0x65, 0x52,
+	0x75, 0x6c, 0x65, 0x12, 0x24, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x75,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x72, 0x6f, 0x73, 0x74,
+	0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x66, 0x66,
+	0x54, 0x69, 0x6d, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x05, 0xb2, 0x7e, 0x02, 0x08, 0x02, 0x12, 0x64, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x4f,
+	0x66, 0x66, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x23, 0x2e, 0x72, 0x6f,
+	0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x66,
+	0x54, 0x69, 0x6d, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x24, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x4f, 0x66, 0x66, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x05, 0xb2, 0x7e, 0x02, 0x08, 0x01, 0x1a, 0x13, 0xba,
+	0x7e, 0x10, 0x0a, 0x0e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x72, 0x42, 0x46, 0x5a, 0x44, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x74, 0x69, 0x65, 0x72, 0x6b, 0x6c, 0x69, 0x6e, 0x69, 0x6b, 0x2d, 0x64, 0x6f, 0x62, 0x65,
+	0x72, 0x73, 0x62, 0x65, 0x72, 0x67, 0x2f, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2f, 0x67,
+	0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2f, 0x76, 0x31,
+	0x3b, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+})
+
+var (
+	file_rosterd_v1_offtime_proto_rawDescOnce sync.Once
+	file_rosterd_v1_offtime_proto_rawDescData []byte
+)
+
+func file_rosterd_v1_offtime_proto_rawDescGZIP() []byte {
+	file_rosterd_v1_offtime_proto_rawDescOnce.Do(func() {
+		file_rosterd_v1_offtime_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rosterd_v1_offtime_proto_rawDesc), len(file_rosterd_v1_offtime_proto_rawDesc)))
+	})
+	return file_rosterd_v1_offtime_proto_rawDescData
+}
+
+var file_rosterd_v1_offtime_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_rosterd_v1_offtime_proto_goTypes = []any{
+	(*VacationBalance)(nil),                // 0: rosterd.v1.VacationBalance
+	(*GetVacationBalanceRequest)(nil),      // 1: rosterd.v1.GetVacationBalanceRequest
+	(*GetVacationBalanceResponse)(nil),     // 2: rosterd.v1.GetVacationBalanceResponse
+	(*CheckOffTimeRequestRequest)(nil),     // 3: rosterd.v1.CheckOffTimeRequestRequest
+	(*CheckOffTimeRequestResponse)(nil),    // 4: rosterd.v1.CheckOffTimeRequestResponse
+	(*OffTimeRule)(nil),                    // 5: rosterd.v1.OffTimeRule
+	(*OffTimeRuleConflict)(nil),            // 6: rosterd.v1.OffTimeRuleConflict
+	(*OffTimeRuleConflicts)(nil),           // 7: rosterd.v1.OffTimeRuleConflicts
+	(*CreateOffTimeRuleRequest)(nil),       // 8: rosterd.v1.CreateOffTimeRuleRequest
+	(*CreateOffTimeRuleResponse)(nil),      // 9: rosterd.v1.CreateOffTimeRuleResponse
+	(*UpdateOffTimeRuleRequest)(nil),       // 10: rosterd.v1.UpdateOffTimeRuleRequest
+	(*UpdateOffTimeRuleResponse)(nil),      // 11: rosterd.v1.UpdateOffTimeRuleResponse
+	(*DeleteOffTimeRuleRequest)(nil),       // 12: rosterd.v1.DeleteOffTimeRuleRequest
+	(*DeleteOffTimeRuleResponse)(nil),      // 13: rosterd.v1.DeleteOffTimeRuleResponse
+	(*ListOffTimeRulesRequest)(nil),        // 14: rosterd.v1.ListOffTimeRulesRequest
+	(*ListOffTimeRulesResponse)(nil),       // 15: rosterd.v1.ListOffTimeRulesResponse
+	(*timestamppb.Timestamp)(nil),          // 16: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),            // 17: google.protobuf.Duration
+	(*v1.CreateOffTimeRequestRequest)(nil), // 18: tkd.roster.v1.CreateOffTimeRequestRequest
+}
+var file_rosterd_v1_offtime_proto_depIdxs = []int32{
+	16, // 0: rosterd.v1.VacationBalance.until:type_name -> google.protobuf.Timestamp
+	17, // 1: rosterd.v1.VacationBalance.credits_left:type_name -> google.protobuf.Duration
+	17, // 2: rosterd.v1.VacationBalance.pending:type_name -> google.protobuf.Duration
+	17, // 3: rosterd.v1.VacationBalance.request_costs:type_name -> google.protobuf.Duration
+	17, // 4: rosterd.v1.VacationBalance.projected:type_name -> google.protobuf.Duration
+	0,  // 5: rosterd.v1.GetVacationBalanceResponse.results:type_name -> rosterd.v1.VacationBalance
+	18, // 6: rosterd.v1.CheckOffTimeRequestRequest.request:type_name -> tkd.roster.v1.CreateOffTimeRequestRequest
+	0,  // 7: rosterd.v1.CheckOffTimeRequestResponse.balance:type_name -> rosterd.v1.VacationBalance
+	6,  // 8: rosterd.v1.CheckOffTimeRequestResponse.conflicts:type_name -> rosterd.v1.OffTimeRuleConflict
+	16, // 9: rosterd.v1.OffTimeRule.created_at:type_name -> google.protobuf.Timestamp
+	5,  // 10: rosterd.v1.OffTimeRuleConflict.rule:type_name -> rosterd.v1.OffTimeRule
+	6,  // 11: rosterd.v1.OffTimeRuleConflicts.conflicts:type_name -> rosterd.v1.OffTimeRuleConflict
+	5,  // 12: rosterd.v1.CreateOffTimeRuleRequest.rule:type_name -> rosterd.v1.OffTimeRule
+	5,  // 13: rosterd.v1.CreateOffTimeRuleResponse.rule:type_name -> rosterd.v1.OffTimeRule
+	5,  // 14: rosterd.v1.UpdateOffTimeRuleRequest.rule:type_name -> rosterd.v1.OffTimeRule
+	5,  // 15: rosterd.v1.UpdateOffTimeRuleResponse.rule:type_name -> rosterd.v1.OffTimeRule
+	5,  // 16: rosterd.v1.ListOffTimeRulesResponse.rules:type_name -> rosterd.v1.OffTimeRule
+	1,  // 17: rosterd.v1.OffTimeService.GetVacationBalance:input_type -> rosterd.v1.GetVacationBalanceRequest
+	3,  // 18: rosterd.v1.OffTimeService.CheckOffTimeRequest:input_type -> rosterd.v1.CheckOffTimeRequestRequest
+	8,  // 19: rosterd.v1.OffTimeService.CreateOffTimeRule:input_type -> rosterd.v1.CreateOffTimeRuleRequest
+	10, // 20: rosterd.v1.OffTimeService.UpdateOffTimeRule:input_type -> rosterd.v1.UpdateOffTimeRuleRequest
+	12, // 21: rosterd.v1.OffTimeService.DeleteOffTimeRule:input_type -> rosterd.v1.DeleteOffTimeRuleRequest
+	14, // 22: rosterd.v1.OffTimeService.ListOffTimeRules:input_type -> rosterd.v1.ListOffTimeRulesRequest
+	2,  // 23: rosterd.v1.OffTimeService.GetVacationBalance:output_type -> rosterd.v1.GetVacationBalanceResponse
+	4,  // 24: rosterd.v1.OffTimeService.CheckOffTimeRequest:output_type -> rosterd.v1.CheckOffTimeRequestResponse
+	9,  // 25: rosterd.v1.OffTimeService.CreateOffTimeRule:output_type -> rosterd.v1.CreateOffTimeRuleResponse
+	11, // 26: rosterd.v1.OffTimeService.UpdateOffTimeRule:output_type -> rosterd.v1.UpdateOffTimeRuleResponse
+	13, // 27: rosterd.v1.OffTimeService.DeleteOffTimeRule:output_type -> rosterd.v1.DeleteOffTimeRuleResponse
+	15, // 28: rosterd.v1.OffTimeService.ListOffTimeRules:output_type -> rosterd.v1.ListOffTimeRulesResponse
+	23, // [23:29] is the sub-list for method output_type
+	17, // [17:23] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
+}
+
+func init() { file_rosterd_v1_offtime_proto_init() }
+func file_rosterd_v1_offtime_proto_init() {
+	if File_rosterd_v1_offtime_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rosterd_v1_offtime_proto_rawDesc), len(file_rosterd_v1_offtime_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   16,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_rosterd_v1_offtime_proto_goTypes,
+		DependencyIndexes: file_rosterd_v1_offtime_proto_depIdxs,
+		MessageInfos:      file_rosterd_v1_offtime_proto_msgTypes,
+	}.Build()
+	File_rosterd_v1_offtime_proto = out.File
+	file_rosterd_v1_offtime_proto_goTypes = nil
+	file_rosterd_v1_offtime_proto_depIdxs = nil
+}
@@ -0,0 +1,1144 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        (unknown)
+// source: rosterd/v1/openshift.proto
+
+package rosterdv1
+
+import (
+	_ "github.com/tierklinik-dobersberg/apis/gen/go/tkd/common/v1"
+	v1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/roster/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type OpenShiftState int32
+
+const (
+	OpenShiftState_OPEN_SHIFT_STATE_UNSPECIFIED OpenShiftState = 0
+	// The shift still has open slots that may be claimed.
+	OpenShiftState_OPEN_SHIFT_STATE_OPEN OpenShiftState = 1
+	// All open slots have been claimed.
+	OpenShiftState_OPEN_SHIFT_STATE_FILLED OpenShiftState = 2
+	// The open shift has been closed by a roster manager.
+	OpenShiftState_OPEN_SHIFT_STATE_CLOSED OpenShiftState = 3
+)
+
+// Enum value maps for OpenShiftState.
+var (
+	OpenShiftState_name = map[int32]string{
+		0: "OPEN_SHIFT_STATE_UNSPECIFIED",
+		1: "OPEN_SHIFT_STATE_OPEN",
+		2: "OPEN_SHIFT_STATE_FILLED",
+		3: "OPEN_SHIFT_STATE_CLOSED",
+	}
+	OpenShiftState_value = map[string]int32{
+		"OPEN_SHIFT_STATE_UNSPECIFIED": 0,
+		"OPEN_SHIFT_STATE_OPEN":        1,
+		"OPEN_SHIFT_STATE_FILLED":      2,
+		"OPEN_SHIFT_STATE_CLOSED":      3,
+	}
+)
+
+func (x OpenShiftState) Enum() *OpenShiftState {
+	p := new(OpenShiftState)
+	*p = x
+	return p
+}
+
+func (x OpenShiftState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OpenShiftState) Descriptor() protoreflect.EnumDescriptor {
+	return file_rosterd_v1_openshift_proto_enumTypes[0].Descriptor()
+}
+
+func (OpenShiftState) Type() protoreflect.EnumType {
+	return &file_rosterd_v1_openshift_proto_enumTypes[0]
+}
+
+func (x OpenShiftState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OpenShiftState.Descriptor instead.
+func (OpenShiftState) EnumDescriptor() ([]byte, []int) {
+	return file_rosterd_v1_openshift_proto_rawDescGZIP(), []int{0}
+}
+
+type OpenShiftClaimState int32
+
+const (
+	OpenShiftClaimState_OPEN_SHIFT_CLAIM_STATE_UNSPECIFIED OpenShiftClaimState = 0
+	// The claim waits for the confirmation of a roster manager.
+	OpenShiftClaimState_OPEN_SHIFT_CLAIM_STATE_PENDING OpenShiftClaimState = 1
+	// The user has been assigned to the shift.
+	OpenShiftClaimState_OPEN_SHIFT_CLAIM_STATE_CONFIRMED OpenShiftClaimState = 2
+	// The claim has been rejected.
+	OpenShiftClaimState_OPEN_SHIFT_CLAIM_STATE_REJECTED OpenShiftClaimState = 3
+)
+
+// Enum value maps for OpenShiftClaimState.
+var (
+	OpenShiftClaimState_name = map[int32]string{
+		0: "OPEN_SHIFT_CLAIM_STATE_UNSPECIFIED",
+		1: "OPEN_SHIFT_CLAIM_STATE_PENDING",
+		2: "OPEN_SHIFT_CLAIM_STATE_CONFIRMED",
+		3: "OPEN_SHIFT_CLAIM_STATE_REJECTED",
+	}
+	OpenShiftClaimState_value = map[string]int32{
+		"OPEN_SHIFT_CLAIM_STATE_UNSPECIFIED": 0,
+		"OPEN_SHIFT_CLAIM_STATE_PENDING":     1,
+		"OPEN_SHIFT_CLAIM_STATE_CONFIRMED":   2,
+		"OPEN_SHIFT_CLAIM_STATE_REJECTED":    3,
+	}
+)
+
+func (x OpenShiftClaimState) Enum() *OpenShiftClaimState {
+	p := new(OpenShiftClaimState)
+	*p = x
+	return p
+}
+
+func (x OpenShiftClaimState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OpenShiftClaimState) Descriptor() protoreflect.EnumDescriptor {
+	return file_rosterd_v1_openshift_proto_enumTypes[1].Descriptor()
+}
+
+func (OpenShiftClaimState) Type() protoreflect.EnumType {
+	return &file_rosterd_v1_openshift_proto_enumTypes[1]
+}
+
+func (x OpenShiftClaimState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OpenShiftClaimState.Descriptor instead.
+func (OpenShiftClaimState) EnumDescriptor() ([]byte, []int) {
+	return file_rosterd_v1_openshift_proto_rawDescGZIP(), []int{1}
+}
+
+type OpenShiftClaim struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	State         OpenShiftClaimState    `protobuf:"varint,2,opt,name=state,proto3,enum=rosterd.v1.OpenShiftClaimState" json:"state,omitempty"`
+	ClaimedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=claimed_at,json=claimedAt,proto3" json:"claimed_at,omitempty"`
+	DecidedBy     string                 `protobuf:"bytes,4,opt,name=decided_by,json=decidedBy,proto3" json:"decided_by,omitempty"`
+	DecidedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=decided_at,json=decidedAt,proto3" json:"decided_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OpenShiftClaim) Reset() {
+	*x = OpenShiftClaim{}
+	mi := &file_rosterd_v1_openshift_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpenShiftClaim) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenShiftClaim) ProtoMessage() {}
+
+func (x *OpenShiftClaim) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_openshift_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenShiftClaim.ProtoReflect.Descriptor instead.
+func (*OpenShiftClaim) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_openshift_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *OpenShiftClaim) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *OpenShiftClaim) GetState() OpenShiftClaimState {
+	if x != nil {
+		return x.State
+	}
+	return OpenShiftClaimState_OPEN_SHIFT_CLAIM_STATE_UNSPECIFIED
+}
+
+func (x *OpenShiftClaim) GetClaimedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClaimedAt
+	}
+	return nil
+}
+
+func (x *OpenShiftClaim) GetDecidedBy() string {
+	if x != nil {
+		return x.DecidedBy
+	}
+	return ""
+}
+
+func (x *OpenShiftClaim) GetDecidedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DecidedAt
+	}
+	return nil
+}
+
+// OpenShift is an understaffed shift of an approved roster that has been
+// published so eligible users can claim it.
+type OpenShift struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// RosterId is the ID of the approved duty roster that contains the
+	// shift.
+	RosterId string          `protobuf:"bytes,2,opt,name=roster_id,json=rosterId,proto3" json:"roster_id,omitempty"`
+	Shift    *ShiftReference `protobuf:"bytes,3,opt,name=shift,proto3" json:"shift,omitempty"`
+	// To holds the time at which the shift ends.
+	To *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	// OpenSlots is the number of users that may still claim the shift.
+	OpenSlots int32 `protobuf:"varint,5,opt,name=open_slots,json=openSlots,proto3" json:"open_slots,omitempty"`
+	// RequireConfirmation is set if claims must be confirmed by a roster
+	// manager. Otherwise claims are accepted on a first-come basis.
+	RequireConfirmation bool                   `protobuf:"varint,6,opt,name=require_confirmation,json=requireConfirmation,proto3" json:"require_confirmation,omitempty"`
+	State               OpenShiftState         `protobuf:"varint,7,opt,name=state,proto3,enum=rosterd.v1.OpenShiftState" json:"state,omitempty"`
+	Claims              []*OpenShiftClaim      `protobuf:"bytes,8,rep,name=claims,proto3" json:"claims,omitempty"`
+	Comment             string                 `protobuf:"bytes,9,opt,name=comment,proto3" json:"comment,omitempty"`
+	CreatedAt           *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatorId           string                 `protobuf:"bytes,11,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	// EligibleUserIds holds the users that have been eligible for the shift
+	// when it has been published.
+	EligibleUserIds []string `protobuf:"bytes,12,rep,name=eligible_user_ids,json=eligibleUserIds,proto3" json:"eligible_user_ids,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *OpenShift) Reset() {
+	*x = OpenShift{}
+	mi := &file_rosterd_v1_openshift_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpenShift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenShift) ProtoMessage() {}
+
+func (x *OpenShift) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_openshift_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenShift.ProtoReflect.Descriptor instead.
+func (*OpenShift) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_openshift_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *OpenShift) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *OpenShift) GetRosterId() string {
+	if x != nil {
+		return x.RosterId
+	}
+	return ""
+}
+
+func (x *OpenShift) GetShift() *ShiftReference {
+	if x != nil {
+		return x.Shift
+	}
+	return nil
+}
+
+func (x *OpenShift) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *OpenShift) GetOpenSlots() int32 {
+	if x != nil {
+		return x.OpenSlots
+	}
+	return 0
+}
+
+func (x *OpenShift) GetRequireConfirmation() bool {
+	if x != nil {
+		return x.RequireConfirmation
+	}
+	return false
+}
+
+func (x *OpenShift) GetState() OpenShiftState {
+	if x != nil {
+		return x.State
+	}
+	return OpenShiftState_OPEN_SHIFT_STATE_UNSPECIFIED
+}
+
+func (x *OpenShift) GetClaims() []*OpenShiftClaim {
+	if x != nil {
+		return x.Claims
+	}
+	return nil
+}
+
+func (x *OpenShift) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *OpenShift) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *OpenShift) GetCreatorId() string {
+	if x != nil {
+		return x.CreatorId
+	}
+	return ""
+}
+
+func (x *OpenShift) GetEligibleUserIds() []string {
+	if x != nil {
+		return x.EligibleUserIds
+	}
+	return nil
+}
+
+type PublishOpenShiftsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	RosterId string                 `protobuf:"bytes,1,opt,name=roster_id,json=rosterId,proto3" json:"roster_id,omitempty"`
+	// Shifts may be set to only publish the given shifts. If empty, all
+	// understaffed shifts of the roster that did not start yet are
+	// published.
+	Shifts []*ShiftReference `protobuf:"bytes,2,rep,name=shifts,proto3" json:"shifts,omitempty"`
+	// RequireConfirmation requires claims to be confirmed by a roster
+	// manager.
+	RequireConfirmation bool   `protobuf:"varint,3,opt,name=require_confirmation,json=requireConfirmation,proto3" json:"require_confirmation,omitempty"`
+	Comment             string `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *PublishOpenShiftsRequest) Reset() {
+	*x = PublishOpenShiftsRequest{}
+	mi := &file_rosterd_v1_openshift_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishOpenShiftsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishOpenShiftsRequest) ProtoMessage() {}
+
+func (x *PublishOpenShiftsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_openshift_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishOpenShiftsRequest.ProtoReflect.Descriptor instead.
+func (*PublishOpenShiftsRequest) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_openshift_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PublishOpenShiftsRequest) GetRosterId() string {
+	if x != nil {
+		return x.RosterId
+	}
+	return ""
+}
+
+func (x *PublishOpenShiftsRequest) GetShifts() []*ShiftReference {
+	if x != nil {
+		return x.Shifts
+	}
+	return nil
+}
+
+func (x *PublishOpenShiftsRequest) GetRequireConfirmation() bool {
+	if x != nil {
+		return x.RequireConfirmation
+	}
+	return false
+}
+
+func (x *PublishOpenShiftsRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type PublishOpenShiftsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OpenShifts    []*OpenShift           `protobuf:"bytes,1,rep,name=open_shifts,json=openShifts,proto3" json:"open_shifts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishOpenShiftsResponse) Reset() {
+	*x = PublishOpenShiftsResponse{}
+	mi := &file_rosterd_v1_openshift_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishOpenShiftsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishOpenShiftsResponse) ProtoMessage() {}
+
+func (x *PublishOpenShiftsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_openshift_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishOpenShiftsResponse.ProtoReflect.Descriptor instead.
+func (*PublishOpenShiftsResponse) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_openshift_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PublishOpenShiftsResponse) GetOpenShifts() []*OpenShift {
+	if x != nil {
+		return x.OpenShifts
+	}
+	return nil
+}
+
+type ListOpenShiftsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// RosterId may be set to only return open shifts of a given roster.
+	RosterId string `protobuf:"bytes,1,opt,name=roster_id,json=rosterId,proto3" json:"roster_id,omitempty"`
+	// States may be set to only return open shifts with the given states.
+	// Defaults to OPEN_SHIFT_STATE_OPEN.
+	States        []OpenShiftState `protobuf:"varint,2,rep,packed,name=states,proto3,enum=rosterd.v1.OpenShiftState" json:"states,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOpenShiftsRequest) Reset() {
+	*x = ListOpenShiftsRequest{}
+	mi := &file_rosterd_v1_openshift_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOpenShiftsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOpenShiftsRequest) ProtoMessage() {}
+
+func (x *ListOpenShiftsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_openshift_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOpenShiftsRequest.ProtoReflect.Descriptor instead.
+func (*ListOpenShiftsRequest) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_openshift_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListOpenShiftsRequest) GetRosterId() string {
+	if x != nil {
+		return x.RosterId
+	}
+	return ""
+}
+
+func (x *ListOpenShiftsRequest) GetStates() []OpenShiftState {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
+type ListOpenShiftsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OpenShifts    []*OpenShift           `protobuf:"bytes,1,rep,name=open_shifts,json=openShifts,proto3" json:"open_shifts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOpenShiftsResponse) Reset() {
+	*x = ListOpenShiftsResponse{}
+	mi := &file_rosterd_v1_openshift_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOpenShiftsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOpenShiftsResponse) ProtoMessage() {}
+
+func (x *ListOpenShiftsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_openshift_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOpenShiftsResponse.ProtoReflect.Descriptor instead.
+func (*ListOpenShiftsResponse) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_openshift_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListOpenShiftsResponse) GetOpenShifts() []*OpenShift {
+	if x != nil {
+		return x.OpenShifts
+	}
+	return nil
+}
+
+type ClaimOpenShiftRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimOpenShiftRequest) Reset() {
+	*x = ClaimOpenShiftRequest{}
+	mi := &file_rosterd_v1_openshift_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimOpenShiftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimOpenShiftRequest) ProtoMessage() {}
+
+func (x *ClaimOpenShiftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_openshift_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimOpenShiftRequest.ProtoReflect.Descriptor instead.
+func (*ClaimOpenShiftRequest) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_openshift_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ClaimOpenShiftRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ClaimOpenShiftResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	OpenShift *OpenShift             `protobuf:"bytes,1,opt,name=open_shift,json=openShift,proto3" json:"open_shift,omitempty"`
+	// Roster holds the updated duty roster if the claim has been accepted
+	// immediately.
+	Roster        *v1.Roster `protobuf:"bytes,2,opt,name=roster,proto3" json:"roster,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimOpenShiftResponse) Reset() {
+	*x = ClaimOpenShiftResponse{}
+	mi := &file_rosterd_v1_openshift_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimOpenShiftResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimOpenShiftResponse) ProtoMessage() {}
+
+func (x *ClaimOpenShiftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_openshift_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimOpenShiftResponse.ProtoReflect.Descriptor instead.
+func (*ClaimOpenShiftResponse) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_openshift_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ClaimOpenShiftResponse) GetOpenShift() *OpenShift {
+	if x != nil {
+		return x.OpenShift
+	}
+	return nil
+}
+
+func (x *ClaimOpenShiftResponse) GetRoster() *v1.Roster {
+	if x != nil {
+		return x.Roster
+	}
+	return nil
+}
+
+type DecideOpenShiftClaimRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Confirm       bool                   `protobuf:"varint,3,opt,name=confirm,proto3" json:"confirm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecideOpenShiftClaimRequest) Reset() {
+	*x = DecideOpenShiftClaimRequest{}
+	mi := &file_rosterd_v1_openshift_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecideOpenShiftClaimRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecideOpenShiftClaimRequest) ProtoMessage() {}
+
+func (x *DecideOpenShiftClaimRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_openshift_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecideOpenShiftClaimRequest.ProtoReflect.Descriptor instead.
+func (*DecideOpenShiftClaimRequest) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_openshift_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DecideOpenShiftClaimRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DecideOpenShiftClaimRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DecideOpenShiftClaimRequest) GetConfirm() bool {
+	if x != nil {
+		return x.Confirm
+	}
+	return false
+}
+
+type DecideOpenShiftClaimResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	OpenShift *OpenShift             `protobuf:"bytes,1,opt,name=open_shift,json=openShift,proto3" json:"open_shift,omitempty"`
+	// Roster holds the updated duty roster if the claim has been confirmed.
+	Roster        *v1.Roster `protobuf:"bytes,2,opt,name=roster,proto3" json:"roster,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecideOpenShiftClaimResponse) Reset() {
+	*x = DecideOpenShiftClaimResponse{}
+	mi := &file_rosterd_v1_openshift_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecideOpenShiftClaimResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecideOpenShiftClaimResponse) ProtoMessage() {}
+
+func (x *DecideOpenShiftClaimResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_openshift_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecideOpenShiftClaimResponse.ProtoReflect.Descriptor instead.
+func (*DecideOpenShiftClaimResponse) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_openshift_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DecideOpenShiftClaimResponse) GetOpenShift() *OpenShift {
+	if x != nil {
+		return x.OpenShift
+	}
+	return nil
+}
+
+func (x *DecideOpenShiftClaimResponse) GetRoster() *v1.Roster {
+	if x != nil {
+		return x.Roster
+	}
+	return nil
+}
+
+type CloseOpenShiftRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseOpenShiftRequest) Reset() {
+	*x = CloseOpenShiftRequest{}
+	mi := &file_rosterd_v1_openshift_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseOpenShiftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseOpenShiftRequest) ProtoMessage() {}
+
+func (x *CloseOpenShiftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_openshift_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseOpenShiftRequest.ProtoReflect.Descriptor instead.
+func (*CloseOpenShiftRequest) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_openshift_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CloseOpenShiftRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CloseOpenShiftResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OpenShift     *OpenShift             `protobuf:"bytes,1,opt,name=open_shift,json=openShift,proto3" json:"open_shift,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseOpenShiftResponse) Reset() {
+	*x = CloseOpenShiftResponse{}
+	mi := &file_rosterd_v1_openshift_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseOpenShiftResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseOpenShiftResponse) ProtoMessage() {}
+
+func (x *CloseOpenShiftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_openshift_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseOpenShiftResponse.ProtoReflect.Descriptor instead.
+func (*CloseOpenShiftResponse) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_openshift_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CloseOpenShiftResponse) GetOpenShift() *OpenShift {
+	if x != nil {
+		return x.OpenShift
+	}
+	return nil
+}
+
+var File_rosterd_v1_openshift_proto protoreflect.FileDescriptor
+
+var file_rosterd_v1_openshift_proto_rawDesc = string([]byte{
+	0x0a, 0x1a, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x70, 0x65,
+	0x6e, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x72, 0x6f,
+	0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x72, 0x6f, 0x73, 0x74, 0x65,
+	0x72, 0x64, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1a, 0x74, 0x6b, 0x64, 0x2f, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f,
+	0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x74, 0x6b,
+	0x64, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf5, 0x01, 0x0a,
+	0x0e, 0x4f, 0x70, 0x65, 0x6e, 0x53, 0x68, 0x69, 0x66, 0x74, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x53, 0x68, 0x69, 0x66, 0x74, 0x43, 0x6c,
+	0x61, 0x69, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x39, 0x0a, 0x0a, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65,
+	0x63, 0x69, 0x64, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x64, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64, 0x42, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x64, 0x65, 0x63,
+	0x69, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x64, 0x65, 0x63, 0x69, 0x64,
+	0x65, 0x64, 0x41, 0x74, 0x22, 0xee, 0x03, 0x0a, 0x09, 0x4f, 0x70, 0x65, 0x6e, 0x53, 0x68, 0x69,
+	0x66, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x30, 0x0a, 0x05, 0x73, 0x68, 0x69, 0x66, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x68, 0x69, 0x66,
+	0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x05, 0x73, 0x68, 0x69, 0x66,
+	0x74, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x1d, 0x0a,
+	0x0a, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x6e, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x12, 0x31, 0x0a, 0x14,
+	0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a,
+	0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
+	0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x32, 0x0a, 0x06, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4f,
+	0x70, 0x65, 0x6e, 0x53, 0x68, 0x69, 0x66, 0x74, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x52, 0x06, 0x63,
+	0x6c, 0x61, 0x69, 0x6d, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x65, 0x6c, 0x69,
+	0x67, 0x69, 0x62, 0x6c, 0x65, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x0c,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x65, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x6c, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x73, 0x22, 0xb8, 0x01, 0x0a, 0x18, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x4f, 0x70, 0x65, 0x6e, 0x53, 0x68, 0x69, 0x66, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x32, 0x0a, 0x06, 0x73, 0x68, 0x69, 0x66, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x68, 0x69,
+	0x66, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x06, 0x73, 0x68, 0x69,
+	0x66, 0x74, 0x73, 0x12, 0x31, 0x0a, 0x14, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x5f, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x13, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x22, 0x53, 0x0a, 0x19, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x4f, 0x70, 0x65, 0x6e, 0x53,
+	0x68, 0x69, 0x66, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a,
+	0x0b, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x73, 0x68, 0x69, 0x66, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x4f, 0x70, 0x65, 0x6e, 0x53, 0x68, 0x69, 0x66, 0x74, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x6e, 0x53,
+	0x68, 0x69, 0x66, 0x74, 0x73, 0x22, 0x68, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65,
+	0x6e, 0x53, 0x68, 0x69, 0x66, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x72, 0x6f,
+	0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x53, 0x68, 0x69,
+	0x66, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x22,
+	0x50, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x53, 0x68, 0x69, 0x66, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0b, 0x6f, 0x70, 0x65,
+	0x6e, 0x5f, 0x73, 0x68, 0x69, 0x66, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
+	0x53, 0x68, 0x69, 0x66, 0x74, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x6e, 0x53, 0x68, 0x69, 0x66, 0x74,
+	0x73, 0x22, 0x27, 0x0a, 0x15, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x4f, 0x70, 0x65, 0x6e, 0x53, 0x68,
+	0x69, 0x66, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x7d, 0x0a, 0x16, 0x43, 0x6c,
+	0x61, 0x69, 0x6d, 0x4f, 0x70, 0x65, 0x6e, 0x53, 0x68, 0x69, 0x66, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0a, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x73, 0x68, 0x69,
+	0x66, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65,
+	0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x53, 0x68, 0x69, 0x66, 0x74, 0x52,
+	0x09, 0x6f, 0x70, 0x65, 0x6e, 0x53, 0x68, 0x69, 0x66, 0x74, 0x12, 0x2d, 0x0a, 0x06, 0x72, 0x6f,
+	0x73, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x6b, 0x64,
+	0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x73, 0x74, 0x65,
+	0x72, 0x52, 0x06, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x22, 0x60, 0x0a, 0x1b, 0x44, 0x65, 0x63,
+	0x69, 0x64, 0x65, 0x4f, 0x70, 0x65, 0x6e, 0x53, 0x68, 0x69, 0x66, 0x74, 0x43, 0x6c, 0x61, 0x69,
+	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x22, 0x83, 0x01, 0x0a, 0x1c,
+	0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x4f, 0x70, 0x65, 0x6e, 0x53, 0x68, 0x69, 0x66, 0x74, 0x43,
+	0x6c, 0x61, 0x69, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0a,
+	0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x73, 0x68, 0x69, 0x66, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70,
+	0x65, 0x6e, 0x53, 0x68, 0x69, 0x66, 0x74, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x6e, 0x53, 0x68, 0x69,
+	0x66, 0x74, 0x12, 0x2d, 0x0a, 0x06, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x6b, 0x64, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x52, 0x06, 0x72, 0x6f, 0x73, 0x74, 0x65,
+	0x72, 0x22, 0x27, 0x0a, 0x15, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4f, 0x70, 0x65, 0x6e, 0x53, 0x68,
+	0x69, 0x66, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4e, 0x0a, 0x16, 0x43, 0x6c,
+	0x6f, 0x73, 0x65, 0x4f, 0x70, 0x65, 0x6e, 0x53, 0x68, 0x69, 0x66, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0a, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x73, 0x68, 0x69,
+	0x66, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65,
+	0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x53, 0x68, 0x69, 0x66, 0x74, 0x52,
+	0x09, 0x6f, 0x70, 0x65, 0x6e, 0x53, 0x68, 0x69, 0x66, 0x74, 0x2a, 0x87, 0x01, 0x0a, 0x0e, 0x4f,
+	0x70, 0x65, 0x6e, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a,
+	0x1c, 0x4f, 0x50, 0x45, 0x4e, 0x5f, 0x53, 0x48, 0x49, 0x46, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x19, 0x0a, 0x15, 0x4f, 0x50, 0x45, 0x4e, 0x5f, 0x53, 0x48, 0x49, 0x46, 0x54, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x4f, 0x50,
+	0x45, 0x4e, 0x5f, 0x53, 0x48, 0x49, 0x46, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x46,
+	0x49, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x4f, 0x50, 0x45, 0x4e, 0x5f,
+	0x53, 0x48, 0x49, 0x46, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x4c, 0x4f, 0x53,
+	0x45, 0x44, 0x10, 0x03, 0x2a, 0xac, 0x01, 0x0a, 0x13, 0x4f, 0x70, 0x65, 0x6e, 0x53, 0x68, 0x69,
+	0x66, 0x74, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x26, 0x0a, 0x22,
+	0x4f, 0x50, 0x45, 0x4e, 0x5f, 0x53, 0x48, 0x49, 0x46, 0x54, 0x5f, 0x43, 0x4c, 0x41, 0x49, 0x4d,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x22, 0x0a, 0x1e, 0x4f, 0x50, 0x45, 0x4e, 0x5f, 0x53, 0x48, 0x49,
+	0x46, 0x54, 0x5f, 0x43, 0x4c, 0x41, 0x49, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x50,
+	0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x24, 0x0a, 0x20, 0x4f, 0x50, 0x45, 0x4e,
+	0x5f, 0x53, 0x48, 0x49, 0x46, 0x54, 0x5f, 0x43, 0x4c, 0x41, 0x49, 0x4d, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x52, 0x4d, 0x45, 0x44, 0x10, 0x02, 0x12, 0x23,
+	0x0a, 0x1f, 0x4f, 0x50, 0x45, 0x4e, 0x5f, 0x53, 0x48, 0x49, 0x46, 0x54, 0x5f, 0x43, 0x4c, 0x41,
+	0x49, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45,
+	0x44, 0x10, 0x03, 0x32, 0xa2, 0x04, 0x0a, 0x10, 0x4f, 0x70, 0x65, 0x6e, 0x53, 0x68, 0x69, 0x66,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x67, 0x0a, 0x11, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x4f, 0x70, 0x65, 0x6e, 0x53, 0x68, 0x69, 0x66, 0x74, 0x73, 0x12, 0x24, 0x2e,
+	0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x4f, 0x70, 0x65, 0x6e, 0x53, 0x68, 0x69, 0x66, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x4f, 0x70, 0x65, 0x6e, 0x53, 0x68, 0x69, 0x66,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x05, 0xb2, 0x7e, 0x02, 0x08,
+	0x02, 0x12, 0x5e, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x53, 0x68, 0x69,
+	0x66, 0x74, 0x73, 0x12, 0x21, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x53, 0x68, 0x69, 0x66, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x53, 0x68, 0x69, 0x66,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x05, 0xb2, 0x7e, 0x02, 0x08,
+	0x01, 0x12, 0x5e, 0x0a, 0x0e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x4f, 0x70, 0x65, 0x6e, 0x53, 0x68,
+	0x69, 0x66, 0x74, 0x12, 0x21, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x4f, 0x70, 0x65, 0x6e, 0x53, 0x68, 0x69, 0x66, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x4f, 0x70, 0x65, 0x6e, 0x53, 0x68, 0x69,
+	0x66, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x05, 0xb2, 0x7e, 0x02, 0x08,
+	0x01, 0x12, 0x70, 0x0a, 0x14, 0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x4f, 0x70, 0x65, 0x6e, 0x53,
+	0x68, 0x69, 0x66, 0x74, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x12, 0x27, 0x2e, 0x72, 0x6f, 0x73, 0x74,
+	0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x4f, 0x70, 0x65,
+	0x6e, 0x53, 0x68, 0x69, 0x66, 0x74, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x28, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x4f, 0x70, 0x65, 0x6e, 0x53, 0x68, 0x69, 0x66, 0x74, 0x43,
+	0x6c, 0x61, 0x69, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x05, 0xb2, 0x7e,
+	0x02, 0x08, 0x02, 0x12, 0x5e, 0x0a, 0x0e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4f, 0x70, 0x65, 0x6e,
+	0x53, 0x68, 0x69, 0x66, 0x74, 0x12, 0x21, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4f, 0x70, 0x65, 0x6e, 0x53, 0x68, 0x69, 0x66,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65,
+	0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4f, 0x70, 0x65, 0x6e, 0x53,
+	0x68, 0x69, 0x66, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x05, 0xb2, 0x7e,
+	0x02, 0x08, 0x02, 0x1a, 0x13, 0xba, 0x7e, 0x10, 0x0a, 0x0e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72,
+	0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x42, 0x46, 0x5a, 0x44, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x69, 0x65, 0x72, 0x6b, 0x6c, 0x69, 0x6e, 0x69,
+	0x6b, 0x2d, 0x64, 0x6f, 0x62, 0x65, 0x72, 0x73, 0x62, 0x65, 0x72, 0x67, 0x2f, 0x72, 0x6f, 0x73,
+	0x74, 0x65, 0x72, 0x64, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x72, 0x6f, 0x73, 0x74,
+	0x65, 0x72, 0x64, 0x2f, 0x76, 0x31, 0x3b, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x76, 0x31,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_rosterd_v1_openshift_proto_rawDescOnce sync.Once
+	file_rosterd_v1_openshift_proto_rawDescData []byte
+)
+
+func file_rosterd_v1_openshift_proto_rawDescGZIP() []byte {
+	file_rosterd_v1_openshift_proto_rawDescOnce.Do(func() {
+		file_rosterd_v1_openshift_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rosterd_v1_openshift_proto_rawDesc), len(file_rosterd_v1_openshift_proto_rawDesc)))
+	})
+	return file_rosterd_v1_openshift_proto_rawDescData
+}
+
+var file_rosterd_v1_openshift_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_rosterd_v1_openshift_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_rosterd_v1_openshift_proto_goTypes = []any{
+	(OpenShiftState)(0),                  // 0: rosterd.v1.OpenShiftState
+	(OpenShiftClaimState)(0),             // 1: rosterd.v1.OpenShiftClaimState
+	(*OpenShiftClaim)(nil),               // 2: rosterd.v1.OpenShiftClaim
+	(*OpenShift)(nil),                    // 3: rosterd.v1.OpenShift
+	(*PublishOpenShiftsRequest)(nil),     // 4: rosterd.v1.PublishOpenShiftsRequest
+	(*PublishOpenShiftsResponse)(nil),    // 5: rosterd.v1.PublishOpenShiftsResponse
+	(*ListOpenShiftsRequest)(nil),        // 6: rosterd.v1.ListOpenShiftsRequest
+	(*ListOpenShiftsResponse)(nil),       // 7: rosterd.v1.ListOpenShiftsResponse
+	(*ClaimOpenShiftRequest)(nil),        // 8: rosterd.v1.ClaimOpenShiftRequest
+	(*ClaimOpenShiftResponse)(nil),       // 9: rosterd.v1.ClaimOpenShiftResponse
+	(*DecideOpenShiftClaimRequest)(nil),  // 10: rosterd.v1.DecideOpenShiftClaimRequest
+	(*DecideOpenShiftClaimResponse)(nil), // 11: rosterd.v1.DecideOpenShiftClaimResponse
+	(*CloseOpenShiftRequest)(nil),        // 12: rosterd.v1.CloseOpenShiftRequest
+	(*CloseOpenShiftResponse)(nil),       // 13: rosterd.v1.CloseOpenShiftResponse
+	(*timestamppb.Timestamp)(nil),        // 14: google.protobuf.Timestamp
+	(*ShiftReference)(nil),               // 15: rosterd.v1.ShiftReference
+	(*v1.Roster)(nil),                    // 16: tkd.roster.v1.Roster
+}
+var file_rosterd_v1_openshift_proto_depIdxs = []int32{
+	1,  // 0: rosterd.v1.OpenShiftClaim.state:type_name -> rosterd.v1.OpenShiftClaimState
+	14, // 1: rosterd.v1.OpenShiftClaim.claimed_at:type_name -> google.protobuf.Timestamp
+	14, // 2: rosterd.v1.OpenShiftClaim.decided_at:type_name -> google.protobuf.Timestamp
+	15, // 3: rosterd.v1.OpenShift.shift:type_name -> rosterd.v1.ShiftReference
+	14, // 4: rosterd.v1.OpenShift.to:type_name -> google.protobuf.Timestamp
+	0,  // 5: rosterd.v1.OpenShift.state:type_name -> rosterd.v1.OpenShiftState
+	2,  // 6: rosterd.v1.OpenShift.claims:type_name -> rosterd.v1.OpenShiftClaim
+	14, // 7: rosterd.v1.OpenShift.created_at:type_name -> google.protobuf.Timestamp
+	15, // 8: rosterd.v1.PublishOpenShiftsRequest.shifts:type_name -> rosterd.v1.ShiftReference
+	3,  // 9: rosterd.v1.PublishOpenShiftsResponse.open_shifts:type_name -> rosterd.v1.OpenShift
+	0,  // 10: rosterd.v1.ListOpenShiftsRequest.states:type_name -> rosterd.v1.OpenShiftState
+	3,  // 11: rosterd.v1.ListOpenShiftsResponse.open_shifts:type_name -> rosterd.v1.OpenShift
+	3,  // 12: rosterd.v1.ClaimOpenShiftResponse.open_shift:type_name -> rosterd.v1.OpenShift
+	16, // 13: rosterd.v1.ClaimOpenShiftResponse.roster:type_name -> tkd.roster.v1.Roster
+	3,  // 14: rosterd.v1.DecideOpenShiftClaimResponse.open_shift:type_name -> rosterd.v1.OpenShift
+	16, // 15: rosterd.v1.DecideOpenShiftClaimResponse.roster:type_name -> tkd.roster.v1.Roster
+	3,  // 16: rosterd.v1.CloseOpenShiftResponse.open_shift:type_name -> rosterd.v1.OpenShift
+	4,  // 17: rosterd.v1.OpenShiftService.PublishOpenShifts:input_type -> rosterd.v1.PublishOpenShiftsRequest
+	6,  // 18: rosterd.v1.OpenShiftService.ListOpenShifts:input_type -> rosterd.v1.ListOpenShiftsRequest
+	8,  // 19: rosterd.v1.OpenShiftService.ClaimOpenShift:input_type -> rosterd.v1.ClaimOpenShiftRequest
+	10, // 20: rosterd.v1.OpenShiftService.DecideOpenShiftClaim:input_type -> rosterd.v1.DecideOpenShiftClaimRequest
+	12, // 21: rosterd.v1.OpenShiftService.CloseOpenShift:input_type -> rosterd.v1.CloseOpenShiftRequest
+	5,  // 22: rosterd.v1.OpenShiftService.PublishOpenShifts:output_type -> rosterd.v1.PublishOpenShiftsResponse
+	7,  // 23: rosterd.v1.OpenShiftService.ListOpenShifts:output_type -> rosterd.v1.ListOpenShiftsResponse
+	9,  // 24: rosterd.v1.OpenShiftService.ClaimOpenShift:output_type -> rosterd.v1.ClaimOpenShiftResponse
+	11, // 25: rosterd.v1.OpenShiftService.DecideOpenShiftClaim:output_type -> rosterd.v1.DecideOpenShiftClaimResponse
+	13, // 26: rosterd.v1.OpenShiftService.CloseOpenShift:output_type -> rosterd.v1.CloseOpenShiftResponse
+	22, // [22:27] is the sub-list for method output_type
+	17, // [17:22] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
+}
+
+func init() { file_rosterd_v1_openshift_proto_init() }
+func file_rosterd_v1_openshift_proto_init() {
+	if File_rosterd_v1_openshift_proto != nil {
+		return
+	}
+	file_rosterd_v1_swap_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rosterd_v1_openshift_proto_rawDesc), len(file_rosterd_v1_openshift_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_rosterd_v1_openshift_proto_goTypes,
+		DependencyIndexes: file_rosterd_v1_openshift_proto_depIdxs,
+		EnumInfos:         file_rosterd_v1_openshift_proto_enumTypes,
+		MessageInfos:      file_rosterd_v1_openshift_proto_msgTypes,
+	}.Build()
+	File_rosterd_v1_openshift_proto = out.File
+	file_rosterd_v1_openshift_proto_goTypes = nil
+	file_rosterd_v1_openshift_proto_depIdxs = nil
+}
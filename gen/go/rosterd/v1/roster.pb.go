@@ -0,0 +1,3927 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        (unknown)
+// source: rosterd/v1/roster.proto
+
+package rosterdv1
+
+import (
+	_ "github.com/tierklinik-dobersberg/apis/gen/go/tkd/common/v1"
+	v1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/roster/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// UnstaffedReason describes why a shift could not be fully staffed.
+type UnstaffedReason int32
+
+const (
+	UnstaffedReason_UNSTAFFED_REASON_UNSPECIFIED UnstaffedReason = 0
+	// There are no users with an eligible role for the shift.
+	UnstaffedReason_UNSTAFFED_REASON_NO_ELIGIBLE_USERS UnstaffedReason = 1
+	// All candidates violate a hard constraint, have an approved off-time
+	// request or do not have a work-time.
+	UnstaffedReason_UNSTAFFED_REASON_HARD_CONSTRAINTS UnstaffedReason = 2
+	// All remaining candidates are already assigned to overlapping shifts.
+	UnstaffedReason_UNSTAFFED_REASON_UNAVAILABLE UnstaffedReason = 3
+	// All eligible users are assigned but there are less eligible users than
+	// required.
+	UnstaffedReason_UNSTAFFED_REASON_NOT_ENOUGH_ELIGIBLE_USERS UnstaffedReason = 4
+)
+
+// Enum value maps for UnstaffedReason.
+var (
+	UnstaffedReason_name = map[int32]string{
+		0: "UNSTAFFED_REASON_UNSPECIFIED",
+		1: "UNSTAFFED_REASON_NO_ELIGIBLE_USERS",
+		2: "UNSTAFFED_REASON_HARD_CONSTRAINTS",
+		3: "UNSTAFFED_REASON_UNAVAILABLE",
+		4: "UNSTAFFED_REASON_NOT_ENOUGH_ELIGIBLE_USERS",
+	}
+	UnstaffedReason_value = map[string]int32{
+		"UNSTAFFED_REASON_UNSPECIFIED":               0,
+		"UNSTAFFED_REASON_NO_ELIGIBLE_USERS":         1,
+		"UNSTAFFED_REASON_HARD_CONSTRAINTS":          2,
+		"UNSTAFFED_REASON_UNAVAILABLE":               3,
+		"UNSTAFFED_REASON_NOT_ENOUGH_ELIGIBLE_USERS": 4,
+	}
+)
+
+func (x UnstaffedReason) Enum() *UnstaffedReason {
+	p := new(UnstaffedReason)
+	*p = x
+	return p
+}
+
+func (x UnstaffedReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UnstaffedReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_rosterd_v1_roster_proto_enumTypes[0].Descriptor()
+}
+
+func (UnstaffedReason) Type() protoreflect.EnumType {
+	return &file_rosterd_v1_roster_proto_enumTypes[0]
+}
+
+func (x UnstaffedReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UnstaffedReason.Descriptor instead.
+func (UnstaffedReason) EnumDescriptor() ([]byte, []int) {
+	return file_rosterd_v1_roster_proto_rawDescGZIP(), []int{0}
+}
+
+// FindingSeverity describes how severe a validation finding is.
+type FindingSeverity int32
+
+const (
+	FindingSeverity_FINDING_SEVERITY_UNSPECIFIED FindingSeverity = 0
+	FindingSeverity_FINDING_SEVERITY_INFO        FindingSeverity = 1
+	FindingSeverity_FINDING_SEVERITY_WARNING     FindingSeverity = 2
+	// Errors are blocking and prevent a roster from being approved.
+	FindingSeverity_FINDING_SEVERITY_ERROR FindingSeverity = 3
+)
+
+// Enum value maps for FindingSeverity.
+var (
+	FindingSeverity_name = map[int32]string{
+		0: "FINDING_SEVERITY_UNSPECIFIED",
+		1: "FINDING_SEVERITY_INFO",
+		2: "FINDING_SEVERITY_WARNING",
+		3: "FINDING_SEVERITY_ERROR",
+	}
+	FindingSeverity_value = map[string]int32{
+		"FINDING_SEVERITY_UNSPECIFIED": 0,
+		"FINDING_SEVERITY_INFO":        1,
+		"FINDING_SEVERITY_WARNING":     2,
+		"FINDING_SEVERITY_ERROR":       3,
+	}
+)
+
+func (x FindingSeverity) Enum() *FindingSeverity {
+	p := new(FindingSeverity)
+	*p = x
+	return p
+}
+
+func (x FindingSeverity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FindingSeverity) Descriptor() protoreflect.EnumDescriptor {
+	return file_rosterd_v1_roster_proto_enumTypes[1].Descriptor()
+}
+
+func (FindingSeverity) Type() protoreflect.EnumType {
+	return &file_rosterd_v1_roster_proto_enumTypes[1]
+}
+
+func (x FindingSeverity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FindingSeverity.Descriptor instead.
+func (FindingSeverity) EnumDescriptor() ([]byte, []int) {
+	return file_rosterd_v1_roster_proto_rawDescGZIP(), []int{1}
+}
+
+// FindingKind describes the kind of a validation finding.
+type FindingKind int32
+
+const (
+	FindingKind_FINDING_KIND_UNSPECIFIED FindingKind = 0
+	// A shift has less assigned users than required.
+	FindingKind_FINDING_KIND_UNDERSTAFFED FindingKind = 1
+	// A user is assigned to a shift despite an approved off-time request.
+	FindingKind_FINDING_KIND_OFF_TIME FindingKind = 2
+	// A user is assigned to a shift but does not have a current work-time.
+	FindingKind_FINDING_KIND_NO_WORK_TIME FindingKind = 3
+	// A user is assigned to a shift but does not have an eligible role.
+	FindingKind_FINDING_KIND_NO_ELIGIBLE_ROLE FindingKind = 4
+	// A user is assigned to overlapping shifts.
+	FindingKind_FINDING_KIND_OVERLAPPING_SHIFTS FindingKind = 5
+	// A user is assigned to a shift but violates a hard constraint.
+	FindingKind_FINDING_KIND_HARD_CONSTRAINT FindingKind = 6
+	// A user is assigned to a shift that violates a built-in labour rule
+	// like the minimum rest period or the maximum work time per week.
+	FindingKind_FINDING_KIND_LABOUR_RULE FindingKind = 7
+)
+
+// Enum value maps for FindingKind.
+var (
+	FindingKind_name = map[int32]string{
+		0: "FINDING_KIND_UNSPECIFIED",
+		1: "FINDING_KIND_UNDERSTAFFED",
+		2: "FINDING_KIND_OFF_TIME",
+		3: "FINDING_KIND_NO_WORK_TIME",
+		4: "FINDING_KIND_NO_ELIGIBLE_ROLE",
+		5: "FINDING_KIND_OVERLAPPING_SHIFTS",
+		6: "FINDING_KIND_HARD_CONSTRAINT",
+		7: "FINDING_KIND_LABOUR_RULE",
+	}
+	FindingKind_value = map[string]int32{
+		"FINDING_KIND_UNSPECIFIED":        0,
+		"FINDING_KIND_UNDERSTAFFED":       1,
+		"FINDING_KIND_OFF_TIME":           2,
+		"FINDING_KIND_NO_WORK_TIME":       3,
+		"FINDING_KIND_NO_ELIGIBLE_ROLE":   4,
+		"FINDING_KIND_OVERLAPPING_SHIFTS": 5,
+		"FINDING_KIND_HARD_CONSTRAINT":    6,
+		"FINDING_KIND_LABOUR_RULE":        7,
+	}
+)
+
+func (x FindingKind) Enum() *FindingKind {
+	p := new(FindingKind)
+	*p = x
+	return p
+}
+
+func (x FindingKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FindingKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_rosterd_v1_roster_proto_enumTypes[2].Descriptor()
+}
+
+func (FindingKind) Type() protoreflect.EnumType {
+	return &file_rosterd_v1_roster_proto_enumTypes[2]
+}
+
+func (x FindingKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FindingKind.Descriptor instead.
+func (FindingKind) EnumDescriptor() ([]byte, []int) {
+	return file_rosterd_v1_roster_proto_rawDescGZIP(), []int{2}
+}
+
+type RosterTableFormat int32
+
+const (
+	RosterTableFormat_ROSTER_TABLE_FORMAT_UNSPECIFIED RosterTableFormat = 0
+	RosterTableFormat_ROSTER_TABLE_FORMAT_CSV         RosterTableFormat = 1
+	RosterTableFormat_ROSTER_TABLE_FORMAT_XLSX        RosterTableFormat = 2
+)
+
+// Enum value maps for RosterTableFormat.
+var (
+	RosterTableFormat_name = map[int32]string{
+		0: "ROSTER_TABLE_FORMAT_UNSPECIFIED",
+		1: "ROSTER_TABLE_FORMAT_CSV",
+		2: "ROSTER_TABLE_FORMAT_XLSX",
+	}
+	RosterTableFormat_value = map[string]int32{
+		"ROSTER_TABLE_FORMAT_UNSPECIFIED": 0,
+		"ROSTER_TABLE_FORMAT_CSV":         1,
+		"ROSTER_TABLE_FORMAT_XLSX":        2,
+	}
+)
+
+func (x RosterTableFormat) Enum() *RosterTableFormat {
+	p := new(RosterTableFormat)
+	*p = x
+	return p
+}
+
+func (x RosterTableFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RosterTableFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_rosterd_v1_roster_proto_enumTypes[3].Descriptor()
+}
+
+func (RosterTableFormat) Type() protoreflect.EnumType {
+	return &file_rosterd_v1_roster_proto_enumTypes[3]
+}
+
+func (x RosterTableFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RosterTableFormat.Descriptor instead.
+func (RosterTableFormat) EnumDescriptor() ([]byte, []int) {
+	return file_rosterd_v1_roster_proto_rawDescGZIP(), []int{3}
+}
+
+type RosterTableLayout int32
+
+const (
+	RosterTableLayout_ROSTER_TABLE_LAYOUT_UNSPECIFIED RosterTableLayout = 0
+	// One row per planned shift with date, shift name, times, time-worth
+	// and the assigned users.
+	RosterTableLayout_ROSTER_TABLE_LAYOUT_SHIFTS RosterTableLayout = 1
+	// One row per user and one column per day of the roster.
+	RosterTableLayout_ROSTER_TABLE_LAYOUT_MATRIX RosterTableLayout = 2
+)
+
+// Enum value maps for RosterTableLayout.
+var (
+	RosterTableLayout_name = map[int32]string{
+		0: "ROSTER_TABLE_LAYOUT_UNSPECIFIED",
+		1: "ROSTER_TABLE_LAYOUT_SHIFTS",
+		2: "ROSTER_TABLE_LAYOUT_MATRIX",
+	}
+	RosterTableLayout_value = map[string]int32{
+		"ROSTER_TABLE_LAYOUT_UNSPECIFIED": 0,
+		"ROSTER_TABLE_LAYOUT_SHIFTS":      1,
+		"ROSTER_TABLE_LAYOUT_MATRIX":      2,
+	}
+)
+
+func (x RosterTableLayout) Enum() *RosterTableLayout {
+	p := new(RosterTableLayout)
+	*p = x
+	return p
+}
+
+func (x RosterTableLayout) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RosterTableLayout) Descriptor() protoreflect.EnumDescriptor {
+	return file_rosterd_v1_roster_proto_enumTypes[4].Descriptor()
+}
+
+func (RosterTableLayout) Type() protoreflect.EnumType {
+	return &file_rosterd_v1_roster_proto_enumTypes[4]
+}
+
+func (x RosterTableLayout) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RosterTableLayout.Descriptor instead.
+func (RosterTableLayout) EnumDescriptor() ([]byte, []int) {
+	return file_rosterd_v1_roster_proto_rawDescGZIP(), []int{4}
+}
+
+type PayrollExportFormat int32
+
+const (
+	PayrollExportFormat_PAYROLL_EXPORT_FORMAT_UNSPECIFIED PayrollExportFormat = 0
+	PayrollExportFormat_PAYROLL_EXPORT_FORMAT_CSV         PayrollExportFormat = 1
+	// JSON follows the schema documented in docs/payroll-export.schema.json.
+	PayrollExportFormat_PAYROLL_EXPORT_FORMAT_JSON PayrollExportFormat = 2
+)
+
+// Enum value maps for PayrollExportFormat.
+var (
+	PayrollExportFormat_name = map[int32]string{
+		0: "PAYROLL_EXPORT_FORMAT_UNSPECIFIED",
+		1: "PAYROLL_EXPORT_FORMAT_CSV",
+		2: "PAYROLL_EXPORT_FORMAT_JSON",
+	}
+	PayrollExportFormat_value = map[string]int32{
+		"PAYROLL_EXPORT_FORMAT_UNSPECIFIED": 0,
+		"PAYROLL_EXPORT_FORMAT_CSV":         1,
+		"PAYROLL_EXPORT_FORMAT_JSON":        2,
+	}
+)
+
+func (x PayrollExportFormat) Enum() *PayrollExportFormat {
+	p := new(PayrollExportFormat)
+	*p = x
+	return p
+}
+
+func (x PayrollExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PayrollExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_rosterd_v1_roster_proto_enumTypes[5].Descriptor()
+}
+
+func (PayrollExportFormat) Type() protoreflect.EnumType {
+	return &file_rosterd_v1_roster_proto_enumTypes[5]
+}
+
+func (x PayrollExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PayrollExportFormat.Descriptor instead.
+func (PayrollExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_rosterd_v1_roster_proto_rawDescGZIP(), []int{5}
+}
+
+type UserRosterFormat int32
+
+const (
+	UserRosterFormat_USER_ROSTER_FORMAT_UNSPECIFIED UserRosterFormat = 0
+	UserRosterFormat_USER_ROSTER_FORMAT_PDF         UserRosterFormat = 1
+	UserRosterFormat_USER_ROSTER_FORMAT_HTML        UserRosterFormat = 2
+)
+
+// Enum value maps for UserRosterFormat.
+var (
+	UserRosterFormat_name = map[int32]string{
+		0: "USER_ROSTER_FORMAT_UNSPECIFIED",
+		1: "USER_ROSTER_FORMAT_PDF",
+		2: "USER_ROSTER_FORMAT_HTML",
+	}
+	UserRosterFormat_value = map[string]int32{
+		"USER_ROSTER_FORMAT_UNSPECIFIED": 0,
+		"USER_ROSTER_FORMAT_PDF":         1,
+		"USER_ROSTER_FORMAT_HTML":        2,
+	}
+)
+
+func (x UserRosterFormat) Enum() *UserRosterFormat {
+	p := new(UserRosterFormat)
+	*p = x
+	return p
+}
+
+func (x UserRosterFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UserRosterFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_rosterd_v1_roster_proto_enumTypes[6].Descriptor()
+}
+
+func (UserRosterFormat) Type() protoreflect.EnumType {
+	return &file_rosterd_v1_roster_proto_enumTypes[6]
+}
+
+func (x UserRosterFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UserRosterFormat.Descriptor instead.
+func (UserRosterFormat) EnumDescriptor() ([]byte, []int) {
+	return file_rosterd_v1_roster_proto_rawDescGZIP(), []int{6}
+}
+
+type ShiftChangeKind int32
+
+const (
+	ShiftChangeKind_SHIFT_CHANGE_KIND_UNSPECIFIED         ShiftChangeKind = 0
+	ShiftChangeKind_SHIFT_CHANGE_KIND_ADDED               ShiftChangeKind = 1
+	ShiftChangeKind_SHIFT_CHANGE_KIND_REMOVED             ShiftChangeKind = 2
+	ShiftChangeKind_SHIFT_CHANGE_KIND_TIMES_CHANGED       ShiftChangeKind = 3
+	ShiftChangeKind_SHIFT_CHANGE_KIND_ASSIGNMENTS_CHANGED ShiftChangeKind = 4
+)
+
+// Enum value maps for ShiftChangeKind.
+var (
+	ShiftChangeKind_name = map[int32]string{
+		0: "SHIFT_CHANGE_KIND_UNSPECIFIED",
+		1: "SHIFT_CHANGE_KIND_ADDED",
+		2: "SHIFT_CHANGE_KIND_REMOVED",
+		3: "SHIFT_CHANGE_KIND_TIMES_CHANGED",
+		4: "SHIFT_CHANGE_KIND_ASSIGNMENTS_CHANGED",
+	}
+	ShiftChangeKind_value = map[string]int32{
+		"SHIFT_CHANGE_KIND_UNSPECIFIED":         0,
+		"SHIFT_CHANGE_KIND_ADDED":               1,
+		"SHIFT_CHANGE_KIND_REMOVED":             2,
+		"SHIFT_CHANGE_KIND_TIMES_CHANGED":       3,
+		"SHIFT_CHANGE_KIND_ASSIGNMENTS_CHANGED": 4,
+	}
+)
+
+func (x ShiftChangeKind) Enum() *ShiftChangeKind {
+	p := new(ShiftChangeKind)
+	*p = x
+	return p
+}
+
+func (x ShiftChangeKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ShiftChangeKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_rosterd_v1_roster_proto_enumTypes[7].Descriptor()
+}
+
+func (ShiftChangeKind) Type() protoreflect.EnumType {
+	return &file_rosterd_v1_roster_proto_enumTypes[7]
+}
+
+func (x ShiftChangeKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ShiftChangeKind.Descriptor instead.
+func (ShiftChangeKind) EnumDescriptor() ([]byte, []int) {
+	return file_rosterd_v1_roster_proto_rawDescGZIP(), []int{7}
+}
+
+type UserShiftChangeKind int32
+
+const (
+	UserShiftChangeKind_USER_SHIFT_CHANGE_KIND_UNSPECIFIED   UserShiftChangeKind = 0
+	UserShiftChangeKind_USER_SHIFT_CHANGE_KIND_ASSIGNED      UserShiftChangeKind = 1
+	UserShiftChangeKind_USER_SHIFT_CHANGE_KIND_UNASSIGNED    UserShiftChangeKind = 2
+	UserShiftChangeKind_USER_SHIFT_CHANGE_KIND_TIMES_CHANGED UserShiftChangeKind = 3
+)
+
+// Enum value maps for UserShiftChangeKind.
+var (
+	UserShiftChangeKind_name = map[int32]string{
+		0: "USER_SHIFT_CHANGE_KIND_UNSPECIFIED",
+		1: "USER_SHIFT_CHANGE_KIND_ASSIGNED",
+		2: "USER_SHIFT_CHANGE_KIND_UNASSIGNED",
+		3: "USER_SHIFT_CHANGE_KIND_TIMES_CHANGED",
+	}
+	UserShiftChangeKind_value = map[string]int32{
+		"USER_SHIFT_CHANGE_KIND_UNSPECIFIED":   0,
+		"USER_SHIFT_CHANGE_KIND_ASSIGNED":      1,
+		"USER_SHIFT_CHANGE_KIND_UNASSIGNED":    2,
+		"USER_SHIFT_CHANGE_KIND_TIMES_CHANGED": 3,
+	}
+)
+
+func (x UserShiftChangeKind) Enum() *UserShiftChangeKind {
+	p := new(UserShiftChangeKind)
+	*p = x
+	return p
+}
+
+func (x UserShiftChangeKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UserShiftChangeKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_rosterd_v1_roster_proto_enumTypes[8].Descriptor()
+}
+
+func (UserShiftChangeKind) Type() protoreflect.EnumType {
+	return &file_rosterd_v1_roster_proto_enumTypes[8]
+}
+
+func (x UserShiftChangeKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UserShiftChangeKind.Descriptor instead.
+func (UserShiftChangeKind) EnumDescriptor() ([]byte, []int) {
+	return file_rosterd_v1_roster_proto_rawDescGZIP(), []int{8}
+}
+
+// UnstaffedShift describes a required shift that could not be fully staffed.
+type UnstaffedShift struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// From holds the time at which the shift begins.
+	From *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// To holds the time at which the shift ends.
+	To *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// WorkShiftId is the ID of the work-shift definition.
+	WorkShiftId string `protobuf:"bytes,3,opt,name=work_shift_id,json=workShiftId,proto3" json:"work_shift_id,omitempty"`
+	// RequiredStaffCount is the number of users that should be assigned.
+	RequiredStaffCount int32 `protobuf:"varint,4,opt,name=required_staff_count,json=requiredStaffCount,proto3" json:"required_staff_count,omitempty"`
+	// AssignedUserIds holds the users that are assigned to the shift.
+	AssignedUserIds []string `protobuf:"bytes,5,rep,name=assigned_user_ids,json=assignedUserIds,proto3" json:"assigned_user_ids,omitempty"`
+	// Reason describes why the shift could not be fully staffed.
+	Reason UnstaffedReason `protobuf:"varint,6,opt,name=reason,proto3,enum=rosterd.v1.UnstaffedReason" json:"reason,omitempty"`
+	// ViolationsPerUserId holds the violations of all users that have an
+	// eligible role but are not eligible for the shift.
+	ViolationsPerUserId map[string]*v1.ConstraintViolationList `protobuf:"bytes,7,rep,name=violations_per_user_id,json=violationsPerUserId,proto3" json:"violations_per_user_id,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// BlockedUserIds holds eligible users that violate a hard constraint
+	// when evaluated against the generated roster.
+	BlockedUserIds []string `protobuf:"bytes,8,rep,name=blocked_user_ids,json=blockedUserIds,proto3" json:"blocked_user_ids,omitempty"`
+	// UnavailableUserIds holds eligible users that are already assigned to
+	// an overlapping shift.
+	UnavailableUserIds []string `protobuf:"bytes,9,rep,name=unavailable_user_ids,json=unavailableUserIds,proto3" json:"unavailable_user_ids,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *UnstaffedShift) Reset() {
+	*x = UnstaffedShift{}
+	mi := &file_rosterd_v1_roster_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnstaffedShift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnstaffedShift) ProtoMessage() {}
+
+func (x *UnstaffedShift) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_roster_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnstaffedShift.ProtoReflect.Descriptor instead.
+func (*UnstaffedShift) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_roster_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UnstaffedShift) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *UnstaffedShift) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *UnstaffedShift) GetWorkShiftId() string {
+	if x != nil {
+		return x.WorkShiftId
+	}
+	return ""
+}
+
+func (x *UnstaffedShift) GetRequiredStaffCount() int32 {
+	if x != nil {
+		return x.RequiredStaffCount
+	}
+	return 0
+}
+
+func (x *UnstaffedShift) GetAssignedUserIds() []string {
+	if x != nil {
+		return x.AssignedUserIds
+	}
+	return nil
+}
+
+func (x *UnstaffedShift) GetReason() UnstaffedReason {
+	if x != nil {
+		return x.Reason
+	}
+	return UnstaffedReason_UNSTAFFED_REASON_UNSPECIFIED
+}
+
+func (x *UnstaffedShift) GetViolationsPerUserId() map[string]*v1.ConstraintViolationList {
+	if x != nil {
+		return x.ViolationsPerUserId
+	}
+	return nil
+}
+
+func (x *UnstaffedShift) GetBlockedUserIds() []string {
+	if x != nil {
+		return x.BlockedUserIds
+	}
+	return nil
+}
+
+func (x *UnstaffedShift) GetUnavailableUserIds() []string {
+	if x != nil {
+		return x.UnavailableUserIds
+	}
+	return nil
+}
+
+type GenerateRosterRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// RosterTypeName is the unique name of the roster type that should be
+	// generated.
+	RosterTypeName string `protobuf:"bytes,1,opt,name=roster_type_name,json=rosterTypeName,proto3" json:"roster_type_name,omitempty"`
+	// From holds the date of the first day in the roster.
+	// It should follow the format YYYY-MM-DD as in 2006-01-02.
+	From string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	// To holds the date of the last day in the roster (inclusive).
+	// It should follow the format YYYY-MM-DD as in 2006-01-02.
+	To string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	// OnCall may be set to true to generate a roster using the on-call
+	// tags of the roster type.
+	OnCall bool `protobuf:"varint,4,opt,name=on_call,json=onCall,proto3" json:"on_call,omitempty"`
+	// WorkTimePenaltyPerHour is the penalty for each hour a user deviates
+	// from the expected work-time. Defaults to 1.
+	WorkTimePenaltyPerHour float64 `protobuf:"fixed64,5,opt,name=work_time_penalty_per_hour,json=workTimePenaltyPerHour,proto3" json:"work_time_penalty_per_hour,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GenerateRosterRequest) Reset() {
+	*x = GenerateRosterRequest{}
+	mi := &file_rosterd_v1_roster_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateRosterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateRosterRequest) ProtoMessage() {}
+
+func (x *GenerateRosterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_roster_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateRosterRequest.ProtoReflect.Descriptor instead.
+func (*GenerateRosterRequest) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_roster_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GenerateRosterRequest) GetRosterTypeName() string {
+	if x != nil {
+		return x.RosterTypeName
+	}
+	return ""
+}
+
+func (x *GenerateRosterRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GenerateRosterRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *GenerateRosterRequest) GetOnCall() bool {
+	if x != nil {
+		return x.OnCall
+	}
+	return false
+}
+
+func (x *GenerateRosterRequest) GetWorkTimePenaltyPerHour() float64 {
+	if x != nil {
+		return x.WorkTimePenaltyPerHour
+	}
+	return 0
+}
+
+type GenerateRosterResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Roster is the generated duty roster. It is not yet saved and does not
+	// have an ID assigned.
+	Roster *v1.Roster `protobuf:"bytes,1,opt,name=roster,proto3" json:"roster,omitempty"`
+	// WorkShiftDefinitions holds the definitions of all work-shifts used in
+	// the roster.
+	WorkShiftDefinitions []*v1.WorkShift `protobuf:"bytes,2,rep,name=work_shift_definitions,json=workShiftDefinitions,proto3" json:"work_shift_definitions,omitempty"`
+	// Penalty is the total penalty of the generated roster, that is the sum
+	// of all constraint penalties and the work-time deviation penalties.
+	Penalty float64 `protobuf:"fixed64,3,opt,name=penalty,proto3" json:"penalty,omitempty"`
+	// UnstaffedShifts holds all shifts that could not be fully staffed.
+	UnstaffedShifts []*UnstaffedShift `protobuf:"bytes,4,rep,name=unstaffed_shifts,json=unstaffedShifts,proto3" json:"unstaffed_shifts,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GenerateRosterResponse) Reset() {
+	*x = GenerateRosterResponse{}
+	mi := &file_rosterd_v1_roster_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateRosterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateRosterResponse) ProtoMessage() {}
+
+func (x *GenerateRosterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_roster_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateRosterResponse.ProtoReflect.Descriptor instead.
+func (*GenerateRosterResponse) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_roster_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GenerateRosterResponse) GetRoster() *v1.Roster {
+	if x != nil {
+		return x.Roster
+	}
+	return nil
+}
+
+func (x *GenerateRosterResponse) GetWorkShiftDefinitions() []*v1.WorkShift {
+	if x != nil {
+		return x.WorkShiftDefinitions
+	}
+	return nil
+}
+
+func (x *GenerateRosterResponse) GetPenalty() float64 {
+	if x != nil {
+		return x.Penalty
+	}
+	return 0
+}
+
+func (x *GenerateRosterResponse) GetUnstaffedShifts() []*UnstaffedShift {
+	if x != nil {
+		return x.UnstaffedShifts
+	}
+	return nil
+}
+
+type CompleteRosterRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// RosterId is the ID of the duty roster that should be completed.
+	RosterId string `protobuf:"bytes,1,opt,name=roster_id,json=rosterId,proto3" json:"roster_id,omitempty"`
+	// WorkTimePenaltyPerHour is the penalty for each hour a user deviates
+	// from the expected work-time. Defaults to 1.
+	WorkTimePenaltyPerHour float64 `protobuf:"fixed64,2,opt,name=work_time_penalty_per_hour,json=workTimePenaltyPerHour,proto3" json:"work_time_penalty_per_hour,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *CompleteRosterRequest) Reset() {
+	*x = CompleteRosterRequest{}
+	mi := &file_rosterd_v1_roster_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteRosterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteRosterRequest) ProtoMessage() {}
+
+func (x *CompleteRosterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_roster_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteRosterRequest.ProtoReflect.Descriptor instead.
+func (*CompleteRosterRequest) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_roster_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CompleteRosterRequest) GetRosterId() string {
+	if x != nil {
+		return x.RosterId
+	}
+	return ""
+}
+
+func (x *CompleteRosterRequest) GetWorkTimePenaltyPerHour() float64 {
+	if x != nil {
+		return x.WorkTimePenaltyPerHour
+	}
+	return 0
+}
+
+type CompleteRosterResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Roster is the completed duty roster. Existing assignments are kept
+	// as they are. The roster is not yet saved but keeps the ID and CAS index
+	// of the original roster so it can be passed to SaveRoster.
+	Roster *v1.Roster `protobuf:"bytes,1,opt,name=roster,proto3" json:"roster,omitempty"`
+	// WorkShiftDefinitions holds the definitions of all work-shifts used in
+	// the roster.
+	WorkShiftDefinitions []*v1.WorkShift `protobuf:"bytes,2,rep,name=work_shift_definitions,json=workShiftDefinitions,proto3" json:"work_shift_definitions,omitempty"`
+	// Penalty is the total penalty of the completed roster.
+	Penalty float64 `protobuf:"fixed64,3,opt,name=penalty,proto3" json:"penalty,omitempty"`
+	// UnstaffedShifts holds all shifts that could not be fully staffed.
+	UnstaffedShifts []*UnstaffedShift `protobuf:"bytes,4,rep,name=unstaffed_shifts,json=unstaffedShifts,proto3" json:"unstaffed_shifts,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CompleteRosterResponse) Reset() {
+	*x = CompleteRosterResponse{}
+	mi := &file_rosterd_v1_roster_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteRosterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteRosterResponse) ProtoMessage() {}
+
+func (x *CompleteRosterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_roster_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteRosterResponse.ProtoReflect.Descriptor instead.
+func (*CompleteRosterResponse) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_roster_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CompleteRosterResponse) GetRoster() *v1.Roster {
+	if x != nil {
+		return x.Roster
+	}
+	return nil
+}
+
+func (x *CompleteRosterResponse) GetWorkShiftDefinitions() []*v1.WorkShift {
+	if x != nil {
+		return x.WorkShiftDefinitions
+	}
+	return nil
+}
+
+func (x *CompleteRosterResponse) GetPenalty() float64 {
+	if x != nil {
+		return x.Penalty
+	}
+	return 0
+}
+
+func (x *CompleteRosterResponse) GetUnstaffedShifts() []*UnstaffedShift {
+	if x != nil {
+		return x.UnstaffedShifts
+	}
+	return nil
+}
+
+// RosterFinding is a problem found while validating a roster.
+type RosterFinding struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Severity FindingSeverity        `protobuf:"varint,1,opt,name=severity,proto3,enum=rosterd.v1.FindingSeverity" json:"severity,omitempty"`
+	Kind     FindingKind            `protobuf:"varint,2,opt,name=kind,proto3,enum=rosterd.v1.FindingKind" json:"kind,omitempty"`
+	// Message is a human readable description of the finding.
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// UserId is the ID of the affected user, if any.
+	UserId string `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// WorkShiftId is the ID of the work-shift definition of the affected
+	// shift.
+	WorkShiftId string `protobuf:"bytes,5,opt,name=work_shift_id,json=workShiftId,proto3" json:"work_shift_id,omitempty"`
+	// From holds the time at which the affected shift begins.
+	From *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=from,proto3" json:"from,omitempty"`
+	// To holds the time at which the affected shift ends.
+	To *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=to,proto3" json:"to,omitempty"`
+	// Violation holds the underlying constraint violation, if any.
+	Violation     *v1.ConstraintViolation `protobuf:"bytes,8,opt,name=violation,proto3" json:"violation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RosterFinding) Reset() {
+	*x = RosterFinding{}
+	mi := &file_rosterd_v1_roster_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RosterFinding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RosterFinding) ProtoMessage() {}
+
+func (x *RosterFinding) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_roster_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RosterFinding.ProtoReflect.Descriptor instead.
+func (*RosterFinding) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_roster_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RosterFinding) GetSeverity() FindingSeverity {
+	if x != nil {
+		return x.Severity
+	}
+	return FindingSeverity_FINDING_SEVERITY_UNSPECIFIED
+}
+
+func (x *RosterFinding) GetKind() FindingKind {
+	if x != nil {
+		return x.Kind
+	}
+	return FindingKind_FINDING_KIND_UNSPECIFIED
+}
+
+func (x *RosterFinding) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RosterFinding) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RosterFinding) GetWorkShiftId() string {
+	if x != nil {
+		return x.WorkShiftId
+	}
+	return ""
+}
+
+func (x *RosterFinding) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *RosterFinding) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *RosterFinding) GetViolation() *v1.ConstraintViolation {
+	if x != nil {
+		return x.Violation
+	}
+	return nil
+}
+
+type ValidateRosterRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Roster:
+	//
+	//	*ValidateRosterRequest_RosterId
+	//	*ValidateRosterRequest_Unsaved
+	Roster        isValidateRosterRequest_Roster `protobuf_oneof:"roster"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateRosterRequest) Reset() {
+	*x = ValidateRosterRequest{}
+	mi := &file_rosterd_v1_roster_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateRosterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateRosterRequest) ProtoMessage() {}
+
+func (x *ValidateRosterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_roster_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateRosterRequest.ProtoReflect.Descriptor instead.
+func (*ValidateRosterRequest) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_roster_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ValidateRosterRequest) GetRoster() isValidateRosterRequest_Roster {
+	if x != nil {
+		return x.Roster
+	}
+	return nil
+}
+
+func (x *ValidateRosterRequest) GetRosterId() string {
+	if x != nil {
+		if x, ok := x.Roster.(*ValidateRosterRequest_RosterId); ok {
+			return x.RosterId
+		}
+	}
+	return ""
+}
+
+func (x *ValidateRosterRequest) GetUnsaved() *v1.Roster {
+	if x != nil {
+		if x, ok := x.Roster.(*ValidateRosterRequest_Unsaved); ok {
+			return x.Unsaved
+		}
+	}
+	return nil
+}
+
+type isValidateRosterRequest_Roster interface {
+	isValidateRosterRequest_Roster()
+}
+
+type ValidateRosterRequest_RosterId struct {
+	// RosterId is the ID of a saved duty roster.
+	RosterId string `protobuf:"bytes,1,opt,name=roster_id,json=rosterId,proto3,oneof"`
+}
+
+type ValidateRosterRequest_Unsaved struct {
+	// Roster is an unsaved duty roster.
+	Unsaved *v1.Roster `protobuf:"bytes,2,opt,name=unsaved,proto3,oneof"`
+}
+
+func (*ValidateRosterRequest_RosterId) isValidateRosterRequest_Roster() {}
+
+func (*ValidateRosterRequest_Unsaved) isValidateRosterRequest_Roster() {}
+
+type ValidateRosterResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Findings []*RosterFinding       `protobuf:"bytes,1,rep,name=findings,proto3" json:"findings,omitempty"`
+	// HasBlockingErrors is set to true if at least one finding has the
+	// severity FINDING_SEVERITY_ERROR.
+	HasBlockingErrors bool `protobuf:"varint,2,opt,name=has_blocking_errors,json=hasBlockingErrors,proto3" json:"has_blocking_errors,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ValidateRosterResponse) Reset() {
+	*x = ValidateRosterResponse{}
+	mi := &file_rosterd_v1_roster_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateRosterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateRosterResponse) ProtoMessage() {}
+
+func (x *ValidateRosterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_roster_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateRosterResponse.ProtoReflect.Descriptor instead.
+func (*ValidateRosterResponse) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_roster_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ValidateRosterResponse) GetFindings() []*RosterFinding {
+	if x != nil {
+		return x.Findings
+	}
+	return nil
+}
+
+func (x *ValidateRosterResponse) GetHasBlockingErrors() bool {
+	if x != nil {
+		return x.HasBlockingErrors
+	}
+	return false
+}
+
+type ValidateAndApproveRosterRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Approval holds the approval request.
+	Approval *v1.ApproveRosterRequest `protobuf:"bytes,1,opt,name=approval,proto3" json:"approval,omitempty"`
+	// Force approves the roster even if there are blocking errors.
+	Force         bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateAndApproveRosterRequest) Reset() {
+	*x = ValidateAndApproveRosterRequest{}
+	mi := &file_rosterd_v1_roster_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateAndApproveRosterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateAndApproveRosterRequest) ProtoMessage() {}
+
+func (x *ValidateAndApproveRosterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_roster_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateAndApproveRosterRequest.ProtoReflect.Descriptor instead.
+func (*ValidateAndApproveRosterRequest) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_roster_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ValidateAndApproveRosterRequest) GetApproval() *v1.ApproveRosterRequest {
+	if x != nil {
+		return x.Approval
+	}
+	return nil
+}
+
+func (x *ValidateAndApproveRosterRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type ValidateAndApproveRosterResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Approved is set to true if the roster has been approved.
+	Approved bool `protobuf:"varint,1,opt,name=approved,proto3" json:"approved,omitempty"`
+	// Findings holds all validation findings.
+	Findings      []*RosterFinding `protobuf:"bytes,2,rep,name=findings,proto3" json:"findings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateAndApproveRosterResponse) Reset() {
+	*x = ValidateAndApproveRosterResponse{}
+	mi := &file_rosterd_v1_roster_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateAndApproveRosterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateAndApproveRosterResponse) ProtoMessage() {}
+
+func (x *ValidateAndApproveRosterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_roster_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateAndApproveRosterResponse.ProtoReflect.Descriptor instead.
+func (*ValidateAndApproveRosterResponse) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_roster_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ValidateAndApproveRosterResponse) GetApproved() bool {
+	if x != nil {
+		return x.Approved
+	}
+	return false
+}
+
+func (x *ValidateAndApproveRosterResponse) GetFindings() []*RosterFinding {
+	if x != nil {
+		return x.Findings
+	}
+	return nil
+}
+
+type AnalyzeOvertimeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Users specifies which users should be analyzed. If unset, only the
+	// user that performs the request will be analyzed.
+	Users *v1.UsersToAnalyze `protobuf:"bytes,1,opt,name=users,proto3" json:"users,omitempty"`
+	// From holds the first day to include in the analysis. Format:
+	// YYYY-MM-DD.
+	From string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	// To holds the last day to include in the analysis. Format: YYYY-MM-DD.
+	To string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	// TimeTrackingOnly may be set to true to only include work-times for
+	// which time-tracking is enabled.
+	TimeTrackingOnly bool `protobuf:"varint,4,opt,name=time_tracking_only,json=timeTrackingOnly,proto3" json:"time_tracking_only,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AnalyzeOvertimeRequest) Reset() {
+	*x = AnalyzeOvertimeRequest{}
+	mi := &file_rosterd_v1_roster_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnalyzeOvertimeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyzeOvertimeRequest) ProtoMessage() {}
+
+func (x *AnalyzeOvertimeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_roster_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyzeOvertimeRequest.ProtoReflect.Descriptor instead.
+func (*AnalyzeOvertimeRequest) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_roster_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AnalyzeOvertimeRequest) GetUsers() *v1.UsersToAnalyze {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *AnalyzeOvertimeRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *AnalyzeOvertimeRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *AnalyzeOvertimeRequest) GetTimeTrackingOnly() bool {
+	if x != nil {
+		return x.TimeTrackingOnly
+	}
+	return false
+}
+
+// MonthlyOvertime describes the overtime of a user in a single month.
+type MonthlyOvertime struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Year         int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	Month        int32                  `protobuf:"varint,2,opt,name=month,proto3" json:"month,omitempty"`
+	ExpectedTime *durationpb.Duration   `protobuf:"bytes,3,opt,name=expected_time,json=expectedTime,proto3" json:"expected_time,omitempty"`
+	PlannedTime  *durationpb.Duration   `protobuf:"bytes,4,opt,name=planned_time,json=plannedTime,proto3" json:"planned_time,omitempty"`
+	// OvertimeAllowance is the overtime allowance of the month. If the
+	// analysis covers only a part of the month, the allowance is prorated.
+	OvertimeAllowance *durationpb.Duration `protobuf:"bytes,5,opt,name=overtime_allowance,json=overtimeAllowance,proto3" json:"overtime_allowance,omitempty"`
+	// RawOvertime is the difference between the planned and the expected
+	// time.
+	RawOvertime *durationpb.Duration `protobuf:"bytes,6,opt,name=raw_overtime,json=rawOvertime,proto3" json:"raw_overtime,omitempty"`
+	// Overtime is the raw overtime after deducting the overtime allowance.
+	// Undertime is not affected by the allowance.
+	Overtime      *durationpb.Duration `protobuf:"bytes,7,opt,name=overtime,proto3" json:"overtime,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MonthlyOvertime) Reset() {
+	*x = MonthlyOvertime{}
+	mi := &file_rosterd_v1_roster_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MonthlyOvertime) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonthlyOvertime) ProtoMessage() {}
+
+func (x *MonthlyOvertime) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_roster_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonthlyOvertime.ProtoReflect.Descriptor instead.
+func (*MonthlyOvertime) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_roster_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *MonthlyOvertime) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *MonthlyOvertime) GetMonth() int32 {
+	if x != nil {
+		return x.Month
+	}
+	return 0
+}
+
+func (x *MonthlyOvertime) GetExpectedTime() *durationpb.Duration {
+	if x != nil {
+		return x.ExpectedTime
+	}
+	return nil
+}
+
+func (x *MonthlyOvertime) GetPlannedTime() *durationpb.Duration {
+	if x != nil {
+		return x.PlannedTime
+	}
+	return nil
+}
+
+func (x *MonthlyOvertime) GetOvertimeAllowance() *durationpb.Duration {
+	if x != nil {
+		return x.OvertimeAllowance
+	}
+	return nil
+}
+
+func (x *MonthlyOvertime) GetRawOvertime() *durationpb.Duration {
+	if x != nil {
+		return x.RawOvertime
+	}
+	return nil
+}
+
+func (x *MonthlyOvertime) GetOvertime() *durationpb.Duration {
+	if x != nil {
+		return x.Overtime
+	}
+	return nil
+}
+
+type OvertimeAnalysis struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	UserId            string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ExpectedTime      *durationpb.Duration   `protobuf:"bytes,2,opt,name=expected_time,json=expectedTime,proto3" json:"expected_time,omitempty"`
+	PlannedTime       *durationpb.Duration   `protobuf:"bytes,3,opt,name=planned_time,json=plannedTime,proto3" json:"planned_time,omitempty"`
+	OvertimeAllowance *durationpb.Duration   `protobuf:"bytes,4,opt,name=overtime_allowance,json=overtimeAllowance,proto3" json:"overtime_allowance,omitempty"`
+	RawOvertime       *durationpb.Duration   `protobuf:"bytes,5,opt,name=raw_overtime,json=rawOvertime,proto3" json:"raw_overtime,omitempty"`
+	// Overtime holds the allowance-adjusted overtime. This is the value that
+	// is booked as off-time costs when a roster is approved.
+	Overtime      *durationpb.Duration `protobuf:"bytes,6,opt,name=overtime,proto3" json:"overtime,omitempty"`
+	Months        []*MonthlyOvertime   `protobuf:"bytes,7,rep,name=months,proto3" json:"months,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OvertimeAnalysis) Reset() {
+	*x = OvertimeAnalysis{}
+	mi := &file_rosterd_v1_roster_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OvertimeAnalysis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OvertimeAnalysis) ProtoMessage() {}
+
+func (x *OvertimeAnalysis) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_roster_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OvertimeAnalysis.ProtoReflect.Descriptor instead.
+func (*OvertimeAnalysis) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_roster_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *OvertimeAnalysis) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *OvertimeAnalysis) GetExpectedTime() *durationpb.Duration {
+	if x != nil {
+		return x.ExpectedTime
+	}
+	return nil
+}
+
+func (x *OvertimeAnalysis) GetPlannedTime() *durationpb.Duration {
+	if x != nil {
+		return x.PlannedTime
+	}
+	return nil
+}
+
+func (x *OvertimeAnalysis) GetOvertimeAllowance() *durationpb.Duration {
+	if x != nil {
+		return x.OvertimeAllowance
+	}
+	return nil
+}
+
+func (x *OvertimeAnalysis) GetRawOvertime() *durationpb.Duration {
+	if x != nil {
+		return x.RawOvertime
+	}
+	return nil
+}
+
+func (x *OvertimeAnalysis) GetOvertime() *durationpb.Duration {
+	if x != nil {
+		return x.Overtime
+	}
+	return nil
+}
+
+func (x *OvertimeAnalysis) GetMonths() []*MonthlyOvertime {
+	if x != nil {
+		return x.Months
+	}
+	return nil
+}
+
+type AnalyzeOvertimeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*OvertimeAnalysis    `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnalyzeOvertimeResponse) Reset() {
+	*x = AnalyzeOvertimeResponse{}
+	mi := &file_rosterd_v1_roster_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnalyzeOvertimeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyzeOvertimeResponse) ProtoMessage() {}
+
+func (x *AnalyzeOvertimeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_roster_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyzeOvertimeResponse.ProtoReflect.Descriptor instead.
+func (*AnalyzeOvertimeResponse) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_roster_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AnalyzeOvertimeResponse) GetResults() []*OvertimeAnalysis {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type ExportRosterTableRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Format defaults to ROSTER_TABLE_FORMAT_CSV.
+	Format RosterTableFormat `protobuf:"varint,2,opt,name=format,proto3,enum=rosterd.v1.RosterTableFormat" json:"format,omitempty"`
+	// Layout defaults to ROSTER_TABLE_LAYOUT_SHIFTS.
+	Layout RosterTableLayout `protobuf:"varint,3,opt,name=layout,proto3,enum=rosterd.v1.RosterTableLayout" json:"layout,omitempty"`
+	// Types that are valid to be assigned to Filter:
+	//
+	//	*ExportRosterTableRequest_ShiftIds
+	//	*ExportRosterTableRequest_ShiftTags
+	Filter        isExportRosterTableRequest_Filter `protobuf_oneof:"filter"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportRosterTableRequest) Reset() {
+	*x = ExportRosterTableRequest{}
+	mi := &file_rosterd_v1_roster_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportRosterTableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportRosterTableRequest) ProtoMessage() {}
+
+func (x *ExportRosterTableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_roster_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportRosterTableRequest.ProtoReflect.Descriptor instead.
+func (*ExportRosterTableRequest) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_roster_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ExportRosterTableRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ExportRosterTableRequest) GetFormat() RosterTableFormat {
+	if x != nil {
+		return x.Format
+	}
+	return RosterTableFormat_ROSTER_TABLE_FORMAT_UNSPECIFIED
+}
+
+func (x *ExportRosterTableRequest) GetLayout() RosterTableLayout {
+	if x != nil {
+		return x.Layout
+	}
+	return RosterTableLayout_ROSTER_TABLE_LAYOUT_UNSPECIFIED
+}
+
+func (x *ExportRosterTableRequest) GetFilter() isExportRosterTableRequest_Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ExportRosterTableRequest) GetShiftIds() *v1.StringList {
+	if x != nil {
+		if x, ok := x.Filter.(*ExportRosterTableRequest_ShiftIds); ok {
+			return x.ShiftIds
+		}
+	}
+	return nil
+}
+
+func (x *ExportRosterTableRequest) GetShiftTags() *v1.StringList {
+	if x != nil {
+		if x, ok := x.Filter.(*ExportRosterTableRequest_ShiftTags); ok {
+			return x.ShiftTags
+		}
+	}
+	return nil
+}
+
+type isExportRosterTableRequest_Filter interface {
+	isExportRosterTableRequest_Filter()
+}
+
+type ExportRosterTableRequest_ShiftIds struct {
+	ShiftIds *v1.StringList `protobuf:"bytes,4,opt,name=shift_ids,json=shiftIds,proto3,oneof"`
+}
+
+type ExportRosterTableRequest_ShiftTags struct {
+	ShiftTags *v1.StringList `protobuf:"bytes,5,opt,name=shift_tags,json=shiftTags,proto3,oneof"`
+}
+
+func (*ExportRosterTableRequest_ShiftIds) isExportRosterTableRequest_Filter() {}
+
+func (*ExportRosterTableRequest_ShiftTags) isExportRosterTableRequest_Filter() {}
+
+type ExportPayrollRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Users specifies which users should be exported. If unset, all users
+	// are exported.
+	Users *v1.UsersToAnalyze `protobuf:"bytes,1,opt,name=users,proto3" json:"users,omitempty"`
+	// From holds the first month to export. Format: YYYY-MM.
+	From string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	// To holds the last month to export. Format: YYYY-MM. Defaults to from.
+	To string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	// NightWindow may be set to overwrite the configured night window.
+	// Format: HH:MM-HH:MM.
+	NightWindow string `protobuf:"bytes,4,opt,name=night_window,json=nightWindow,proto3" json:"night_window,omitempty"`
+	// Format defaults to PAYROLL_EXPORT_FORMAT_CSV.
+	Format        PayrollExportFormat `protobuf:"varint,5,opt,name=format,proto3,enum=rosterd.v1.PayrollExportFormat" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportPayrollRequest) Reset() {
+	*x = ExportPayrollRequest{}
+	mi := &file_rosterd_v1_roster_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportPayrollRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportPayrollRequest) ProtoMessage() {}
+
+func (x *ExportPayrollRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_roster_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportPayrollRequest.ProtoReflect.Descriptor instead.
+func (*ExportPayrollRequest) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_roster_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ExportPayrollRequest) GetUsers() *v1.UsersToAnalyze {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *ExportPayrollRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *ExportPayrollRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *ExportPayrollRequest) GetNightWindow() string {
+	if x != nil {
+		return x.NightWindow
+	}
+	return ""
+}
+
+func (x *ExportPayrollRequest) GetFormat() PayrollExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return PayrollExportFormat_PAYROLL_EXPORT_FORMAT_UNSPECIFIED
+}
+
+// PayrollRecord holds the payroll relevant work time of a user in a single
+// month.
+type PayrollRecord struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Year   int32                  `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
+	Month  int32                  `protobuf:"varint,3,opt,name=month,proto3" json:"month,omitempty"`
+	// PlannedTime is the total planned work time. It is split into
+	// weekday, weekend and holiday time.
+	PlannedTime *durationpb.Duration `protobuf:"bytes,4,opt,name=planned_time,json=plannedTime,proto3" json:"planned_time,omitempty"`
+	WeekdayTime *durationpb.Duration `protobuf:"bytes,5,opt,name=weekday_time,json=weekdayTime,proto3" json:"weekday_time,omitempty"`
+	WeekendTime *durationpb.Duration `protobuf:"bytes,6,opt,name=weekend_time,json=weekendTime,proto3" json:"weekend_time,omitempty"`
+	HolidayTime *durationpb.Duration `protobuf:"bytes,7,opt,name=holiday_time,json=holidayTime,proto3" json:"holiday_time,omitempty"`
+	// NightTime is the part of the planned time that falls into the night
+	// window.
+	NightTime *durationpb.Duration `protobuf:"bytes,8,opt,name=night_time,json=nightTime,proto3" json:"night_time,omitempty"`
+	// Overtime is the allowance-adjusted overtime of the month.
+	Overtime      *durationpb.Duration `protobuf:"bytes,9,opt,name=overtime,proto3" json:"overtime,omitempty"`
+	Vacation      *durationpb.Duration `protobuf:"bytes,10,opt,name=vacation,proto3" json:"vacation,omitempty"`
+	TimeOff       *durationpb.Duration `protobuf:"bytes,11,opt,name=time_off,json=timeOff,proto3" json:"time_off,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PayrollRecord) Reset() {
+	*x = PayrollRecord{}
+	mi := &file_rosterd_v1_roster_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PayrollRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayrollRecord) ProtoMessage() {}
+
+func (x *PayrollRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_roster_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayrollRecord.ProtoReflect.Descriptor instead.
+func (*PayrollRecord) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_roster_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *PayrollRecord) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PayrollRecord) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *PayrollRecord) GetMonth() int32 {
+	if x != nil {
+		return x.Month
+	}
+	return 0
+}
+
+func (x *PayrollRecord) GetPlannedTime() *durationpb.Duration {
+	if x != nil {
+		return x.PlannedTime
+	}
+	return nil
+}
+
+func (x *PayrollRecord) GetWeekdayTime() *durationpb.Duration {
+	if x != nil {
+		return x.WeekdayTime
+	}
+	return nil
+}
+
+func (x *PayrollRecord) GetWeekendTime() *durationpb.Duration {
+	if x != nil {
+		return x.WeekendTime
+	}
+	return nil
+}
+
+func (x *PayrollRecord) GetHolidayTime() *durationpb.Duration {
+	if x != nil {
+		return x.HolidayTime
+	}
+	return nil
+}
+
+func (x *PayrollRecord) GetNightTime() *durationpb.Duration {
+	if x != nil {
+		return x.NightTime
+	}
+	return nil
+}
+
+func (x *PayrollRecord) GetOvertime() *durationpb.Duration {
+	if x != nil {
+		return x.Overtime
+	}
+	return nil
+}
+
+func (x *PayrollRecord) GetVacation() *durationpb.Duration {
+	if x != nil {
+		return x.Vacation
+	}
+	return nil
+}
+
+func (x *PayrollRecord) GetTimeOff() *durationpb.Duration {
+	if x != nil {
+		return x.TimeOff
+	}
+	return nil
+}
+
+type ExportPayrollResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Records []*PayrollRecord       `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	// Payload holds the records encoded in the requested format.
+	Payload       []byte `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	ContentType   string `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	FileName      string `protobuf:"bytes,4,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportPayrollResponse) Reset() {
+	*x = ExportPayrollResponse{}
+	mi := &file_rosterd_v1_roster_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportPayrollResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportPayrollResponse) ProtoMessage() {}
+
+func (x *ExportPayrollResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_roster_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportPayrollResponse.ProtoReflect.Descriptor instead.
+func (*ExportPayrollResponse) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_roster_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ExportPayrollResponse) GetRecords() []*PayrollRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+func (x *ExportPayrollResponse) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *ExportPayrollResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportPayrollResponse) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+type ExportUserRosterRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UserId defaults to the authenticated user. Only roster managers may
+	// export the roster of other users.
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Month holds the month to export. Format: YYYY-MM.
+	Month string `protobuf:"bytes,2,opt,name=month,proto3" json:"month,omitempty"`
+	// Format defaults to USER_ROSTER_FORMAT_PDF.
+	Format        UserRosterFormat `protobuf:"varint,3,opt,name=format,proto3,enum=rosterd.v1.UserRosterFormat" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportUserRosterRequest) Reset() {
+	*x = ExportUserRosterRequest{}
+	mi := &file_rosterd_v1_roster_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUserRosterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserRosterRequest) ProtoMessage() {}
+
+func (x *ExportUserRosterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_roster_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserRosterRequest.ProtoReflect.Descriptor instead.
+func (*ExportUserRosterRequest) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_roster_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ExportUserRosterRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ExportUserRosterRequest) GetMonth() string {
+	if x != nil {
+		return x.Month
+	}
+	return ""
+}
+
+func (x *ExportUserRosterRequest) GetFormat() UserRosterFormat {
+	if x != nil {
+		return x.Format
+	}
+	return UserRosterFormat_USER_ROSTER_FORMAT_UNSPECIFIED
+}
+
+// RosterVersionRef references a version of a duty roster.
+type RosterVersionRef struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	RosterId string                 `protobuf:"bytes,1,opt,name=roster_id,json=rosterId,proto3" json:"roster_id,omitempty"`
+	// CasIndex is the CAS index of the roster document after the version has
+	// been saved. Zero refers to the latest version of the document.
+	CasIndex      uint64 `protobuf:"varint,2,opt,name=cas_index,json=casIndex,proto3" json:"cas_index,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RosterVersionRef) Reset() {
+	*x = RosterVersionRef{}
+	mi := &file_rosterd_v1_roster_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RosterVersionRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RosterVersionRef) ProtoMessage() {}
+
+func (x *RosterVersionRef) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_roster_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RosterVersionRef.ProtoReflect.Descriptor instead.
+func (*RosterVersionRef) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_roster_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RosterVersionRef) GetRosterId() string {
+	if x != nil {
+		return x.RosterId
+	}
+	return ""
+}
+
+func (x *RosterVersionRef) GetCasIndex() uint64 {
+	if x != nil {
+		return x.CasIndex
+	}
+	return 0
+}
+
+// RosterVersion describes a saved version of a duty roster. Versions of
+// rosters that have been superseded by a new roster document are included.
+type RosterVersion struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	RosterId string                 `protobuf:"bytes,1,opt,name=roster_id,json=rosterId,proto3" json:"roster_id,omitempty"`
+	CasIndex uint64                 `protobuf:"varint,2,opt,name=cas_index,json=casIndex,proto3" json:"cas_index,omitempty"`
+	// Version is the 1-based number of the version within the history of
+	// the roster.
+	Version  int32                  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	SavedAt  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=saved_at,json=savedAt,proto3" json:"saved_at,omitempty"`
+	SavedBy  string                 `protobuf:"bytes,5,opt,name=saved_by,json=savedBy,proto3" json:"saved_by,omitempty"`
+	Approved bool                   `protobuf:"varint,6,opt,name=approved,proto3" json:"approved,omitempty"`
+	// Current is set for the latest version of the latest roster document.
+	Current       bool `protobuf:"varint,7,opt,name=current,proto3" json:"current,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RosterVersion) Reset() {
+	*x = RosterVersion{}
+	mi := &file_rosterd_v1_roster_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RosterVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RosterVersion) ProtoMessage() {}
+
+func (x *RosterVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_roster_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RosterVersion.ProtoReflect.Descriptor instead.
+func (*RosterVersion) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_roster_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RosterVersion) GetRosterId() string {
+	if x != nil {
+		return x.RosterId
+	}
+	return ""
+}
+
+func (x *RosterVersion) GetCasIndex() uint64 {
+	if x != nil {
+		return x.CasIndex
+	}
+	return 0
+}
+
+func (x *RosterVersion) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *RosterVersion) GetSavedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SavedAt
+	}
+	return nil
+}
+
+func (x *RosterVersion) GetSavedBy() string {
+	if x != nil {
+		return x.SavedBy
+	}
+	return ""
+}
+
+func (x *RosterVersion) GetApproved() bool {
+	if x != nil {
+		return x.Approved
+	}
+	return false
+}
+
+func (x *RosterVersion) GetCurrent() bool {
+	if x != nil {
+		return x.Current
+	}
+	return false
+}
+
+type ListRosterVersionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// RosterId may be the ID of any roster document in the history.
+	RosterId      string `protobuf:"bytes,1,opt,name=roster_id,json=rosterId,proto3" json:"roster_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRosterVersionsRequest) Reset() {
+	*x = ListRosterVersionsRequest{}
+	mi := &file_rosterd_v1_roster_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRosterVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRosterVersionsRequest) ProtoMessage() {}
+
+func (x *ListRosterVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_roster_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRosterVersionsRequest.ProtoReflect.Descriptor instead.
+func (*ListRosterVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_roster_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListRosterVersionsRequest) GetRosterId() string {
+	if x != nil {
+		return x.RosterId
+	}
+	return ""
+}
+
+type ListRosterVersionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Versions holds all versions, oldest first.
+	Versions      []*RosterVersion `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRosterVersionsResponse) Reset() {
+	*x = ListRosterVersionsResponse{}
+	mi := &file_rosterd_v1_roster_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRosterVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRosterVersionsResponse) ProtoMessage() {}
+
+func (x *ListRosterVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_roster_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRosterVersionsResponse.ProtoReflect.Descriptor instead.
+func (*ListRosterVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_roster_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListRosterVersionsResponse) GetVersions() []*RosterVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+type GetRosterVersionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ref           *RosterVersionRef      `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRosterVersionRequest) Reset() {
+	*x = GetRosterVersionRequest{}
+	mi := &file_rosterd_v1_roster_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRosterVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRosterVersionRequest) ProtoMessage() {}
+
+func (x *GetRosterVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_roster_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRosterVersionRequest.ProtoReflect.Descriptor instead.
+func (*GetRosterVersionRequest) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_roster_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetRosterVersionRequest) GetRef() *RosterVersionRef {
+	if x != nil {
+		return x.Ref
+	}
+	return nil
+}
+
+type GetRosterVersionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       *RosterVersion         `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Roster        *v1.Roster             `protobuf:"bytes,2,opt,name=roster,proto3" json:"roster,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRosterVersionResponse) Reset() {
+	*x = GetRosterVersionResponse{}
+	mi := &file_rosterd_v1_roster_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRosterVersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRosterVersionResponse) ProtoMessage() {}
+
+func (x *GetRosterVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_roster_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRosterVersionResponse.ProtoReflect.Descriptor instead.
+func (*GetRosterVersionResponse) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_roster_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetRosterVersionResponse) GetVersion() *RosterVersion {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
+func (x *GetRosterVersionResponse) GetRoster() *v1.Roster {
+	if x != nil {
+		return x.Roster
+	}
+	return nil
+}
+
+// ShiftChange describes the change of a single planned shift. Shifts whose
+// times changed are matched by work-shift and day.
+type ShiftChange struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Kind        ShiftChangeKind        `protobuf:"varint,1,opt,name=kind,proto3,enum=rosterd.v1.ShiftChangeKind" json:"kind,omitempty"`
+	WorkShiftId string                 `protobuf:"bytes,2,opt,name=work_shift_id,json=workShiftId,proto3" json:"work_shift_id,omitempty"`
+	// From and To hold the new times of the shift or the times of the
+	// removed shift.
+	From *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	// PreviousFrom and PreviousTo are only set for
+	// SHIFT_CHANGE_KIND_TIMES_CHANGED.
+	PreviousFrom      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=previous_from,json=previousFrom,proto3" json:"previous_from,omitempty"`
+	PreviousTo        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=previous_to,json=previousTo,proto3" json:"previous_to,omitempty"`
+	AssignedUserIds   []string               `protobuf:"bytes,7,rep,name=assigned_user_ids,json=assignedUserIds,proto3" json:"assigned_user_ids,omitempty"`
+	UnassignedUserIds []string               `protobuf:"bytes,8,rep,name=unassigned_user_ids,json=unassignedUserIds,proto3" json:"unassigned_user_ids,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ShiftChange) Reset() {
+	*x = ShiftChange{}
+	mi := &file_rosterd_v1_roster_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShiftChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShiftChange) ProtoMessage() {}
+
+func (x *ShiftChange) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_roster_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShiftChange.ProtoReflect.Descriptor instead.
+func (*ShiftChange) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_roster_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ShiftChange) GetKind() ShiftChangeKind {
+	if x != nil {
+		return x.Kind
+	}
+	return ShiftChangeKind_SHIFT_CHANGE_KIND_UNSPECIFIED
+}
+
+func (x *ShiftChange) GetWorkShiftId() string {
+	if x != nil {
+		return x.WorkShiftId
+	}
+	return ""
+}
+
+func (x *ShiftChange) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ShiftChange) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ShiftChange) GetPreviousFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PreviousFrom
+	}
+	return nil
+}
+
+func (x *ShiftChange) GetPreviousTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PreviousTo
+	}
+	return nil
+}
+
+func (x *ShiftChange) GetAssignedUserIds() []string {
+	if x != nil {
+		return x.AssignedUserIds
+	}
+	return nil
+}
+
+func (x *ShiftChange) GetUnassignedUserIds() []string {
+	if x != nil {
+		return x.UnassignedUserIds
+	}
+	return nil
+}
+
+// UserShiftChange describes the change of a single shift for a user.
+type UserShiftChange struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Kind        UserShiftChangeKind    `protobuf:"varint,1,opt,name=kind,proto3,enum=rosterd.v1.UserShiftChangeKind" json:"kind,omitempty"`
+	WorkShiftId string                 `protobuf:"bytes,2,opt,name=work_shift_id,json=workShiftId,proto3" json:"work_shift_id,omitempty"`
+	From        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	// PreviousFrom and PreviousTo are only set for
+	// USER_SHIFT_CHANGE_KIND_TIMES_CHANGED.
+	PreviousFrom  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=previous_from,json=previousFrom,proto3" json:"previous_from,omitempty"`
+	PreviousTo    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=previous_to,json=previousTo,proto3" json:"previous_to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserShiftChange) Reset() {
+	*x = UserShiftChange{}
+	mi := &file_rosterd_v1_roster_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserShiftChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserShiftChange) ProtoMessage() {}
+
+func (x *UserShiftChange) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_roster_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserShiftChange.ProtoReflect.Descriptor instead.
+func (*UserShiftChange) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_roster_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *UserShiftChange) GetKind() UserShiftChangeKind {
+	if x != nil {
+		return x.Kind
+	}
+	return UserShiftChangeKind_USER_SHIFT_CHANGE_KIND_UNSPECIFIED
+}
+
+func (x *UserShiftChange) GetWorkShiftId() string {
+	if x != nil {
+		return x.WorkShiftId
+	}
+	return ""
+}
+
+func (x *UserShiftChange) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *UserShiftChange) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *UserShiftChange) GetPreviousFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PreviousFrom
+	}
+	return nil
+}
+
+func (x *UserShiftChange) GetPreviousTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PreviousTo
+	}
+	return nil
+}
+
+type UserRosterChanges struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Changes       []*UserShiftChange     `protobuf:"bytes,2,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserRosterChanges) Reset() {
+	*x = UserRosterChanges{}
+	mi := &file_rosterd_v1_roster_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserRosterChanges) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserRosterChanges) ProtoMessage() {}
+
+func (x *UserRosterChanges) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_roster_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserRosterChanges.ProtoReflect.Descriptor instead.
+func (*UserRosterChanges) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_roster_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *UserRosterChanges) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserRosterChanges) GetChanges() []*UserShiftChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type DiffRostersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// From and To may reference any two rosters or roster versions that
+	// cover the same period.
+	From          *RosterVersionRef `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            *RosterVersionRef `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffRostersRequest) Reset() {
+	*x = DiffRostersRequest{}
+	mi := &file_rosterd_v1_roster_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffRostersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffRostersRequest) ProtoMessage() {}
+
+func (x *DiffRostersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_roster_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffRostersRequest.ProtoReflect.Descriptor instead.
+func (*DiffRostersRequest) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_roster_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DiffRostersRequest) GetFrom() *RosterVersionRef {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *DiffRostersRequest) GetTo() *RosterVersionRef {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type DiffRostersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Shifts holds all changed shifts ordered by their start time.
+	Shifts []*ShiftChange `protobuf:"bytes,1,rep,name=shifts,proto3" json:"shifts,omitempty"`
+	// Users holds the changes per user ordered by user ID.
+	Users         []*UserRosterChanges `protobuf:"bytes,2,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffRostersResponse) Reset() {
+	*x = DiffRostersResponse{}
+	mi := &file_rosterd_v1_roster_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffRostersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffRostersResponse) ProtoMessage() {}
+
+func (x *DiffRostersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_roster_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffRostersResponse.ProtoReflect.Descriptor instead.
+func (*DiffRostersResponse) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_roster_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *DiffRostersResponse) GetShifts() []*ShiftChange {
+	if x != nil {
+		return x.Shifts
+	}
+	return nil
+}
+
+func (x *DiffRostersResponse) GetUsers() []*UserRosterChanges {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+// RosterShiftDiff describes an assignment change of a single user.
+//
+// Deprecated: only used by DiffRosterVersions.
+//
+// Deprecated: Marked as deprecated in rosterd/v1/roster.proto.
+type RosterShiftDiff struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	WorkShiftId string                 `protobuf:"bytes,1,opt,name=work_shift_id,json=workShiftId,proto3" json:"work_shift_id,omitempty"`
+	From        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	// Assigned is set if the user has been assigned to the shift and unset
+	// if the user has been removed from the shift.
+	Assigned      bool `protobuf:"varint,4,opt,name=assigned,proto3" json:"assigned,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RosterShiftDiff) Reset() {
+	*x = RosterShiftDiff{}
+	mi := &file_rosterd_v1_roster_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RosterShiftDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RosterShiftDiff) ProtoMessage() {}
+
+func (x *RosterShiftDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_roster_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RosterShiftDiff.ProtoReflect.Descriptor instead.
+func (*RosterShiftDiff) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_roster_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RosterShiftDiff) GetWorkShiftId() string {
+	if x != nil {
+		return x.WorkShiftId
+	}
+	return ""
+}
+
+func (x *RosterShiftDiff) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *RosterShiftDiff) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *RosterShiftDiff) GetAssigned() bool {
+	if x != nil {
+		return x.Assigned
+	}
+	return false
+}
+
+// Deprecated: only used by DiffRosterVersions.
+//
+// Deprecated: Marked as deprecated in rosterd/v1/roster.proto.
+type UserRosterDiff struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Shifts        []*RosterShiftDiff     `protobuf:"bytes,2,rep,name=shifts,proto3" json:"shifts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserRosterDiff) Reset() {
+	*x = UserRosterDiff{}
+	mi := &file_rosterd_v1_roster_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserRosterDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserRosterDiff) ProtoMessage() {}
+
+func (x *UserRosterDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_roster_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserRosterDiff.ProtoReflect.Descriptor instead.
+func (*UserRosterDiff) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_roster_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *UserRosterDiff) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserRosterDiff) GetShifts() []*RosterShiftDiff {
+	if x != nil {
+		return x.Shifts
+	}
+	return nil
+}
+
+// Deprecated: use DiffRostersRequest.
+//
+// Deprecated: Marked as deprecated in rosterd/v1/roster.proto.
+type DiffRosterVersionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          *RosterVersionRef      `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            *RosterVersionRef      `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffRosterVersionsRequest) Reset() {
+	*x = DiffRosterVersionsRequest{}
+	mi := &file_rosterd_v1_roster_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffRosterVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffRosterVersionsRequest) ProtoMessage() {}
+
+func (x *DiffRosterVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_roster_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffRosterVersionsRequest.ProtoReflect.Descriptor instead.
+func (*DiffRosterVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_roster_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DiffRosterVersionsRequest) GetFrom() *RosterVersionRef {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *DiffRosterVersionsRequest) GetTo() *RosterVersionRef {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+// Deprecated: use DiffRostersResponse.
+//
+// Deprecated: Marked as deprecated in rosterd/v1/roster.proto.
+type DiffRosterVersionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*UserRosterDiff      `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffRosterVersionsResponse) Reset() {
+	*x = DiffRosterVersionsResponse{}
+	mi := &file_rosterd_v1_roster_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffRosterVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffRosterVersionsResponse) ProtoMessage() {}
+
+func (x *DiffRosterVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_roster_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffRosterVersionsResponse.ProtoReflect.Descriptor instead.
+func (*DiffRosterVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_roster_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DiffRosterVersionsResponse) GetUsers() []*UserRosterDiff {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type RestoreRosterVersionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ref           *RosterVersionRef      `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreRosterVersionRequest) Reset() {
+	*x = RestoreRosterVersionRequest{}
+	mi := &file_rosterd_v1_roster_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreRosterVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreRosterVersionRequest) ProtoMessage() {}
+
+func (x *RestoreRosterVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_roster_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreRosterVersionRequest.ProtoReflect.Descriptor instead.
+func (*RestoreRosterVersionRequest) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_roster_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RestoreRosterVersionRequest) GetRef() *RosterVersionRef {
+	if x != nil {
+		return x.Ref
+	}
+	return nil
+}
+
+type RestoreRosterVersionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Roster is the latest roster document with the shifts of the restored
+	// version. If the roster has been approved, a new unapproved roster
+	// that supersedes the approved one is created.
+	Roster        *v1.Roster `protobuf:"bytes,1,opt,name=roster,proto3" json:"roster,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreRosterVersionResponse) Reset() {
+	*x = RestoreRosterVersionResponse{}
+	mi := &file_rosterd_v1_roster_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreRosterVersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreRosterVersionResponse) ProtoMessage() {}
+
+func (x *RestoreRosterVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_roster_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreRosterVersionResponse.ProtoReflect.Descriptor instead.
+func (*RestoreRosterVersionResponse) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_roster_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *RestoreRosterVersionResponse) GetRoster() *v1.Roster {
+	if x != nil {
+		return x.Roster
+	}
+	return nil
+}
+
+var File_rosterd_v1_roster_proto protoreflect.FileDescriptor
+
+var file_rosterd_v1_roster_proto_rawDesc = string([]byte{
+	0x0a, 0x17, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x6f, 0x73,
+	0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x72, 0x6f, 0x73, 0x74, 0x65,
+	0x72, 0x64, 0x2e, 0x76, 0x31, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x74, 0x6b, 0x64, 0x2f, 0x72, 0x6f, 0x73, 0x74,
+	0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1d, 0x74, 0x6b, 0x64, 0x2f, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x2f, 0x76,
+	0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1e, 0x74, 0x6b, 0x64, 0x2f, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x2f, 0x76, 0x31,
+	0x2f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1e, 0x74, 0x6b, 0x64, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x76, 0x31,
+	0x2f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0xd9, 0x04, 0x0a, 0x0e, 0x55, 0x6e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x65, 0x64, 0x53,
+	0x68, 0x69, 0x66, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04,
+	0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f,
+	0x12, 0x22, 0x0a, 0x0d, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x73, 0x68, 0x69, 0x66, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x68, 0x69,
+	0x66, 0x74, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
+	0x5f, 0x73, 0x74, 0x61, 0x66, 0x66, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x12, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x53, 0x74, 0x61, 0x66,
+	0x66, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x73, 0x12, 0x33, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x55, 0x6e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x65, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x68, 0x0a, 0x16, 0x76, 0x69, 0x6f, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x65, 0x64, 0x53, 0x68,
+	0x69, 0x66, 0x74, 0x2e, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x50, 0x65,
+	0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x13, 0x76, 0x69,
+	0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x50, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x75,
+	0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x75, 0x6e, 0x61, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x1a, 0x6e, 0x0a,
+	0x18, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x50, 0x65, 0x72, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3c, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x74, 0x6b, 0x64,
+	0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x74,
+	0x72, 0x61, 0x69, 0x6e, 0x74, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xba, 0x01,
+	0x0a, 0x15, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x6f, 0x73, 0x74, 0x65,
+	0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x17, 0x0a, 0x07, 0x6f, 0x6e, 0x5f, 0x63, 0x61, 0x6c, 0x6c,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x3a,
+	0x0a, 0x1a, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x70, 0x65, 0x6e, 0x61,
+	0x6c, 0x74, 0x79, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x16, 0x77, 0x6f, 0x72, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x50, 0x65, 0x6e, 0x61,
+	0x6c, 0x74, 0x79, 0x50, 0x65, 0x72, 0x48, 0x6f, 0x75, 0x72, 0x22, 0xf8, 0x01, 0x0a, 0x16, 0x47,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x6b, 0x64, 0x2e, 0x72, 0x6f, 0x73, 0x74,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x52, 0x06, 0x72, 0x6f,
+	0x73, 0x74, 0x65, 0x72, 0x12, 0x4e, 0x0a, 0x16, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x73, 0x68, 0x69,
+	0x66, 0x74, 0x5f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x6b, 0x64, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x53, 0x68, 0x69, 0x66, 0x74, 0x52, 0x14,
+	0x77, 0x6f, 0x72, 0x6b, 0x53, 0x68, 0x69, 0x66, 0x74, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x12, 0x45,
+	0x0a, 0x10, 0x75, 0x6e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x65, 0x64, 0x5f, 0x73, 0x68, 0x69, 0x66,
+	0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65,
+	0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x65, 0x64, 0x53,
+	0x68, 0x69, 0x66, 0x74, 0x52, 0x0f, 0x75, 0x6e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x65, 0x64, 0x53,
+	0x68, 0x69, 0x66, 0x74, 0x73, 0x22, 0x70, 0x0a, 0x15, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x1a, 0x77,
+	0x6f, 0x72, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79,
+	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x16, 0x77, 0x6f, 0x72, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79,
+	0x50, 0x65, 0x72, 0x48, 0x6f, 0x75, 0x72, 0x22, 0xf8, 0x01, 0x0a, 0x16, 0x43, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x6b, 0x64, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x52, 0x06, 0x72, 0x6f, 0x73, 0x74, 0x65,
+	0x72, 0x12, 0x4e, 0x0a, 0x16, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x73, 0x68, 0x69, 0x66, 0x74, 0x5f,
+	0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x74, 0x6b, 0x64, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x53, 0x68, 0x69, 0x66, 0x74, 0x52, 0x14, 0x77, 0x6f, 0x72,
+	0x6b, 0x53, 0x68, 0x69, 0x66, 0x74, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x12, 0x45, 0x0a, 0x10, 0x75,
+	0x6e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x65, 0x64, 0x5f, 0x73, 0x68, 0x69, 0x66, 0x74, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x55, 0x6e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x65, 0x64, 0x53, 0x68, 0x69, 0x66,
+	0x74, 0x52, 0x0f, 0x75, 0x6e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x65, 0x64, 0x53, 0x68, 0x69, 0x66,
+	0x74, 0x73, 0x22, 0xea, 0x02, 0x0a, 0x0d, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x46, 0x69, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x12, 0x37, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x76, 0x65, 0x72,
+	0x69, 0x74, 0x79, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x2b, 0x0a,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x72, 0x6f,
+	0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x22, 0x0a,
+	0x0d, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x73, 0x68, 0x69, 0x66, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x68, 0x69, 0x66, 0x74, 0x49,
+	0x64, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x40, 0x0a,
+	0x09, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x74, 0x6b, 0x64, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x56, 0x69, 0x6f, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x73, 0x0a, 0x15, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x73, 0x74, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x09, 0x72, 0x6f, 0x73, 0x74,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x72,
+	0x6f, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x07, 0x75, 0x6e, 0x73, 0x61, 0x76,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x6b, 0x64, 0x2e, 0x72,
+	0x6f, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x48,
+	0x00, 0x52, 0x07, 0x75, 0x6e, 0x73, 0x61, 0x76, 0x65, 0x64, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x6f,
+	0x73, 0x74, 0x65, 0x72, 0x22, 0x7f, 0x0a, 0x16, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35,
+	0x0a, 0x08, 0x66, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f,
+	0x73, 0x74, 0x65, 0x72, 0x46, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x66, 0x69, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x68, 0x61, 0x73, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x11, 0x68, 0x61, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x78, 0x0a, 0x1f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x41, 0x6e, 0x64, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x6f, 0x73, 0x74, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3f, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x74, 0x6b, 0x64,
+	0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x22,
+	0x75, 0x0a, 0x20, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x41, 0x6e, 0x64, 0x41, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x12,
+	0x35, 0x0a, 0x08, 0x66, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x6f, 0x73, 0x74, 0x65, 0x72, 0x46, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x66, 0x69,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x9f, 0x01, 0x0a, 0x16, 0x41, 0x6e, 0x61, 0x6c, 0x79,
+	0x7a, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x33, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x74, 0x6b, 0x64, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x54, 0x6f, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x52,
+	0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x69,
+	0x6d, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x6f, 0x6e, 0x6c, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x54, 0x72, 0x61, 0x63,
+	0x6b, 0x69, 0x6e, 0x67, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0xf8, 0x02, 0x0a, 0x0f, 0x4d, 0x6f, 0x6e,
+	0x74, 0x68, 0x6c, 0x79, 0x4f, 0x76, 0x65, 0x72, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x79, 0x65, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72,
+	0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x12, 0x3e, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3c, 0x0a, 0x0c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65,
+	0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x6f, 0x76, 0x65, 0x72, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x6f, 0x76, 0x65,
+	0x72, 0x74, 0x69, 0x6d, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x3c,
+	0x0a, 0x0c, 0x72, 0x61, 0x77, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0b, 0x72, 0x61, 0x77, 0x4f, 0x76, 0x65, 0x72, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08,
+	0x6f, 0x76, 0x65, 0x72, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6f, 0x76, 0x65, 0x72, 0x74,
+	0x69, 0x6d, 0x65, 0x22, 0x9d, 0x03, 0x0a, 0x10, 0x4f, 0x76, 0x65, 0x72, 0x74, 0x69, 0x6d, 0x65,
+	0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x3e, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x3c, 0x0a, 0x0c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0b, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x48, 0x0a, 0x12, 0x6f, 0x76, 0x65, 0x72, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x6f, 0x76, 0x65, 0x72, 0x74, 0x69, 0x6d, 0x65,
+	0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x3c, 0x0a, 0x0c, 0x72, 0x61, 0x77,
+	0x5f, 0x6f, 0x76, 0x65, 0x72, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x72, 0x61, 0x77, 0x4f,
+	0x76, 0x65, 0x72, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x6f, 0x76, 0x65, 0x72, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6f, 0x76, 0x65, 0x72, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x33,
+	0x0a, 0x06, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x74,
+	0x68, 0x6c, 0x79, 0x4f, 0x76, 0x65, 0x72, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x06, 0x6d, 0x6f, 0x6e,
+	0x74, 0x68, 0x73, 0x22, 0x51, 0x0a, 0x17, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x4f, 0x76,
+	0x65, 0x72, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36,
+	0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x76, 0x65,
+	0x72, 0x74, 0x69, 0x6d, 0x65, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x52, 0x07, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x98, 0x02, 0x0a, 0x18, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x35, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x35, 0x0a, 0x06, 0x6c, 0x61,
+	0x79, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x72, 0x6f, 0x73,
+	0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x54, 0x61,
+	0x62, 0x6c, 0x65, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x52, 0x06, 0x6c, 0x61, 0x79, 0x6f, 0x75,
+	0x74, 0x12, 0x38, 0x0a, 0x09, 0x73, 0x68, 0x69, 0x66, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x6b, 0x64, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x48,
+	0x00, 0x52, 0x08, 0x73, 0x68, 0x69, 0x66, 0x74, 0x49, 0x64, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x73,
+	0x68, 0x69, 0x66, 0x74, 0x5f, 0x74, 0x61, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x74, 0x6b, 0x64, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x00, 0x52, 0x09, 0x73, 0x68,
+	0x69, 0x66, 0x74, 0x54, 0x61, 0x67, 0x73, 0x42, 0x08, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x22, 0xcb, 0x01, 0x0a, 0x14, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x61, 0x79, 0x72,
+	0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x05, 0x75, 0x73,
+	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x74, 0x6b, 0x64, 0x2e,
+	0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x54,
+	0x6f, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x74, 0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x77, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x69, 0x67, 0x68, 0x74,
+	0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x37, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x72, 0x6f, 0x6c, 0x6c, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22,
+	0xa8, 0x04, 0x0a, 0x0d, 0x50, 0x61, 0x79, 0x72, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65,
+	0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6d,
+	0x6f, 0x6e, 0x74, 0x68, 0x12, 0x3c, 0x0a, 0x0c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x3c, 0x0a, 0x0c, 0x77, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x77, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x3c, 0x0a, 0x0c, 0x77, 0x65, 0x65, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0b, 0x77, 0x65, 0x65, 0x6b, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3c,
+	0x0a, 0x0c, 0x68, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0b, 0x68, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x0a,
+	0x6e, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6e, 0x69, 0x67,
+	0x68, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x6f, 0x76, 0x65, 0x72, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6f, 0x76, 0x65, 0x72, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a,
+	0x08, 0x76, 0x61, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x76, 0x61, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6f, 0x66, 0x66,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x4f, 0x66, 0x66, 0x22, 0xa6, 0x01, 0x0a, 0x15, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x61, 0x79, 0x72, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x72, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0x7e, 0x0a, 0x17, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x12, 0x34, 0x0a,
+	0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e,
+	0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x6f, 0x73, 0x74, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x22, 0x4c, 0x0a, 0x10, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x73, 0x74, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x73, 0x74,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x61, 0x73, 0x5f, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x61, 0x73, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x22, 0xeb, 0x01, 0x0a, 0x0d, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x63, 0x61, 0x73, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x61, 0x73, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x18, 0x0a,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x08, 0x73, 0x61, 0x76, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x73, 0x61, 0x76, 0x65, 0x64, 0x41, 0x74, 0x12, 0x19,
+	0x0a, 0x08, 0x73, 0x61, 0x76, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x73, 0x61, 0x76, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x22,
+	0x38, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x22, 0x53, 0x0a, 0x1a, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x72, 0x6f, 0x73, 0x74,
+	0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x49,
+	0x0a, 0x17, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x03, 0x72, 0x65, 0x66,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x66, 0x52, 0x03, 0x72, 0x65, 0x66, 0x22, 0x7e, 0x0a, 0x18, 0x47, 0x65, 0x74,
+	0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x06, 0x72, 0x6f,
+	0x73, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x6b, 0x64,
+	0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x73, 0x74, 0x65,
+	0x72, 0x52, 0x06, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x22, 0x98, 0x03, 0x0a, 0x0b, 0x53, 0x68,
+	0x69, 0x66, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x2f, 0x0a, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x68, 0x69, 0x66, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x77, 0x6f,
+	0x72, 0x6b, 0x5f, 0x73, 0x68, 0x69, 0x66, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x68, 0x69, 0x66, 0x74, 0x49, 0x64, 0x12, 0x2e,
+	0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a,
+	0x0a, 0x02, 0x74, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x3f, 0x0a, 0x0d, 0x70, 0x72,
+	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x70,
+	0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x3b, 0x0a, 0x0b, 0x70,
+	0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x74, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x70, 0x72,
+	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x54, 0x6f, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x75, 0x6e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x11, 0x75, 0x6e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x73, 0x22, 0xc4, 0x02, 0x0a, 0x0f, 0x55, 0x73, 0x65, 0x72, 0x53, 0x68, 0x69,
+	0x66, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x33, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x68, 0x69, 0x66, 0x74, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x22, 0x0a,
+	0x0d, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x73, 0x68, 0x69, 0x66, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x68, 0x69, 0x66, 0x74, 0x49,
+	0x64, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x3f, 0x0a,
+	0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0c, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x3b,
+	0x0a, 0x0b, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x74, 0x6f, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0a, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x54, 0x6f, 0x22, 0x63, 0x0a, 0x11, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x07, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x72, 0x6f, 0x73,
+	0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x68, 0x69, 0x66,
+	0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
+	0x22, 0x74, 0x0a, 0x12, 0x44, 0x69, 0x66, 0x66, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x66, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2c, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x66, 0x52, 0x02, 0x74, 0x6f, 0x22, 0x7b, 0x0a, 0x13, 0x44, 0x69, 0x66, 0x66, 0x52, 0x6f,
+	0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a,
+	0x06, 0x73, 0x68, 0x69, 0x66, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x68, 0x69, 0x66, 0x74,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x06, 0x73, 0x68, 0x69, 0x66, 0x74, 0x73, 0x12, 0x33,
+	0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x6f, 0x73, 0x74, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x05, 0x75, 0x73,
+	0x65, 0x72, 0x73, 0x22, 0xb1, 0x01, 0x0a, 0x0f, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x53, 0x68,
+	0x69, 0x66, 0x74, 0x44, 0x69, 0x66, 0x66, 0x12, 0x22, 0x0a, 0x0d, 0x77, 0x6f, 0x72, 0x6b, 0x5f,
+	0x73, 0x68, 0x69, 0x66, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x77, 0x6f, 0x72, 0x6b, 0x53, 0x68, 0x69, 0x66, 0x74, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74,
+	0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x64, 0x3a, 0x02, 0x18, 0x01, 0x22, 0x62, 0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x6f, 0x73, 0x74, 0x65, 0x72, 0x44, 0x69, 0x66, 0x66, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x68, 0x69, 0x66, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x53, 0x68, 0x69, 0x66, 0x74, 0x44, 0x69, 0x66, 0x66, 0x52,
+	0x06, 0x73, 0x68, 0x69, 0x66, 0x74, 0x73, 0x3a, 0x02, 0x18, 0x01, 0x22, 0x7f, 0x0a, 0x19, 0x44,
+	0x69, 0x66, 0x66, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x66, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2c, 0x0a, 0x02, 0x74, 0x6f,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x66, 0x52, 0x02, 0x74, 0x6f, 0x3a, 0x02, 0x18, 0x01, 0x22, 0x52, 0x0a, 0x1a,
+	0x44, 0x69, 0x66, 0x66, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x75, 0x73,
+	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x72, 0x6f, 0x73, 0x74,
+	0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x73, 0x74, 0x65,
+	0x72, 0x44, 0x69, 0x66, 0x66, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x3a, 0x02, 0x18, 0x01,
+	0x22, 0x4d, 0x0a, 0x1b, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x6f, 0x73, 0x74, 0x65,
+	0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2e, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x72,
+	0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x52, 0x03, 0x72, 0x65, 0x66, 0x22,
+	0x4d, 0x0a, 0x1c, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2d, 0x0a, 0x06, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x74, 0x6b, 0x64, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x52, 0x06, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x2a, 0xd4,
+	0x01, 0x0a, 0x0f, 0x55, 0x6e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x65, 0x64, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x1c, 0x55, 0x4e, 0x53, 0x54, 0x41, 0x46, 0x46, 0x45, 0x44, 0x5f,
+	0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x26, 0x0a, 0x22, 0x55, 0x4e, 0x53, 0x54, 0x41, 0x46, 0x46, 0x45,
+	0x44, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x5f, 0x45, 0x4c, 0x49, 0x47,
+	0x49, 0x42, 0x4c, 0x45, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x53, 0x10, 0x01, 0x12, 0x25, 0x0a, 0x21,
+	0x55, 0x4e, 0x53, 0x54, 0x41, 0x46, 0x46, 0x45, 0x44, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e,
+	0x5f, 0x48, 0x41, 0x52, 0x44, 0x5f, 0x43, 0x4f, 0x4e, 0x53, 0x54, 0x52, 0x41, 0x49, 0x4e, 0x54,
+	0x53, 0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c, 0x55, 0x4e, 0x53, 0x54, 0x41, 0x46, 0x46, 0x45, 0x44,
+	0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41,
+	0x42, 0x4c, 0x45, 0x10, 0x03, 0x12, 0x2e, 0x0a, 0x2a, 0x55, 0x4e, 0x53, 0x54, 0x41, 0x46, 0x46,
+	0x45, 0x44, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x45, 0x4e,
+	0x4f, 0x55, 0x47, 0x48, 0x5f, 0x45, 0x4c, 0x49, 0x47, 0x49, 0x42, 0x4c, 0x45, 0x5f, 0x55, 0x53,
+	0x45, 0x52, 0x53, 0x10, 0x04, 0x2a, 0x88, 0x01, 0x0a, 0x0f, 0x46, 0x69, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x20, 0x0a, 0x1c, 0x46, 0x49, 0x4e,
+	0x44, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x46,
+	0x49, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59, 0x5f,
+	0x49, 0x4e, 0x46, 0x4f, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x46, 0x49, 0x4e, 0x44, 0x49, 0x4e,
+	0x47, 0x5f, 0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x57, 0x41, 0x52, 0x4e, 0x49,
+	0x4e, 0x47, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x46, 0x49, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f,
+	0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x03,
+	0x2a, 0x8c, 0x02, 0x0a, 0x0b, 0x46, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4b, 0x69, 0x6e, 0x64,
+	0x12, 0x1c, 0x0a, 0x18, 0x46, 0x49, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x4b, 0x49, 0x4e, 0x44,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d,
+	0x0a, 0x19, 0x46, 0x49, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55,
+	0x4e, 0x44, 0x45, 0x52, 0x53, 0x54, 0x41, 0x46, 0x46, 0x45, 0x44, 0x10, 0x01, 0x12, 0x19, 0x0a,
+	0x15, 0x46, 0x49, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x4f, 0x46,
+	0x46, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x46, 0x49, 0x4e, 0x44,
+	0x49, 0x4e, 0x47, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x4e, 0x4f, 0x5f, 0x57, 0x4f, 0x52, 0x4b,
+	0x5f, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x03, 0x12, 0x21, 0x0a, 0x1d, 0x46, 0x49, 0x4e, 0x44, 0x49,
+	0x4e, 0x47, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x4e, 0x4f, 0x5f, 0x45, 0x4c, 0x49, 0x47, 0x49,
+	0x42, 0x4c, 0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x10, 0x04, 0x12, 0x23, 0x0a, 0x1f, 0x46, 0x49,
+	0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x4c,
+	0x41, 0x50, 0x50, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x48, 0x49, 0x46, 0x54, 0x53, 0x10, 0x05, 0x12,
+	0x20, 0x0a, 0x1c, 0x46, 0x49, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f,
+	0x48, 0x41, 0x52, 0x44, 0x5f, 0x43, 0x4f, 0x4e, 0x53, 0x54, 0x52, 0x41, 0x49, 0x4e, 0x54, 0x10,
+	0x06, 0x12, 0x1c, 0x0a, 0x18, 0x46, 0x49, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x4b, 0x49, 0x4e,
+	0x44, 0x5f, 0x4c, 0x41, 0x42, 0x4f, 0x55, 0x52, 0x5f, 0x52, 0x55, 0x4c, 0x45, 0x10, 0x07, 0x2a,
+	0x73, 0x0a, 0x11, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x12, 0x23, 0x0a, 0x1f, 0x52, 0x4f, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x54,
+	0x41, 0x42, 0x4c, 0x45, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x52, 0x4f, 0x53,
+	0x54, 0x45, 0x52, 0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54,
+	0x5f, 0x43, 0x53, 0x56, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x52, 0x4f, 0x53, 0x54, 0x45, 0x52,
+	0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x58, 0x4c,
+	0x53, 0x58, 0x10, 0x02, 0x2a, 0x78, 0x0a, 0x11, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x54, 0x61,
+	0x62, 0x6c, 0x65, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x12, 0x23, 0x0a, 0x1f, 0x52, 0x4f, 0x53,
+	0x54, 0x45, 0x52, 0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x4c, 0x41, 0x59, 0x4f, 0x55, 0x54,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e,
+	0x0a, 0x1a, 0x52, 0x4f, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x4c,
+	0x41, 0x59, 0x4f, 0x55, 0x54, 0x5f, 0x53, 0x48, 0x49, 0x46, 0x54, 0x53, 0x10, 0x01, 0x12, 0x1e,
+	0x0a, 0x1a, 0x52, 0x4f, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x4c,
+	0x41, 0x59, 0x4f, 0x55, 0x54, 0x5f, 0x4d, 0x41, 0x54, 0x52, 0x49, 0x58, 0x10, 0x02, 0x2a, 0x7b,
+	0x0a, 0x13, 0x50, 0x61, 0x79, 0x72, 0x6f, 0x6c, 0x6c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x25, 0x0a, 0x21, 0x50, 0x41, 0x59, 0x52, 0x4f, 0x4c, 0x4c,
+	0x5f, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19,
+	0x50, 0x41, 0x59, 0x52, 0x4f, 0x4c, 0x4c, 0x5f, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46,
+	0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x43, 0x53, 0x56, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x50,
+	0x41, 0x59, 0x52, 0x4f, 0x4c, 0x4c, 0x5f, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f,
+	0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x02, 0x2a, 0x6f, 0x0a, 0x10, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12,
+	0x22, 0x0a, 0x1e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x52, 0x4f, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x46,
+	0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x52, 0x4f, 0x53, 0x54,
+	0x45, 0x52, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x50, 0x44, 0x46, 0x10, 0x01, 0x12,
+	0x1b, 0x0a, 0x17, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x52, 0x4f, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x46,
+	0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x48, 0x54, 0x4d, 0x4c, 0x10, 0x02, 0x2a, 0xc0, 0x01, 0x0a,
+	0x0f, 0x53, 0x68, 0x69, 0x66, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4b, 0x69, 0x6e, 0x64,
+	0x12, 0x21, 0x0a, 0x1d, 0x53, 0x48, 0x49, 0x46, 0x54, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45,
+	0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x48, 0x49, 0x46, 0x54, 0x5f, 0x43, 0x48, 0x41,
+	0x4e, 0x47, 0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x01,
+	0x12, 0x1d, 0x0a, 0x19, 0x53, 0x48, 0x49, 0x46, 0x54, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45,
+	0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x02, 0x12,
+	0x23, 0x0a, 0x1f, 0x53, 0x48, 0x49, 0x46, 0x54, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f,
+	0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x53, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47,
+	0x45, 0x44, 0x10, 0x03, 0x12, 0x29, 0x0a, 0x25, 0x53, 0x48, 0x49, 0x46, 0x54, 0x5f, 0x43, 0x48,
+	0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e,
+	0x4d, 0x45, 0x4e, 0x54, 0x53, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x44, 0x10, 0x04, 0x2a,
+	0xb3, 0x01, 0x0a, 0x13, 0x55, 0x73, 0x65, 0x72, 0x53, 0x68, 0x69, 0x66, 0x74, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x26, 0x0a, 0x22, 0x55, 0x53, 0x45, 0x52, 0x5f,
+	0x53, 0x48, 0x49, 0x46, 0x54, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4b, 0x49, 0x4e,
+	0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x23, 0x0a, 0x1f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x53, 0x48, 0x49, 0x46, 0x54, 0x5f, 0x43, 0x48,
+	0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e,
+	0x45, 0x44, 0x10, 0x01, 0x12, 0x25, 0x0a, 0x21, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x53, 0x48, 0x49,
+	0x46, 0x54, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55,
+	0x4e, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e, 0x45, 0x44, 0x10, 0x02, 0x12, 0x28, 0x0a, 0x24, 0x55,
+	0x53, 0x45, 0x52, 0x5f, 0x53, 0x48, 0x49, 0x46, 0x54, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45,
+	0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x53, 0x5f, 0x43, 0x48, 0x41, 0x4e,
+	0x47, 0x45, 0x44, 0x10, 0x03, 0x32, 0xd8, 0x0a, 0x0a, 0x0d, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5e, 0x0a, 0x0e, 0x47, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x12, 0x21, 0x2e, 0x72, 0x6f, 0x73, 0x74,
+	0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52,
+	0x6f, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x72,
+	0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x05, 0xb2, 0x7e, 0x02, 0x08, 0x02, 0x12, 0x5e, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x12, 0x21, 0x2e, 0x72, 0x6f, 0x73, 0x74,
+	0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x6f, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x72,
+	0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x05, 0xb2, 0x7e, 0x02, 0x08, 0x02, 0x12, 0x5e, 0x0a, 0x0e, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x12, 0x21, 0x2e, 0x72, 0x6f, 0x73, 0x74,
+	0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x6f, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x72,
+	0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x05, 0xb2, 0x7e, 0x02, 0x08, 0x02, 0x12, 0x7c, 0x0a, 0x18, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x41, 0x6e, 0x64, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x6f, 0x73,
+	0x74, 0x65, 0x72, 0x12, 0x2b, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x41, 0x6e, 0x64, 0x41, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2c, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x41, 0x6e, 0x64, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
+	0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x05,
+	0xb2, 0x7e, 0x02, 0x08, 0x02, 0x12, 0x61, 0x0a, 0x0f, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65,
+	0x4f, 0x76, 0x65, 0x72, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x22, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65,
+	0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x4f, 0x76, 0x65,
+	0x72, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x72,
+	0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a,
+	0x65, 0x4f, 0x76, 0x65, 0x72, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x05, 0xb2, 0x7e, 0x02, 0x08, 0x01, 0x12, 0x65, 0x0a, 0x11, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x24, 0x2e,
+	0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x74, 0x6b, 0x64, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x05, 0xb2, 0x7e, 0x02, 0x08, 0x01, 0x12,
+	0x63, 0x0a, 0x10, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x73,
+	0x74, 0x65, 0x72, 0x12, 0x23, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x73, 0x74, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x74, 0x6b, 0x64, 0x2e, 0x72,
+	0x6f, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x6f, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x05, 0xb2,
+	0x7e, 0x02, 0x08, 0x01, 0x12, 0x5b, 0x0a, 0x0d, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x61,
+	0x79, 0x72, 0x6f, 0x6c, 0x6c, 0x12, 0x20, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x61, 0x79, 0x72, 0x6f, 0x6c, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x61, 0x79, 0x72, 0x6f,
+	0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x05, 0xb2, 0x7e, 0x02, 0x08,
+	0x02, 0x12, 0x6a, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26,
+	0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x05, 0xb2, 0x7e, 0x02, 0x08, 0x02, 0x12, 0x64, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x23, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x05, 0xb2, 0x7e,
+	0x02, 0x08, 0x02, 0x12, 0x55, 0x0a, 0x0b, 0x44, 0x69, 0x66, 0x66, 0x52, 0x6f, 0x73, 0x74, 0x65,
+	0x72, 0x73, 0x12, 0x1e, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x69, 0x66, 0x66, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x69, 0x66, 0x66, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x05, 0xb2, 0x7e, 0x02, 0x08, 0x02, 0x12, 0x6d, 0x0a, 0x12, 0x44, 0x69,
+	0x66, 0x66, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x25, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69,
+	0x66, 0x66, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x08, 0xb2, 0x7e, 0x02, 0x08, 0x02, 0x88, 0x02, 0x01, 0x12, 0x70, 0x0a, 0x14, 0x52, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x27, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x72, 0x6f, 0x73,
+	0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52,
+	0x6f, 0x73, 0x74, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x05, 0xb2, 0x7e, 0x02, 0x08, 0x02, 0x1a, 0x13, 0xba, 0x7e, 0x10,
+	0x0a, 0x0e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
+	0x42, 0x46, 0x5a, 0x44, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74,
+	0x69, 0x65, 0x72, 0x6b, 0x6c, 0x69, 0x6e, 0x69, 0x6b, 0x2d, 0x64, 0x6f, 0x62, 0x65, 0x72, 0x73,
+	0x62, 0x65, 0x72, 0x67, 0x2f, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2f, 0x67, 0x65, 0x6e,
+	0x2f, 0x67, 0x6f, 0x2f, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2f, 0x76, 0x31, 0x3b, 0x72,
+	0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_rosterd_v1_roster_proto_rawDescOnce sync.Once
+	file_rosterd_v1_roster_proto_rawDescData []byte
+)
+
+func file_rosterd_v1_roster_proto_rawDescGZIP() []byte {
+	file_rosterd_v1_roster_proto_rawDescOnce.Do(func() {
+		file_rosterd_v1_roster_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rosterd_v1_roster_proto_rawDesc), len(file_rosterd_v1_roster_proto_rawDesc)))
+	})
+	return file_rosterd_v1_roster_proto_rawDescData
+}
+
+var file_rosterd_v1_roster_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
+var file_rosterd_v1_roster_proto_msgTypes = make([]protoimpl.MessageInfo, 37)
+var file_rosterd_v1_roster_proto_goTypes = []any{
+	(UnstaffedReason)(0),                     // 0: rosterd.v1.UnstaffedReason
+	(FindingSeverity)(0),                     // 1: rosterd.v1.FindingSeverity
+	(FindingKind)(0),                         // 2: rosterd.v1.FindingKind
+	(RosterTableFormat)(0),                   // 3: rosterd.v1.RosterTableFormat
+	(RosterTableLayout)(0),                   // 4: rosterd.v1.RosterTableLayout
+	(PayrollExportFormat)(0),                 // 5: rosterd.v1.PayrollExportFormat
+	(UserRosterFormat)(0),                    // 6: rosterd.v1.UserRosterFormat
+	(ShiftChangeKind)(0),                     // 7: rosterd.v1.ShiftChangeKind
+	(UserShiftChangeKind)(0),                 // 8: rosterd.v1.UserShiftChangeKind
+	(*UnstaffedShift)(nil),                   // 9: rosterd.v1.UnstaffedShift
+	(*GenerateRosterRequest)(nil),            // 10: rosterd.v1.GenerateRosterRequest
+	(*GenerateRosterResponse)(nil),           // 11: rosterd.v1.GenerateRosterResponse
+	(*CompleteRosterRequest)(nil),            // 12: rosterd.v1.CompleteRosterRequest
+	(*CompleteRosterResponse)(nil),           // 13: rosterd.v1.CompleteRosterResponse
+	(*RosterFinding)(nil),                    // 14: rosterd.v1.RosterFinding
+	(*ValidateRosterRequest)(nil),            // 15: rosterd.v1.ValidateRosterRequest
+	(*ValidateRosterResponse)(nil),           // 16: rosterd.v1.ValidateRosterResponse
+	(*ValidateAndApproveRosterRequest)(nil),  // 17: rosterd.v1.ValidateAndApproveRosterRequest
+	(*ValidateAndApproveRosterResponse)(nil), // 18: rosterd.v1.ValidateAndApproveRosterResponse
+	(*AnalyzeOvertimeRequest)(nil),           // 19: rosterd.v1.AnalyzeOvertimeRequest
+	(*MonthlyOvertime)(nil),                  // 20: rosterd.v1.MonthlyOvertime
+	(*OvertimeAnalysis)(nil),                 // 21: rosterd.v1.OvertimeAnalysis
+	(*AnalyzeOvertimeResponse)(nil),          // 22: rosterd.v1.AnalyzeOvertimeResponse
+	(*ExportRosterTableRequest)(nil),         // 23: rosterd.v1.ExportRosterTableRequest
+	(*ExportPayrollRequest)(nil),             // 24: rosterd.v1.ExportPayrollRequest
+	(*PayrollRecord)(nil),                    // 25: rosterd.v1.PayrollRecord
+	(*ExportPayrollResponse)(nil),            // 26: rosterd.v1.ExportPayrollResponse
+	(*ExportUserRosterRequest)(nil),          // 27: rosterd.v1.ExportUserRosterRequest
+	(*RosterVersionRef)(nil),                 // 28: rosterd.v1.RosterVersionRef
+	(*RosterVersion)(nil),                    // 29: rosterd.v1.RosterVersion
+	(*ListRosterVersionsRequest)(nil),        // 30: rosterd.v1.ListRosterVersionsRequest
+	(*ListRosterVersionsResponse)(nil),       // 31: rosterd.v1.ListRosterVersionsResponse
+	(*GetRosterVersionRequest)(nil),          // 32: rosterd.v1.GetRosterVersionRequest
+	(*GetRosterVersionResponse)(nil),         // 33: rosterd.v1.GetRosterVersionResponse
+	(*ShiftChange)(nil),                      // 34: rosterd.v1.ShiftChange
+	(*UserShiftChange)(nil),                  // 35: rosterd.v1.UserShiftChange
+	(*UserRosterChanges)(nil),                // 36: rosterd.v1.UserRosterChanges
+	(*DiffRostersRequest)(nil),               // 37: rosterd.v1.DiffRostersRequest
+	(*DiffRostersResponse)(nil),              // 38: rosterd.v1.DiffRostersResponse
+	(*RosterShiftDiff)(nil),                  // 39: rosterd.v1.RosterShiftDiff
+	(*UserRosterDiff)(nil),                   // 40: rosterd.v1.UserRosterDiff
+	(*DiffRosterVersionsRequest)(nil),        // 41: rosterd.v1.DiffRosterVersionsRequest
+	(*DiffRosterVersionsResponse)(nil),       // 42: rosterd.v1.DiffRosterVersionsResponse
+	(*RestoreRosterVersionRequest)(nil),      // 43: rosterd.v1.RestoreRosterVersionRequest
+	(*RestoreRosterVersionResponse)(nil),     // 44: rosterd.v1.RestoreRosterVersionResponse
+	nil,                                      // 45: rosterd.v1.UnstaffedShift.ViolationsPerUserIdEntry
+	(*timestamppb.Timestamp)(nil),            // 46: google.protobuf.Timestamp
+	(*v1.Roster)(nil),                        // 47: tkd.roster.v1.Roster
+	(*v1.WorkShift)(nil),                     // 48: tkd.roster.v1.WorkShift
+	(*v1.ConstraintViolation)(nil),           // 49: tkd.roster.v1.ConstraintViolation
+	(*v1.ApproveRosterRequest)(nil),          // 50: tkd.roster.v1.ApproveRosterRequest
+	(*v1.UsersToAnalyze)(nil),                // 51: tkd.roster.v1.UsersToAnalyze
+	(*durationpb.Duration)(nil),              // 52: google.protobuf.Duration
+	(*v1.StringList)(nil),                    // 53: tkd.roster.v1.StringList
+	(*v1.ConstraintViolationList)(nil),       // 54: tkd.roster.v1.ConstraintViolationList
+	(*v1.ExportRosterResponse)(nil),          // 55: tkd.roster.v1.ExportRosterResponse
+}
+var file_rosterd_v1_roster_proto_depIdxs = []int32{
+	46, // 0: rosterd.v1.UnstaffedShift.from:type_name -> google.protobuf.Timestamp
+	46, // 1: rosterd.v1.UnstaffedShift.to:type_name -> google.protobuf.Timestamp
+	0,  // 2: rosterd.v1.UnstaffedShift.reason:type_name -> rosterd.v1.UnstaffedReason
+	45, // 3: rosterd.v1.UnstaffedShift.violations_per_user_id:type_name -> rosterd.v1.UnstaffedShift.ViolationsPerUserIdEntry
+	47, // 4: rosterd.v1.GenerateRosterResponse.roster:type_name -> tkd.roster.v1.Roster
+	48, // 5: rosterd.v1.GenerateRosterResponse.work_shift_definitions:type_name -> tkd.roster.v1.WorkShift
+	9,  // 6: rosterd.v1.GenerateRosterResponse.unstaffed_shifts:type_name -> rosterd.v1.UnstaffedShift
+	47, // 7: rosterd.v1.CompleteRosterResponse.roster:type_name -> tkd.roster.v1.Roster
+	48, // 8: rosterd.v1.CompleteRosterResponse.work_shift_definitions:type_name -> tkd.roster.v1.WorkShift
+	9,  // 9: rosterd.v1.CompleteRosterResponse.unstaffed_shifts:type_name -> rosterd.v1.UnstaffedShift
+	1,  // 10: rosterd.v1.RosterFinding.severity:type_name -> rosterd.v1.FindingSeverity
+	2,  // 11: rosterd.v1.RosterFinding.kind:type_name -> rosterd.v1.FindingKind
+	46, // 12: rosterd.v1.RosterFinding.from:type_name -> google.protobuf.Timestamp
+	46, // 13: rosterd.v1.RosterFinding.to:type_name -> google.protobuf.Timestamp
+	49, // 14: rosterd.v1.RosterFinding.violation:type_name -> tkd.roster.v1.ConstraintViolation
+	47, // 15: rosterd.v1.ValidateRosterRequest.unsaved:type_name -> tkd.roster.v1.Roster
+	14, // 16: rosterd.v1.ValidateRosterResponse.findings:type_name -> rosterd.v1.RosterFinding
+	50, // 17: rosterd.v1.ValidateAndApproveRosterRequest.approval:type_name -> tkd.roster.v1.ApproveRosterRequest
+	14, // 18: rosterd.v1.ValidateAndApproveRosterResponse.findings:type_name -> rosterd.v1.RosterFinding
+	51, // 19: rosterd.v1.AnalyzeOvertimeRequest.users:type_name -> tkd.roster.v1.UsersToAnalyze
+	52, // 20: rosterd.v1.MonthlyOvertime.expected_time:type_name -> google.protobuf.Duration
+	52, // 21: rosterd.v1.MonthlyOvertime.planned_time:type_name -> google.protobuf.Duration
+	52, // 22: rosterd.v1.MonthlyOvertime.overtime_allowance:type_name -> google.protobuf.Duration
+	52, // 23: rosterd.v1.MonthlyOvertime.raw_overtime:type_name -> google.protobuf.Duration
+	52, // 24: rosterd.v1.MonthlyOvertime.overtime:type_name -> google.protobuf.Duration
+	52, // 25: rosterd.v1.OvertimeAnalysis.expected_time:type_name -> google.protobuf.Duration
+	52, // 26: rosterd.v1.OvertimeAnalysis.planned_time:type_name -> google.protobuf.Duration
+	52, // 27: rosterd.v1.OvertimeAnalysis.overtime_allowance:type_name -> google.protobuf.Duration
+	52, // 28: rosterd.v1.OvertimeAnalysis.raw_overtime:type_name -> google.protobuf.Duration
+	52, // 29: rosterd.v1.OvertimeAnalysis.overtime:type_name -> google.protobuf.Duration
+	20, // 30: rosterd.v1.OvertimeAnalysis.months:type_name -> rosterd.v1.MonthlyOvertime
+	21, // 31: rosterd.v1.AnalyzeOvertimeResponse.results:type_name -> rosterd.v1.OvertimeAnalysis
+	3,  // 32: rosterd.v1.ExportRosterTableRequest.format:type_name -> rosterd.v1.RosterTableFormat
+	4,  // 33: rosterd.v1.ExportRosterTableRequest.layout:type_name -> rosterd.v1.RosterTableLayout
+	53, // 34: rosterd.v1.ExportRosterTableRequest.shift_ids:type_name -> tkd.roster.v1.StringList
+	53, // 35: rosterd.v1.ExportRosterTableRequest.shift_tags:type_name -> tkd.roster.v1.StringList
+	51, // 36: rosterd.v1.ExportPayrollRequest.users:type_name -> tkd.roster.v1.UsersToAnalyze
+	5,  // 37: rosterd.v1.ExportPayrollRequest.format:type_name -> rosterd.v1.PayrollExportFormat
+	52, // 38: rosterd.v1.PayrollRecord.planned_time:type_name -> google.protobuf.Duration
+	52, // 39: rosterd.v1.PayrollRecord.weekday_time:type_name -> google.protobuf.Duration
+	52, // 40: rosterd.v1.PayrollRecord.weekend_time:type_name -> google.protobuf.Duration
+	52, // 41: rosterd.v1.PayrollRecord.holiday_time:type_name -> google.protobuf.Duration
+	52, // 42: rosterd.v1.PayrollRecord.night_time:type_name -> google.protobuf.Duration
+	52, // 43: rosterd.v1.PayrollRecord.overtime:type_name -> google.protobuf.Duration
+	52, // 44: rosterd.v1.PayrollRecord.vacation:type_name -> google.protobuf.Duration
+	52, // 45: rosterd.v1.PayrollRecord.time_off:type_name -> google.protobuf.Duration
+	25, // 46: rosterd.v1.ExportPayrollResponse.records:type_name -> rosterd.v1.PayrollRecord
+	6,  // 47: rosterd.v1.ExportUserRosterRequest.format:type_name -> rosterd.v1.UserRosterFormat
+	46, // 48: rosterd.v1.RosterVersion.saved_at:type_name -> google.protobuf.Timestamp
+	29, // 49: rosterd.v1.ListRosterVersionsResponse.versions:type_name -> rosterd.v1.RosterVersion
+	28, // 50: rosterd.v1.GetRosterVersionRequest.ref:type_name -> rosterd.v1.RosterVersionRef
+	29, // 51: rosterd.v1.GetRosterVersionResponse.version:type_name -> rosterd.v1.RosterVersion
+	47, // 52: rosterd.v1.GetRosterVersionResponse.roster:type_name -> tkd.roster.v1.Roster
+	7,  // 53: rosterd.v1.ShiftChange.kind:type_name -> rosterd.v1.ShiftChangeKind
+	46, // 54: rosterd.v1.ShiftChange.from:type_name -> google.protobuf.Timestamp
+	46, // 55: rosterd.v1.ShiftChange.to:type_name -> google.protobuf.Timestamp
+	46, // 56: rosterd.v1.ShiftChange.previous_from:type_name -> google.protobuf.Timestamp
+	46, // 57: rosterd.v1.ShiftChange.previous_to:type_name -> google.protobuf.Timestamp
+	8,  // 58: rosterd.v1.UserShiftChange.kind:type_name -> rosterd.v1.UserShiftChangeKind
+	46, // 59: rosterd.v1.UserShiftChange.from:type_name -> google.protobuf.Timestamp
+	46, // 60: rosterd.v1.UserShiftChange.to:type_name -> google.protobuf.Timestamp
+	46, // 61: rosterd.v1.UserShiftChange.previous_from:type_name -> google.protobuf.Timestamp
+	46, // 62: rosterd.v1.UserShiftChange.previous_to:type_name -> google.protobuf.Timestamp
+	35, // 63: rosterd.v1.UserRosterChanges.changes:type_name -> rosterd.v1.UserShiftChange
+	28, // 64: rosterd.v1.DiffRostersRequest.from:type_name -> rosterd.v1.RosterVersionRef
+	28, // 65: rosterd.v1.DiffRostersRequest.to:type_name -> rosterd.v1.RosterVersionRef
+	34, // 66: rosterd.v1.DiffRostersResponse.shifts:type_name -> rosterd.v1.ShiftChange
+	36, // 67: rosterd.v1.DiffRostersResponse.users:type_name -> rosterd.v1.UserRosterChanges
+	46, // 68: rosterd.v1.RosterShiftDiff.from:type_name -> google.protobuf.Timestamp
+	46, // 69: rosterd.v1.RosterShiftDiff.to:type_name -> google.protobuf.Timestamp
+	39, // 70: rosterd.v1.UserRosterDiff.shifts:type_name -> rosterd.v1.RosterShiftDiff
+	28, // 71: rosterd.v1.DiffRosterVersionsRequest.from:type_name -> rosterd.v1.RosterVersionRef
+	28, // 72: rosterd.v1.DiffRosterVersionsRequest.to:type_name -> rosterd.v1.RosterVersionRef
+	40, // 73: rosterd.v1.DiffRosterVersionsResponse.users:type_name -> rosterd.v1.UserRosterDiff
+	28, // 74: rosterd.v1.RestoreRosterVersionRequest.ref:type_name -> rosterd.v1.RosterVersionRef
+	47, // 75: rosterd.v1.RestoreRosterVersionResponse.roster:type_name -> tkd.roster.v1.Roster
+	54, // 76: rosterd.v1.UnstaffedShift.ViolationsPerUserIdEntry.value:type_name -> tkd.roster.v1.ConstraintViolationList
+	10, // 77: rosterd.v1.RosterService.GenerateRoster:input_type -> rosterd.v1.GenerateRosterRequest
+	12, // 78: rosterd.v1.RosterService.CompleteRoster:input_type -> rosterd.v1.CompleteRosterRequest
+	15, // 79: rosterd.v1.RosterService.ValidateRoster:input_type -> rosterd.v1.ValidateRosterRequest
+	17, // 80: rosterd.v1.RosterService.ValidateAndApproveRoster:input_type -> rosterd.v1.ValidateAndApproveRosterRequest
+	19, // 81: rosterd.v1.RosterService.AnalyzeOvertime:input_type -> rosterd.v1.AnalyzeOvertimeRequest
+	23, // 82: rosterd.v1.RosterService.ExportRosterTable:input_type -> rosterd.v1.ExportRosterTableRequest
+	27, // 83: rosterd.v1.RosterService.ExportUserRoster:input_type -> rosterd.v1.ExportUserRosterRequest
+	24, // 84: rosterd.v1.RosterService.ExportPayroll:input_type -> rosterd.v1.ExportPayrollRequest
+	30, // 85: rosterd.v1.RosterService.ListRosterVersions:input_type -> rosterd.v1.ListRosterVersionsRequest
+	32, // 86: rosterd.v1.RosterService.GetRosterVersion:input_type -> rosterd.v1.GetRosterVersionRequest
+	37, // 87: rosterd.v1.RosterService.DiffRosters:input_type -> rosterd.v1.DiffRostersRequest
+	41, // 88: rosterd.v1.RosterService.DiffRosterVersions:input_type -> rosterd.v1.DiffRosterVersionsRequest
+	43, // 89: rosterd.v1.RosterService.RestoreRosterVersion:input_type -> rosterd.v1.RestoreRosterVersionRequest
+	11, // 90: rosterd.v1.RosterService.GenerateRoster:output_type -> rosterd.v1.GenerateRosterResponse
+	13, // 91: rosterd.v1.RosterService.CompleteRoster:output_type -> rosterd.v1.CompleteRosterResponse
+	16, // 92: rosterd.v1.RosterService.ValidateRoster:output_type -> rosterd.v1.ValidateRosterResponse
+	18, // 93: rosterd.v1.RosterService.ValidateAndApproveRoster:output_type -> rosterd.v1.ValidateAndApproveRosterResponse
+	22, // 94: rosterd.v1.RosterService.AnalyzeOvertime:output_type -> rosterd.v1.AnalyzeOvertimeResponse
+	55, // 95: rosterd.v1.RosterService.ExportRosterTable:output_type -> tkd.roster.v1.ExportRosterResponse
+	55, // 96: rosterd.v1.RosterService.ExportUserRoster:output_type -> tkd.roster.v1.ExportRosterResponse
+	26, // 97: rosterd.v1.RosterService.ExportPayroll:output_type -> rosterd.v1.ExportPayrollResponse
+	31, // 98: rosterd.v1.RosterService.ListRosterVersions:output_type -> rosterd.v1.ListRosterVersionsResponse
+	33, // 99: rosterd.v1.RosterService.GetRosterVersion:output_type -> rosterd.v1.GetRosterVersionResponse
+	38, // 100: rosterd.v1.RosterService.DiffRosters:output_type -> rosterd.v1.DiffRostersResponse
+	42, // 101: rosterd.v1.RosterService.DiffRosterVersions:output_type -> rosterd.v1.DiffRosterVersionsResponse
+	44, // 102: rosterd.v1.RosterService.RestoreRosterVersion:output_type -> rosterd.v1.RestoreRosterVersionResponse
+	90, // [90:103] is the sub-list for method output_type
+	77, // [77:90] is the sub-list for method input_type
+	77, // [77:77] is the sub-list for extension type_name
+	77, // [77:77] is the sub-list for extension extendee
+	0,  // [0:77] is the sub-list for field type_name
+}
+
+func init() { file_rosterd_v1_roster_proto_init() }
+func file_rosterd_v1_roster_proto_init() {
+	if File_rosterd_v1_roster_proto != nil {
+		return
+	}
+	file_rosterd_v1_roster_proto_msgTypes[6].OneofWrappers = []any{
+		(*ValidateRosterRequest_RosterId)(nil),
+		(*ValidateRosterRequest_Unsaved)(nil),
+	}
+	file_rosterd_v1_roster_proto_msgTypes[14].OneofWrappers = []any{
+		(*ExportRosterTableRequest_ShiftIds)(nil),
+		(*ExportRosterTableRequest_ShiftTags)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rosterd_v1_roster_proto_rawDesc), len(file_rosterd_v1_roster_proto_rawDesc)),
+			NumEnums:      9,
+			NumMessages:   37,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_rosterd_v1_roster_proto_goTypes,
+		DependencyIndexes: file_rosterd_v1_roster_proto_depIdxs,
+		EnumInfos:         file_rosterd_v1_roster_proto_enumTypes,
+		MessageInfos:      file_rosterd_v1_roster_proto_msgTypes,
+	}.Build()
+	File_rosterd_v1_roster_proto = out.File
+	file_rosterd_v1_roster_proto_goTypes = nil
+	file_rosterd_v1_roster_proto_depIdxs = nil
+}
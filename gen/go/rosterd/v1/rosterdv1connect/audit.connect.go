@@ -0,0 +1,107 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: rosterd/v1/audit.proto
+
+package rosterdv1connect
+
+import (
+	context "context"
+	errors "errors"
+	connect_go "github.com/bufbuild/connect-go"
+	v1 "github.com/tierklinik-dobersberg/rosterd/gen/go/rosterd/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect_go.IsAtLeastVersion0_1_0
+
+const (
+	// AuditServiceName is the fully-qualified name of the AuditService service.
+	AuditServiceName = "rosterd.v1.AuditService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// AuditServiceListAuditEventsProcedure is the fully-qualified name of the AuditService's
+	// ListAuditEvents RPC.
+	AuditServiceListAuditEventsProcedure = "/rosterd.v1.AuditService/ListAuditEvents"
+)
+
+// AuditServiceClient is a client for the rosterd.v1.AuditService service.
+type AuditServiceClient interface {
+	// ListAuditEvents returns all audit events matching the request.
+	ListAuditEvents(context.Context, *connect_go.Request[v1.ListAuditEventsRequest]) (*connect_go.Response[v1.ListAuditEventsResponse], error)
+}
+
+// NewAuditServiceClient constructs a client for the rosterd.v1.AuditService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAuditServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) AuditServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &auditServiceClient{
+		listAuditEvents: connect_go.NewClient[v1.ListAuditEventsRequest, v1.ListAuditEventsResponse](
+			httpClient,
+			baseURL+AuditServiceListAuditEventsProcedure,
+			opts...,
+		),
+	}
+}
+
+// auditServiceClient implements AuditServiceClient.
+type auditServiceClient struct {
+	listAuditEvents *connect_go.Client[v1.ListAuditEventsRequest, v1.ListAuditEventsResponse]
+}
+
+// ListAuditEvents calls rosterd.v1.AuditService.ListAuditEvents.
+func (c *auditServiceClient) ListAuditEvents(ctx context.Context, req *connect_go.Request[v1.ListAuditEventsRequest]) (*connect_go.Response[v1.ListAuditEventsResponse], error) {
+	return c.listAuditEvents.CallUnary(ctx, req)
+}
+
+// AuditServiceHandler is an implementation of the rosterd.v1.AuditService service.
+type AuditServiceHandler interface {
+	// ListAuditEvents returns all audit events matching the request.
+	ListAuditEvents(context.Context, *connect_go.Request[v1.ListAuditEventsRequest]) (*connect_go.Response[v1.ListAuditEventsResponse], error)
+}
+
+// NewAuditServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAuditServiceHandler(svc AuditServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
+	auditServiceListAuditEventsHandler := connect_go.NewUnaryHandler(
+		AuditServiceListAuditEventsProcedure,
+		svc.ListAuditEvents,
+		opts...,
+	)
+	return "/rosterd.v1.AuditService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AuditServiceListAuditEventsProcedure:
+			auditServiceListAuditEventsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAuditServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAuditServiceHandler struct{}
+
+func (UnimplementedAuditServiceHandler) ListAuditEvents(context.Context, *connect_go.Request[v1.ListAuditEventsRequest]) (*connect_go.Response[v1.ListAuditEventsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("rosterd.v1.AuditService.ListAuditEvents is not implemented"))
+}
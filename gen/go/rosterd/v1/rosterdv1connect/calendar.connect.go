@@ -0,0 +1,167 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: rosterd/v1/calendar.proto
+
+package rosterdv1connect
+
+import (
+	context "context"
+	errors "errors"
+	connect_go "github.com/bufbuild/connect-go"
+	v1 "github.com/tierklinik-dobersberg/rosterd/gen/go/rosterd/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect_go.IsAtLeastVersion0_1_0
+
+const (
+	// CalendarFeedServiceName is the fully-qualified name of the CalendarFeedService service.
+	CalendarFeedServiceName = "rosterd.v1.CalendarFeedService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// CalendarFeedServiceCreateCalendarFeedProcedure is the fully-qualified name of the
+	// CalendarFeedService's CreateCalendarFeed RPC.
+	CalendarFeedServiceCreateCalendarFeedProcedure = "/rosterd.v1.CalendarFeedService/CreateCalendarFeed"
+	// CalendarFeedServiceGetCalendarFeedProcedure is the fully-qualified name of the
+	// CalendarFeedService's GetCalendarFeed RPC.
+	CalendarFeedServiceGetCalendarFeedProcedure = "/rosterd.v1.CalendarFeedService/GetCalendarFeed"
+	// CalendarFeedServiceRevokeCalendarFeedProcedure is the fully-qualified name of the
+	// CalendarFeedService's RevokeCalendarFeed RPC.
+	CalendarFeedServiceRevokeCalendarFeedProcedure = "/rosterd.v1.CalendarFeedService/RevokeCalendarFeed"
+)
+
+// CalendarFeedServiceClient is a client for the rosterd.v1.CalendarFeedService service.
+type CalendarFeedServiceClient interface {
+	// CreateCalendarFeed creates a new feed token for the user. Any
+	// previous token of the user is revoked.
+	CreateCalendarFeed(context.Context, *connect_go.Request[v1.CreateCalendarFeedRequest]) (*connect_go.Response[v1.CreateCalendarFeedResponse], error)
+	// GetCalendarFeed returns the calendar feed of the user.
+	GetCalendarFeed(context.Context, *connect_go.Request[v1.GetCalendarFeedRequest]) (*connect_go.Response[v1.GetCalendarFeedResponse], error)
+	// RevokeCalendarFeed revokes the feed token of the user.
+	RevokeCalendarFeed(context.Context, *connect_go.Request[v1.RevokeCalendarFeedRequest]) (*connect_go.Response[v1.RevokeCalendarFeedResponse], error)
+}
+
+// NewCalendarFeedServiceClient constructs a client for the rosterd.v1.CalendarFeedService service.
+// By default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped
+// responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewCalendarFeedServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) CalendarFeedServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &calendarFeedServiceClient{
+		createCalendarFeed: connect_go.NewClient[v1.CreateCalendarFeedRequest, v1.CreateCalendarFeedResponse](
+			httpClient,
+			baseURL+CalendarFeedServiceCreateCalendarFeedProcedure,
+			opts...,
+		),
+		getCalendarFeed: connect_go.NewClient[v1.GetCalendarFeedRequest, v1.GetCalendarFeedResponse](
+			httpClient,
+			baseURL+CalendarFeedServiceGetCalendarFeedProcedure,
+			opts...,
+		),
+		revokeCalendarFeed: connect_go.NewClient[v1.RevokeCalendarFeedRequest, v1.RevokeCalendarFeedResponse](
+			httpClient,
+			baseURL+CalendarFeedServiceRevokeCalendarFeedProcedure,
+			opts...,
+		),
+	}
+}
+
+// calendarFeedServiceClient implements CalendarFeedServiceClient.
+type calendarFeedServiceClient struct {
+	createCalendarFeed *connect_go.Client[v1.CreateCalendarFeedRequest, v1.CreateCalendarFeedResponse]
+	getCalendarFeed    *connect_go.Client[v1.GetCalendarFeedRequest, v1.GetCalendarFeedResponse]
+	revokeCalendarFeed *connect_go.Client[v1.RevokeCalendarFeedRequest, v1.RevokeCalendarFeedResponse]
+}
+
+// CreateCalendarFeed calls rosterd.v1.CalendarFeedService.CreateCalendarFeed.
+func (c *calendarFeedServiceClient) CreateCalendarFeed(ctx context.Context, req *connect_go.Request[v1.CreateCalendarFeedRequest]) (*connect_go.Response[v1.CreateCalendarFeedResponse], error) {
+	return c.createCalendarFeed.CallUnary(ctx, req)
+}
+
+// GetCalendarFeed calls rosterd.v1.CalendarFeedService.GetCalendarFeed.
+func (c *calendarFeedServiceClient) GetCalendarFeed(ctx context.Context, req *connect_go.Request[v1.GetCalendarFeedRequest]) (*connect_go.Response[v1.GetCalendarFeedResponse], error) {
+	return c.getCalendarFeed.CallUnary(ctx, req)
+}
+
+// RevokeCalendarFeed calls rosterd.v1.CalendarFeedService.RevokeCalendarFeed.
+func (c *calendarFeedServiceClient) RevokeCalendarFeed(ctx context.Context, req *connect_go.Request[v1.RevokeCalendarFeedRequest]) (*connect_go.Response[v1.RevokeCalendarFeedResponse], error) {
+	return c.revokeCalendarFeed.CallUnary(ctx, req)
+}
+
+// CalendarFeedServiceHandler is an implementation of the rosterd.v1.CalendarFeedService service.
+type CalendarFeedServiceHandler interface {
+	// CreateCalendarFeed creates a new feed token for the user. Any
+	// previous token of the user is revoked.
+	CreateCalendarFeed(context.Context, *connect_go.Request[v1.CreateCalendarFeedRequest]) (*connect_go.Response[v1.CreateCalendarFeedResponse], error)
+	// GetCalendarFeed returns the calendar feed of the user.
+	GetCalendarFeed(context.Context, *connect_go.Request[v1.GetCalendarFeedRequest]) (*connect_go.Response[v1.GetCalendarFeedResponse], error)
+	// RevokeCalendarFeed revokes the feed token of the user.
+	RevokeCalendarFeed(context.Context, *connect_go.Request[v1.RevokeCalendarFeedRequest]) (*connect_go.Response[v1.RevokeCalendarFeedResponse], error)
+}
+
+// NewCalendarFeedServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewCalendarFeedServiceHandler(svc CalendarFeedServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
+	calendarFeedServiceCreateCalendarFeedHandler := connect_go.NewUnaryHandler(
+		CalendarFeedServiceCreateCalendarFeedProcedure,
+		svc.CreateCalendarFeed,
+		opts...,
+	)
+	calendarFeedServiceGetCalendarFeedHandler := connect_go.NewUnaryHandler(
+		CalendarFeedServiceGetCalendarFeedProcedure,
+		svc.GetCalendarFeed,
+		opts...,
+	)
+	calendarFeedServiceRevokeCalendarFeedHandler := connect_go.NewUnaryHandler(
+		CalendarFeedServiceRevokeCalendarFeedProcedure,
+		svc.RevokeCalendarFeed,
+		opts...,
+	)
+	return "/rosterd.v1.CalendarFeedService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case CalendarFeedServiceCreateCalendarFeedProcedure:
+			calendarFeedServiceCreateCalendarFeedHandler.ServeHTTP(w, r)
+		case CalendarFeedServiceGetCalendarFeedProcedure:
+			calendarFeedServiceGetCalendarFeedHandler.ServeHTTP(w, r)
+		case CalendarFeedServiceRevokeCalendarFeedProcedure:
+			calendarFeedServiceRevokeCalendarFeedHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedCalendarFeedServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedCalendarFeedServiceHandler struct{}
+
+func (UnimplementedCalendarFeedServiceHandler) CreateCalendarFeed(context.Context, *connect_go.Request[v1.CreateCalendarFeedRequest]) (*connect_go.Response[v1.CreateCalendarFeedResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("rosterd.v1.CalendarFeedService.CreateCalendarFeed is not implemented"))
+}
+
+func (UnimplementedCalendarFeedServiceHandler) GetCalendarFeed(context.Context, *connect_go.Request[v1.GetCalendarFeedRequest]) (*connect_go.Response[v1.GetCalendarFeedResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("rosterd.v1.CalendarFeedService.GetCalendarFeed is not implemented"))
+}
+
+func (UnimplementedCalendarFeedServiceHandler) RevokeCalendarFeed(context.Context, *connect_go.Request[v1.RevokeCalendarFeedRequest]) (*connect_go.Response[v1.RevokeCalendarFeedResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("rosterd.v1.CalendarFeedService.RevokeCalendarFeed is not implemented"))
+}
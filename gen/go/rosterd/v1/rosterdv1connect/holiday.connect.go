@@ -0,0 +1,225 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: rosterd/v1/holiday.proto
+
+package rosterdv1connect
+
+import (
+	context "context"
+	errors "errors"
+	connect_go "github.com/bufbuild/connect-go"
+	v1 "github.com/tierklinik-dobersberg/rosterd/gen/go/rosterd/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect_go.IsAtLeastVersion0_1_0
+
+const (
+	// HolidayServiceName is the fully-qualified name of the HolidayService service.
+	HolidayServiceName = "rosterd.v1.HolidayService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// HolidayServiceCreateLocalHolidayProcedure is the fully-qualified name of the HolidayService's
+	// CreateLocalHoliday RPC.
+	HolidayServiceCreateLocalHolidayProcedure = "/rosterd.v1.HolidayService/CreateLocalHoliday"
+	// HolidayServiceUpdateLocalHolidayProcedure is the fully-qualified name of the HolidayService's
+	// UpdateLocalHoliday RPC.
+	HolidayServiceUpdateLocalHolidayProcedure = "/rosterd.v1.HolidayService/UpdateLocalHoliday"
+	// HolidayServiceDeleteLocalHolidayProcedure is the fully-qualified name of the HolidayService's
+	// DeleteLocalHoliday RPC.
+	HolidayServiceDeleteLocalHolidayProcedure = "/rosterd.v1.HolidayService/DeleteLocalHoliday"
+	// HolidayServiceListLocalHolidaysProcedure is the fully-qualified name of the HolidayService's
+	// ListLocalHolidays RPC.
+	HolidayServiceListLocalHolidaysProcedure = "/rosterd.v1.HolidayService/ListLocalHolidays"
+	// HolidayServiceListEffectiveHolidaysProcedure is the fully-qualified name of the HolidayService's
+	// ListEffectiveHolidays RPC.
+	HolidayServiceListEffectiveHolidaysProcedure = "/rosterd.v1.HolidayService/ListEffectiveHolidays"
+)
+
+// HolidayServiceClient is a client for the rosterd.v1.HolidayService service.
+type HolidayServiceClient interface {
+	// CreateLocalHoliday creates a new local holiday.
+	CreateLocalHoliday(context.Context, *connect_go.Request[v1.CreateLocalHolidayRequest]) (*connect_go.Response[v1.CreateLocalHolidayResponse], error)
+	// UpdateLocalHoliday replaces an existing local holiday.
+	UpdateLocalHoliday(context.Context, *connect_go.Request[v1.UpdateLocalHolidayRequest]) (*connect_go.Response[v1.UpdateLocalHolidayResponse], error)
+	// DeleteLocalHoliday deletes a local holiday.
+	DeleteLocalHoliday(context.Context, *connect_go.Request[v1.DeleteLocalHolidayRequest]) (*connect_go.Response[v1.DeleteLocalHolidayResponse], error)
+	// ListLocalHolidays returns all local holidays.
+	ListLocalHolidays(context.Context, *connect_go.Request[v1.ListLocalHolidaysRequest]) (*connect_go.Response[v1.ListLocalHolidaysResponse], error)
+	// ListEffectiveHolidays returns the public holidays of the calendar
+	// service merged with the local holidays.
+	ListEffectiveHolidays(context.Context, *connect_go.Request[v1.ListEffectiveHolidaysRequest]) (*connect_go.Response[v1.ListEffectiveHolidaysResponse], error)
+}
+
+// NewHolidayServiceClient constructs a client for the rosterd.v1.HolidayService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewHolidayServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) HolidayServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &holidayServiceClient{
+		createLocalHoliday: connect_go.NewClient[v1.CreateLocalHolidayRequest, v1.CreateLocalHolidayResponse](
+			httpClient,
+			baseURL+HolidayServiceCreateLocalHolidayProcedure,
+			opts...,
+		),
+		updateLocalHoliday: connect_go.NewClient[v1.UpdateLocalHolidayRequest, v1.UpdateLocalHolidayResponse](
+			httpClient,
+			baseURL+HolidayServiceUpdateLocalHolidayProcedure,
+			opts...,
+		),
+		deleteLocalHoliday: connect_go.NewClient[v1.DeleteLocalHolidayRequest, v1.DeleteLocalHolidayResponse](
+			httpClient,
+			baseURL+HolidayServiceDeleteLocalHolidayProcedure,
+			opts...,
+		),
+		listLocalHolidays: connect_go.NewClient[v1.ListLocalHolidaysRequest, v1.ListLocalHolidaysResponse](
+			httpClient,
+			baseURL+HolidayServiceListLocalHolidaysProcedure,
+			opts...,
+		),
+		listEffectiveHolidays: connect_go.NewClient[v1.ListEffectiveHolidaysRequest, v1.ListEffectiveHolidaysResponse](
+			httpClient,
+			baseURL+HolidayServiceListEffectiveHolidaysProcedure,
+			opts...,
+		),
+	}
+}
+
+// holidayServiceClient implements HolidayServiceClient.
+type holidayServiceClient struct {
+	createLocalHoliday    *connect_go.Client[v1.CreateLocalHolidayRequest, v1.CreateLocalHolidayResponse]
+	updateLocalHoliday    *connect_go.Client[v1.UpdateLocalHolidayRequest, v1.UpdateLocalHolidayResponse]
+	deleteLocalHoliday    *connect_go.Client[v1.DeleteLocalHolidayRequest, v1.DeleteLocalHolidayResponse]
+	listLocalHolidays     *connect_go.Client[v1.ListLocalHolidaysRequest, v1.ListLocalHolidaysResponse]
+	listEffectiveHolidays *connect_go.Client[v1.ListEffectiveHolidaysRequest, v1.ListEffectiveHolidaysResponse]
+}
+
+// CreateLocalHoliday calls rosterd.v1.HolidayService.CreateLocalHoliday.
+func (c *holidayServiceClient) CreateLocalHoliday(ctx context.Context, req *connect_go.Request[v1.CreateLocalHolidayRequest]) (*connect_go.Response[v1.CreateLocalHolidayResponse], error) {
+	return c.createLocalHoliday.CallUnary(ctx, req)
+}
+
+// UpdateLocalHoliday calls rosterd.v1.HolidayService.UpdateLocalHoliday.
+func (c *holidayServiceClient) UpdateLocalHoliday(ctx context.Context, req *connect_go.Request[v1.UpdateLocalHolidayRequest]) (*connect_go.Response[v1.UpdateLocalHolidayResponse], error) {
+	return c.updateLocalHoliday.CallUnary(ctx, req)
+}
+
+// DeleteLocalHoliday calls rosterd.v1.HolidayService.DeleteLocalHoliday.
+func (c *holidayServiceClient) DeleteLocalHoliday(ctx context.Context, req *connect_go.Request[v1.DeleteLocalHolidayRequest]) (*connect_go.Response[v1.DeleteLocalHolidayResponse], error) {
+	return c.deleteLocalHoliday.CallUnary(ctx, req)
+}
+
+// ListLocalHolidays calls rosterd.v1.HolidayService.ListLocalHolidays.
+func (c *holidayServiceClient) ListLocalHolidays(ctx context.Context, req *connect_go.Request[v1.ListLocalHolidaysRequest]) (*connect_go.Response[v1.ListLocalHolidaysResponse], error) {
+	return c.listLocalHolidays.CallUnary(ctx, req)
+}
+
+// ListEffectiveHolidays calls rosterd.v1.HolidayService.ListEffectiveHolidays.
+func (c *holidayServiceClient) ListEffectiveHolidays(ctx context.Context, req *connect_go.Request[v1.ListEffectiveHolidaysRequest]) (*connect_go.Response[v1.ListEffectiveHolidaysResponse], error) {
+	return c.listEffectiveHolidays.CallUnary(ctx, req)
+}
+
+// HolidayServiceHandler is an implementation of the rosterd.v1.HolidayService service.
+type HolidayServiceHandler interface {
+	// CreateLocalHoliday creates a new local holiday.
+	CreateLocalHoliday(context.Context, *connect_go.Request[v1.CreateLocalHolidayRequest]) (*connect_go.Response[v1.CreateLocalHolidayResponse], error)
+	// UpdateLocalHoliday replaces an existing local holiday.
+	UpdateLocalHoliday(context.Context, *connect_go.Request[v1.UpdateLocalHolidayRequest]) (*connect_go.Response[v1.UpdateLocalHolidayResponse], error)
+	// DeleteLocalHoliday deletes a local holiday.
+	DeleteLocalHoliday(context.Context, *connect_go.Request[v1.DeleteLocalHolidayRequest]) (*connect_go.Response[v1.DeleteLocalHolidayResponse], error)
+	// ListLocalHolidays returns all local holidays.
+	ListLocalHolidays(context.Context, *connect_go.Request[v1.ListLocalHolidaysRequest]) (*connect_go.Response[v1.ListLocalHolidaysResponse], error)
+	// ListEffectiveHolidays returns the public holidays of the calendar
+	// service merged with the local holidays.
+	ListEffectiveHolidays(context.Context, *connect_go.Request[v1.ListEffectiveHolidaysRequest]) (*connect_go.Response[v1.ListEffectiveHolidaysResponse], error)
+}
+
+// NewHolidayServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewHolidayServiceHandler(svc HolidayServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
+	holidayServiceCreateLocalHolidayHandler := connect_go.NewUnaryHandler(
+		HolidayServiceCreateLocalHolidayProcedure,
+		svc.CreateLocalHoliday,
+		opts...,
+	)
+	holidayServiceUpdateLocalHolidayHandler := connect_go.NewUnaryHandler(
+		HolidayServiceUpdateLocalHolidayProcedure,
+		svc.UpdateLocalHoliday,
+		opts...,
+	)
+	holidayServiceDeleteLocalHolidayHandler := connect_go.NewUnaryHandler(
+		HolidayServiceDeleteLocalHolidayProcedure,
+		svc.DeleteLocalHoliday,
+		opts...,
+	)
+	holidayServiceListLocalHolidaysHandler := connect_go.NewUnaryHandler(
+		HolidayServiceListLocalHolidaysProcedure,
+		svc.ListLocalHolidays,
+		opts...,
+	)
+	holidayServiceListEffectiveHolidaysHandler := connect_go.NewUnaryHandler(
+		HolidayServiceListEffectiveHolidaysProcedure,
+		svc.ListEffectiveHolidays,
+		opts...,
+	)
+	return "/rosterd.v1.HolidayService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case HolidayServiceCreateLocalHolidayProcedure:
+			holidayServiceCreateLocalHolidayHandler.ServeHTTP(w, r)
+		case HolidayServiceUpdateLocalHolidayProcedure:
+			holidayServiceUpdateLocalHolidayHandler.ServeHTTP(w, r)
+		case HolidayServiceDeleteLocalHolidayProcedure:
+			holidayServiceDeleteLocalHolidayHandler.ServeHTTP(w, r)
+		case HolidayServiceListLocalHolidaysProcedure:
+			holidayServiceListLocalHolidaysHandler.ServeHTTP(w, r)
+		case HolidayServiceListEffectiveHolidaysProcedure:
+			holidayServiceListEffectiveHolidaysHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedHolidayServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedHolidayServiceHandler struct{}
+
+func (UnimplementedHolidayServiceHandler) CreateLocalHoliday(context.Context, *connect_go.Request[v1.CreateLocalHolidayRequest]) (*connect_go.Response[v1.CreateLocalHolidayResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("rosterd.v1.HolidayService.CreateLocalHoliday is not implemented"))
+}
+
+func (UnimplementedHolidayServiceHandler) UpdateLocalHoliday(context.Context, *connect_go.Request[v1.UpdateLocalHolidayRequest]) (*connect_go.Response[v1.UpdateLocalHolidayResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("rosterd.v1.HolidayService.UpdateLocalHoliday is not implemented"))
+}
+
+func (UnimplementedHolidayServiceHandler) DeleteLocalHoliday(context.Context, *connect_go.Request[v1.DeleteLocalHolidayRequest]) (*connect_go.Response[v1.DeleteLocalHolidayResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("rosterd.v1.HolidayService.DeleteLocalHoliday is not implemented"))
+}
+
+func (UnimplementedHolidayServiceHandler) ListLocalHolidays(context.Context, *connect_go.Request[v1.ListLocalHolidaysRequest]) (*connect_go.Response[v1.ListLocalHolidaysResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("rosterd.v1.HolidayService.ListLocalHolidays is not implemented"))
+}
+
+func (UnimplementedHolidayServiceHandler) ListEffectiveHolidays(context.Context, *connect_go.Request[v1.ListEffectiveHolidaysRequest]) (*connect_go.Response[v1.ListEffectiveHolidaysResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("rosterd.v1.HolidayService.ListEffectiveHolidays is not implemented"))
+}
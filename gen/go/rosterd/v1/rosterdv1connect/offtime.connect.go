@@ -0,0 +1,256 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: rosterd/v1/offtime.proto
+
+package rosterdv1connect
+
+import (
+	context "context"
+	errors "errors"
+	connect_go "github.com/bufbuild/connect-go"
+	v1 "github.com/tierklinik-dobersberg/rosterd/gen/go/rosterd/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect_go.IsAtLeastVersion0_1_0
+
+const (
+	// OffTimeServiceName is the fully-qualified name of the OffTimeService service.
+	OffTimeServiceName = "rosterd.v1.OffTimeService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// OffTimeServiceGetVacationBalanceProcedure is the fully-qualified name of the OffTimeService's
+	// GetVacationBalance RPC.
+	OffTimeServiceGetVacationBalanceProcedure = "/rosterd.v1.OffTimeService/GetVacationBalance"
+	// OffTimeServiceCheckOffTimeRequestProcedure is the fully-qualified name of the OffTimeService's
+	// CheckOffTimeRequest RPC.
+	OffTimeServiceCheckOffTimeRequestProcedure = "/rosterd.v1.OffTimeService/CheckOffTimeRequest"
+	// OffTimeServiceCreateOffTimeRuleProcedure is the fully-qualified name of the OffTimeService's
+	// CreateOffTimeRule RPC.
+	OffTimeServiceCreateOffTimeRuleProcedure = "/rosterd.v1.OffTimeService/CreateOffTimeRule"
+	// OffTimeServiceUpdateOffTimeRuleProcedure is the fully-qualified name of the OffTimeService's
+	// UpdateOffTimeRule RPC.
+	OffTimeServiceUpdateOffTimeRuleProcedure = "/rosterd.v1.OffTimeService/UpdateOffTimeRule"
+	// OffTimeServiceDeleteOffTimeRuleProcedure is the fully-qualified name of the OffTimeService's
+	// DeleteOffTimeRule RPC.
+	OffTimeServiceDeleteOffTimeRuleProcedure = "/rosterd.v1.OffTimeService/DeleteOffTimeRule"
+	// OffTimeServiceListOffTimeRulesProcedure is the fully-qualified name of the OffTimeService's
+	// ListOffTimeRules RPC.
+	OffTimeServiceListOffTimeRulesProcedure = "/rosterd.v1.OffTimeService/ListOffTimeRules"
+)
+
+// OffTimeServiceClient is a client for the rosterd.v1.OffTimeService service.
+type OffTimeServiceClient interface {
+	// GetVacationBalance returns the vacation balance of users or the
+	// projected balance for off-time requests.
+	GetVacationBalance(context.Context, *connect_go.Request[v1.GetVacationBalanceRequest]) (*connect_go.Response[v1.GetVacationBalanceResponse], error)
+	// CheckOffTimeRequest performs all checks of CreateOffTimeRequest
+	// without actually creating the request.
+	CheckOffTimeRequest(context.Context, *connect_go.Request[v1.CheckOffTimeRequestRequest]) (*connect_go.Response[v1.CheckOffTimeRequestResponse], error)
+	// CreateOffTimeRule creates a new blackout or concurrency rule.
+	CreateOffTimeRule(context.Context, *connect_go.Request[v1.CreateOffTimeRuleRequest]) (*connect_go.Response[v1.CreateOffTimeRuleResponse], error)
+	// UpdateOffTimeRule replaces an existing rule.
+	UpdateOffTimeRule(context.Context, *connect_go.Request[v1.UpdateOffTimeRuleRequest]) (*connect_go.Response[v1.UpdateOffTimeRuleResponse], error)
+	// DeleteOffTimeRule deletes a rule.
+	DeleteOffTimeRule(context.Context, *connect_go.Request[v1.DeleteOffTimeRuleRequest]) (*connect_go.Response[v1.DeleteOffTimeRuleResponse], error)
+	// ListOffTimeRules returns all rules.
+	ListOffTimeRules(context.Context, *connect_go.Request[v1.ListOffTimeRulesRequest]) (*connect_go.Response[v1.ListOffTimeRulesResponse], error)
+}
+
+// NewOffTimeServiceClient constructs a client for the rosterd.v1.OffTimeService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewOffTimeServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) OffTimeServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &offTimeServiceClient{
+		getVacationBalance: connect_go.NewClient[v1.GetVacationBalanceRequest, v1.GetVacationBalanceResponse](
+			httpClient,
+			baseURL+OffTimeServiceGetVacationBalanceProcedure,
+			opts...,
+		),
+		checkOffTimeRequest: connect_go.NewClient[v1.CheckOffTimeRequestRequest, v1.CheckOffTimeRequestResponse](
+			httpClient,
+			baseURL+OffTimeServiceCheckOffTimeRequestProcedure,
+			opts...,
+		),
+		createOffTimeRule: connect_go.NewClient[v1.CreateOffTimeRuleRequest, v1.CreateOffTimeRuleResponse](
+			httpClient,
+			baseURL+OffTimeServiceCreateOffTimeRuleProcedure,
+			opts...,
+		),
+		updateOffTimeRule: connect_go.NewClient[v1.UpdateOffTimeRuleRequest, v1.UpdateOffTimeRuleResponse](
+			httpClient,
+			baseURL+OffTimeServiceUpdateOffTimeRuleProcedure,
+			opts...,
+		),
+		deleteOffTimeRule: connect_go.NewClient[v1.DeleteOffTimeRuleRequest, v1.DeleteOffTimeRuleResponse](
+			httpClient,
+			baseURL+OffTimeServiceDeleteOffTimeRuleProcedure,
+			opts...,
+		),
+		listOffTimeRules: connect_go.NewClient[v1.ListOffTimeRulesRequest, v1.ListOffTimeRulesResponse](
+			httpClient,
+			baseURL+OffTimeServiceListOffTimeRulesProcedure,
+			opts...,
+		),
+	}
+}
+
+// offTimeServiceClient implements OffTimeServiceClient.
+type offTimeServiceClient struct {
+	getVacationBalance  *connect_go.Client[v1.GetVacationBalanceRequest, v1.GetVacationBalanceResponse]
+	checkOffTimeRequest *connect_go.Client[v1.CheckOffTimeRequestRequest, v1.CheckOffTimeRequestResponse]
+	createOffTimeRule   *connect_go.Client[v1.CreateOffTimeRuleRequest, v1.CreateOffTimeRuleResponse]
+	updateOffTimeRule   *connect_go.Client[v1.UpdateOffTimeRuleRequest, v1.UpdateOffTimeRuleResponse]
+	deleteOffTimeRule   *connect_go.Client[v1.DeleteOffTimeRuleRequest, v1.DeleteOffTimeRuleResponse]
+	listOffTimeRules    *connect_go.Client[v1.ListOffTimeRulesRequest, v1.ListOffTimeRulesResponse]
+}
+
+// GetVacationBalance calls rosterd.v1.OffTimeService.GetVacationBalance.
+func (c *offTimeServiceClient) GetVacationBalance(ctx context.Context, req *connect_go.Request[v1.GetVacationBalanceRequest]) (*connect_go.Response[v1.GetVacationBalanceResponse], error) {
+	return c.getVacationBalance.CallUnary(ctx, req)
+}
+
+// CheckOffTimeRequest calls rosterd.v1.OffTimeService.CheckOffTimeRequest.
+func (c *offTimeServiceClient) CheckOffTimeRequest(ctx context.Context, req *connect_go.Request[v1.CheckOffTimeRequestRequest]) (*connect_go.Response[v1.CheckOffTimeRequestResponse], error) {
+	return c.checkOffTimeRequest.CallUnary(ctx, req)
+}
+
+// CreateOffTimeRule calls rosterd.v1.OffTimeService.CreateOffTimeRule.
+func (c *offTimeServiceClient) CreateOffTimeRule(ctx context.Context, req *connect_go.Request[v1.CreateOffTimeRuleRequest]) (*connect_go.Response[v1.CreateOffTimeRuleResponse], error) {
+	return c.createOffTimeRule.CallUnary(ctx, req)
+}
+
+// UpdateOffTimeRule calls rosterd.v1.OffTimeService.UpdateOffTimeRule.
+func (c *offTimeServiceClient) UpdateOffTimeRule(ctx context.Context, req *connect_go.Request[v1.UpdateOffTimeRuleRequest]) (*connect_go.Response[v1.UpdateOffTimeRuleResponse], error) {
+	return c.updateOffTimeRule.CallUnary(ctx, req)
+}
+
+// DeleteOffTimeRule calls rosterd.v1.OffTimeService.DeleteOffTimeRule.
+func (c *offTimeServiceClient) DeleteOffTimeRule(ctx context.Context, req *connect_go.Request[v1.DeleteOffTimeRuleRequest]) (*connect_go.Response[v1.DeleteOffTimeRuleResponse], error) {
+	return c.deleteOffTimeRule.CallUnary(ctx, req)
+}
+
+// ListOffTimeRules calls rosterd.v1.OffTimeService.ListOffTimeRules.
+func (c *offTimeServiceClient) ListOffTimeRules(ctx context.Context, req *connect_go.Request[v1.ListOffTimeRulesRequest]) (*connect_go.Response[v1.ListOffTimeRulesResponse], error) {
+	return c.listOffTimeRules.CallUnary(ctx, req)
+}
+
+// OffTimeServiceHandler is an implementation of the rosterd.v1.OffTimeService service.
+type OffTimeServiceHandler interface {
+	// GetVacationBalance returns the vacation balance of users or the
+	// projected balance for off-time requests.
+	GetVacationBalance(context.Context, *connect_go.Request[v1.GetVacationBalanceRequest]) (*connect_go.Response[v1.GetVacationBalanceResponse], error)
+	// CheckOffTimeRequest performs all checks of CreateOffTimeRequest
+	// without actually creating the request.
+	CheckOffTimeRequest(context.Context, *connect_go.Request[v1.CheckOffTimeRequestRequest]) (*connect_go.Response[v1.CheckOffTimeRequestResponse], error)
+	// CreateOffTimeRule creates a new blackout or concurrency rule.
+	CreateOffTimeRule(context.Context, *connect_go.Request[v1.CreateOffTimeRuleRequest]) (*connect_go.Response[v1.CreateOffTimeRuleResponse], error)
+	// UpdateOffTimeRule replaces an existing rule.
+	UpdateOffTimeRule(context.Context, *connect_go.Request[v1.UpdateOffTimeRuleRequest]) (*connect_go.Response[v1.UpdateOffTimeRuleResponse], error)
+	// DeleteOffTimeRule deletes a rule.
+	DeleteOffTimeRule(context.Context, *connect_go.Request[v1.DeleteOffTimeRuleRequest]) (*connect_go.Response[v1.DeleteOffTimeRuleResponse], error)
+	// ListOffTimeRules returns all rules.
+	ListOffTimeRules(context.Context, *connect_go.Request[v1.ListOffTimeRulesRequest]) (*connect_go.Response[v1.ListOffTimeRulesResponse], error)
+}
+
+// NewOffTimeServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewOffTimeServiceHandler(svc OffTimeServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
+	offTimeServiceGetVacationBalanceHandler := connect_go.NewUnaryHandler(
+		OffTimeServiceGetVacationBalanceProcedure,
+		svc.GetVacationBalance,
+		opts...,
+	)
+	offTimeServiceCheckOffTimeRequestHandler := connect_go.NewUnaryHandler(
+		OffTimeServiceCheckOffTimeRequestProcedure,
+		svc.CheckOffTimeRequest,
+		opts...,
+	)
+	offTimeServiceCreateOffTimeRuleHandler := connect_go.NewUnaryHandler(
+		OffTimeServiceCreateOffTimeRuleProcedure,
+		svc.CreateOffTimeRule,
+		opts...,
+	)
+	offTimeServiceUpdateOffTimeRuleHandler := connect_go.NewUnaryHandler(
+		OffTimeServiceUpdateOffTimeRuleProcedure,
+		svc.UpdateOffTimeRule,
+		opts...,
+	)
+	offTimeServiceDeleteOffTimeRuleHandler := connect_go.NewUnaryHandler(
+		OffTimeServiceDeleteOffTimeRuleProcedure,
+		svc.DeleteOffTimeRule,
+		opts...,
+	)
+	offTimeServiceListOffTimeRulesHandler := connect_go.NewUnaryHandler(
+		OffTimeServiceListOffTimeRulesProcedure,
+		svc.ListOffTimeRules,
+		opts...,
+	)
+	return "/rosterd.v1.OffTimeService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case OffTimeServiceGetVacationBalanceProcedure:
+			offTimeServiceGetVacationBalanceHandler.ServeHTTP(w, r)
+		case OffTimeServiceCheckOffTimeRequestProcedure:
+			offTimeServiceCheckOffTimeRequestHandler.ServeHTTP(w, r)
+		case OffTimeServiceCreateOffTimeRuleProcedure:
+			offTimeServiceCreateOffTimeRuleHandler.ServeHTTP(w, r)
+		case OffTimeServiceUpdateOffTimeRuleProcedure:
+			offTimeServiceUpdateOffTimeRuleHandler.ServeHTTP(w, r)
+		case OffTimeServiceDeleteOffTimeRuleProcedure:
+			offTimeServiceDeleteOffTimeRuleHandler.ServeHTTP(w, r)
+		case OffTimeServiceListOffTimeRulesProcedure:
+			offTimeServiceListOffTimeRulesHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedOffTimeServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedOffTimeServiceHandler struct{}
+
+func (UnimplementedOffTimeServiceHandler) GetVacationBalance(context.Context, *connect_go.Request[v1.GetVacationBalanceRequest]) (*connect_go.Response[v1.GetVacationBalanceResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("rosterd.v1.OffTimeService.GetVacationBalance is not implemented"))
+}
+
+func (UnimplementedOffTimeServiceHandler) CheckOffTimeRequest(context.Context, *connect_go.Request[v1.CheckOffTimeRequestRequest]) (*connect_go.Response[v1.CheckOffTimeRequestResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("rosterd.v1.OffTimeService.CheckOffTimeRequest is not implemented"))
+}
+
+func (UnimplementedOffTimeServiceHandler) CreateOffTimeRule(context.Context, *connect_go.Request[v1.CreateOffTimeRuleRequest]) (*connect_go.Response[v1.CreateOffTimeRuleResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("rosterd.v1.OffTimeService.CreateOffTimeRule is not implemented"))
+}
+
+func (UnimplementedOffTimeServiceHandler) UpdateOffTimeRule(context.Context, *connect_go.Request[v1.UpdateOffTimeRuleRequest]) (*connect_go.Response[v1.UpdateOffTimeRuleResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("rosterd.v1.OffTimeService.UpdateOffTimeRule is not implemented"))
+}
+
+func (UnimplementedOffTimeServiceHandler) DeleteOffTimeRule(context.Context, *connect_go.Request[v1.DeleteOffTimeRuleRequest]) (*connect_go.Response[v1.DeleteOffTimeRuleResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("rosterd.v1.OffTimeService.DeleteOffTimeRule is not implemented"))
+}
+
+func (UnimplementedOffTimeServiceHandler) ListOffTimeRules(context.Context, *connect_go.Request[v1.ListOffTimeRulesRequest]) (*connect_go.Response[v1.ListOffTimeRulesResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("rosterd.v1.OffTimeService.ListOffTimeRules is not implemented"))
+}
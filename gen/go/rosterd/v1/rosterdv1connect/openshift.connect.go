@@ -0,0 +1,227 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: rosterd/v1/openshift.proto
+
+package rosterdv1connect
+
+import (
+	context "context"
+	errors "errors"
+	connect_go "github.com/bufbuild/connect-go"
+	v1 "github.com/tierklinik-dobersberg/rosterd/gen/go/rosterd/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect_go.IsAtLeastVersion0_1_0
+
+const (
+	// OpenShiftServiceName is the fully-qualified name of the OpenShiftService service.
+	OpenShiftServiceName = "rosterd.v1.OpenShiftService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// OpenShiftServicePublishOpenShiftsProcedure is the fully-qualified name of the OpenShiftService's
+	// PublishOpenShifts RPC.
+	OpenShiftServicePublishOpenShiftsProcedure = "/rosterd.v1.OpenShiftService/PublishOpenShifts"
+	// OpenShiftServiceListOpenShiftsProcedure is the fully-qualified name of the OpenShiftService's
+	// ListOpenShifts RPC.
+	OpenShiftServiceListOpenShiftsProcedure = "/rosterd.v1.OpenShiftService/ListOpenShifts"
+	// OpenShiftServiceClaimOpenShiftProcedure is the fully-qualified name of the OpenShiftService's
+	// ClaimOpenShift RPC.
+	OpenShiftServiceClaimOpenShiftProcedure = "/rosterd.v1.OpenShiftService/ClaimOpenShift"
+	// OpenShiftServiceDecideOpenShiftClaimProcedure is the fully-qualified name of the
+	// OpenShiftService's DecideOpenShiftClaim RPC.
+	OpenShiftServiceDecideOpenShiftClaimProcedure = "/rosterd.v1.OpenShiftService/DecideOpenShiftClaim"
+	// OpenShiftServiceCloseOpenShiftProcedure is the fully-qualified name of the OpenShiftService's
+	// CloseOpenShift RPC.
+	OpenShiftServiceCloseOpenShiftProcedure = "/rosterd.v1.OpenShiftService/CloseOpenShift"
+)
+
+// OpenShiftServiceClient is a client for the rosterd.v1.OpenShiftService service.
+type OpenShiftServiceClient interface {
+	// PublishOpenShifts publishes understaffed shifts of an approved roster
+	// and notifies all eligible users.
+	PublishOpenShifts(context.Context, *connect_go.Request[v1.PublishOpenShiftsRequest]) (*connect_go.Response[v1.PublishOpenShiftsResponse], error)
+	// ListOpenShifts returns published open shifts.
+	ListOpenShifts(context.Context, *connect_go.Request[v1.ListOpenShiftsRequest]) (*connect_go.Response[v1.ListOpenShiftsResponse], error)
+	// ClaimOpenShift claims an open shift for the calling user. The user is
+	// assigned immediately unless the open shift requires confirmation.
+	ClaimOpenShift(context.Context, *connect_go.Request[v1.ClaimOpenShiftRequest]) (*connect_go.Response[v1.ClaimOpenShiftResponse], error)
+	// DecideOpenShiftClaim confirms or rejects a pending claim.
+	DecideOpenShiftClaim(context.Context, *connect_go.Request[v1.DecideOpenShiftClaimRequest]) (*connect_go.Response[v1.DecideOpenShiftClaimResponse], error)
+	// CloseOpenShift closes an open shift. Pending claims are rejected.
+	CloseOpenShift(context.Context, *connect_go.Request[v1.CloseOpenShiftRequest]) (*connect_go.Response[v1.CloseOpenShiftResponse], error)
+}
+
+// NewOpenShiftServiceClient constructs a client for the rosterd.v1.OpenShiftService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewOpenShiftServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) OpenShiftServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &openShiftServiceClient{
+		publishOpenShifts: connect_go.NewClient[v1.PublishOpenShiftsRequest, v1.PublishOpenShiftsResponse](
+			httpClient,
+			baseURL+OpenShiftServicePublishOpenShiftsProcedure,
+			opts...,
+		),
+		listOpenShifts: connect_go.NewClient[v1.ListOpenShiftsRequest, v1.ListOpenShiftsResponse](
+			httpClient,
+			baseURL+OpenShiftServiceListOpenShiftsProcedure,
+			opts...,
+		),
+		claimOpenShift: connect_go.NewClient[v1.ClaimOpenShiftRequest, v1.ClaimOpenShiftResponse](
+			httpClient,
+			baseURL+OpenShiftServiceClaimOpenShiftProcedure,
+			opts...,
+		),
+		decideOpenShiftClaim: connect_go.NewClient[v1.DecideOpenShiftClaimRequest, v1.DecideOpenShiftClaimResponse](
+			httpClient,
+			baseURL+OpenShiftServiceDecideOpenShiftClaimProcedure,
+			opts...,
+		),
+		closeOpenShift: connect_go.NewClient[v1.CloseOpenShiftRequest, v1.CloseOpenShiftResponse](
+			httpClient,
+			baseURL+OpenShiftServiceCloseOpenShiftProcedure,
+			opts...,
+		),
+	}
+}
+
+// openShiftServiceClient implements OpenShiftServiceClient.
+type openShiftServiceClient struct {
+	publishOpenShifts    *connect_go.Client[v1.PublishOpenShiftsRequest, v1.PublishOpenShiftsResponse]
+	listOpenShifts       *connect_go.Client[v1.ListOpenShiftsRequest, v1.ListOpenShiftsResponse]
+	claimOpenShift       *connect_go.Client[v1.ClaimOpenShiftRequest, v1.ClaimOpenShiftResponse]
+	decideOpenShiftClaim *connect_go.Client[v1.DecideOpenShiftClaimRequest, v1.DecideOpenShiftClaimResponse]
+	closeOpenShift       *connect_go.Client[v1.CloseOpenShiftRequest, v1.CloseOpenShiftResponse]
+}
+
+// PublishOpenShifts calls rosterd.v1.OpenShiftService.PublishOpenShifts.
+func (c *openShiftServiceClient) PublishOpenShifts(ctx context.Context, req *connect_go.Request[v1.PublishOpenShiftsRequest]) (*connect_go.Response[v1.PublishOpenShiftsResponse], error) {
+	return c.publishOpenShifts.CallUnary(ctx, req)
+}
+
+// ListOpenShifts calls rosterd.v1.OpenShiftService.ListOpenShifts.
+func (c *openShiftServiceClient) ListOpenShifts(ctx context.Context, req *connect_go.Request[v1.ListOpenShiftsRequest]) (*connect_go.Response[v1.ListOpenShiftsResponse], error) {
+	return c.listOpenShifts.CallUnary(ctx, req)
+}
+
+// ClaimOpenShift calls rosterd.v1.OpenShiftService.ClaimOpenShift.
+func (c *openShiftServiceClient) ClaimOpenShift(ctx context.Context, req *connect_go.Request[v1.ClaimOpenShiftRequest]) (*connect_go.Response[v1.ClaimOpenShiftResponse], error) {
+	return c.claimOpenShift.CallUnary(ctx, req)
+}
+
+// DecideOpenShiftClaim calls rosterd.v1.OpenShiftService.DecideOpenShiftClaim.
+func (c *openShiftServiceClient) DecideOpenShiftClaim(ctx context.Context, req *connect_go.Request[v1.DecideOpenShiftClaimRequest]) (*connect_go.Response[v1.DecideOpenShiftClaimResponse], error) {
+	return c.decideOpenShiftClaim.CallUnary(ctx, req)
+}
+
+// CloseOpenShift calls rosterd.v1.OpenShiftService.CloseOpenShift.
+func (c *openShiftServiceClient) CloseOpenShift(ctx context.Context, req *connect_go.Request[v1.CloseOpenShiftRequest]) (*connect_go.Response[v1.CloseOpenShiftResponse], error) {
+	return c.closeOpenShift.CallUnary(ctx, req)
+}
+
+// OpenShiftServiceHandler is an implementation of the rosterd.v1.OpenShiftService service.
+type OpenShiftServiceHandler interface {
+	// PublishOpenShifts publishes understaffed shifts of an approved roster
+	// and notifies all eligible users.
+	PublishOpenShifts(context.Context, *connect_go.Request[v1.PublishOpenShiftsRequest]) (*connect_go.Response[v1.PublishOpenShiftsResponse], error)
+	// ListOpenShifts returns published open shifts.
+	ListOpenShifts(context.Context, *connect_go.Request[v1.ListOpenShiftsRequest]) (*connect_go.Response[v1.ListOpenShiftsResponse], error)
+	// ClaimOpenShift claims an open shift for the calling user. The user is
+	// assigned immediately unless the open shift requires confirmation.
+	ClaimOpenShift(context.Context, *connect_go.Request[v1.ClaimOpenShiftRequest]) (*connect_go.Response[v1.ClaimOpenShiftResponse], error)
+	// DecideOpenShiftClaim confirms or rejects a pending claim.
+	DecideOpenShiftClaim(context.Context, *connect_go.Request[v1.DecideOpenShiftClaimRequest]) (*connect_go.Response[v1.DecideOpenShiftClaimResponse], error)
+	// CloseOpenShift closes an open shift. Pending claims are rejected.
+	CloseOpenShift(context.Context, *connect_go.Request[v1.CloseOpenShiftRequest]) (*connect_go.Response[v1.CloseOpenShiftResponse], error)
+}
+
+// NewOpenShiftServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewOpenShiftServiceHandler(svc OpenShiftServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
+	openShiftServicePublishOpenShiftsHandler := connect_go.NewUnaryHandler(
+		OpenShiftServicePublishOpenShiftsProcedure,
+		svc.PublishOpenShifts,
+		opts...,
+	)
+	openShiftServiceListOpenShiftsHandler := connect_go.NewUnaryHandler(
+		OpenShiftServiceListOpenShiftsProcedure,
+		svc.ListOpenShifts,
+		opts...,
+	)
+	openShiftServiceClaimOpenShiftHandler := connect_go.NewUnaryHandler(
+		OpenShiftServiceClaimOpenShiftProcedure,
+		svc.ClaimOpenShift,
+		opts...,
+	)
+	openShiftServiceDecideOpenShiftClaimHandler := connect_go.NewUnaryHandler(
+		OpenShiftServiceDecideOpenShiftClaimProcedure,
+		svc.DecideOpenShiftClaim,
+		opts...,
+	)
+	openShiftServiceCloseOpenShiftHandler := connect_go.NewUnaryHandler(
+		OpenShiftServiceCloseOpenShiftProcedure,
+		svc.CloseOpenShift,
+		opts...,
+	)
+	return "/rosterd.v1.OpenShiftService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case OpenShiftServicePublishOpenShiftsProcedure:
+			openShiftServicePublishOpenShiftsHandler.ServeHTTP(w, r)
+		case OpenShiftServiceListOpenShiftsProcedure:
+			openShiftServiceListOpenShiftsHandler.ServeHTTP(w, r)
+		case OpenShiftServiceClaimOpenShiftProcedure:
+			openShiftServiceClaimOpenShiftHandler.ServeHTTP(w, r)
+		case OpenShiftServiceDecideOpenShiftClaimProcedure:
+			openShiftServiceDecideOpenShiftClaimHandler.ServeHTTP(w, r)
+		case OpenShiftServiceCloseOpenShiftProcedure:
+			openShiftServiceCloseOpenShiftHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedOpenShiftServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedOpenShiftServiceHandler struct{}
+
+func (UnimplementedOpenShiftServiceHandler) PublishOpenShifts(context.Context, *connect_go.Request[v1.PublishOpenShiftsRequest]) (*connect_go.Response[v1.PublishOpenShiftsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("rosterd.v1.OpenShiftService.PublishOpenShifts is not implemented"))
+}
+
+func (UnimplementedOpenShiftServiceHandler) ListOpenShifts(context.Context, *connect_go.Request[v1.ListOpenShiftsRequest]) (*connect_go.Response[v1.ListOpenShiftsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("rosterd.v1.OpenShiftService.ListOpenShifts is not implemented"))
+}
+
+func (UnimplementedOpenShiftServiceHandler) ClaimOpenShift(context.Context, *connect_go.Request[v1.ClaimOpenShiftRequest]) (*connect_go.Response[v1.ClaimOpenShiftResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("rosterd.v1.OpenShiftService.ClaimOpenShift is not implemented"))
+}
+
+func (UnimplementedOpenShiftServiceHandler) DecideOpenShiftClaim(context.Context, *connect_go.Request[v1.DecideOpenShiftClaimRequest]) (*connect_go.Response[v1.DecideOpenShiftClaimResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("rosterd.v1.OpenShiftService.DecideOpenShiftClaim is not implemented"))
+}
+
+func (UnimplementedOpenShiftServiceHandler) CloseOpenShift(context.Context, *connect_go.Request[v1.CloseOpenShiftRequest]) (*connect_go.Response[v1.CloseOpenShiftResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("rosterd.v1.OpenShiftService.CloseOpenShift is not implemented"))
+}
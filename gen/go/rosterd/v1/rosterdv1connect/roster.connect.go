@@ -0,0 +1,500 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: rosterd/v1/roster.proto
+
+package rosterdv1connect
+
+import (
+	context "context"
+	errors "errors"
+	connect_go "github.com/bufbuild/connect-go"
+	v11 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/roster/v1"
+	v1 "github.com/tierklinik-dobersberg/rosterd/gen/go/rosterd/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect_go.IsAtLeastVersion0_1_0
+
+const (
+	// RosterServiceName is the fully-qualified name of the RosterService service.
+	RosterServiceName = "rosterd.v1.RosterService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// RosterServiceGenerateRosterProcedure is the fully-qualified name of the RosterService's
+	// GenerateRoster RPC.
+	RosterServiceGenerateRosterProcedure = "/rosterd.v1.RosterService/GenerateRoster"
+	// RosterServiceCompleteRosterProcedure is the fully-qualified name of the RosterService's
+	// CompleteRoster RPC.
+	RosterServiceCompleteRosterProcedure = "/rosterd.v1.RosterService/CompleteRoster"
+	// RosterServiceValidateRosterProcedure is the fully-qualified name of the RosterService's
+	// ValidateRoster RPC.
+	RosterServiceValidateRosterProcedure = "/rosterd.v1.RosterService/ValidateRoster"
+	// RosterServiceValidateAndApproveRosterProcedure is the fully-qualified name of the RosterService's
+	// ValidateAndApproveRoster RPC.
+	RosterServiceValidateAndApproveRosterProcedure = "/rosterd.v1.RosterService/ValidateAndApproveRoster"
+	// RosterServiceAnalyzeOvertimeProcedure is the fully-qualified name of the RosterService's
+	// AnalyzeOvertime RPC.
+	RosterServiceAnalyzeOvertimeProcedure = "/rosterd.v1.RosterService/AnalyzeOvertime"
+	// RosterServiceExportRosterTableProcedure is the fully-qualified name of the RosterService's
+	// ExportRosterTable RPC.
+	RosterServiceExportRosterTableProcedure = "/rosterd.v1.RosterService/ExportRosterTable"
+	// RosterServiceExportUserRosterProcedure is the fully-qualified name of the RosterService's
+	// ExportUserRoster RPC.
+	RosterServiceExportUserRosterProcedure = "/rosterd.v1.RosterService/ExportUserRoster"
+	// RosterServiceExportPayrollProcedure is the fully-qualified name of the RosterService's
+	// ExportPayroll RPC.
+	RosterServiceExportPayrollProcedure = "/rosterd.v1.RosterService/ExportPayroll"
+	// RosterServiceListRosterVersionsProcedure is the fully-qualified name of the RosterService's
+	// ListRosterVersions RPC.
+	RosterServiceListRosterVersionsProcedure = "/rosterd.v1.RosterService/ListRosterVersions"
+	// RosterServiceGetRosterVersionProcedure is the fully-qualified name of the RosterService's
+	// GetRosterVersion RPC.
+	RosterServiceGetRosterVersionProcedure = "/rosterd.v1.RosterService/GetRosterVersion"
+	// RosterServiceDiffRostersProcedure is the fully-qualified name of the RosterService's DiffRosters
+	// RPC.
+	RosterServiceDiffRostersProcedure = "/rosterd.v1.RosterService/DiffRosters"
+	// RosterServiceDiffRosterVersionsProcedure is the fully-qualified name of the RosterService's
+	// DiffRosterVersions RPC.
+	RosterServiceDiffRosterVersionsProcedure = "/rosterd.v1.RosterService/DiffRosterVersions"
+	// RosterServiceRestoreRosterVersionProcedure is the fully-qualified name of the RosterService's
+	// RestoreRosterVersion RPC.
+	RosterServiceRestoreRosterVersionProcedure = "/rosterd.v1.RosterService/RestoreRosterVersion"
+)
+
+// RosterServiceClient is a client for the rosterd.v1.RosterService service.
+type RosterServiceClient interface {
+	// GenerateRoster automatically generates a draft duty roster for a
+	// roster type and date range. The generated roster is not saved.
+	GenerateRoster(context.Context, *connect_go.Request[v1.GenerateRosterRequest]) (*connect_go.Response[v1.GenerateRosterResponse], error)
+	// CompleteRoster fills all remaining staffing gaps of an existing duty
+	// roster. Existing assignments are treated as fixed. The completed roster
+	// is not saved.
+	CompleteRoster(context.Context, *connect_go.Request[v1.CompleteRosterRequest]) (*connect_go.Response[v1.CompleteRosterResponse], error)
+	// ValidateRoster checks a saved or unsaved duty roster for problems.
+	ValidateRoster(context.Context, *connect_go.Request[v1.ValidateRosterRequest]) (*connect_go.Response[v1.ValidateRosterResponse], error)
+	// ValidateAndApproveRoster validates a duty roster and approves it if
+	// there are no blocking errors or force is set.
+	ValidateAndApproveRoster(context.Context, *connect_go.Request[v1.ValidateAndApproveRosterRequest]) (*connect_go.Response[v1.ValidateAndApproveRosterResponse], error)
+	// AnalyzeOvertime analyzes the work time of users and reports the raw
+	// and the allowance-adjusted overtime per month.
+	AnalyzeOvertime(context.Context, *connect_go.Request[v1.AnalyzeOvertimeRequest]) (*connect_go.Response[v1.AnalyzeOvertimeResponse], error)
+	// ExportRosterTable exports a duty roster as a CSV or XLSX table. Unlike
+	// tkd.roster.v1.RosterService/ExportRoster the export does not require
+	// an external renderer.
+	ExportRosterTable(context.Context, *connect_go.Request[v1.ExportRosterTableRequest]) (*connect_go.Response[v11.ExportRosterResponse], error)
+	// ExportUserRoster exports the personal roster of a single user
+	// including planned shifts, approved off-time, work time and vacation
+	// balance.
+	ExportUserRoster(context.Context, *connect_go.Request[v1.ExportUserRosterRequest]) (*connect_go.Response[v11.ExportRosterResponse], error)
+	// ExportPayroll exports the monthly work time of users split into
+	// payroll categories together with overtime, vacation and time-off.
+	ExportPayroll(context.Context, *connect_go.Request[v1.ExportPayrollRequest]) (*connect_go.Response[v1.ExportPayrollResponse], error)
+	// ListRosterVersions returns the version history of a duty roster
+	// including all rosters it superseded or has been superseded by.
+	ListRosterVersions(context.Context, *connect_go.Request[v1.ListRosterVersionsRequest]) (*connect_go.Response[v1.ListRosterVersionsResponse], error)
+	// GetRosterVersion returns a single version of a duty roster.
+	GetRosterVersion(context.Context, *connect_go.Request[v1.GetRosterVersionRequest]) (*connect_go.Response[v1.GetRosterVersionResponse], error)
+	// DiffRosters returns the shift and assignment changes between two
+	// rosters or roster versions covering the same period.
+	DiffRosters(context.Context, *connect_go.Request[v1.DiffRostersRequest]) (*connect_go.Response[v1.DiffRostersResponse], error)
+	// DiffRosterVersions returns the assignment changes per user between
+	// two versions of duty rosters covering the same period. A shift whose
+	// times changed is reported as removed at the previous and assigned at
+	// the new time.
+	//
+	// Deprecated: use DiffRosters, which also reports added and removed
+	// shifts and shifts with changed times.
+	//
+	// Deprecated: do not use.
+	DiffRosterVersions(context.Context, *connect_go.Request[v1.DiffRosterVersionsRequest]) (*connect_go.Response[v1.DiffRosterVersionsResponse], error)
+	// RestoreRosterVersion saves the shifts of an older version as a new
+	// draft of the roster.
+	RestoreRosterVersion(context.Context, *connect_go.Request[v1.RestoreRosterVersionRequest]) (*connect_go.Response[v1.RestoreRosterVersionResponse], error)
+}
+
+// NewRosterServiceClient constructs a client for the rosterd.v1.RosterService service. By default,
+// it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and
+// sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC()
+// or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewRosterServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) RosterServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &rosterServiceClient{
+		generateRoster: connect_go.NewClient[v1.GenerateRosterRequest, v1.GenerateRosterResponse](
+			httpClient,
+			baseURL+RosterServiceGenerateRosterProcedure,
+			opts...,
+		),
+		completeRoster: connect_go.NewClient[v1.CompleteRosterRequest, v1.CompleteRosterResponse](
+			httpClient,
+			baseURL+RosterServiceCompleteRosterProcedure,
+			opts...,
+		),
+		validateRoster: connect_go.NewClient[v1.ValidateRosterRequest, v1.ValidateRosterResponse](
+			httpClient,
+			baseURL+RosterServiceValidateRosterProcedure,
+			opts...,
+		),
+		validateAndApproveRoster: connect_go.NewClient[v1.ValidateAndApproveRosterRequest, v1.ValidateAndApproveRosterResponse](
+			httpClient,
+			baseURL+RosterServiceValidateAndApproveRosterProcedure,
+			opts...,
+		),
+		analyzeOvertime: connect_go.NewClient[v1.AnalyzeOvertimeRequest, v1.AnalyzeOvertimeResponse](
+			httpClient,
+			baseURL+RosterServiceAnalyzeOvertimeProcedure,
+			opts...,
+		),
+		exportRosterTable: connect_go.NewClient[v1.ExportRosterTableRequest, v11.ExportRosterResponse](
+			httpClient,
+			baseURL+RosterServiceExportRosterTableProcedure,
+			opts...,
+		),
+		exportUserRoster: connect_go.NewClient[v1.ExportUserRosterRequest, v11.ExportRosterResponse](
+			httpClient,
+			baseURL+RosterServiceExportUserRosterProcedure,
+			opts...,
+		),
+		exportPayroll: connect_go.NewClient[v1.ExportPayrollRequest, v1.ExportPayrollResponse](
+			httpClient,
+			baseURL+RosterServiceExportPayrollProcedure,
+			opts...,
+		),
+		listRosterVersions: connect_go.NewClient[v1.ListRosterVersionsRequest, v1.ListRosterVersionsResponse](
+			httpClient,
+			baseURL+RosterServiceListRosterVersionsProcedure,
+			opts...,
+		),
+		getRosterVersion: connect_go.NewClient[v1.GetRosterVersionRequest, v1.GetRosterVersionResponse](
+			httpClient,
+			baseURL+RosterServiceGetRosterVersionProcedure,
+			opts...,
+		),
+		diffRosters: connect_go.NewClient[v1.DiffRostersRequest, v1.DiffRostersResponse](
+			httpClient,
+			baseURL+RosterServiceDiffRostersProcedure,
+			opts...,
+		),
+		diffRosterVersions: connect_go.NewClient[v1.DiffRosterVersionsRequest, v1.DiffRosterVersionsResponse](
+			httpClient,
+			baseURL+RosterServiceDiffRosterVersionsProcedure,
+			opts...,
+		),
+		restoreRosterVersion: connect_go.NewClient[v1.RestoreRosterVersionRequest, v1.RestoreRosterVersionResponse](
+			httpClient,
+			baseURL+RosterServiceRestoreRosterVersionProcedure,
+			opts...,
+		),
+	}
+}
+
+// rosterServiceClient implements RosterServiceClient.
+type rosterServiceClient struct {
+	generateRoster           *connect_go.Client[v1.GenerateRosterRequest, v1.GenerateRosterResponse]
+	completeRoster           *connect_go.Client[v1.CompleteRosterRequest, v1.CompleteRosterResponse]
+	validateRoster           *connect_go.Client[v1.ValidateRosterRequest, v1.ValidateRosterResponse]
+	validateAndApproveRoster *connect_go.Client[v1.ValidateAndApproveRosterRequest, v1.ValidateAndApproveRosterResponse]
+	analyzeOvertime          *connect_go.Client[v1.AnalyzeOvertimeRequest, v1.AnalyzeOvertimeResponse]
+	exportRosterTable        *connect_go.Client[v1.ExportRosterTableRequest, v11.ExportRosterResponse]
+	exportUserRoster         *connect_go.Client[v1.ExportUserRosterRequest, v11.ExportRosterResponse]
+	exportPayroll            *connect_go.Client[v1.ExportPayrollRequest, v1.ExportPayrollResponse]
+	listRosterVersions       *connect_go.Client[v1.ListRosterVersionsRequest, v1.ListRosterVersionsResponse]
+	getRosterVersion         *connect_go.Client[v1.GetRosterVersionRequest, v1.GetRosterVersionResponse]
+	diffRosters              *connect_go.Client[v1.DiffRostersRequest, v1.DiffRostersResponse]
+	diffRosterVersions       *connect_go.Client[v1.DiffRosterVersionsRequest, v1.DiffRosterVersionsResponse]
+	restoreRosterVersion     *connect_go.Client[v1.RestoreRosterVersionRequest, v1.RestoreRosterVersionResponse]
+}
+
+// GenerateRoster calls rosterd.v1.RosterService.GenerateRoster.
+func (c *rosterServiceClient) GenerateRoster(ctx context.Context, req *connect_go.Request[v1.GenerateRosterRequest]) (*connect_go.Response[v1.GenerateRosterResponse], error) {
+	return c.generateRoster.CallUnary(ctx, req)
+}
+
+// CompleteRoster calls rosterd.v1.RosterService.CompleteRoster.
+func (c *rosterServiceClient) CompleteRoster(ctx context.Context, req *connect_go.Request[v1.CompleteRosterRequest]) (*connect_go.Response[v1.CompleteRosterResponse], error) {
+	return c.completeRoster.CallUnary(ctx, req)
+}
+
+// ValidateRoster calls rosterd.v1.RosterService.ValidateRoster.
+func (c *rosterServiceClient) ValidateRoster(ctx context.Context, req *connect_go.Request[v1.ValidateRosterRequest]) (*connect_go.Response[v1.ValidateRosterResponse], error) {
+	return c.validateRoster.CallUnary(ctx, req)
+}
+
+// ValidateAndApproveRoster calls rosterd.v1.RosterService.ValidateAndApproveRoster.
+func (c *rosterServiceClient) ValidateAndApproveRoster(ctx context.Context, req *connect_go.Request[v1.ValidateAndApproveRosterRequest]) (*connect_go.Response[v1.ValidateAndApproveRosterResponse], error) {
+	return c.validateAndApproveRoster.CallUnary(ctx, req)
+}
+
+// AnalyzeOvertime calls rosterd.v1.RosterService.AnalyzeOvertime.
+func (c *rosterServiceClient) AnalyzeOvertime(ctx context.Context, req *connect_go.Request[v1.AnalyzeOvertimeRequest]) (*connect_go.Response[v1.AnalyzeOvertimeResponse], error) {
+	return c.analyzeOvertime.CallUnary(ctx, req)
+}
+
+// ExportRosterTable calls rosterd.v1.RosterService.ExportRosterTable.
+func (c *rosterServiceClient) ExportRosterTable(ctx context.Context, req *connect_go.Request[v1.ExportRosterTableRequest]) (*connect_go.Response[v11.ExportRosterResponse], error) {
+	return c.exportRosterTable.CallUnary(ctx, req)
+}
+
+// ExportUserRoster calls rosterd.v1.RosterService.ExportUserRoster.
+func (c *rosterServiceClient) ExportUserRoster(ctx context.Context, req *connect_go.Request[v1.ExportUserRosterRequest]) (*connect_go.Response[v11.ExportRosterResponse], error) {
+	return c.exportUserRoster.CallUnary(ctx, req)
+}
+
+// ExportPayroll calls rosterd.v1.RosterService.ExportPayroll.
+func (c *rosterServiceClient) ExportPayroll(ctx context.Context, req *connect_go.Request[v1.ExportPayrollRequest]) (*connect_go.Response[v1.ExportPayrollResponse], error) {
+	return c.exportPayroll.CallUnary(ctx, req)
+}
+
+// ListRosterVersions calls rosterd.v1.RosterService.ListRosterVersions.
+func (c *rosterServiceClient) ListRosterVersions(ctx context.Context, req *connect_go.Request[v1.ListRosterVersionsRequest]) (*connect_go.Response[v1.ListRosterVersionsResponse], error) {
+	return c.listRosterVersions.CallUnary(ctx, req)
+}
+
+// GetRosterVersion calls rosterd.v1.RosterService.GetRosterVersion.
+func (c *rosterServiceClient) GetRosterVersion(ctx context.Context, req *connect_go.Request[v1.GetRosterVersionRequest]) (*connect_go.Response[v1.GetRosterVersionResponse], error) {
+	return c.getRosterVersion.CallUnary(ctx, req)
+}
+
+// DiffRosters calls rosterd.v1.RosterService.DiffRosters.
+func (c *rosterServiceClient) DiffRosters(ctx context.Context, req *connect_go.Request[v1.DiffRostersRequest]) (*connect_go.Response[v1.DiffRostersResponse], error) {
+	return c.diffRosters.CallUnary(ctx, req)
+}
+
+// DiffRosterVersions calls rosterd.v1.RosterService.DiffRosterVersions.
+//
+// Deprecated: do not use.
+func (c *rosterServiceClient) DiffRosterVersions(ctx context.Context, req *connect_go.Request[v1.DiffRosterVersionsRequest]) (*connect_go.Response[v1.DiffRosterVersionsResponse], error) {
+	return c.diffRosterVersions.CallUnary(ctx, req)
+}
+
+// RestoreRosterVersion calls rosterd.v1.RosterService.RestoreRosterVersion.
+func (c *rosterServiceClient) RestoreRosterVersion(ctx context.Context, req *connect_go.Request[v1.RestoreRosterVersionRequest]) (*connect_go.Response[v1.RestoreRosterVersionResponse], error) {
+	return c.restoreRosterVersion.CallUnary(ctx, req)
+}
+
+// RosterServiceHandler is an implementation of the rosterd.v1.RosterService service.
+type RosterServiceHandler interface {
+	// GenerateRoster automatically generates a draft duty roster for a
+	// roster type and date range. The generated roster is not saved.
+	GenerateRoster(context.Context, *connect_go.Request[v1.GenerateRosterRequest]) (*connect_go.Response[v1.GenerateRosterResponse], error)
+	// CompleteRoster fills all remaining staffing gaps of an existing duty
+	// roster. Existing assignments are treated as fixed. The completed roster
+	// is not saved.
+	CompleteRoster(context.Context, *connect_go.Request[v1.CompleteRosterRequest]) (*connect_go.Response[v1.CompleteRosterResponse], error)
+	// ValidateRoster checks a saved or unsaved duty roster for problems.
+	ValidateRoster(context.Context, *connect_go.Request[v1.ValidateRosterRequest]) (*connect_go.Response[v1.ValidateRosterResponse], error)
+	// ValidateAndApproveRoster validates a duty roster and approves it if
+	// there are no blocking errors or force is set.
+	ValidateAndApproveRoster(context.Context, *connect_go.Request[v1.ValidateAndApproveRosterRequest]) (*connect_go.Response[v1.ValidateAndApproveRosterResponse], error)
+	// AnalyzeOvertime analyzes the work time of users and reports the raw
+	// and the allowance-adjusted overtime per month.
+	AnalyzeOvertime(context.Context, *connect_go.Request[v1.AnalyzeOvertimeRequest]) (*connect_go.Response[v1.AnalyzeOvertimeResponse], error)
+	// ExportRosterTable exports a duty roster as a CSV or XLSX table. Unlike
+	// tkd.roster.v1.RosterService/ExportRoster the export does not require
+	// an external renderer.
+	ExportRosterTable(context.Context, *connect_go.Request[v1.ExportRosterTableRequest]) (*connect_go.Response[v11.ExportRosterResponse], error)
+	// ExportUserRoster exports the personal roster of a single user
+	// including planned shifts, approved off-time, work time and vacation
+	// balance.
+	ExportUserRoster(context.Context, *connect_go.Request[v1.ExportUserRosterRequest]) (*connect_go.Response[v11.ExportRosterResponse], error)
+	// ExportPayroll exports the monthly work time of users split into
+	// payroll categories together with overtime, vacation and time-off.
+	ExportPayroll(context.Context, *connect_go.Request[v1.ExportPayrollRequest]) (*connect_go.Response[v1.ExportPayrollResponse], error)
+	// ListRosterVersions returns the version history of a duty roster
+	// including all rosters it superseded or has been superseded by.
+	ListRosterVersions(context.Context, *connect_go.Request[v1.ListRosterVersionsRequest]) (*connect_go.Response[v1.ListRosterVersionsResponse], error)
+	// GetRosterVersion returns a single version of a duty roster.
+	GetRosterVersion(context.Context, *connect_go.Request[v1.GetRosterVersionRequest]) (*connect_go.Response[v1.GetRosterVersionResponse], error)
+	// DiffRosters returns the shift and assignment changes between two
+	// rosters or roster versions covering the same period.
+	DiffRosters(context.Context, *connect_go.Request[v1.DiffRostersRequest]) (*connect_go.Response[v1.DiffRostersResponse], error)
+	// DiffRosterVersions returns the assignment changes per user between
+	// two versions of duty rosters covering the same period. A shift whose
+	// times changed is reported as removed at the previous and assigned at
+	// the new time.
+	//
+	// Deprecated: use DiffRosters, which also reports added and removed
+	// shifts and shifts with changed times.
+	//
+	// Deprecated: do not use.
+	DiffRosterVersions(context.Context, *connect_go.Request[v1.DiffRosterVersionsRequest]) (*connect_go.Response[v1.DiffRosterVersionsResponse], error)
+	// RestoreRosterVersion saves the shifts of an older version as a new
+	// draft of the roster.
+	RestoreRosterVersion(context.Context, *connect_go.Request[v1.RestoreRosterVersionRequest]) (*connect_go.Response[v1.RestoreRosterVersionResponse], error)
+}
+
+// NewRosterServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewRosterServiceHandler(svc RosterServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
+	rosterServiceGenerateRosterHandler := connect_go.NewUnaryHandler(
+		RosterServiceGenerateRosterProcedure,
+		svc.GenerateRoster,
+		opts...,
+	)
+	rosterServiceCompleteRosterHandler := connect_go.NewUnaryHandler(
+		RosterServiceCompleteRosterProcedure,
+		svc.CompleteRoster,
+		opts...,
+	)
+	rosterServiceValidateRosterHandler := connect_go.NewUnaryHandler(
+		RosterServiceValidateRosterProcedure,
+		svc.ValidateRoster,
+		opts...,
+	)
+	rosterServiceValidateAndApproveRosterHandler := connect_go.NewUnaryHandler(
+		RosterServiceValidateAndApproveRosterProcedure,
+		svc.ValidateAndApproveRoster,
+		opts...,
+	)
+	rosterServiceAnalyzeOvertimeHandler := connect_go.NewUnaryHandler(
+		RosterServiceAnalyzeOvertimeProcedure,
+		svc.AnalyzeOvertime,
+		opts...,
+	)
+	rosterServiceExportRosterTableHandler := connect_go.NewUnaryHandler(
+		RosterServiceExportRosterTableProcedure,
+		svc.ExportRosterTable,
+		opts...,
+	)
+	rosterServiceExportUserRosterHandler := connect_go.NewUnaryHandler(
+		RosterServiceExportUserRosterProcedure,
+		svc.ExportUserRoster,
+		opts...,
+	)
+	rosterServiceExportPayrollHandler := connect_go.NewUnaryHandler(
+		RosterServiceExportPayrollProcedure,
+		svc.ExportPayroll,
+		opts...,
+	)
+	rosterServiceListRosterVersionsHandler := connect_go.NewUnaryHandler(
+		RosterServiceListRosterVersionsProcedure,
+		svc.ListRosterVersions,
+		opts...,
+	)
+	rosterServiceGetRosterVersionHandler := connect_go.NewUnaryHandler(
+		RosterServiceGetRosterVersionProcedure,
+		svc.GetRosterVersion,
+		opts...,
+	)
+	rosterServiceDiffRostersHandler := connect_go.NewUnaryHandler(
+		RosterServiceDiffRostersProcedure,
+		svc.DiffRosters,
+		opts...,
+	)
+	rosterServiceDiffRosterVersionsHandler := connect_go.NewUnaryHandler(
+		RosterServiceDiffRosterVersionsProcedure,
+		svc.DiffRosterVersions,
+		opts...,
+	)
+	rosterServiceRestoreRosterVersionHandler := connect_go.NewUnaryHandler(
+		RosterServiceRestoreRosterVersionProcedure,
+		svc.RestoreRosterVersion,
+		opts...,
+	)
+	return "/rosterd.v1.RosterService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case RosterServiceGenerateRosterProcedure:
+			rosterServiceGenerateRosterHandler.ServeHTTP(w, r)
+		case RosterServiceCompleteRosterProcedure:
+			rosterServiceCompleteRosterHandler.ServeHTTP(w, r)
+		case RosterServiceValidateRosterProcedure:
+			rosterServiceValidateRosterHandler.ServeHTTP(w, r)
+		case RosterServiceValidateAndApproveRosterProcedure:
+			rosterServiceValidateAndApproveRosterHandler.ServeHTTP(w, r)
+		case RosterServiceAnalyzeOvertimeProcedure:
+			rosterServiceAnalyzeOvertimeHandler.ServeHTTP(w, r)
+		case RosterServiceExportRosterTableProcedure:
+			rosterServiceExportRosterTableHandler.ServeHTTP(w, r)
+		case RosterServiceExportUserRosterProcedure:
+			rosterServiceExportUserRosterHandler.ServeHTTP(w, r)
+		case RosterServiceExportPayrollProcedure:
+			rosterServiceExportPayrollHandler.ServeHTTP(w, r)
+		case RosterServiceListRosterVersionsProcedure:
+			rosterServiceListRosterVersionsHandler.ServeHTTP(w, r)
+		case RosterServiceGetRosterVersionProcedure:
+			rosterServiceGetRosterVersionHandler.ServeHTTP(w, r)
+		case RosterServiceDiffRostersProcedure:
+			rosterServiceDiffRostersHandler.ServeHTTP(w, r)
+		case RosterServiceDiffRosterVersionsProcedure:
+			rosterServiceDiffRosterVersionsHandler.ServeHTTP(w, r)
+		case RosterServiceRestoreRosterVersionProcedure:
+			rosterServiceRestoreRosterVersionHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedRosterServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedRosterServiceHandler struct{}
+
+func (UnimplementedRosterServiceHandler) GenerateRoster(context.Context, *connect_go.Request[v1.GenerateRosterRequest]) (*connect_go.Response[v1.GenerateRosterResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("rosterd.v1.RosterService.GenerateRoster is not implemented"))
+}
+
+func (UnimplementedRosterServiceHandler) CompleteRoster(context.Context, *connect_go.Request[v1.CompleteRosterRequest]) (*connect_go.Response[v1.CompleteRosterResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("rosterd.v1.RosterService.CompleteRoster is not implemented"))
+}
+
+func (UnimplementedRosterServiceHandler) ValidateRoster(context.Context, *connect_go.Request[v1.ValidateRosterRequest]) (*connect_go.Response[v1.ValidateRosterResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("rosterd.v1.RosterService.ValidateRoster is not implemented"))
+}
+
+func (UnimplementedRosterServiceHandler) ValidateAndApproveRoster(context.Context, *connect_go.Request[v1.ValidateAndApproveRosterRequest]) (*connect_go.Response[v1.ValidateAndApproveRosterResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("rosterd.v1.RosterService.ValidateAndApproveRoster is not implemented"))
+}
+
+func (UnimplementedRosterServiceHandler) AnalyzeOvertime(context.Context, *connect_go.Request[v1.AnalyzeOvertimeRequest]) (*connect_go.Response[v1.AnalyzeOvertimeResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("rosterd.v1.RosterService.AnalyzeOvertime is not implemented"))
+}
+
+func (UnimplementedRosterServiceHandler) ExportRosterTable(context.Context, *connect_go.Request[v1.ExportRosterTableRequest]) (*connect_go.Response[v11.ExportRosterResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("rosterd.v1.RosterService.ExportRosterTable is not implemented"))
+}
+
+func (UnimplementedRosterServiceHandler) ExportUserRoster(context.Context, *connect_go.Request[v1.ExportUserRosterRequest]) (*connect_go.Response[v11.ExportRosterResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("rosterd.v1.RosterService.ExportUserRoster is not implemented"))
+}
+
+func (UnimplementedRosterServiceHandler) ExportPayroll(context.Context, *connect_go.Request[v1.ExportPayrollRequest]) (*connect_go.Response[v1.ExportPayrollResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("rosterd.v1.RosterService.ExportPayroll is not implemented"))
+}
+
+func (UnimplementedRosterServiceHandler) ListRosterVersions(context.Context, *connect_go.Request[v1.ListRosterVersionsRequest]) (*connect_go.Response[v1.ListRosterVersionsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("rosterd.v1.RosterService.ListRosterVersions is not implemented"))
+}
+
+func (UnimplementedRosterServiceHandler) GetRosterVersion(context.Context, *connect_go.Request[v1.GetRosterVersionRequest]) (*connect_go.Response[v1.GetRosterVersionResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("rosterd.v1.RosterService.GetRosterVersion is not implemented"))
+}
+
+func (UnimplementedRosterServiceHandler) DiffRosters(context.Context, *connect_go.Request[v1.DiffRostersRequest]) (*connect_go.Response[v1.DiffRostersResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("rosterd.v1.RosterService.DiffRosters is not implemented"))
+}
+
+func (UnimplementedRosterServiceHandler) DiffRosterVersions(context.Context, *connect_go.Request[v1.DiffRosterVersionsRequest]) (*connect_go.Response[v1.DiffRosterVersionsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("rosterd.v1.RosterService.DiffRosterVersions is not implemented"))
+}
+
+func (UnimplementedRosterServiceHandler) RestoreRosterVersion(context.Context, *connect_go.Request[v1.RestoreRosterVersionRequest]) (*connect_go.Response[v1.RestoreRosterVersionResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("rosterd.v1.RosterService.RestoreRosterVersion is not implemented"))
+}
@@ -0,0 +1,260 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: rosterd/v1/swap.proto
+
+package rosterdv1connect
+
+import (
+	context "context"
+	errors "errors"
+	connect_go "github.com/bufbuild/connect-go"
+	v1 "github.com/tierklinik-dobersberg/rosterd/gen/go/rosterd/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect_go.IsAtLeastVersion0_1_0
+
+const (
+	// ShiftSwapServiceName is the fully-qualified name of the ShiftSwapService service.
+	ShiftSwapServiceName = "rosterd.v1.ShiftSwapService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ShiftSwapServiceCreateShiftSwapProcedure is the fully-qualified name of the ShiftSwapService's
+	// CreateShiftSwap RPC.
+	ShiftSwapServiceCreateShiftSwapProcedure = "/rosterd.v1.ShiftSwapService/CreateShiftSwap"
+	// ShiftSwapServiceListShiftSwapsProcedure is the fully-qualified name of the ShiftSwapService's
+	// ListShiftSwaps RPC.
+	ShiftSwapServiceListShiftSwapsProcedure = "/rosterd.v1.ShiftSwapService/ListShiftSwaps"
+	// ShiftSwapServiceAcceptShiftSwapProcedure is the fully-qualified name of the ShiftSwapService's
+	// AcceptShiftSwap RPC.
+	ShiftSwapServiceAcceptShiftSwapProcedure = "/rosterd.v1.ShiftSwapService/AcceptShiftSwap"
+	// ShiftSwapServiceDeclineShiftSwapProcedure is the fully-qualified name of the ShiftSwapService's
+	// DeclineShiftSwap RPC.
+	ShiftSwapServiceDeclineShiftSwapProcedure = "/rosterd.v1.ShiftSwapService/DeclineShiftSwap"
+	// ShiftSwapServiceCancelShiftSwapProcedure is the fully-qualified name of the ShiftSwapService's
+	// CancelShiftSwap RPC.
+	ShiftSwapServiceCancelShiftSwapProcedure = "/rosterd.v1.ShiftSwapService/CancelShiftSwap"
+	// ShiftSwapServiceDecideShiftSwapProcedure is the fully-qualified name of the ShiftSwapService's
+	// DecideShiftSwap RPC.
+	ShiftSwapServiceDecideShiftSwapProcedure = "/rosterd.v1.ShiftSwapService/DecideShiftSwap"
+)
+
+// ShiftSwapServiceClient is a client for the rosterd.v1.ShiftSwapService service.
+type ShiftSwapServiceClient interface {
+	// CreateShiftSwap offers a shift of the calling user or proposes a
+	// direct exchange with a colleague.
+	CreateShiftSwap(context.Context, *connect_go.Request[v1.CreateShiftSwapRequest]) (*connect_go.Response[v1.CreateShiftSwapResponse], error)
+	// ListShiftSwaps returns shift swaps.
+	ListShiftSwaps(context.Context, *connect_go.Request[v1.ListShiftSwapsRequest]) (*connect_go.Response[v1.ListShiftSwapsResponse], error)
+	// AcceptShiftSwap accepts an offered swap. Only the target user may
+	// accept a direct swap while open offers may be accepted by any
+	// eligible colleague.
+	AcceptShiftSwap(context.Context, *connect_go.Request[v1.AcceptShiftSwapRequest]) (*connect_go.Response[v1.AcceptShiftSwapResponse], error)
+	// DeclineShiftSwap declines a direct swap.
+	DeclineShiftSwap(context.Context, *connect_go.Request[v1.DeclineShiftSwapRequest]) (*connect_go.Response[v1.DeclineShiftSwapResponse], error)
+	// CancelShiftSwap cancels a swap that has not yet been decided.
+	CancelShiftSwap(context.Context, *connect_go.Request[v1.CancelShiftSwapRequest]) (*connect_go.Response[v1.CancelShiftSwapResponse], error)
+	// DecideShiftSwap approves or rejects an accepted swap. Approved swaps
+	// are applied to the roster without revoking its approval.
+	DecideShiftSwap(context.Context, *connect_go.Request[v1.DecideShiftSwapRequest]) (*connect_go.Response[v1.DecideShiftSwapResponse], error)
+}
+
+// NewShiftSwapServiceClient constructs a client for the rosterd.v1.ShiftSwapService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewShiftSwapServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) ShiftSwapServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &shiftSwapServiceClient{
+		createShiftSwap: connect_go.NewClient[v1.CreateShiftSwapRequest, v1.CreateShiftSwapResponse](
+			httpClient,
+			baseURL+ShiftSwapServiceCreateShiftSwapProcedure,
+			opts...,
+		),
+		listShiftSwaps: connect_go.NewClient[v1.ListShiftSwapsRequest, v1.ListShiftSwapsResponse](
+			httpClient,
+			baseURL+ShiftSwapServiceListShiftSwapsProcedure,
+			opts...,
+		),
+		acceptShiftSwap: connect_go.NewClient[v1.AcceptShiftSwapRequest, v1.AcceptShiftSwapResponse](
+			httpClient,
+			baseURL+ShiftSwapServiceAcceptShiftSwapProcedure,
+			opts...,
+		),
+		declineShiftSwap: connect_go.NewClient[v1.DeclineShiftSwapRequest, v1.DeclineShiftSwapResponse](
+			httpClient,
+			baseURL+ShiftSwapServiceDeclineShiftSwapProcedure,
+			opts...,
+		),
+		cancelShiftSwap: connect_go.NewClient[v1.CancelShiftSwapRequest, v1.CancelShiftSwapResponse](
+			httpClient,
+			baseURL+ShiftSwapServiceCancelShiftSwapProcedure,
+			opts...,
+		),
+		decideShiftSwap: connect_go.NewClient[v1.DecideShiftSwapRequest, v1.DecideShiftSwapResponse](
+			httpClient,
+			baseURL+ShiftSwapServiceDecideShiftSwapProcedure,
+			opts...,
+		),
+	}
+}
+
+// shiftSwapServiceClient implements ShiftSwapServiceClient.
+type shiftSwapServiceClient struct {
+	createShiftSwap  *connect_go.Client[v1.CreateShiftSwapRequest, v1.CreateShiftSwapResponse]
+	listShiftSwaps   *connect_go.Client[v1.ListShiftSwapsRequest, v1.ListShiftSwapsResponse]
+	acceptShiftSwap  *connect_go.Client[v1.AcceptShiftSwapRequest, v1.AcceptShiftSwapResponse]
+	declineShiftSwap *connect_go.Client[v1.DeclineShiftSwapRequest, v1.DeclineShiftSwapResponse]
+	cancelShiftSwap  *connect_go.Client[v1.CancelShiftSwapRequest, v1.CancelShiftSwapResponse]
+	decideShiftSwap  *connect_go.Client[v1.DecideShiftSwapRequest, v1.DecideShiftSwapResponse]
+}
+
+// CreateShiftSwap calls rosterd.v1.ShiftSwapService.CreateShiftSwap.
+func (c *shiftSwapServiceClient) CreateShiftSwap(ctx context.Context, req *connect_go.Request[v1.CreateShiftSwapRequest]) (*connect_go.Response[v1.CreateShiftSwapResponse], error) {
+	return c.createShiftSwap.CallUnary(ctx, req)
+}
+
+// ListShiftSwaps calls rosterd.v1.ShiftSwapService.ListShiftSwaps.
+func (c *shiftSwapServiceClient) ListShiftSwaps(ctx context.Context, req *connect_go.Request[v1.ListShiftSwapsRequest]) (*connect_go.Response[v1.ListShiftSwapsResponse], error) {
+	return c.listShiftSwaps.CallUnary(ctx, req)
+}
+
+// AcceptShiftSwap calls rosterd.v1.ShiftSwapService.AcceptShiftSwap.
+func (c *shiftSwapServiceClient) AcceptShiftSwap(ctx context.Context, req *connect_go.Request[v1.AcceptShiftSwapRequest]) (*connect_go.Response[v1.AcceptShiftSwapResponse], error) {
+	return c.acceptShiftSwap.CallUnary(ctx, req)
+}
+
+// DeclineShiftSwap calls rosterd.v1.ShiftSwapService.DeclineShiftSwap.
+func (c *shiftSwapServiceClient) DeclineShiftSwap(ctx context.Context, req *connect_go.Request[v1.DeclineShiftSwapRequest]) (*connect_go.Response[v1.DeclineShiftSwapResponse], error) {
+	return c.declineShiftSwap.CallUnary(ctx, req)
+}
+
+// CancelShiftSwap calls rosterd.v1.ShiftSwapService.CancelShiftSwap.
+func (c *shiftSwapServiceClient) CancelShiftSwap(ctx context.Context, req *connect_go.Request[v1.CancelShiftSwapRequest]) (*connect_go.Response[v1.CancelShiftSwapResponse], error) {
+	return c.cancelShiftSwap.CallUnary(ctx, req)
+}
+
+// DecideShiftSwap calls rosterd.v1.ShiftSwapService.DecideShiftSwap.
+func (c *shiftSwapServiceClient) DecideShiftSwap(ctx context.Context, req *connect_go.Request[v1.DecideShiftSwapRequest]) (*connect_go.Response[v1.DecideShiftSwapResponse], error) {
+	return c.decideShiftSwap.CallUnary(ctx, req)
+}
+
+// ShiftSwapServiceHandler is an implementation of the rosterd.v1.ShiftSwapService service.
+type ShiftSwapServiceHandler interface {
+	// CreateShiftSwap offers a shift of the calling user or proposes a
+	// direct exchange with a colleague.
+	CreateShiftSwap(context.Context, *connect_go.Request[v1.CreateShiftSwapRequest]) (*connect_go.Response[v1.CreateShiftSwapResponse], error)
+	// ListShiftSwaps returns shift swaps.
+	ListShiftSwaps(context.Context, *connect_go.Request[v1.ListShiftSwapsRequest]) (*connect_go.Response[v1.ListShiftSwapsResponse], error)
+	// AcceptShiftSwap accepts an offered swap. Only the target user may
+	// accept a direct swap while open offers may be accepted by any
+	// eligible colleague.
+	AcceptShiftSwap(context.Context, *connect_go.Request[v1.AcceptShiftSwapRequest]) (*connect_go.Response[v1.AcceptShiftSwapResponse], error)
+	// DeclineShiftSwap declines a direct swap.
+	DeclineShiftSwap(context.Context, *connect_go.Request[v1.DeclineShiftSwapRequest]) (*connect_go.Response[v1.DeclineShiftSwapResponse], error)
+	// CancelShiftSwap cancels a swap that has not yet been decided.
+	CancelShiftSwap(context.Context, *connect_go.Request[v1.CancelShiftSwapRequest]) (*connect_go.Response[v1.CancelShiftSwapResponse], error)
+	// DecideShiftSwap approves or rejects an accepted swap. Approved swaps
+	// are applied to the roster without revoking its approval.
+	DecideShiftSwap(context.Context, *connect_go.Request[v1.DecideShiftSwapRequest]) (*connect_go.Response[v1.DecideShiftSwapResponse], error)
+}
+
+// NewShiftSwapServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewShiftSwapServiceHandler(svc ShiftSwapServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
+	shiftSwapServiceCreateShiftSwapHandler := connect_go.NewUnaryHandler(
+		ShiftSwapServiceCreateShiftSwapProcedure,
+		svc.CreateShiftSwap,
+		opts...,
+	)
+	shiftSwapServiceListShiftSwapsHandler := connect_go.NewUnaryHandler(
+		ShiftSwapServiceListShiftSwapsProcedure,
+		svc.ListShiftSwaps,
+		opts...,
+	)
+	shiftSwapServiceAcceptShiftSwapHandler := connect_go.NewUnaryHandler(
+		ShiftSwapServiceAcceptShiftSwapProcedure,
+		svc.AcceptShiftSwap,
+		opts...,
+	)
+	shiftSwapServiceDeclineShiftSwapHandler := connect_go.NewUnaryHandler(
+		ShiftSwapServiceDeclineShiftSwapProcedure,
+		svc.DeclineShiftSwap,
+		opts...,
+	)
+	shiftSwapServiceCancelShiftSwapHandler := connect_go.NewUnaryHandler(
+		ShiftSwapServiceCancelShiftSwapProcedure,
+		svc.CancelShiftSwap,
+		opts...,
+	)
+	shiftSwapServiceDecideShiftSwapHandler := connect_go.NewUnaryHandler(
+		ShiftSwapServiceDecideShiftSwapProcedure,
+		svc.DecideShiftSwap,
+		opts...,
+	)
+	return "/rosterd.v1.ShiftSwapService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ShiftSwapServiceCreateShiftSwapProcedure:
+			shiftSwapServiceCreateShiftSwapHandler.ServeHTTP(w, r)
+		case ShiftSwapServiceListShiftSwapsProcedure:
+			shiftSwapServiceListShiftSwapsHandler.ServeHTTP(w, r)
+		case ShiftSwapServiceAcceptShiftSwapProcedure:
+			shiftSwapServiceAcceptShiftSwapHandler.ServeHTTP(w, r)
+		case ShiftSwapServiceDeclineShiftSwapProcedure:
+			shiftSwapServiceDeclineShiftSwapHandler.ServeHTTP(w, r)
+		case ShiftSwapServiceCancelShiftSwapProcedure:
+			shiftSwapServiceCancelShiftSwapHandler.ServeHTTP(w, r)
+		case ShiftSwapServiceDecideShiftSwapProcedure:
+			shiftSwapServiceDecideShiftSwapHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedShiftSwapServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedShiftSwapServiceHandler struct{}
+
+func (UnimplementedShiftSwapServiceHandler) CreateShiftSwap(context.Context, *connect_go.Request[v1.CreateShiftSwapRequest]) (*connect_go.Response[v1.CreateShiftSwapResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("rosterd.v1.ShiftSwapService.CreateShiftSwap is not implemented"))
+}
+
+func (UnimplementedShiftSwapServiceHandler) ListShiftSwaps(context.Context, *connect_go.Request[v1.ListShiftSwapsRequest]) (*connect_go.Response[v1.ListShiftSwapsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("rosterd.v1.ShiftSwapService.ListShiftSwaps is not implemented"))
+}
+
+func (UnimplementedShiftSwapServiceHandler) AcceptShiftSwap(context.Context, *connect_go.Request[v1.AcceptShiftSwapRequest]) (*connect_go.Response[v1.AcceptShiftSwapResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("rosterd.v1.ShiftSwapService.AcceptShiftSwap is not implemented"))
+}
+
+func (UnimplementedShiftSwapServiceHandler) DeclineShiftSwap(context.Context, *connect_go.Request[v1.DeclineShiftSwapRequest]) (*connect_go.Response[v1.DeclineShiftSwapResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("rosterd.v1.ShiftSwapService.DeclineShiftSwap is not implemented"))
+}
+
+func (UnimplementedShiftSwapServiceHandler) CancelShiftSwap(context.Context, *connect_go.Request[v1.CancelShiftSwapRequest]) (*connect_go.Response[v1.CancelShiftSwapResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("rosterd.v1.ShiftSwapService.CancelShiftSwap is not implemented"))
+}
+
+func (UnimplementedShiftSwapServiceHandler) DecideShiftSwap(context.Context, *connect_go.Request[v1.DecideShiftSwapRequest]) (*connect_go.Response[v1.DecideShiftSwapResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("rosterd.v1.ShiftSwapService.DecideShiftSwap is not implemented"))
+}
@@ -0,0 +1,138 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: rosterd/v1/worktime.proto
+
+package rosterdv1connect
+
+import (
+	context "context"
+	errors "errors"
+	connect_go "github.com/bufbuild/connect-go"
+	v1 "github.com/tierklinik-dobersberg/rosterd/gen/go/rosterd/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect_go.IsAtLeastVersion0_1_0
+
+const (
+	// WorkTimeServiceName is the fully-qualified name of the WorkTimeService service.
+	WorkTimeServiceName = "rosterd.v1.WorkTimeService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// WorkTimeServiceSetWorkTimeScheduleProcedure is the fully-qualified name of the WorkTimeService's
+	// SetWorkTimeSchedule RPC.
+	WorkTimeServiceSetWorkTimeScheduleProcedure = "/rosterd.v1.WorkTimeService/SetWorkTimeSchedule"
+	// WorkTimeServiceGetWorkTimeScheduleProcedure is the fully-qualified name of the WorkTimeService's
+	// GetWorkTimeSchedule RPC.
+	WorkTimeServiceGetWorkTimeScheduleProcedure = "/rosterd.v1.WorkTimeService/GetWorkTimeSchedule"
+)
+
+// WorkTimeServiceClient is a client for the rosterd.v1.WorkTimeService service.
+type WorkTimeServiceClient interface {
+	// SetWorkTimeSchedule configures the working weekdays and/or the work
+	// time per weekday of a work-time entry.
+	SetWorkTimeSchedule(context.Context, *connect_go.Request[v1.SetWorkTimeScheduleRequest]) (*connect_go.Response[v1.SetWorkTimeScheduleResponse], error)
+	// GetWorkTimeSchedule returns the schedule of a work-time entry.
+	GetWorkTimeSchedule(context.Context, *connect_go.Request[v1.GetWorkTimeScheduleRequest]) (*connect_go.Response[v1.GetWorkTimeScheduleResponse], error)
+}
+
+// NewWorkTimeServiceClient constructs a client for the rosterd.v1.WorkTimeService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewWorkTimeServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) WorkTimeServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &workTimeServiceClient{
+		setWorkTimeSchedule: connect_go.NewClient[v1.SetWorkTimeScheduleRequest, v1.SetWorkTimeScheduleResponse](
+			httpClient,
+			baseURL+WorkTimeServiceSetWorkTimeScheduleProcedure,
+			opts...,
+		),
+		getWorkTimeSchedule: connect_go.NewClient[v1.GetWorkTimeScheduleRequest, v1.GetWorkTimeScheduleResponse](
+			httpClient,
+			baseURL+WorkTimeServiceGetWorkTimeScheduleProcedure,
+			opts...,
+		),
+	}
+}
+
+// workTimeServiceClient implements WorkTimeServiceClient.
+type workTimeServiceClient struct {
+	setWorkTimeSchedule *connect_go.Client[v1.SetWorkTimeScheduleRequest, v1.SetWorkTimeScheduleResponse]
+	getWorkTimeSchedule *connect_go.Client[v1.GetWorkTimeScheduleRequest, v1.GetWorkTimeScheduleResponse]
+}
+
+// SetWorkTimeSchedule calls rosterd.v1.WorkTimeService.SetWorkTimeSchedule.
+func (c *workTimeServiceClient) SetWorkTimeSchedule(ctx context.Context, req *connect_go.Request[v1.SetWorkTimeScheduleRequest]) (*connect_go.Response[v1.SetWorkTimeScheduleResponse], error) {
+	return c.setWorkTimeSchedule.CallUnary(ctx, req)
+}
+
+// GetWorkTimeSchedule calls rosterd.v1.WorkTimeService.GetWorkTimeSchedule.
+func (c *workTimeServiceClient) GetWorkTimeSchedule(ctx context.Context, req *connect_go.Request[v1.GetWorkTimeScheduleRequest]) (*connect_go.Response[v1.GetWorkTimeScheduleResponse], error) {
+	return c.getWorkTimeSchedule.CallUnary(ctx, req)
+}
+
+// WorkTimeServiceHandler is an implementation of the rosterd.v1.WorkTimeService service.
+type WorkTimeServiceHandler interface {
+	// SetWorkTimeSchedule configures the working weekdays and/or the work
+	// time per weekday of a work-time entry.
+	SetWorkTimeSchedule(context.Context, *connect_go.Request[v1.SetWorkTimeScheduleRequest]) (*connect_go.Response[v1.SetWorkTimeScheduleResponse], error)
+	// GetWorkTimeSchedule returns the schedule of a work-time entry.
+	GetWorkTimeSchedule(context.Context, *connect_go.Request[v1.GetWorkTimeScheduleRequest]) (*connect_go.Response[v1.GetWorkTimeScheduleResponse], error)
+}
+
+// NewWorkTimeServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewWorkTimeServiceHandler(svc WorkTimeServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
+	workTimeServiceSetWorkTimeScheduleHandler := connect_go.NewUnaryHandler(
+		WorkTimeServiceSetWorkTimeScheduleProcedure,
+		svc.SetWorkTimeSchedule,
+		opts...,
+	)
+	workTimeServiceGetWorkTimeScheduleHandler := connect_go.NewUnaryHandler(
+		WorkTimeServiceGetWorkTimeScheduleProcedure,
+		svc.GetWorkTimeSchedule,
+		opts...,
+	)
+	return "/rosterd.v1.WorkTimeService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case WorkTimeServiceSetWorkTimeScheduleProcedure:
+			workTimeServiceSetWorkTimeScheduleHandler.ServeHTTP(w, r)
+		case WorkTimeServiceGetWorkTimeScheduleProcedure:
+			workTimeServiceGetWorkTimeScheduleHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedWorkTimeServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedWorkTimeServiceHandler struct{}
+
+func (UnimplementedWorkTimeServiceHandler) SetWorkTimeSchedule(context.Context, *connect_go.Request[v1.SetWorkTimeScheduleRequest]) (*connect_go.Response[v1.SetWorkTimeScheduleResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("rosterd.v1.WorkTimeService.SetWorkTimeSchedule is not implemented"))
+}
+
+func (UnimplementedWorkTimeServiceHandler) GetWorkTimeSchedule(context.Context, *connect_go.Request[v1.GetWorkTimeScheduleRequest]) (*connect_go.Response[v1.GetWorkTimeScheduleResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("rosterd.v1.WorkTimeService.GetWorkTimeSchedule is not implemented"))
+}
@@ -0,0 +1,1242 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        (unknown)
+// source: rosterd/v1/swap.proto
+
+package rosterdv1
+
+import (
+	_ "github.com/tierklinik-dobersberg/apis/gen/go/tkd/common/v1"
+	v1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/roster/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ShiftSwapState int32
+
+const (
+	ShiftSwapState_SHIFT_SWAP_STATE_UNSPECIFIED ShiftSwapState = 0
+	// The swap has been offered and waits for a colleague to accept it.
+	ShiftSwapState_SHIFT_SWAP_STATE_OFFERED ShiftSwapState = 1
+	// A colleague accepted the swap and it waits for the approval of a
+	// roster manager.
+	ShiftSwapState_SHIFT_SWAP_STATE_ACCEPTED ShiftSwapState = 2
+	// A roster manager approved the swap and the roster has been updated.
+	ShiftSwapState_SHIFT_SWAP_STATE_APPROVED ShiftSwapState = 3
+	// A roster manager rejected the swap.
+	ShiftSwapState_SHIFT_SWAP_STATE_REJECTED ShiftSwapState = 4
+	// The colleague declined a direct swap.
+	ShiftSwapState_SHIFT_SWAP_STATE_DECLINED ShiftSwapState = 5
+	// The requestor cancelled the swap.
+	ShiftSwapState_SHIFT_SWAP_STATE_CANCELLED ShiftSwapState = 6
+)
+
+// Enum value maps for ShiftSwapState.
+var (
+	ShiftSwapState_name = map[int32]string{
+		0: "SHIFT_SWAP_STATE_UNSPECIFIED",
+		1: "SHIFT_SWAP_STATE_OFFERED",
+		2: "SHIFT_SWAP_STATE_ACCEPTED",
+		3: "SHIFT_SWAP_STATE_APPROVED",
+		4: "SHIFT_SWAP_STATE_REJECTED",
+		5: "SHIFT_SWAP_STATE_DECLINED",
+		6: "SHIFT_SWAP_STATE_CANCELLED",
+	}
+	ShiftSwapState_value = map[string]int32{
+		"SHIFT_SWAP_STATE_UNSPECIFIED": 0,
+		"SHIFT_SWAP_STATE_OFFERED":     1,
+		"SHIFT_SWAP_STATE_ACCEPTED":    2,
+		"SHIFT_SWAP_STATE_APPROVED":    3,
+		"SHIFT_SWAP_STATE_REJECTED":    4,
+		"SHIFT_SWAP_STATE_DECLINED":    5,
+		"SHIFT_SWAP_STATE_CANCELLED":   6,
+	}
+)
+
+func (x ShiftSwapState) Enum() *ShiftSwapState {
+	p := new(ShiftSwapState)
+	*p = x
+	return p
+}
+
+func (x ShiftSwapState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ShiftSwapState) Descriptor() protoreflect.EnumDescriptor {
+	return file_rosterd_v1_swap_proto_enumTypes[0].Descriptor()
+}
+
+func (ShiftSwapState) Type() protoreflect.EnumType {
+	return &file_rosterd_v1_swap_proto_enumTypes[0]
+}
+
+func (x ShiftSwapState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ShiftSwapState.Descriptor instead.
+func (ShiftSwapState) EnumDescriptor() ([]byte, []int) {
+	return file_rosterd_v1_swap_proto_rawDescGZIP(), []int{0}
+}
+
+// ShiftReference identifies a planned shift within a duty roster.
+type ShiftReference struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkShiftId   string                 `protobuf:"bytes,1,opt,name=work_shift_id,json=workShiftId,proto3" json:"work_shift_id,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShiftReference) Reset() {
+	*x = ShiftReference{}
+	mi := &file_rosterd_v1_swap_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShiftReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShiftReference) ProtoMessage() {}
+
+func (x *ShiftReference) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_swap_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShiftReference.ProtoReflect.Descriptor instead.
+func (*ShiftReference) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_swap_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ShiftReference) GetWorkShiftId() string {
+	if x != nil {
+		return x.WorkShiftId
+	}
+	return ""
+}
+
+func (x *ShiftReference) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+// ShiftSwap describes a request to hand over a planned shift to a colleague
+// or to exchange shifts with a colleague.
+type ShiftSwap struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// RosterId is the ID of the approved duty roster that contains the
+	// shifts. If the roster is superseded, the ID of the new roster is used
+	// once the swap is accepted or approved.
+	RosterId string `protobuf:"bytes,2,opt,name=roster_id,json=rosterId,proto3" json:"roster_id,omitempty"`
+	// Shift is the shift of the requestor that should be handed over.
+	Shift       *ShiftReference `protobuf:"bytes,3,opt,name=shift,proto3" json:"shift,omitempty"`
+	RequestorId string          `protobuf:"bytes,4,opt,name=requestor_id,json=requestorId,proto3" json:"requestor_id,omitempty"`
+	// TargetUserId is the colleague that should take over the shift. If
+	// empty, the shift is offered to all colleagues and the user that
+	// accepts the offer is stored here.
+	TargetUserId string `protobuf:"bytes,5,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
+	// ExchangeShift is the shift of the target user that the requestor
+	// takes over in exchange. If unset, the shift is just handed over.
+	ExchangeShift *ShiftReference `protobuf:"bytes,6,opt,name=exchange_shift,json=exchangeShift,proto3" json:"exchange_shift,omitempty"`
+	State         ShiftSwapState  `protobuf:"varint,7,opt,name=state,proto3,enum=rosterd.v1.ShiftSwapState" json:"state,omitempty"`
+	// Comment is an optional comment of the requestor.
+	Comment         string                 `protobuf:"bytes,8,opt,name=comment,proto3" json:"comment,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	AcceptedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=accepted_at,json=acceptedAt,proto3" json:"accepted_at,omitempty"`
+	DecidedBy       string                 `protobuf:"bytes,11,opt,name=decided_by,json=decidedBy,proto3" json:"decided_by,omitempty"`
+	DecidedAt       *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=decided_at,json=decidedAt,proto3" json:"decided_at,omitempty"`
+	DecisionComment string                 `protobuf:"bytes,13,opt,name=decision_comment,json=decisionComment,proto3" json:"decision_comment,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ShiftSwap) Reset() {
+	*x = ShiftSwap{}
+	mi := &file_rosterd_v1_swap_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShiftSwap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShiftSwap) ProtoMessage() {}
+
+func (x *ShiftSwap) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_swap_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShiftSwap.ProtoReflect.Descriptor instead.
+func (*ShiftSwap) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_swap_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ShiftSwap) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ShiftSwap) GetRosterId() string {
+	if x != nil {
+		return x.RosterId
+	}
+	return ""
+}
+
+func (x *ShiftSwap) GetShift() *ShiftReference {
+	if x != nil {
+		return x.Shift
+	}
+	return nil
+}
+
+func (x *ShiftSwap) GetRequestorId() string {
+	if x != nil {
+		return x.RequestorId
+	}
+	return ""
+}
+
+func (x *ShiftSwap) GetTargetUserId() string {
+	if x != nil {
+		return x.TargetUserId
+	}
+	return ""
+}
+
+func (x *ShiftSwap) GetExchangeShift() *ShiftReference {
+	if x != nil {
+		return x.ExchangeShift
+	}
+	return nil
+}
+
+func (x *ShiftSwap) GetState() ShiftSwapState {
+	if x != nil {
+		return x.State
+	}
+	return ShiftSwapState_SHIFT_SWAP_STATE_UNSPECIFIED
+}
+
+func (x *ShiftSwap) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *ShiftSwap) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ShiftSwap) GetAcceptedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AcceptedAt
+	}
+	return nil
+}
+
+func (x *ShiftSwap) GetDecidedBy() string {
+	if x != nil {
+		return x.DecidedBy
+	}
+	return ""
+}
+
+func (x *ShiftSwap) GetDecidedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DecidedAt
+	}
+	return nil
+}
+
+func (x *ShiftSwap) GetDecisionComment() string {
+	if x != nil {
+		return x.DecisionComment
+	}
+	return ""
+}
+
+// ShiftSwapFindings is attached as an error detail if a swap is refused
+// because it would introduce blocking validation errors.
+type ShiftSwapFindings struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Findings      []*RosterFinding       `protobuf:"bytes,1,rep,name=findings,proto3" json:"findings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShiftSwapFindings) Reset() {
+	*x = ShiftSwapFindings{}
+	mi := &file_rosterd_v1_swap_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShiftSwapFindings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShiftSwapFindings) ProtoMessage() {}
+
+func (x *ShiftSwapFindings) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_swap_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShiftSwapFindings.ProtoReflect.Descriptor instead.
+func (*ShiftSwapFindings) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_swap_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ShiftSwapFindings) GetFindings() []*RosterFinding {
+	if x != nil {
+		return x.Findings
+	}
+	return nil
+}
+
+type CreateShiftSwapRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	RosterId string                 `protobuf:"bytes,1,opt,name=roster_id,json=rosterId,proto3" json:"roster_id,omitempty"`
+	// Shift is the shift of the calling user that should be handed over.
+	Shift *ShiftReference `protobuf:"bytes,2,opt,name=shift,proto3" json:"shift,omitempty"`
+	// TargetUserId may be set to propose the swap to a single colleague.
+	// It is required if exchange_shift is set.
+	TargetUserId string `protobuf:"bytes,3,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
+	// ExchangeShift may be set to propose a direct exchange with the shift
+	// of the target user.
+	ExchangeShift *ShiftReference `protobuf:"bytes,4,opt,name=exchange_shift,json=exchangeShift,proto3" json:"exchange_shift,omitempty"`
+	Comment       string          `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateShiftSwapRequest) Reset() {
+	*x = CreateShiftSwapRequest{}
+	mi := &file_rosterd_v1_swap_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateShiftSwapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShiftSwapRequest) ProtoMessage() {}
+
+func (x *CreateShiftSwapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_swap_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateShiftSwapRequest.ProtoReflect.Descriptor instead.
+func (*CreateShiftSwapRequest) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_swap_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateShiftSwapRequest) GetRosterId() string {
+	if x != nil {
+		return x.RosterId
+	}
+	return ""
+}
+
+func (x *CreateShiftSwapRequest) GetShift() *ShiftReference {
+	if x != nil {
+		return x.Shift
+	}
+	return nil
+}
+
+func (x *CreateShiftSwapRequest) GetTargetUserId() string {
+	if x != nil {
+		return x.TargetUserId
+	}
+	return ""
+}
+
+func (x *CreateShiftSwapRequest) GetExchangeShift() *ShiftReference {
+	if x != nil {
+		return x.ExchangeShift
+	}
+	return nil
+}
+
+func (x *CreateShiftSwapRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type CreateShiftSwapResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Swap          *ShiftSwap             `protobuf:"bytes,1,opt,name=swap,proto3" json:"swap,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateShiftSwapResponse) Reset() {
+	*x = CreateShiftSwapResponse{}
+	mi := &file_rosterd_v1_swap_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateShiftSwapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShiftSwapResponse) ProtoMessage() {}
+
+func (x *CreateShiftSwapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_swap_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateShiftSwapResponse.ProtoReflect.Descriptor instead.
+func (*CreateShiftSwapResponse) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_swap_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateShiftSwapResponse) GetSwap() *ShiftSwap {
+	if x != nil {
+		return x.Swap
+	}
+	return nil
+}
+
+type ListShiftSwapsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// RosterId may be set to only return swaps of a given roster.
+	RosterId string `protobuf:"bytes,1,opt,name=roster_id,json=rosterId,proto3" json:"roster_id,omitempty"`
+	// UserId may be set to only return swaps where the user is either the
+	// requestor or the target. Non-administrators always receive their own
+	// swaps and all open offers.
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// States may be set to only return swaps with the given states.
+	States        []ShiftSwapState `protobuf:"varint,3,rep,packed,name=states,proto3,enum=rosterd.v1.ShiftSwapState" json:"states,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListShiftSwapsRequest) Reset() {
+	*x = ListShiftSwapsRequest{}
+	mi := &file_rosterd_v1_swap_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShiftSwapsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShiftSwapsRequest) ProtoMessage() {}
+
+func (x *ListShiftSwapsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_swap_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShiftSwapsRequest.ProtoReflect.Descriptor instead.
+func (*ListShiftSwapsRequest) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_swap_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListShiftSwapsRequest) GetRosterId() string {
+	if x != nil {
+		return x.RosterId
+	}
+	return ""
+}
+
+func (x *ListShiftSwapsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListShiftSwapsRequest) GetStates() []ShiftSwapState {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
+type ListShiftSwapsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Swaps         []*ShiftSwap           `protobuf:"bytes,1,rep,name=swaps,proto3" json:"swaps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListShiftSwapsResponse) Reset() {
+	*x = ListShiftSwapsResponse{}
+	mi := &file_rosterd_v1_swap_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShiftSwapsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShiftSwapsResponse) ProtoMessage() {}
+
+func (x *ListShiftSwapsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_swap_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShiftSwapsResponse.ProtoReflect.Descriptor instead.
+func (*ListShiftSwapsResponse) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_swap_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListShiftSwapsResponse) GetSwaps() []*ShiftSwap {
+	if x != nil {
+		return x.Swaps
+	}
+	return nil
+}
+
+type AcceptShiftSwapRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptShiftSwapRequest) Reset() {
+	*x = AcceptShiftSwapRequest{}
+	mi := &file_rosterd_v1_swap_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptShiftSwapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptShiftSwapRequest) ProtoMessage() {}
+
+func (x *AcceptShiftSwapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_swap_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptShiftSwapRequest.ProtoReflect.Descriptor instead.
+func (*AcceptShiftSwapRequest) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_swap_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AcceptShiftSwapRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type AcceptShiftSwapResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Swap          *ShiftSwap             `protobuf:"bytes,1,opt,name=swap,proto3" json:"swap,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptShiftSwapResponse) Reset() {
+	*x = AcceptShiftSwapResponse{}
+	mi := &file_rosterd_v1_swap_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptShiftSwapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptShiftSwapResponse) ProtoMessage() {}
+
+func (x *AcceptShiftSwapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_swap_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptShiftSwapResponse.ProtoReflect.Descriptor instead.
+func (*AcceptShiftSwapResponse) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_swap_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AcceptShiftSwapResponse) GetSwap() *ShiftSwap {
+	if x != nil {
+		return x.Swap
+	}
+	return nil
+}
+
+type DeclineShiftSwapRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeclineShiftSwapRequest) Reset() {
+	*x = DeclineShiftSwapRequest{}
+	mi := &file_rosterd_v1_swap_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeclineShiftSwapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineShiftSwapRequest) ProtoMessage() {}
+
+func (x *DeclineShiftSwapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_swap_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineShiftSwapRequest.ProtoReflect.Descriptor instead.
+func (*DeclineShiftSwapRequest) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_swap_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeclineShiftSwapRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeclineShiftSwapResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Swap          *ShiftSwap             `protobuf:"bytes,1,opt,name=swap,proto3" json:"swap,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeclineShiftSwapResponse) Reset() {
+	*x = DeclineShiftSwapResponse{}
+	mi := &file_rosterd_v1_swap_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeclineShiftSwapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineShiftSwapResponse) ProtoMessage() {}
+
+func (x *DeclineShiftSwapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_swap_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineShiftSwapResponse.ProtoReflect.Descriptor instead.
+func (*DeclineShiftSwapResponse) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_swap_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeclineShiftSwapResponse) GetSwap() *ShiftSwap {
+	if x != nil {
+		return x.Swap
+	}
+	return nil
+}
+
+type CancelShiftSwapRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelShiftSwapRequest) Reset() {
+	*x = CancelShiftSwapRequest{}
+	mi := &file_rosterd_v1_swap_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelShiftSwapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelShiftSwapRequest) ProtoMessage() {}
+
+func (x *CancelShiftSwapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_swap_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelShiftSwapRequest.ProtoReflect.Descriptor instead.
+func (*CancelShiftSwapRequest) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_swap_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CancelShiftSwapRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CancelShiftSwapResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Swap          *ShiftSwap             `protobuf:"bytes,1,opt,name=swap,proto3" json:"swap,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelShiftSwapResponse) Reset() {
+	*x = CancelShiftSwapResponse{}
+	mi := &file_rosterd_v1_swap_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelShiftSwapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelShiftSwapResponse) ProtoMessage() {}
+
+func (x *CancelShiftSwapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_swap_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelShiftSwapResponse.ProtoReflect.Descriptor instead.
+func (*CancelShiftSwapResponse) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_swap_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CancelShiftSwapResponse) GetSwap() *ShiftSwap {
+	if x != nil {
+		return x.Swap
+	}
+	return nil
+}
+
+type DecideShiftSwapRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Approve       bool                   `protobuf:"varint,2,opt,name=approve,proto3" json:"approve,omitempty"`
+	Comment       string                 `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecideShiftSwapRequest) Reset() {
+	*x = DecideShiftSwapRequest{}
+	mi := &file_rosterd_v1_swap_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecideShiftSwapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecideShiftSwapRequest) ProtoMessage() {}
+
+func (x *DecideShiftSwapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_swap_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecideShiftSwapRequest.ProtoReflect.Descriptor instead.
+func (*DecideShiftSwapRequest) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_swap_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DecideShiftSwapRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DecideShiftSwapRequest) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+func (x *DecideShiftSwapRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type DecideShiftSwapResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Swap  *ShiftSwap             `protobuf:"bytes,1,opt,name=swap,proto3" json:"swap,omitempty"`
+	// Roster holds the updated duty roster if the swap has been approved.
+	Roster        *v1.Roster `protobuf:"bytes,2,opt,name=roster,proto3" json:"roster,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecideShiftSwapResponse) Reset() {
+	*x = DecideShiftSwapResponse{}
+	mi := &file_rosterd_v1_swap_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecideShiftSwapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecideShiftSwapResponse) ProtoMessage() {}
+
+func (x *DecideShiftSwapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_swap_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecideShiftSwapResponse.ProtoReflect.Descriptor instead.
+func (*DecideShiftSwapResponse) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_swap_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DecideShiftSwapResponse) GetSwap() *ShiftSwap {
+	if x != nil {
+		return x.Swap
+	}
+	return nil
+}
+
+func (x *DecideShiftSwapResponse) GetRoster() *v1.Roster {
+	if x != nil {
+		return x.Roster
+	}
+	return nil
+}
+
+var File_rosterd_v1_swap_proto protoreflect.FileDescriptor
+
+var file_rosterd_v1_swap_proto_rawDesc = string([]byte{
+	0x0a, 0x15, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x77, 0x61,
+	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64,
+	0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2f, 0x76, 0x31,
+	0x2f, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x74,
+	0x6b, 0x64, 0x2f, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x6f, 0x73,
+	0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x74, 0x6b, 0x64, 0x2f, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x64, 0x0a, 0x0e, 0x53, 0x68, 0x69,
+	0x66, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x77,
+	0x6f, 0x72, 0x6b, 0x5f, 0x73, 0x68, 0x69, 0x66, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x68, 0x69, 0x66, 0x74, 0x49, 0x64, 0x12,
+	0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x22,
+	0xbf, 0x04, 0x0a, 0x09, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x68,
+	0x69, 0x66, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x72, 0x6f, 0x73, 0x74,
+	0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x68, 0x69, 0x66, 0x74, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x05, 0x73, 0x68, 0x69, 0x66, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12,
+	0x24, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x41, 0x0a, 0x0e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x5f, 0x73, 0x68, 0x69, 0x66, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x68, 0x69, 0x66, 0x74,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0d, 0x65, 0x78, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x3b, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x64, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x64, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64, 0x42, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x64,
+	0x65, 0x63, 0x69, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x64, 0x65, 0x63,
+	0x69, 0x64, 0x65, 0x64, 0x41, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x22, 0x4a, 0x0a, 0x11, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x46, 0x69,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x35, 0x0a, 0x08, 0x66, 0x69, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65,
+	0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x46, 0x69, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x52, 0x08, 0x66, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x22, 0xea, 0x01,
+	0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61,
+	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x73, 0x74,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x73,
+	0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x68, 0x69, 0x66, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x68, 0x69, 0x66, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x52, 0x05, 0x73, 0x68, 0x69, 0x66, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x41, 0x0a,
+	0x0e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x73, 0x68, 0x69, 0x66, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x68, 0x69, 0x66, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x52, 0x0d, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x68, 0x69, 0x66, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x44, 0x0a, 0x17, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x04, 0x73, 0x77, 0x61, 0x70, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x52, 0x04, 0x73, 0x77, 0x61, 0x70,
+	0x22, 0x81, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x77,
+	0x61, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f,
+	0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
+	0x6f, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x32, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e,
+	0x32, 0x1a, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x68,
+	0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x73, 0x22, 0x45, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x68, 0x69, 0x66,
+	0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b,
+	0x0a, 0x05, 0x73, 0x77, 0x61, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x68, 0x69, 0x66, 0x74,
+	0x53, 0x77, 0x61, 0x70, 0x52, 0x05, 0x73, 0x77, 0x61, 0x70, 0x73, 0x22, 0x28, 0x0a, 0x16, 0x41,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x44, 0x0a, 0x17, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x53,
+	0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x29, 0x0a, 0x04, 0x73, 0x77, 0x61, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x68, 0x69, 0x66,
+	0x74, 0x53, 0x77, 0x61, 0x70, 0x52, 0x04, 0x73, 0x77, 0x61, 0x70, 0x22, 0x29, 0x0a, 0x17, 0x44,
+	0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x45, 0x0a, 0x18, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e,
+	0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x29, 0x0a, 0x04, 0x73, 0x77, 0x61, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x68,
+	0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x52, 0x04, 0x73, 0x77, 0x61, 0x70, 0x22, 0x28, 0x0a,
+	0x16, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x44, 0x0a, 0x17, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x29, 0x0a, 0x04, 0x73, 0x77, 0x61, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x68,
+	0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x52, 0x04, 0x73, 0x77, 0x61, 0x70, 0x22, 0x5c, 0x0a,
+	0x16, 0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x73, 0x0a, 0x17, 0x44,
+	0x65, 0x63, 0x69, 0x64, 0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x04, 0x73, 0x77, 0x61, 0x70, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x52, 0x04, 0x73, 0x77, 0x61,
+	0x70, 0x12, 0x2d, 0x0a, 0x06, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x74, 0x6b, 0x64, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x52, 0x06, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72,
+	0x2a, 0xec, 0x01, 0x0a, 0x0e, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x1c, 0x53, 0x48, 0x49, 0x46, 0x54, 0x5f, 0x53, 0x57, 0x41,
+	0x50, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x53, 0x48, 0x49, 0x46, 0x54, 0x5f, 0x53,
+	0x57, 0x41, 0x50, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x4f, 0x46, 0x46, 0x45, 0x52, 0x45,
+	0x44, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x48, 0x49, 0x46, 0x54, 0x5f, 0x53, 0x57, 0x41,
+	0x50, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x45, 0x44,
+	0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x48, 0x49, 0x46, 0x54, 0x5f, 0x53, 0x57, 0x41, 0x50,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x45, 0x44, 0x10,
+	0x03, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x48, 0x49, 0x46, 0x54, 0x5f, 0x53, 0x57, 0x41, 0x50, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x04,
+	0x12, 0x1d, 0x0a, 0x19, 0x53, 0x48, 0x49, 0x46, 0x54, 0x5f, 0x53, 0x57, 0x41, 0x50, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x45, 0x43, 0x4c, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x05, 0x12,
+	0x1e, 0x0a, 0x1a, 0x53, 0x48, 0x49, 0x46, 0x54, 0x5f, 0x53, 0x57, 0x41, 0x50, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x06, 0x32,
+	0xf9, 0x04, 0x0a, 0x10, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x61, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x68,
+	0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x12, 0x22, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x68, 0x69, 0x66, 0x74,
+	0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x72, 0x6f,
+	0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
+	0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x05, 0xb2, 0x7e, 0x02, 0x08, 0x01, 0x12, 0x5e, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x12, 0x21, 0x2e, 0x72, 0x6f, 0x73, 0x74,
+	0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x68, 0x69, 0x66, 0x74,
+	0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x72,
+	0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x68,
+	0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x05, 0xb2, 0x7e, 0x02, 0x08, 0x01, 0x12, 0x61, 0x0a, 0x0f, 0x41, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x12, 0x22, 0x2e, 0x72, 0x6f, 0x73,
+	0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x53, 0x68,
+	0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
+	0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x05, 0xb2, 0x7e, 0x02, 0x08, 0x01, 0x12, 0x64, 0x0a, 0x10, 0x44, 0x65,
+	0x63, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x12, 0x23,
+	0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x6c,
+	0x69, 0x6e, 0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61,
+	0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x05, 0xb2, 0x7e, 0x02, 0x08, 0x01,
+	0x12, 0x61, 0x0a, 0x0f, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53,
+	0x77, 0x61, 0x70, 0x12, 0x22, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x68, 0x69, 0x66, 0x74,
+	0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x05, 0xb2, 0x7e,
+	0x02, 0x08, 0x01, 0x12, 0x61, 0x0a, 0x0f, 0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x53, 0x68, 0x69,
+	0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x12, 0x22, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53,
+	0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x72, 0x6f, 0x73,
+	0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x53, 0x68,
+	0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x05, 0xb2, 0x7e, 0x02, 0x08, 0x02, 0x1a, 0x13, 0xba, 0x7e, 0x10, 0x0a, 0x0e, 0x72, 0x6f, 0x73,
+	0x74, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x42, 0x46, 0x5a, 0x44, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x69, 0x65, 0x72, 0x6b, 0x6c,
+	0x69, 0x6e, 0x69, 0x6b, 0x2d, 0x64, 0x6f, 0x62, 0x65, 0x72, 0x73, 0x62, 0x65, 0x72, 0x67, 0x2f,
+	0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x72,
+	0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2f, 0x76, 0x31, 0x3b, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72,
+	0x64, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_rosterd_v1_swap_proto_rawDescOnce sync.Once
+	file_rosterd_v1_swap_proto_rawDescData []byte
+)
+
+func file_rosterd_v1_swap_proto_rawDescGZIP() []byte {
+	file_rosterd_v1_swap_proto_rawDescOnce.Do(func() {
+		file_rosterd_v1_swap_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rosterd_v1_swap_proto_rawDesc), len(file_rosterd_v1_swap_proto_rawDesc)))
+	})
+	return file_rosterd_v1_swap_proto_rawDescData
+}
+
+var file_rosterd_v1_swap_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_rosterd_v1_swap_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_rosterd_v1_swap_proto_goTypes = []any{
+	(ShiftSwapState)(0),              // 0: rosterd.v1.ShiftSwapState
+	(*ShiftReference)(nil),           // 1: rosterd.v1.ShiftReference
+	(*ShiftSwap)(nil),                // 2: rosterd.v1.ShiftSwap
+	(*ShiftSwapFindings)(nil),        // 3: rosterd.v1.ShiftSwapFindings
+	(*CreateShiftSwapRequest)(nil),   // 4: rosterd.v1.CreateShiftSwapRequest
+	(*CreateShiftSwapResponse)(nil),  // 5: rosterd.v1.CreateShiftSwapResponse
+	(*ListShiftSwapsRequest)(nil),    // 6: rosterd.v1.ListShiftSwapsRequest
+	(*ListShiftSwapsResponse)(nil),   // 7: rosterd.v1.ListShiftSwapsResponse
+	(*AcceptShiftSwapRequest)(nil),   // 8: rosterd.v1.AcceptShiftSwapRequest
+	(*AcceptShiftSwapResponse)(nil),  // 9: rosterd.v1.AcceptShiftSwapResponse
+	(*DeclineShiftSwapRequest)(nil),  // 10: rosterd.v1.DeclineShiftSwapRequest
+	(*DeclineShiftSwapResponse)(nil), // 11: rosterd.v1.DeclineShiftSwapResponse
+	(*CancelShiftSwapRequest)(nil),   // 12: rosterd.v1.CancelShiftSwapRequest
+	(*CancelShiftSwapResponse)(nil),  // 13: rosterd.v1.CancelShiftSwapResponse
+	(*DecideShiftSwapRequest)(nil),   // 14: rosterd.v1.DecideShiftSwapRequest
+	(*DecideShiftSwapResponse)(nil),  // 15: rosterd.v1.DecideShiftSwapResponse
+	(*timestamppb.Timestamp)(nil),    // 16: google.protobuf.Timestamp
+	(*RosterFinding)(nil),            // 17: rosterd.v1.RosterFinding
+	(*v1.Roster)(nil),                // 18: tkd.roster.v1.Roster
+}
+var file_rosterd_v1_swap_proto_depIdxs = []int32{
+	16, // 0: rosterd.v1.ShiftReference.from:type_name -> google.protobuf.Timestamp
+	1,  // 1: rosterd.v1.ShiftSwap.shift:type_name -> rosterd.v1.ShiftReference
+	1,  // 2: rosterd.v1.ShiftSwap.exchange_shift:type_name -> rosterd.v1.ShiftReference
+	0,  // 3: rosterd.v1.ShiftSwap.state:type_name -> rosterd.v1.ShiftSwapState
+	16, // 4: rosterd.v1.ShiftSwap.created_at:type_name -> google.protobuf.Timestamp
+	16, // 5: rosterd.v1.ShiftSwap.accepted_at:type_name -> google.protobuf.Timestamp
+	16, // 6: rosterd.v1.ShiftSwap.decided_at:type_name -> google.protobuf.Timestamp
+	17, // 7: rosterd.v1.ShiftSwapFindings.findings:type_name -> rosterd.v1.RosterFinding
+	1,  // 8: rosterd.v1.CreateShiftSwapRequest.shift:type_name -> rosterd.v1.ShiftReference
+	1,  // 9: rosterd.v1.CreateShiftSwapRequest.exchange_shift:type_name -> rosterd.v1.ShiftReference
+	2,  // 10: rosterd.v1.CreateShiftSwapResponse.swap:type_name -> rosterd.v1.ShiftSwap
+	0,  // 11: rosterd.v1.ListShiftSwapsRequest.states:type_name -> rosterd.v1.ShiftSwapState
+	2,  // 12: rosterd.v1.ListShiftSwapsResponse.swaps:type_name -> rosterd.v1.ShiftSwap
+	2,  // 13: rosterd.v1.AcceptShiftSwapResponse.swap:type_name -> rosterd.v1.ShiftSwap
+	2,  // 14: rosterd.v1.DeclineShiftSwapResponse.swap:type_name -> rosterd.v1.ShiftSwap
+	2,  // 15: rosterd.v1.CancelShiftSwapResponse.swap:type_name -> rosterd.v1.ShiftSwap
+	2,  // 16: rosterd.v1.DecideShiftSwapResponse.swap:type_name -> rosterd.v1.ShiftSwap
+	18, // 17: rosterd.v1.DecideShiftSwapResponse.roster:type_name -> tkd.roster.v1.Roster
+	4,  // 18: rosterd.v1.ShiftSwapService.CreateShiftSwap:input_type -> rosterd.v1.CreateShiftSwapRequest
+	6,  // 19: rosterd.v1.ShiftSwapService.ListShiftSwaps:input_type -> rosterd.v1.ListShiftSwapsRequest
+	8,  // 20: rosterd.v1.ShiftSwapService.AcceptShiftSwap:input_type -> rosterd.v1.AcceptShiftSwapRequest
+	10, // 21: rosterd.v1.ShiftSwapService.DeclineShiftSwap:input_type -> rosterd.v1.DeclineShiftSwapRequest
+	12, // 22: rosterd.v1.ShiftSwapService.CancelShiftSwap:input_type -> rosterd.v1.CancelShiftSwapRequest
+	14, // 23: rosterd.v1.ShiftSwapService.DecideShiftSwap:input_type -> rosterd.v1.DecideShiftSwapRequest
+	5,  // 24: rosterd.v1.ShiftSwapService.CreateShiftSwap:output_type -> rosterd.v1.CreateShiftSwapResponse
+	7,  // 25: rosterd.v1.ShiftSwapService.ListShiftSwaps:output_type -> rosterd.v1.ListShiftSwapsResponse
+	9,  // 26: rosterd.v1.ShiftSwapService.AcceptShiftSwap:output_type -> rosterd.v1.AcceptShiftSwapResponse
+	11, // 27: rosterd.v1.ShiftSwapService.DeclineShiftSwap:output_type -> rosterd.v1.DeclineShiftSwapResponse
+	13, // 28: rosterd.v1.ShiftSwapService.CancelShiftSwap:output_type -> rosterd.v1.CancelShiftSwapResponse
+	15, // 29: rosterd.v1.ShiftSwapService.DecideShiftSwap:output_type -> rosterd.v1.DecideShiftSwapResponse
+	24, // [24:30] is the sub-list for method output_type
+	18, // [18:24] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
+}
+
+func init() { file_rosterd_v1_swap_proto_init() }
+func file_rosterd_v1_swap_proto_init() {
+	if File_rosterd_v1_swap_proto != nil {
+		return
+	}
+	file_rosterd_v1_roster_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rosterd_v1_swap_proto_rawDesc), len(file_rosterd_v1_swap_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   15,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_rosterd_v1_swap_proto_goTypes,
+		DependencyIndexes: file_rosterd_v1_swap_proto_depIdxs,
+		EnumInfos:         file_rosterd_v1_swap_proto_enumTypes,
+		MessageInfos:      file_rosterd_v1_swap_proto_msgTypes,
+	}.Build()
+	File_rosterd_v1_swap_proto = out.File
+	file_rosterd_v1_swap_proto_goTypes = nil
+	file_rosterd_v1_swap_proto_depIdxs = nil
+}
@@ -0,0 +1,450 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        (unknown)
+// source: rosterd/v1/worktime.proto
+
+package rosterdv1
+
+import (
+	_ "github.com/tierklinik-dobersberg/apis/gen/go/tkd/common/v1"
+	v1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/roster/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// WorkTimeSchedule describes on which weekdays a user is working.
+type WorkTimeSchedule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Days holds the weekdays the user is working on (0 = Sunday, 6 = Saturday).
+	// If empty, the user works on all working days of the clinic.
+	Days []int32 `protobuf:"varint,1,rep,packed,name=days,proto3" json:"days,omitempty"`
+	// TimePerWeekday holds the work time for each weekday (0 = Sunday,
+	// 6 = Saturday). If set, it takes precedence over days and the sum must
+	// match the time per week of the work-time. Only weekdays listed in days
+	// may be set.
+	TimePerWeekday map[int32]*durationpb.Duration `protobuf:"bytes,2,rep,name=time_per_weekday,json=timePerWeekday,proto3" json:"time_per_weekday,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WorkTimeSchedule) Reset() {
+	*x = WorkTimeSchedule{}
+	mi := &file_rosterd_v1_worktime_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkTimeSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkTimeSchedule) ProtoMessage() {}
+
+func (x *WorkTimeSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_worktime_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkTimeSchedule.ProtoReflect.Descriptor instead.
+func (*WorkTimeSchedule) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_worktime_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WorkTimeSchedule) GetDays() []int32 {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *WorkTimeSchedule) GetTimePerWeekday() map[int32]*durationpb.Duration {
+	if x != nil {
+		return x.TimePerWeekday
+	}
+	return nil
+}
+
+type SetWorkTimeScheduleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// WorkTimeId is the ID of the work-time entry the new schedule is based
+	// on. The work-time entry itself is not modified.
+	WorkTimeId string `protobuf:"bytes,1,opt,name=work_time_id,json=workTimeId,proto3" json:"work_time_id,omitempty"`
+	// Schedule is the new schedule of the work-time. An empty schedule
+	// restores the default.
+	Schedule *WorkTimeSchedule `protobuf:"bytes,2,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	// ApplicableAfter is the date (YYYY-MM-DD) from which on the new
+	// schedule applies. A new work-time entry with the schedule and the
+	// settings of work_time_id is created so previous rosters keep their
+	// expected work time. It must be after the applicable date of
+	// work_time_id.
+	ApplicableAfter string `protobuf:"bytes,3,opt,name=applicable_after,json=applicableAfter,proto3" json:"applicable_after,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SetWorkTimeScheduleRequest) Reset() {
+	*x = SetWorkTimeScheduleRequest{}
+	mi := &file_rosterd_v1_worktime_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetWorkTimeScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetWorkTimeScheduleRequest) ProtoMessage() {}
+
+func (x *SetWorkTimeScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_worktime_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetWorkTimeScheduleRequest.ProtoReflect.Descriptor instead.
+func (*SetWorkTimeScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_worktime_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SetWorkTimeScheduleRequest) GetWorkTimeId() string {
+	if x != nil {
+		return x.WorkTimeId
+	}
+	return ""
+}
+
+func (x *SetWorkTimeScheduleRequest) GetSchedule() *WorkTimeSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *SetWorkTimeScheduleRequest) GetApplicableAfter() string {
+	if x != nil {
+		return x.ApplicableAfter
+	}
+	return ""
+}
+
+type SetWorkTimeScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkTime      *v1.WorkTime           `protobuf:"bytes,1,opt,name=work_time,json=workTime,proto3" json:"work_time,omitempty"`
+	Schedule      *WorkTimeSchedule      `protobuf:"bytes,2,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetWorkTimeScheduleResponse) Reset() {
+	*x = SetWorkTimeScheduleResponse{}
+	mi := &file_rosterd_v1_worktime_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetWorkTimeScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetWorkTimeScheduleResponse) ProtoMessage() {}
+
+func (x *SetWorkTimeScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_worktime_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetWorkTimeScheduleResponse.ProtoReflect.Descriptor instead.
+func (*SetWorkTimeScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_worktime_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SetWorkTimeScheduleResponse) GetWorkTime() *v1.WorkTime {
+	if x != nil {
+		return x.WorkTime
+	}
+	return nil
+}
+
+func (x *SetWorkTimeScheduleResponse) GetSchedule() *WorkTimeSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+type GetWorkTimeScheduleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// WorkTimeId is the ID of the work-time entry.
+	WorkTimeId    string `protobuf:"bytes,1,opt,name=work_time_id,json=workTimeId,proto3" json:"work_time_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkTimeScheduleRequest) Reset() {
+	*x = GetWorkTimeScheduleRequest{}
+	mi := &file_rosterd_v1_worktime_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkTimeScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkTimeScheduleRequest) ProtoMessage() {}
+
+func (x *GetWorkTimeScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_worktime_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkTimeScheduleRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkTimeScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_worktime_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetWorkTimeScheduleRequest) GetWorkTimeId() string {
+	if x != nil {
+		return x.WorkTimeId
+	}
+	return ""
+}
+
+type GetWorkTimeScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkTime      *v1.WorkTime           `protobuf:"bytes,1,opt,name=work_time,json=workTime,proto3" json:"work_time,omitempty"`
+	Schedule      *WorkTimeSchedule      `protobuf:"bytes,2,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkTimeScheduleResponse) Reset() {
+	*x = GetWorkTimeScheduleResponse{}
+	mi := &file_rosterd_v1_worktime_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkTimeScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkTimeScheduleResponse) ProtoMessage() {}
+
+func (x *GetWorkTimeScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rosterd_v1_worktime_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkTimeScheduleResponse.ProtoReflect.Descriptor instead.
+func (*GetWorkTimeScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_rosterd_v1_worktime_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetWorkTimeScheduleResponse) GetWorkTime() *v1.WorkTime {
+	if x != nil {
+		return x.WorkTime
+	}
+	return nil
+}
+
+func (x *GetWorkTimeScheduleResponse) GetSchedule() *WorkTimeSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+var File_rosterd_v1_worktime_proto protoreflect.FileDescriptor
+
+var file_rosterd_v1_worktime_proto_rawDesc = string([]byte{
+	0x0a, 0x19, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72,
+	0x6b, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x72, 0x6f, 0x73,
+	0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x74, 0x6b, 0x64, 0x2f, 0x72, 0x6f, 0x73,
+	0x74, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x74, 0x69, 0x6d, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x74, 0x6b, 0x64, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe0, 0x01, 0x0a, 0x10, 0x57, 0x6f, 0x72, 0x6b, 0x54, 0x69,
+	0x6d, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
+	0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x04, 0x64, 0x61, 0x79, 0x73, 0x12, 0x5a,
+	0x0a, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x77, 0x65, 0x65, 0x6b, 0x64,
+	0x61, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65,
+	0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x50, 0x65, 0x72, 0x57, 0x65,
+	0x65, 0x6b, 0x64, 0x61, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x74, 0x69, 0x6d, 0x65,
+	0x50, 0x65, 0x72, 0x57, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x1a, 0x5c, 0x0a, 0x13, 0x54, 0x69,
+	0x6d, 0x65, 0x50, 0x65, 0x72, 0x57, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x2f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa3, 0x01, 0x0a, 0x1a, 0x53, 0x65, 0x74,
+	0x57, 0x6f, 0x72, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77,
+	0x6f, 0x72, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x08, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x72, 0x6f,
+	0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x54, 0x69, 0x6d,
+	0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x62, 0x6c,
+	0x65, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x22, 0x8d,
+	0x01, 0x0a, 0x1b, 0x53, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34,
+	0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x74, 0x6b, 0x64, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x08, 0x77, 0x6f, 0x72, 0x6b,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x22, 0x3e,
+	0x0a, 0x1a, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0c,
+	0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x8d,
+	0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34,
+	0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x74, 0x6b, 0x64, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x08, 0x77, 0x6f, 0x72, 0x6b,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x32, 0x84,
+	0x02, 0x0a, 0x0f, 0x57, 0x6f, 0x72, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x6d, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x54, 0x69, 0x6d,
+	0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x26, 0x2e, 0x72, 0x6f, 0x73, 0x74,
+	0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x54, 0x69,
+	0x6d, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x27, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x05, 0xb2, 0x7e, 0x02, 0x08,
+	0x02, 0x12, 0x6d, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x54, 0x69, 0x6d, 0x65,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x26, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65,
+	0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x54, 0x69, 0x6d,
+	0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x27, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x57, 0x6f, 0x72, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x05, 0xb2, 0x7e, 0x02, 0x08, 0x02,
+	0x1a, 0x13, 0xba, 0x7e, 0x10, 0x0a, 0x0e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x72, 0x42, 0x46, 0x5a, 0x44, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x69, 0x65, 0x72, 0x6b, 0x6c, 0x69, 0x6e, 0x69, 0x6b, 0x2d, 0x64,
+	0x6f, 0x62, 0x65, 0x72, 0x73, 0x62, 0x65, 0x72, 0x67, 0x2f, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72,
+	0x64, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64,
+	0x2f, 0x76, 0x31, 0x3b, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x64, 0x76, 0x31, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_rosterd_v1_worktime_proto_rawDescOnce sync.Once
+	file_rosterd_v1_worktime_proto_rawDescData []byte
+)
+
+func file_rosterd_v1_worktime_proto_rawDescGZIP() []byte {
+	file_rosterd_v1_worktime_proto_rawDescOnce.Do(func() {
+		file_rosterd_v1_worktime_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rosterd_v1_worktime_proto_rawDesc), len(file_rosterd_v1_worktime_proto_rawDesc)))
+	})
+	return file_rosterd_v1_worktime_proto_rawDescData
+}
+
+var file_rosterd_v1_worktime_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_rosterd_v1_worktime_proto_goTypes = []any{
+	(*WorkTimeSchedule)(nil),            // 0: rosterd.v1.WorkTimeSchedule
+	(*SetWorkTimeScheduleRequest)(nil),  // 1: rosterd.v1.SetWorkTimeScheduleRequest
+	(*SetWorkTimeScheduleResponse)(nil), // 2: rosterd.v1.SetWorkTimeScheduleResponse
+	(*GetWorkTimeScheduleRequest)(nil),  // 3: rosterd.v1.GetWorkTimeScheduleRequest
+	(*GetWorkTimeScheduleResponse)(nil), // 4: rosterd.v1.GetWorkTimeScheduleResponse
+	nil,                                 // 5: rosterd.v1.WorkTimeSchedule.TimePerWeekdayEntry
+	(*v1.WorkTime)(nil),                 // 6: tkd.roster.v1.WorkTime
+	(*durationpb.Duration)(nil),         // 7: google.protobuf.Duration
+}
+var file_rosterd_v1_worktime_proto_depIdxs = []int32{
+	5, // 0: rosterd.v1.WorkTimeSchedule.time_per_weekday:type_name -> rosterd.v1.WorkTimeSchedule.TimePerWeekdayEntry
+	0, // 1: rosterd.v1.SetWorkTimeScheduleRequest.schedule:type_name -> rosterd.v1.WorkTimeSchedule
+	6, // 2: rosterd.v1.SetWorkTimeScheduleResponse.work_time:type_name -> tkd.roster.v1.WorkTime
+	0, // 3: rosterd.v1.SetWorkTimeScheduleResponse.schedule:type_name -> rosterd.v1.WorkTimeSchedule
+	6, // 4: rosterd.v1.GetWorkTimeScheduleResponse.work_time:type_name -> tkd.roster.v1.WorkTime
+	0, // 5: rosterd.v1.GetWorkTimeScheduleResponse.schedule:type_name -> rosterd.v1.WorkTimeSchedule
+	7, // 6: rosterd.v1.WorkTimeSchedule.TimePerWeekdayEntry.value:type_name -> google.protobuf.Duration
+	1, // 7: rosterd.v1.WorkTimeService.SetWorkTimeSchedule:input_type -> rosterd.v1.SetWorkTimeScheduleRequest
+	3, // 8: rosterd.v1.WorkTimeService.GetWorkTimeSchedule:input_type -> rosterd.v1.GetWorkTimeScheduleRequest
+	2, // 9: rosterd.v1.WorkTimeService.SetWorkTimeSchedule:output_type -> rosterd.v1.SetWorkTimeScheduleResponse
+	4, // 10: rosterd.v1.WorkTimeService.GetWorkTimeSchedule:output_type -> rosterd.v1.GetWorkTimeScheduleResponse
+	9, // [9:11] is the sub-list for method output_type
+	7, // [7:9] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_rosterd_v1_worktime_proto_init() }
+func file_rosterd_v1_worktime_proto_init() {
+	if File_rosterd_v1_worktime_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rosterd_v1_worktime_proto_rawDesc), len(file_rosterd_v1_worktime_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_rosterd_v1_worktime_proto_goTypes,
+		DependencyIndexes: file_rosterd_v1_worktime_proto_depIdxs,
+		MessageInfos:      file_rosterd_v1_worktime_proto_msgTypes,
+	}.Build()
+	File_rosterd_v1_worktime_proto = out.File
+	file_rosterd_v1_worktime_proto_goTypes = nil
+	file_rosterd_v1_worktime_proto_depIdxs = nil
+}
@@ -0,0 +1,96 @@
+// Package audit writes the append-only audit log of changes to rosters,
+// off-time requests, work-times and other entities.
+package audit
+
+import (
+	"context"
+	"time"
+
+	"github.com/tierklinik-dobersberg/apis/pkg/auth"
+	"github.com/tierklinik-dobersberg/apis/pkg/log"
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+)
+
+// SystemActor is recorded as the actor of changes that are not caused by an
+// authenticated user.
+const SystemActor = "system"
+
+// Store persists audit events.
+type Store interface {
+	InsertAuditEvent(ctx context.Context, event *structs.AuditEvent) error
+}
+
+// Logger records audit events. A nil Logger discards all events.
+type Logger struct {
+	store Store
+
+	// now is replaced in tests.
+	now func() time.Time
+}
+
+// NewLogger returns a new audit logger that writes to store.
+func NewLogger(store Store) *Logger {
+	return &Logger{
+		store: store,
+		now:   time.Now,
+	}
+}
+
+// Record writes an audit event for a change of an entity. before and after
+// hold the previous and the new state of the entity and are nil for create
+// and delete operations respectively. userIds holds the users affected by
+// the change.
+//
+// Recording happens after the change has been persisted so errors are only
+// logged and never returned to the caller.
+func (l *Logger) Record(ctx context.Context, entity structs.AuditEntity, entityId string, op structs.AuditOperation, before, after any, userIds ...string) {
+	if l == nil {
+		return
+	}
+
+	changes, err := Diff(before, after)
+	if err != nil {
+		log.L(ctx).Error("failed to calculate audit diff", "entity", entity, "id", entityId, "error", err)
+	}
+
+	actor := SystemActor
+	if remoteUser := auth.From(ctx); remoteUser != nil {
+		actor = remoteUser.ID
+	}
+
+	event := &structs.AuditEvent{
+		Time:      l.now(),
+		ActorID:   actor,
+		Entity:    entity,
+		EntityID:  entityId,
+		Operation: op,
+		UserIDs:   dedup(userIds),
+		Changes:   changes,
+	}
+
+	if err := l.store.InsertAuditEvent(ctx, event); err != nil {
+		log.L(ctx).Error("failed to record audit event", "entity", entity, "id", entityId, "operation", op, "error", err)
+	}
+}
+
+func dedup(values []string) []string {
+	var (
+		result []string
+		seen   = make(map[string]struct{}, len(values))
+	)
+
+	for _, v := range values {
+		if v == "" {
+			continue
+		}
+
+		if _, ok := seen[v]; ok {
+			continue
+		}
+
+		seen[v] = struct{}{}
+		result = append(result, v)
+	}
+
+	return result
+}
@@ -0,0 +1,62 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+)
+
+type fakeStore struct {
+	events []structs.AuditEvent
+	err    error
+}
+
+func (f *fakeStore) InsertAuditEvent(_ context.Context, event *structs.AuditEvent) error {
+	if f.err != nil {
+		return f.err
+	}
+
+	f.events = append(f.events, *event)
+
+	return nil
+}
+
+func Test_Logger_Record(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, time.May, 1, 10, 0, 0, 0, time.UTC)
+
+	store := new(fakeStore)
+	logger := NewLogger(store)
+	logger.now = func() time.Time { return now }
+
+	before := structs.WorkShift{Name: "Früh"}
+	after := structs.WorkShift{Name: "Spät"}
+
+	logger.Record(ctx, structs.AuditEntityWorkShift, "id-1", structs.AuditOperationUpdate, before, after, "alice", "", "bob", "alice")
+
+	require.Len(t, store.events, 1)
+
+	event := store.events[0]
+	require.Equal(t, now, event.Time)
+	require.Equal(t, SystemActor, event.ActorID)
+	require.Equal(t, structs.AuditEntityWorkShift, event.Entity)
+	require.Equal(t, "id-1", event.EntityID)
+	require.Equal(t, structs.AuditOperationUpdate, event.Operation)
+	require.Equal(t, []string{"alice", "bob"}, event.UserIDs)
+	require.Equal(t, []structs.AuditChange{
+		{Path: "name", Before: `"Früh"`, After: `"Spät"`},
+	}, event.Changes)
+
+	// errors of the store are only logged
+	store.err = errors.New("database unavailable")
+	logger.Record(ctx, structs.AuditEntityWorkShift, "id-1", structs.AuditOperationDelete, after, nil)
+	require.Len(t, store.events, 1)
+
+	// a nil logger discards all events
+	var nilLogger *Logger
+	nilLogger.Record(ctx, structs.AuditEntityWorkShift, "id-1", structs.AuditOperationDelete, after, nil)
+}
@@ -0,0 +1,138 @@
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
+// Diff returns the changes between before and after. Both values are
+// compared using their JSON representation. If one of them is nil, a single
+// change with an empty path holding the whole document is returned.
+func Diff(before, after any) ([]structs.AuditChange, error) {
+	b, err := normalize(before)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode previous value: %w", err)
+	}
+
+	a, err := normalize(after)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode new value: %w", err)
+	}
+
+	if b == nil || a == nil {
+		if b == nil && a == nil {
+			return nil, nil
+		}
+
+		return []structs.AuditChange{change("", b, a)}, nil
+	}
+
+	var changes []structs.AuditChange
+	diffValue("", b, a, &changes)
+
+	return changes, nil
+}
+
+// normalize converts v into its generic JSON representation.
+func normalize(v any) (any, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.Pointer && rv.IsNil() {
+		return nil, nil
+	}
+
+	blob, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var result any
+	if err := json.Unmarshal(blob, &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func diffValue(path string, before, after any, changes *[]structs.AuditChange) {
+	switch b := before.(type) {
+	case map[string]any:
+		a, ok := after.(map[string]any)
+		if !ok {
+			break
+		}
+
+		keys := maps.Keys(b)
+		for key := range a {
+			if _, ok := b[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+		slices.Sort(keys)
+
+		for _, key := range keys {
+			diffValue(join(path, key), b[key], a[key], changes)
+		}
+
+		return
+
+	case []any:
+		a, ok := after.([]any)
+		if !ok {
+			break
+		}
+
+		for idx := 0; idx < max(len(a), len(b)); idx++ {
+			var bv, av any
+			if idx < len(b) {
+				bv = b[idx]
+			}
+			if idx < len(a) {
+				av = a[idx]
+			}
+
+			diffValue(join(path, strconv.Itoa(idx)), bv, av, changes)
+		}
+
+		return
+	}
+
+	if !reflect.DeepEqual(before, after) {
+		*changes = append(*changes, change(path, before, after))
+	}
+}
+
+func join(path, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
+
+func change(path string, before, after any) structs.AuditChange {
+	return structs.AuditChange{
+		Path:   path,
+		Before: encode(before),
+		After:  encode(after),
+	}
+}
+
+func encode(v any) string {
+	if v == nil {
+		return ""
+	}
+
+	// v has been decoded from JSON so encoding cannot fail.
+	blob, _ := json.Marshal(v)
+
+	return string(blob)
+}
@@ -0,0 +1,82 @@
+package audit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+)
+
+type diffTestValue struct {
+	Name   string            `json:"name"`
+	Tags   []string          `json:"tags,omitempty"`
+	Labels map[string]string `json:"labels,omitempty"`
+	Nested *diffTestValue    `json:"nested,omitempty"`
+}
+
+func Test_Diff(t *testing.T) {
+	cases := []struct {
+		name     string
+		before   any
+		after    any
+		expected []structs.AuditChange
+	}{
+		{
+			name:     "both nil",
+			before:   nil,
+			after:    (*diffTestValue)(nil),
+			expected: nil,
+		},
+		{
+			name:   "create",
+			before: nil,
+			after:  diffTestValue{Name: "a"},
+			expected: []structs.AuditChange{
+				{Path: "", After: `{"name":"a"}`},
+			},
+		},
+		{
+			name:   "delete",
+			before: &diffTestValue{Name: "a"},
+			after:  nil,
+			expected: []structs.AuditChange{
+				{Path: "", Before: `{"name":"a"}`},
+			},
+		},
+		{
+			name:     "unchanged",
+			before:   diffTestValue{Name: "a", Tags: []string{"x"}},
+			after:    diffTestValue{Name: "a", Tags: []string{"x"}},
+			expected: nil,
+		},
+		{
+			name:   "changed fields",
+			before: diffTestValue{Name: "a", Tags: []string{"x", "y"}, Labels: map[string]string{"k": "v"}},
+			after:  diffTestValue{Name: "b", Tags: []string{"x", "z", "w"}, Nested: &diffTestValue{Name: "n"}},
+			expected: []structs.AuditChange{
+				{Path: "labels", Before: `{"k":"v"}`},
+				{Path: "name", Before: `"a"`, After: `"b"`},
+				{Path: "nested", After: `{"name":"n"}`},
+				{Path: "tags.1", Before: `"y"`, After: `"z"`},
+				{Path: "tags.2", After: `"w"`},
+			},
+		},
+		{
+			name:   "nested maps",
+			before: diffTestValue{Nested: &diffTestValue{Labels: map[string]string{"a": "1", "b": "2"}}},
+			after:  diffTestValue{Nested: &diffTestValue{Labels: map[string]string{"a": "1", "c": "3"}}},
+			expected: []structs.AuditChange{
+				{Path: "nested.labels.b", Before: `"2"`},
+				{Path: "nested.labels.c", After: `"3"`},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			changes, err := Diff(c.before, c.after)
+			require.NoError(t, err)
+			require.Equal(t, c.expected, changes)
+		})
+	}
+}
@@ -3,18 +3,25 @@ package config
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/sethvargo/go-envconfig"
+	"github.com/tierklinik-dobersberg/rosterd/internal/labour"
+	"github.com/tierklinik-dobersberg/rosterd/internal/timecalc"
 )
 
 type (
 	ServiceConfig struct {
 		// IdentityProvider holds the address of the identity provider.
 		IdentityProvider string `env:"IDM_URL,default=http://cisidm:8081"`
+		// Datastore selects the storage backend. Either "mongodb" or
+		// "memory". The in-memory datastore loses all data on restart and
+		// is meant for demos and tests.
+		Datastore string `env:"DATASTORE,default=mongodb"`
 		// DatabaseURL is the mongodb connection URL
-		DatabaseURL string `env:"DATABASE_URL,required"`
+		DatabaseURL string `env:"DATABASE_URL"`
 		// DatabaseName is the name of the mongodb database.
-		DatabaseName string `env:"DATABASE_NAME,required"`
+		DatabaseName string `env:"DATABASE_NAME"`
 		// Address holds the listen address of the HTTP server.
 		Address string `env:"ADDRESS,default=:8080"`
 		// AdminAddress holds the address of the unauthenticated admin endpoint.
@@ -42,12 +49,57 @@ type (
 		StaticFiles string `env:"STATIC_FILES"`
 		// Gotenberg holds the gotenberg URL
 		Gotenberg string `env:"GOTENBERG"`
+		// PDFRenderer selects the renderer for PDF exports. Either "builtin"
+		// or "gotenberg". Defaults to gotenberg if GOTENBERG is set.
+		PDFRenderer string `env:"PDF_RENDERER"`
+		// AttachUserRosterPDF configures roster notification mails to
+		// include the personal roster of each user as a PDF attachment.
+		AttachUserRosterPDF bool `env:"ROSTER_MAIL_ATTACH_PDF"`
+		// HolidayCacheTTL configures how long public holidays fetched from
+		// the calendar service are cached. Zero disables caching.
+		HolidayCacheTTL time.Duration `env:"HOLIDAY_CACHE_TTL,default=24h"`
 		// EventServiceUrl holds the URL of the event-service used to publish
 		// messages.
 		EventServiceUrl string `env:"EVENTS_SERVICE_URL,required"`
+		// ValidateOnApproval configures ApproveRoster to refuse rosters that
+		// have blocking validation errors.
+		ValidateOnApproval bool `env:"VALIDATE_ON_APPROVAL"`
+		// Weekend holds a comma separated list of weekdays that are not
+		// regular working days of the clinic.
+		Weekend timecalc.Weekend `env:"WEEKEND_DAYS,default=saturday,sunday"`
+		// MinRestPeriod is the minimum rest period between two work periods
+		// of a user. Austrian labour law requires 11 hours.
+		MinRestPeriod time.Duration `env:"LABOUR_MIN_REST_PERIOD"`
+		// MaxConsecutiveWorkDays is the maximum number of consecutive days a
+		// user may work.
+		MaxConsecutiveWorkDays int `env:"LABOUR_MAX_CONSECUTIVE_DAYS"`
+		// MaxWorkTimePerDay is the maximum work time of a user per day.
+		MaxWorkTimePerDay time.Duration `env:"LABOUR_MAX_WORK_TIME_PER_DAY"`
+		// MaxWorkTimePerWeek is the maximum work time of a user per week.
+		MaxWorkTimePerWeek time.Duration `env:"LABOUR_MAX_WORK_TIME_PER_WEEK"`
+		// VacationBalanceCheck configures how CreateOffTimeRequest handles
+		// vacation requests that exceed the remaining vacation credits.
+		// Either "off", "warn" or "reject".
+		VacationBalanceCheck string `env:"VACATION_BALANCE_CHECK,default=warn"`
+		// PayrollNightWindow is the daily time window (HH:MM-HH:MM) that is
+		// reported as night work in payroll exports.
+		PayrollNightWindow timecalc.NightWindow `env:"PAYROLL_NIGHT_WINDOW,default=22:00-06:00"`
 	}
 )
 
+// Supported values for ServiceConfig.Datastore.
+const (
+	DatastoreMongoDB = "mongodb"
+	DatastoreMemory  = "memory"
+)
+
+// Supported values for ServiceConfig.VacationBalanceCheck.
+const (
+	VacationBalanceCheckOff    = "off"
+	VacationBalanceCheckWarn   = "warn"
+	VacationBalanceCheckReject = "reject"
+)
+
 // Read reads the service configuration from environment variables
 func Read(ctx context.Context) (*ServiceConfig, error) {
 	var cfg ServiceConfig
@@ -60,9 +112,35 @@ func Read(ctx context.Context) (*ServiceConfig, error) {
 		return &cfg, fmt.Errorf("missing PUBLIC_URL configuration")
 	}
 
+	switch cfg.Datastore {
+	case DatastoreMongoDB:
+		if cfg.DatabaseURL == "" || cfg.DatabaseName == "" {
+			return &cfg, fmt.Errorf("missing DATABASE_URL or DATABASE_NAME configuration")
+		}
+	case DatastoreMemory:
+	default:
+		return &cfg, fmt.Errorf("invalid DATASTORE value %q", cfg.Datastore)
+	}
+
+	switch cfg.VacationBalanceCheck {
+	case VacationBalanceCheckOff, VacationBalanceCheckWarn, VacationBalanceCheckReject:
+	default:
+		return &cfg, fmt.Errorf("invalid VACATION_BALANCE_CHECK value %q", cfg.VacationBalanceCheck)
+	}
+
 	if cfg.PreviewRosterURL == "" {
 		cfg.PreviewRosterURL = fmt.Sprintf("%s/roster/view/%%s", cfg.PublicURL)
 	}
 
 	return &cfg, nil
 }
+
+// LabourRules returns the configured labour rules.
+func (cfg *ServiceConfig) LabourRules() labour.Rules {
+	return labour.Rules{
+		MinRest:            cfg.MinRestPeriod,
+		MaxConsecutiveDays: cfg.MaxConsecutiveWorkDays,
+		MaxWorkTimePerDay:  cfg.MaxWorkTimePerDay,
+		MaxWorkTimePerWeek: cfg.MaxWorkTimePerWeek,
+	}
+}
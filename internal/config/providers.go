@@ -4,12 +4,10 @@ import (
 	"context"
 	"embed"
 	"fmt"
-	"io"
 	"io/fs"
 	"log/slog"
 	"net/http"
 	"os"
-	"time"
 
 	"github.com/bufbuild/connect-go"
 	"github.com/sirupsen/logrus"
@@ -20,27 +18,37 @@ import (
 	"github.com/tierklinik-dobersberg/apis/gen/go/tkd/idm/v1/idmv1connect"
 	"github.com/tierklinik-dobersberg/apis/pkg/cli"
 	"github.com/tierklinik-dobersberg/apis/pkg/overlayfs"
+	"github.com/tierklinik-dobersberg/rosterd/internal/audit"
+	"github.com/tierklinik-dobersberg/rosterd/internal/constraints"
 	"github.com/tierklinik-dobersberg/rosterd/internal/database"
+	"github.com/tierklinik-dobersberg/rosterd/internal/database/memory"
+	"github.com/tierklinik-dobersberg/rosterd/internal/holiday"
+	"github.com/tierklinik-dobersberg/rosterd/internal/render"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
-
-	"github.com/dcaraxes/gotenberg-go-client/v8"
-	"github.com/dcaraxes/gotenberg-go-client/v8/document"
 )
 
 type Providers struct {
-	Users     idmv1connect.UserServiceClient
-	Roles     idmv1connect.RoleServiceClient
-	Notify    idmv1connect.NotifyServiceClient
-	Calendar  calendarv1connect.CalendarServiceClient
-	Events    eventsv1connect.EventServiceClient
-	Holidays  calendarv1connect.HolidayServiceClient
-	Templates fs.FS
-	Datastore *database.DatabaseImpl
-	Config    *ServiceConfig
+	Users    idmv1connect.UserServiceClient
+	Roles    idmv1connect.RoleServiceClient
+	Notify   idmv1connect.NotifyServiceClient
+	Calendar calendarv1connect.CalendarServiceClient
+	Events   eventsv1connect.EventServiceClient
+	Holidays calendarv1connect.HolidayServiceClient
+	// HolidayCache caches the public holidays of the calendar service.
+	HolidayCache *holiday.Cache
+	// HolidayProvider merges the cached public holidays with the local
+	// holiday store and should be used to look up holidays.
+	HolidayProvider holiday.Provider
+	Templates       fs.FS
+	Datastore       database.Datastore
+	Constraints     *constraints.Evaluator
+	Renderer        render.Renderer
+	Audit           *audit.Logger
+	Config          *ServiceConfig
 }
 
 func NewProviders(ctx context.Context, cfg *ServiceConfig, httpClient *http.Client, template embed.FS) (*Providers, error) {
@@ -55,6 +63,50 @@ func NewProviders(ctx context.Context, cfg *ServiceConfig, httpClient *http.Clie
 		}
 	}
 
+	db, err := NewDatastore(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	renderer, err := render.New(cfg.PDFRenderer, cfg.Gotenberg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare PDF renderer: %w", err)
+	}
+
+	holidayClient := calendarv1connect.NewHolidayServiceClient(httpClient, cfg.CalendarService)
+	holidayCache := holiday.NewCache(holidayClient, cfg.HolidayCacheTTL)
+
+	p := &Providers{
+		Config:          cfg,
+		Users:           idmv1connect.NewUserServiceClient(httpClient, cfg.IdentityProvider),
+		Roles:           idmv1connect.NewRoleServiceClient(httpClient, cfg.IdentityProvider),
+		Notify:          idmv1connect.NewNotifyServiceClient(httpClient, cfg.IdentityProvider),
+		Calendar:        calendarv1connect.NewCalendarServiceClient(httpClient, cfg.CalendarService),
+		Holidays:        holidayClient,
+		HolidayCache:    holidayCache,
+		HolidayProvider: holiday.NewMerged(holidayCache, db),
+		Events:          eventsv1connect.NewEventServiceClient(cli.NewInsecureHttp2Client(), cfg.EventServiceUrl),
+		Templates:       overlayfs.NewFS(fileSystems...),
+		Datastore:       db,
+		Constraints:     constraints.NewEvaluator(),
+		Renderer:        renderer,
+		Audit:           audit.NewLogger(db),
+	}
+
+	return p, nil
+}
+
+// NewDatastore creates the datastore configured in cfg. For MongoDB, all
+// pending migrations are applied first.
+func NewDatastore(ctx context.Context, cfg *ServiceConfig) (database.Datastore, error) {
+	logger := logrus.NewEntry(logrus.StandardLogger())
+
+	if cfg.Datastore == DatastoreMemory {
+		logger.Warn("using in-memory datastore, all data is lost on restart")
+
+		return memory.New(logger), nil
+	}
+
 	clientOptions := options.Client().
 		ApplyURI(cfg.DatabaseURL).
 		SetAppName("rosterd")
@@ -76,28 +128,12 @@ func NewProviders(ctx context.Context, cfg *ServiceConfig, httpClient *http.Clie
 	}
 
 	// finally, create our repository (database wrapper)
-	db, err := database.NewDatabase(
-		ctx,
-		mongoDatabase,
-		logrus.NewEntry(logrus.StandardLogger()),
-	)
+	db, err := database.NewDatabase(ctx, mongoDatabase, logger)
 	if err != nil {
 		return nil, fmt.Errorf("failed to perpare database: %w", err)
 	}
 
-	p := &Providers{
-		Config:    cfg,
-		Users:     idmv1connect.NewUserServiceClient(httpClient, cfg.IdentityProvider),
-		Roles:     idmv1connect.NewRoleServiceClient(httpClient, cfg.IdentityProvider),
-		Notify:    idmv1connect.NewNotifyServiceClient(httpClient, cfg.IdentityProvider),
-		Calendar:  calendarv1connect.NewCalendarServiceClient(httpClient, cfg.CalendarService),
-		Holidays:  calendarv1connect.NewHolidayServiceClient(httpClient, cfg.CalendarService),
-		Events:    eventsv1connect.NewEventServiceClient(cli.NewInsecureHttp2Client(), cfg.EventServiceUrl),
-		Templates: overlayfs.NewFS(fileSystems...),
-		Datastore: db,
-	}
-
-	return p, nil
+	return db, nil
 }
 
 func (p *Providers) FetchAllUserIds(ctx context.Context) ([]string, error) {
@@ -146,43 +182,6 @@ func (p *Providers) FetchAllUserProfiles(ctx context.Context) ([]*idmv1.Profile,
 	return res.Msg.Users, nil
 }
 
-func (p *Providers) RenderHTML(ctx context.Context, index string) (io.ReadCloser, error) {
-	if p.Config.Gotenberg == "" {
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("no gotenberg server configured"))
-	}
-
-	client, err := gotenberg.NewClient(p.Config.Gotenberg, http.DefaultClient)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create gotenberg client: %w", err)
-	}
-
-	indexDocument, err := document.FromString("index.html", index)
-	if err != nil {
-		return nil, fmt.Errorf("failed to prepare document: %w", err)
-	}
-
-	req := gotenberg.NewHTMLRequest(indexDocument)
-	req.PaperSize(gotenberg.A4)
-	req.Landscape()
-	req.Margins(gotenberg.NoMargins)
-	req.SkipNetworkIdleEvent()
-	req.WaitDelay(time.Second * 3)
-	req.Scale(0.75)
-
-	res, err := client.Send(ctx, req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to send request to gotenberg: %w", err)
-	}
-
-	if res.StatusCode != 200 {
-		res.Body.Close()
-
-		return nil, fmt.Errorf("unexpected response from gotenberg: %s", res.Status)
-	}
-
-	return res.Body, nil
-}
-
 func (p *Providers) PublishEvent(msg proto.Message, retained bool) {
 	go func() {
 		pb, err := anypb.New(msg)
@@ -0,0 +1,266 @@
+// Package constraints implements evaluation of user defined roster
+// constraints.
+//
+// Constraint expressions are written in CEL (https://cel.dev) and must
+// evaluate to a boolean. The following variables are available:
+//
+//	shift        map   the work-shift that is evaluated (see below)
+//	date         string the date of the shift in the format YYYY-MM-DD
+//	weekday      int    the weekday of the shift (0 = Sunday, 6 = Saturday)
+//	day          int    the day of month
+//	month        int    the month (1 = January)
+//	year         int    the year
+//	holiday      bool   whether or not the shift is on a public holiday
+//	weekend      bool   whether or not the shift is on a weekend
+//	user         string the ID of the user that is evaluated
+//	hasWorkTime  bool   whether or not the user has a work-time defined
+//	workTime     map    the current work-time of the user (see below)
+//	planned      list   shifts already planned for the user (see below)
+//
+// The shift map and each entry of planned contain the keys id, name,
+// shortName, tags, date, weekday, start, end and timeWorth. The shift map
+// additionally contains from (HH:MM), duration and requiredStaffCount.
+// The workTime map contains timePerWeek, vacationWeeksPerYear,
+// overtimeAllowancePerMonth and excludeFromTimeTracking.
+//
+// For deny constraints the expression describes when the user must not be
+// assigned and the constraint is violated if the expression evaluates to true.
+// For all other constraints the expression describes a requirement and the
+// constraint is violated if the expression evaluates to false.
+package constraints
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/cel-go/cel"
+	rosterv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/roster/v1"
+	"github.com/tierklinik-dobersberg/apis/pkg/data"
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+)
+
+// Input holds all information that is available to a constraint expression.
+type Input struct {
+	// Shift is the work-shift definition that is evaluated.
+	Shift structs.WorkShift
+
+	// From and To hold the actual start and end time of the shift.
+	From time.Time
+	To   time.Time
+
+	Holiday bool
+	Weekend bool
+
+	// UserID is the ID of the user that would be assigned to the shift.
+	UserID string
+
+	// WorkTime is the current work-time of the user, if any.
+	WorkTime *structs.WorkTime
+
+	// Planned holds the shifts that are already planned for the user.
+	Planned []structs.PlannedShift
+
+	// Definitions is used to resolve the work-shift definitions of the
+	// planned shifts.
+	Definitions map[string]structs.WorkShift
+}
+
+// Evaluator compiles and evaluates constraint expressions. Compiled programs
+// are cached by their expression so an Evaluator should be re-used.
+type Evaluator struct {
+	env *cel.Env
+
+	l        sync.Mutex
+	programs map[string]cel.Program
+}
+
+// NewEvaluator returns a new constraint evaluator.
+func NewEvaluator() *Evaluator {
+	env, err := cel.NewEnv(
+		cel.Variable("shift", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("date", cel.StringType),
+		cel.Variable("weekday", cel.IntType),
+		cel.Variable("day", cel.IntType),
+		cel.Variable("month", cel.IntType),
+		cel.Variable("year", cel.IntType),
+		cel.Variable("holiday", cel.BoolType),
+		cel.Variable("weekend", cel.BoolType),
+		cel.Variable("user", cel.StringType),
+		cel.Variable("hasWorkTime", cel.BoolType),
+		cel.Variable("workTime", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("planned", cel.ListType(cel.MapType(cel.StringType, cel.DynType))),
+	)
+	if err != nil {
+		// the environment is static so this can only be a programming error.
+		panic(fmt.Errorf("failed to create constraint environment: %w", err))
+	}
+
+	return &Evaluator{
+		env:      env,
+		programs: make(map[string]cel.Program),
+	}
+}
+
+// Compile compiles expr and returns the resulting program. It may be used to
+// validate expressions before they are stored.
+func (e *Evaluator) Compile(expr string) (cel.Program, error) {
+	e.l.Lock()
+	defer e.l.Unlock()
+
+	if prg, ok := e.programs[expr]; ok {
+		return prg, nil
+	}
+
+	ast, issues := e.env.Compile(expr)
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+
+	if out := ast.OutputType(); !out.IsExactType(cel.BoolType) && !out.IsExactType(cel.DynType) {
+		return nil, fmt.Errorf("expression must evaluate to a boolean but returns %s", out)
+	}
+
+	prg, err := e.env.Program(ast)
+	if err != nil {
+		return nil, err
+	}
+
+	e.programs[expr] = prg
+
+	return prg, nil
+}
+
+// Evaluate evaluates the constraint c against in and reports whether or not
+// the constraint is violated.
+func (e *Evaluator) Evaluate(c structs.Constraint, in Input) (bool, error) {
+	prg, err := e.Compile(c.Expression)
+	if err != nil {
+		return false, fmt.Errorf("failed to compile expression: %w", err)
+	}
+
+	out, _, err := prg.Eval(in.activation())
+	if err != nil {
+		return false, fmt.Errorf("failed to evaluate expression: %w", err)
+	}
+
+	result, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("expression returned %T rather than a boolean", out.Value())
+	}
+
+	if c.Deny {
+		return result, nil
+	}
+
+	return !result, nil
+}
+
+// AppliesTo reports whether or not the constraint c applies to the user with
+// the given ID and roles. Constraints that neither specify users nor roles
+// apply to everyone.
+func AppliesTo(c structs.Constraint, userID string, roleIDs []string) bool {
+	if len(c.AppliesToUser) == 0 && len(c.AppliesToRole) == 0 {
+		return true
+	}
+
+	for _, id := range c.AppliesToUser {
+		if id == userID {
+			return true
+		}
+	}
+
+	return data.ElemInBothSlices(c.AppliesToRole, roleIDs)
+}
+
+// IsBlocking reports whether a violation of c prevents the user from being
+// assigned.
+func IsBlocking(c structs.Constraint) bool {
+	return c.Hard || c.Deny
+}
+
+// Violation returns the constraint violation that is reported when c is
+// violated.
+func Violation(c structs.Constraint) *rosterv1.ConstraintViolation {
+	return &rosterv1.ConstraintViolation{
+		Hard: IsBlocking(c),
+		Kind: &rosterv1.ConstraintViolation_Evaluation{
+			Evaluation: &rosterv1.ConstraintEvaluationViolation{
+				Id:          c.ID.Hex(),
+				Description: c.Description,
+			},
+		},
+	}
+}
+
+func (in Input) activation() map[string]any {
+	shift := shiftValue(in.Shift, in.From, in.To, shiftTimeWorth(in.Shift, in.From, in.To))
+	shift["from"] = in.Shift.From.String()
+	shift["duration"] = time.Duration(in.Shift.Duration)
+	shift["requiredStaffCount"] = int64(in.Shift.RequiredStaffCount)
+
+	workTime := map[string]any{
+		"timePerWeek":               time.Duration(0),
+		"vacationWeeksPerYear":      float64(0),
+		"overtimeAllowancePerMonth": time.Duration(0),
+		"excludeFromTimeTracking":   false,
+	}
+	if in.WorkTime != nil {
+		workTime["timePerWeek"] = in.WorkTime.TimePerWeek
+		workTime["vacationWeeksPerYear"] = float64(in.WorkTime.VacationWeeksPerYear)
+		workTime["overtimeAllowancePerMonth"] = in.WorkTime.OvertimeAllowancePerMonth
+		workTime["excludeFromTimeTracking"] = in.WorkTime.ExcludeFromTimeTracking
+	}
+
+	planned := make([]map[string]any, 0, len(in.Planned))
+	for _, p := range in.Planned {
+		planned = append(planned, shiftValue(in.Definitions[p.WorkShiftID.Hex()], p.From, p.To, p.TimeWorth))
+	}
+
+	return map[string]any{
+		"shift":       shift,
+		"date":        in.From.Format("2006-01-02"),
+		"weekday":     int64(in.From.Weekday()),
+		"day":         int64(in.From.Day()),
+		"month":       int64(in.From.Month()),
+		"year":        int64(in.From.Year()),
+		"holiday":     in.Holiday,
+		"weekend":     in.Weekend,
+		"user":        in.UserID,
+		"hasWorkTime": in.WorkTime != nil,
+		"workTime":    workTime,
+		"planned":     planned,
+	}
+}
+
+func shiftValue(def structs.WorkShift, from, to time.Time, worth time.Duration) map[string]any {
+	tags := def.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+
+	var id string
+	if !def.ID.IsZero() {
+		id = def.ID.Hex()
+	}
+
+	return map[string]any{
+		"id":        id,
+		"name":      def.Name,
+		"shortName": def.ShortName,
+		"tags":      tags,
+		"date":      from.Format("2006-01-02"),
+		"weekday":   int64(from.Weekday()),
+		"start":     from,
+		"end":       to,
+		"timeWorth": worth,
+	}
+}
+
+func shiftTimeWorth(def structs.WorkShift, from, to time.Time) time.Duration {
+	if def.MinutesWorth != nil {
+		return time.Duration(*def.MinutesWorth) * time.Minute
+	}
+
+	return to.Sub(from)
+}
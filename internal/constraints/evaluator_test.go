@@ -0,0 +1,125 @@
+package constraints_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tierklinik-dobersberg/rosterd/internal/constraints"
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func Test_Evaluator_Evaluate(t *testing.T) {
+	var (
+		early = structs.WorkShift{
+			ID:                 primitive.NewObjectID(),
+			Name:               "Früh",
+			ShortName:          "F",
+			Tags:               []string{"regular"},
+			From:               structs.Daytime(8 * time.Hour),
+			Duration:           structs.JSDuration(8 * time.Hour),
+			RequiredStaffCount: 2,
+		}
+		onCall = structs.WorkShift{
+			ID:   primitive.NewObjectID(),
+			Name: "Bereitschaft",
+			Tags: []string{"oncall"},
+		}
+
+		// 2024-05-04 is a Saturday
+		from = time.Date(2024, time.May, 4, 8, 0, 0, 0, time.Local)
+		to   = from.Add(8 * time.Hour)
+	)
+
+	input := constraints.Input{
+		Shift:   early,
+		From:    from,
+		To:      to,
+		Weekend: true,
+		UserID:  "alice",
+		WorkTime: &structs.WorkTime{
+			UserID:      "alice",
+			TimePerWeek: 20 * time.Hour,
+		},
+		Planned: []structs.PlannedShift{
+			{WorkShiftID: onCall.ID, From: from.AddDate(0, 0, -1).Add(20 * time.Hour), To: from, TimeWorth: 2 * time.Hour},
+		},
+		Definitions: map[string]structs.WorkShift{
+			onCall.ID.Hex(): onCall,
+		},
+	}
+
+	cases := []struct {
+		name       string
+		expression string
+		deny       bool
+		violated   bool
+		err        bool
+	}{
+		// matching expressions
+		{name: "shift name", expression: `shift.name == "Früh"`},
+		{name: "shift tags", expression: `"regular" in shift.tags`},
+		{name: "calendar", expression: `weekday == 6 && weekend && !holiday && date == "2024-05-04" && month == 5`},
+		{name: "shift definition", expression: `shift.from == "08:00" && shift.duration == duration("8h") && shift.requiredStaffCount == 2`},
+		{name: "work-time", expression: `hasWorkTime && workTime.timePerWeek == duration("20h")`},
+		{name: "planned shifts", expression: `planned.exists(p, "oncall" in p.tags && p.timeWorth == duration("2h"))`},
+		{name: "deny not matching", expression: `user == "bob"`, deny: true},
+
+		// non-matching expressions
+		{name: "requirement not met", expression: `shift.name == "Spät"`, violated: true},
+		{name: "no planned shifts", expression: `size(planned) == 0`, violated: true},
+		{name: "deny matching", expression: `user == "alice"`, deny: true, violated: true},
+
+		// compile errors
+		{name: "syntax error", expression: `shift.name ==`, err: true},
+		{name: "unknown variable", expression: `unknown == 1`, err: true},
+
+		// non-bool results
+		{name: "int result", expression: `1 + 1`, err: true},
+		{name: "dynamic string result", expression: `shift.name`, err: true},
+	}
+
+	evaluator := constraints.NewEvaluator()
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			violated, err := evaluator.Evaluate(structs.Constraint{
+				Expression: c.expression,
+				Deny:       c.deny,
+			}, input)
+
+			if c.err {
+				require.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, c.violated, violated)
+		})
+	}
+}
+
+func Test_Evaluator_Compile(t *testing.T) {
+	evaluator := constraints.NewEvaluator()
+
+	prg, err := evaluator.Compile(`weekend`)
+	require.NoError(t, err)
+
+	// compiled programs are cached
+	cached, err := evaluator.Compile(`weekend`)
+	require.NoError(t, err)
+	require.Equal(t, prg, cached)
+
+	_, err = evaluator.Compile(`"not a bool"`)
+	require.Error(t, err)
+}
+
+func Test_AppliesTo(t *testing.T) {
+	require.True(t, constraints.AppliesTo(structs.Constraint{}, "alice", nil))
+	require.True(t, constraints.AppliesTo(structs.Constraint{AppliesToUser: []string{"alice"}}, "alice", nil))
+	require.False(t, constraints.AppliesTo(structs.Constraint{AppliesToUser: []string{"bob"}}, "alice", nil))
+	require.True(t, constraints.AppliesTo(structs.Constraint{AppliesToRole: []string{"vet"}}, "alice", []string{"vet"}))
+	require.False(t, constraints.AppliesTo(structs.Constraint{AppliesToRole: []string{"vet"}}, "alice", []string{"assistant"}))
+}
@@ -0,0 +1,73 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// InsertAuditEvent appends event to the audit log. Audit events are never
+// updated or deleted.
+func (db *DatabaseImpl) InsertAuditEvent(ctx context.Context, event *structs.AuditEvent) error {
+	event.ID = primitive.NewObjectID()
+	if _, err := db.auditEvents.InsertOne(ctx, event); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// FindAuditEvents returns all audit events matching filter, newest first.
+func (db *DatabaseImpl) FindAuditEvents(ctx context.Context, filter structs.AuditEventFilter) ([]structs.AuditEvent, error) {
+	query := bson.M{}
+
+	if filter.Entity != "" {
+		query["entity"] = filter.Entity
+	}
+	if filter.EntityID != "" {
+		query["entityId"] = filter.EntityID
+	}
+	if filter.ActorID != "" {
+		query["actorId"] = filter.ActorID
+	}
+	if filter.UserID != "" {
+		query["userIds"] = filter.UserID
+	}
+
+	timeFilter := bson.M{}
+	if !filter.From.IsZero() {
+		timeFilter["$gte"] = filter.From
+	}
+	if !filter.To.IsZero() {
+		timeFilter["$lt"] = filter.To
+	}
+	if len(timeFilter) > 0 {
+		query["time"] = timeFilter
+	}
+
+	opts := options.Find().SetSort(bson.D{
+		{Key: "time", Value: -1},
+		{Key: "_id", Value: -1},
+	})
+	if filter.Limit > 0 {
+		opts.SetLimit(filter.Limit)
+	}
+
+	db.dumpFilter("find audit events", query)
+
+	res, err := db.auditEvents.Find(ctx, query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []structs.AuditEvent
+	if err := res.All(ctx, &result); err != nil {
+		return nil, fmt.Errorf("failed to decode audit events: %w", err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,59 @@
+package database
+
+import (
+	"context"
+
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// SaveCalendarFeed stores feed and replaces any existing feed of the same
+// user, revoking the previous token.
+func (db *DatabaseImpl) SaveCalendarFeed(ctx context.Context, feed *structs.CalendarFeed) error {
+	// the ID is not part of the replacement so an existing document keeps
+	// its ID.
+	replacement := *feed
+	replacement.ID = primitive.NilObjectID
+
+	_, err := db.calendarFeeds.ReplaceOne(ctx, bson.M{"userId": feed.UserID}, replacement, options.Replace().SetUpsert(true))
+
+	return err
+}
+
+func (db *DatabaseImpl) GetCalendarFeed(ctx context.Context, userId string) (*structs.CalendarFeed, error) {
+	return db.findCalendarFeed(ctx, bson.M{"userId": userId})
+}
+
+func (db *DatabaseImpl) GetCalendarFeedByToken(ctx context.Context, tokenHash string) (*structs.CalendarFeed, error) {
+	return db.findCalendarFeed(ctx, bson.M{"tokenHash": tokenHash})
+}
+
+func (db *DatabaseImpl) DeleteCalendarFeed(ctx context.Context, userId string) error {
+	res, err := db.calendarFeeds.DeleteOne(ctx, bson.M{"userId": userId})
+	if err != nil {
+		return err
+	}
+
+	if res.DeletedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+
+	return nil
+}
+
+func (db *DatabaseImpl) findCalendarFeed(ctx context.Context, filter bson.M) (*structs.CalendarFeed, error) {
+	res := db.calendarFeeds.FindOne(ctx, filter)
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+
+	var feed structs.CalendarFeed
+	if err := res.Decode(&feed); err != nil {
+		return nil, err
+	}
+
+	return &feed, nil
+}
@@ -19,10 +19,17 @@ const (
 	RosterCollection         = "rosterd-rosters"
 	OffTimeRequestCollection = "rosterd-offtime"
 	OffTimeCostsCollection   = "rosterd-offtime-costs"
+	OffTimeRuleCollection    = "rosterd-offtime-rules"
+	ShiftSwapCollection      = "rosterd-shift-swaps"
+	OpenShiftCollection      = "rosterd-open-shifts"
+	CalendarFeedCollection   = "rosterd-calendar-feeds"
 	ConstraintCollection     = "rosterd-constraints"
 	WorktimeCollection       = "rosterd-worktime"
 	DutyRosterCollection     = "rosterd-dutyrosters"
 	RosterTypeCollection     = "rosterd-rostertypes"
+	LocalHolidayCollection   = "rosterd-local-holidays"
+	AuditEventCollection     = "rosterd-audit-events"
+	RosterVersionCollection  = "rosterd-roster-versions"
 )
 
 type (
@@ -30,24 +37,73 @@ type (
 		SaveWorkShift(context.Context, *structs.WorkShift) error
 		DeleteWorkShift(context.Context, string) error
 		ListWorkShifts(context.Context) ([]structs.WorkShift, error)
+		GetWorkShiftById(ctx context.Context, id string) (structs.WorkShift, error)
 		GetShiftsForDay(ctx context.Context, weekDay time.Weekday, isHoliday bool) ([]structs.WorkShift, error)
 	}
 
 	OffTimeDatabase interface {
 		GetOffTimeRequest(ctx context.Context, ids ...string) ([]structs.OffTimeEntry, error)
 		CreateOffTimeRequest(ctx context.Context, req *structs.OffTimeEntry) error
+		UpdateOffTimeRequest(ctx context.Context, req *structs.OffTimeEntry) error
 		DeleteOffTimeRequest(ctx context.Context, id ...string) error
 		FindOffTimeRequests(ctx context.Context, from, to time.Time, approved *bool, userIds []string) ([]structs.OffTimeEntry, error)
 		ApproveOffTimeRequest(ctx context.Context, id string, approval *structs.Approval) error
 		AddOffTimeCost(ctx context.Context, cost *structs.OffTimeCosts) error
 		GetOffTimeCosts(ctx context.Context, user_ids ...string) ([]structs.OffTimeCosts, error)
+		GetOffTimeCostsByID(ctx context.Context, ids ...string) ([]structs.OffTimeCosts, error)
 		DeleteOffTimeCosts(ctx context.Context, ids ...string) error
+		DeleteOffTimeCostsByOffTime(ctx context.Context, offTimeID string) error
+		DeleteOffTimeCostsByRoster(ctx context.Context, rosterID string) error
+		DeleteOffTimeCostsByRosterAndUser(ctx context.Context, rosterID string, userIds ...string) error
 		// CalculateOffTimeCredits(ctx context.Context) (map[string]time.Duration, error)
 	}
 
+	OffTimeRuleDatabase interface {
+		CreateOffTimeRule(ctx context.Context, rule *structs.OffTimeRule) error
+		UpdateOffTimeRule(ctx context.Context, rule *structs.OffTimeRule) error
+		GetOffTimeRule(ctx context.Context, id string) (*structs.OffTimeRule, error)
+		DeleteOffTimeRule(ctx context.Context, id string) error
+		ListOffTimeRules(ctx context.Context) ([]structs.OffTimeRule, error)
+	}
+
+	LocalHolidayDatabase interface {
+		CreateLocalHoliday(ctx context.Context, hd *structs.LocalHoliday) error
+		UpdateLocalHoliday(ctx context.Context, hd *structs.LocalHoliday) error
+		GetLocalHoliday(ctx context.Context, id string) (*structs.LocalHoliday, error)
+		DeleteLocalHoliday(ctx context.Context, id string) error
+		ListLocalHolidays(ctx context.Context, from, to string) ([]structs.LocalHoliday, error)
+	}
+
+	AuditDatabase interface {
+		InsertAuditEvent(ctx context.Context, event *structs.AuditEvent) error
+		FindAuditEvents(ctx context.Context, filter structs.AuditEventFilter) ([]structs.AuditEvent, error)
+	}
+
+	ShiftSwapDatabase interface {
+		CreateShiftSwap(ctx context.Context, swap *structs.ShiftSwap) error
+		UpdateShiftSwap(ctx context.Context, swap *structs.ShiftSwap, expected structs.ShiftSwapState) error
+		GetShiftSwap(ctx context.Context, id string) (*structs.ShiftSwap, error)
+		FindShiftSwaps(ctx context.Context, filter structs.ShiftSwapFilter) ([]structs.ShiftSwap, error)
+	}
+
+	OpenShiftDatabase interface {
+		CreateOpenShift(ctx context.Context, os *structs.OpenShift) error
+		UpdateOpenShift(ctx context.Context, os *structs.OpenShift) error
+		GetOpenShift(ctx context.Context, id string) (*structs.OpenShift, error)
+		FindOpenShifts(ctx context.Context, rosterID string, states []structs.OpenShiftState) ([]structs.OpenShift, error)
+	}
+
+	CalendarFeedDatabase interface {
+		SaveCalendarFeed(ctx context.Context, feed *structs.CalendarFeed) error
+		GetCalendarFeed(ctx context.Context, userId string) (*structs.CalendarFeed, error)
+		GetCalendarFeedByToken(ctx context.Context, tokenHash string) (*structs.CalendarFeed, error)
+		DeleteCalendarFeed(ctx context.Context, userId string) error
+	}
+
 	ConstraintDatabase interface {
 		CreateConstraint(ctx context.Context, req *structs.Constraint) error
 		UpdateConstraint(ctx context.Context, constraint *structs.Constraint) error
+		GetConstraintByID(ctx context.Context, id string) (*structs.Constraint, error)
 		DeleteConstraint(ctx context.Context, id string) error
 		FindConstraints(ctx context.Context, staff []string, roleIds []string) ([]structs.Constraint, error)
 	}
@@ -61,25 +117,64 @@ type (
 		UpdateWorkTime(ctx context.Context, wt *structs.WorkTime) error
 	}
 
+	RosterTypeDatabase interface {
+		SaveRosterType(ctx context.Context, model structs.RosterType) error
+		DeleteRosterType(ctx context.Context, rosterTypeName string) error
+		GetRosterType(ctx context.Context, name string) (structs.RosterType, error)
+		GetRosterTypes(ctx context.Context) ([]structs.RosterType, error)
+	}
+
 	DutyRosterDatabase interface {
 		SaveDutyRoster(ctx context.Context, roster *structs.DutyRoster, casIndex *uint64) (bool, error)
 		DeleteDutyRoster(ctx context.Context, rosterID string, supersededBy primitive.ObjectID) error
 		ApproveDutyRoster(ctx context.Context, rosterID, approver string) error
 		DutyRosterByID(ctx context.Context, id string) (structs.DutyRoster, error)
 		DutyRostersByTime(ctx context.Context, time time.Time) ([]structs.DutyRoster, error)
+		LoadDutyRosters(ctx context.Context) ([]structs.DutyRoster, error)
 		GetSupersededDutyRoster(ctx context.Context, rosterID primitive.ObjectID) (*structs.DutyRoster, error)
 		FindRostersWithActiveShifts(ctx context.Context, t time.Time) ([]structs.DutyRoster, error)
 		FindRostersWithActiveShiftsInRange(ctx context.Context, from, to time.Time) ([]structs.DutyRoster, error)
 	}
 
+	RosterVersionDatabase interface {
+		ListRosterVersions(ctx context.Context, rosterIds ...primitive.ObjectID) ([]structs.RosterVersion, error)
+		GetRosterVersion(ctx context.Context, rosterID primitive.ObjectID, casIndex uint64) (*structs.RosterVersion, error)
+	}
+
+	// Datastore combines all database interfaces and is implemented by
+	// DatabaseImpl for MongoDB and by the in-memory store in
+	// internal/database/memory.
+	Datastore interface {
+		WorkShiftDatabase
+		OffTimeDatabase
+		OffTimeRuleDatabase
+		LocalHolidayDatabase
+		AuditDatabase
+		ShiftSwapDatabase
+		OpenShiftDatabase
+		CalendarFeedDatabase
+		ConstraintDatabase
+		WorkTimeDatabase
+		RosterTypeDatabase
+		DutyRosterDatabase
+		RosterVersionDatabase
+	}
+
 	DatabaseImpl struct {
 		shifts          *mongo.Collection
 		offTime         *mongo.Collection
 		offTimeCosts    *mongo.Collection
+		offTimeRules    *mongo.Collection
+		shiftSwaps      *mongo.Collection
+		openShifts      *mongo.Collection
+		calendarFeeds   *mongo.Collection
 		constraints     *mongo.Collection
 		worktime        *mongo.Collection
 		dutyRosters     *mongo.Collection
 		dutyRosterTypes *mongo.Collection
+		localHolidays   *mongo.Collection
+		auditEvents     *mongo.Collection
+		rosterVersions  *mongo.Collection
 		logger          *logrus.Entry
 		debug           bool
 	}
@@ -90,10 +185,17 @@ func NewDatabase(ctx context.Context, db *mongo.Database, logger *logrus.Entry)
 		shifts:          db.Collection(ShiftCollection),
 		offTime:         db.Collection(OffTimeRequestCollection),
 		offTimeCosts:    db.Collection(OffTimeCostsCollection),
+		offTimeRules:    db.Collection(OffTimeRuleCollection),
+		shiftSwaps:      db.Collection(ShiftSwapCollection),
+		openShifts:      db.Collection(OpenShiftCollection),
+		calendarFeeds:   db.Collection(CalendarFeedCollection),
 		constraints:     db.Collection(ConstraintCollection),
 		worktime:        db.Collection(WorktimeCollection),
 		dutyRosters:     db.Collection(DutyRosterCollection),
 		dutyRosterTypes: db.Collection(RosterTypeCollection),
+		localHolidays:   db.Collection(LocalHolidayCollection),
+		auditEvents:     db.Collection(AuditEventCollection),
+		rosterVersions:  db.Collection(RosterVersionCollection),
 		logger:          logger,
 		debug:           false,
 	}
@@ -161,6 +263,57 @@ func (db *DatabaseImpl) setup(ctx context.Context) error {
 		return fmt.Errorf("failed to create offtime indexes: %w", err)
 	}
 
+	_, err = db.shiftSwaps.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "rosterId", Value: 1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "requestorId", Value: 1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "targetUserId", Value: 1},
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create shift-swap indexes: %w", err)
+	}
+
+	_, err = db.openShifts.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "rosterId", Value: 1},
+				{Key: "state", Value: 1},
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create open-shift indexes: %w", err)
+	}
+
+	_, err = db.calendarFeeds.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "userId", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "tokenHash", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create calendar-feed indexes: %w", err)
+	}
+
 	_, err = db.dutyRosterTypes.Indexes().CreateMany(ctx, []mongo.IndexModel{
 		{
 			Keys: bson.D{
@@ -173,14 +326,58 @@ func (db *DatabaseImpl) setup(ctx context.Context) error {
 		return fmt.Errorf("failed to create roster-type indexes: %w", err)
 	}
 
+	_, err = db.localHolidays.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "date", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create local-holiday indexes: %w", err)
+	}
+
+	_, err = db.auditEvents.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "entity", Value: 1},
+				{Key: "entityId", Value: 1},
+				{Key: "time", Value: -1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "userIds", Value: 1},
+				{Key: "time", Value: -1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "actorId", Value: 1},
+				{Key: "time", Value: -1},
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create audit-event indexes: %w", err)
+	}
+
+	_, err = db.rosterVersions.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "rosterId", Value: 1},
+				{Key: "casIndex", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create roster-version indexes: %w", err)
+	}
+
 	return nil
 }
 
 // Interfaces check
-var _ interface {
-	WorkShiftDatabase
-	OffTimeDatabase
-	ConstraintDatabase
-	WorkTimeDatabase
-	DutyRosterDatabase
-} = new(DatabaseImpl)
+var _ Datastore = new(DatabaseImpl)
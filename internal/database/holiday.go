@@ -0,0 +1,102 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func (db *DatabaseImpl) CreateLocalHoliday(ctx context.Context, hd *structs.LocalHoliday) error {
+	hd.ID = primitive.NewObjectID()
+	if _, err := db.localHolidays.InsertOne(ctx, hd); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *DatabaseImpl) UpdateLocalHoliday(ctx context.Context, hd *structs.LocalHoliday) error {
+	res, err := db.localHolidays.ReplaceOne(ctx, bson.M{"_id": hd.ID}, hd)
+	if err != nil {
+		return err
+	}
+
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+
+	return nil
+}
+
+func (db *DatabaseImpl) GetLocalHoliday(ctx context.Context, id string) (*structs.LocalHoliday, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, err
+	}
+
+	res := db.localHolidays.FindOne(ctx, bson.M{"_id": oid})
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+
+	var hd structs.LocalHoliday
+	if err := res.Decode(&hd); err != nil {
+		return nil, err
+	}
+
+	return &hd, nil
+}
+
+func (db *DatabaseImpl) DeleteLocalHoliday(ctx context.Context, id string) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+
+	res, err := db.localHolidays.DeleteOne(ctx, bson.M{"_id": oid})
+	if err != nil {
+		return err
+	}
+
+	if res.DeletedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+
+	return nil
+}
+
+// ListLocalHolidays returns all local holidays between from and to
+// (inclusive, YYYY-MM-DD). An empty from or to leaves the range open.
+func (db *DatabaseImpl) ListLocalHolidays(ctx context.Context, from, to string) ([]structs.LocalHoliday, error) {
+	dateFilter := bson.M{}
+	if from != "" {
+		dateFilter["$gte"] = from
+	}
+	if to != "" {
+		dateFilter["$lte"] = to
+	}
+
+	filter := bson.M{}
+	if len(dateFilter) > 0 {
+		filter["date"] = dateFilter
+	}
+
+	res, err := db.localHolidays.Find(ctx, filter, options.Find().SetSort(bson.D{
+		{Key: "date", Value: 1},
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	var result []structs.LocalHoliday
+	if err := res.All(ctx, &result); err != nil {
+		return nil, fmt.Errorf("failed to decode local holidays: %w", err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,63 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"golang.org/x/exp/slices"
+)
+
+// InsertAuditEvent appends event to the audit log. Audit events are never
+// updated or deleted.
+func (db *Database) InsertAuditEvent(ctx context.Context, event *structs.AuditEvent) error {
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	event.ID = primitive.NewObjectID()
+
+	return db.auditEvents.insert(event)
+}
+
+// FindAuditEvents returns all audit events matching filter, newest first.
+func (db *Database) FindAuditEvents(ctx context.Context, filter structs.AuditEventFilter) ([]structs.AuditEvent, error) {
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	result, err := db.auditEvents.find(func(e *structs.AuditEvent) bool {
+		switch {
+		case filter.Entity != "" && e.Entity != filter.Entity:
+			return false
+		case filter.EntityID != "" && e.EntityID != filter.EntityID:
+			return false
+		case filter.ActorID != "" && e.ActorID != filter.ActorID:
+			return false
+		case filter.UserID != "" && !slices.Contains(e.UserIDs, filter.UserID):
+			return false
+		case !filter.From.IsZero() && e.Time.Before(dbTime(filter.From)):
+			return false
+		case !filter.To.IsZero() && !e.Time.Before(dbTime(filter.To)):
+			return false
+		}
+
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		if !result[i].Time.Equal(result[j].Time) {
+			return result[i].Time.After(result[j].Time)
+		}
+
+		return result[i].ID.Hex() > result[j].ID.Hex()
+	})
+
+	if filter.Limit > 0 && int64(len(result)) > filter.Limit {
+		result = result[:filter.Limit]
+	}
+
+	return result, nil
+}
@@ -0,0 +1,80 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// SaveCalendarFeed stores feed and replaces any existing feed of the same
+// user, revoking the previous token.
+func (db *Database) SaveCalendarFeed(ctx context.Context, feed *structs.CalendarFeed) error {
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	// like the MongoDB implementation an existing document keeps its ID and
+	// the ID of feed is ignored.
+	replacement := *feed
+
+	idx, existing, err := db.calendarFeeds.findOne(func(f *structs.CalendarFeed) bool {
+		return f.UserID == feed.UserID
+	})
+	switch {
+	case err == nil:
+		replacement.ID = existing.ID
+		_, err = db.calendarFeeds.replace(idx, &replacement)
+
+		return err
+
+	case err == mongo.ErrNoDocuments:
+		replacement.ID = primitive.NewObjectID()
+
+		return db.calendarFeeds.insert(&replacement)
+	}
+
+	return err
+}
+
+func (db *Database) GetCalendarFeed(ctx context.Context, userId string) (*structs.CalendarFeed, error) {
+	return db.findCalendarFeed(func(f *structs.CalendarFeed) bool {
+		return f.UserID == userId
+	})
+}
+
+func (db *Database) GetCalendarFeedByToken(ctx context.Context, tokenHash string) (*structs.CalendarFeed, error) {
+	return db.findCalendarFeed(func(f *structs.CalendarFeed) bool {
+		return f.TokenHash == tokenHash
+	})
+}
+
+func (db *Database) DeleteCalendarFeed(ctx context.Context, userId string) error {
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	count, err := db.calendarFeeds.remove(func(f *structs.CalendarFeed) bool {
+		return f.UserID == userId
+	})
+	if err != nil {
+		return err
+	}
+
+	if count == 0 {
+		return mongo.ErrNoDocuments
+	}
+
+	return nil
+}
+
+func (db *Database) findCalendarFeed(fn func(*structs.CalendarFeed) bool) (*structs.CalendarFeed, error) {
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	_, feed, err := db.calendarFeeds.findOne(fn)
+	if err != nil {
+		return nil, err
+	}
+
+	return feed, nil
+}
@@ -0,0 +1,106 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"golang.org/x/exp/slices"
+)
+
+func (db *Database) CreateConstraint(ctx context.Context, constraint *structs.Constraint) error {
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	constraint.ID = primitive.NewObjectID()
+
+	return db.constraints.insert(constraint)
+}
+
+func (db *Database) UpdateConstraint(ctx context.Context, constraint *structs.Constraint) error {
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	idx, _, err := db.constraints.findOne(func(c *structs.Constraint) bool {
+		return c.ID == constraint.ID
+	})
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return fmt.Errorf("not found")
+		}
+
+		return err
+	}
+
+	_, err = db.constraints.replace(idx, constraint)
+
+	return err
+}
+
+func (db *Database) GetConstraintByID(ctx context.Context, id string) (*structs.Constraint, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, err
+	}
+
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	_, c, err := db.constraints.findOne(func(c *structs.Constraint) bool {
+		return c.ID == oid
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (db *Database) DeleteConstraint(ctx context.Context, id string) error {
+	obid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	count, err := db.constraints.remove(func(c *structs.Constraint) bool {
+		return c.ID == obid
+	})
+	if err != nil {
+		return err
+	}
+
+	if count == 0 {
+		return fmt.Errorf("not found")
+	}
+
+	return nil
+}
+
+func (db *Database) FindConstraints(ctx context.Context, staffs []string, roles []string) ([]structs.Constraint, error) {
+	intersects := func(a, b []string) bool {
+		return slices.ContainsFunc(a, func(s string) bool {
+			return slices.Contains(b, s)
+		})
+	}
+
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	return db.constraints.find(func(c *structs.Constraint) bool {
+		switch {
+		case len(staffs) > 0 && len(roles) > 0:
+			return intersects(c.AppliesToRole, roles) || intersects(c.AppliesToUser, staffs)
+		case len(staffs) > 0:
+			return intersects(c.AppliesToUser, staffs)
+		case len(roles) > 0:
+			return intersects(c.AppliesToRole, roles)
+		}
+
+		return true
+	})
+}
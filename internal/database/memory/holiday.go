@@ -0,0 +1,97 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func (db *Database) CreateLocalHoliday(ctx context.Context, hd *structs.LocalHoliday) error {
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	hd.ID = primitive.NewObjectID()
+
+	return db.localHolidays.insert(hd)
+}
+
+func (db *Database) UpdateLocalHoliday(ctx context.Context, hd *structs.LocalHoliday) error {
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	idx, _, err := db.localHolidays.findOne(func(l *structs.LocalHoliday) bool {
+		return l.ID == hd.ID
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = db.localHolidays.replace(idx, hd)
+
+	return err
+}
+
+func (db *Database) GetLocalHoliday(ctx context.Context, id string) (*structs.LocalHoliday, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, err
+	}
+
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	_, hd, err := db.localHolidays.findOne(func(l *structs.LocalHoliday) bool {
+		return l.ID == oid
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return hd, nil
+}
+
+func (db *Database) DeleteLocalHoliday(ctx context.Context, id string) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	count, err := db.localHolidays.remove(func(l *structs.LocalHoliday) bool {
+		return l.ID == oid
+	})
+	if err != nil {
+		return err
+	}
+
+	if count == 0 {
+		return mongo.ErrNoDocuments
+	}
+
+	return nil
+}
+
+// ListLocalHolidays returns all local holidays between from and to
+// (inclusive, YYYY-MM-DD). An empty from or to leaves the range open.
+func (db *Database) ListLocalHolidays(ctx context.Context, from, to string) ([]structs.LocalHoliday, error) {
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	result, err := db.localHolidays.find(func(l *structs.LocalHoliday) bool {
+		return (from == "" || l.Date >= from) && (to == "" || l.Date <= to)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Date < result[j].Date
+	})
+
+	return result, nil
+}
@@ -0,0 +1,283 @@
+// Package memory provides an in-memory implementation of database.Datastore.
+// It is used for tests and for running rosterd without MongoDB. Documents are
+// stored BSON encoded so the store behaves like MongoDB with regard to
+// copies, omitted fields and the millisecond precision of timestamps.
+package memory
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/tierklinik-dobersberg/rosterd/internal/database"
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type Database struct {
+	l sync.Mutex
+
+	shifts          *collection[structs.WorkShift]
+	offTime         *collection[structs.OffTimeEntry]
+	offTimeCosts    *collection[structs.OffTimeCosts]
+	offTimeRules    *collection[structs.OffTimeRule]
+	shiftSwaps      *collection[structs.ShiftSwap]
+	openShifts      *collection[structs.OpenShift]
+	calendarFeeds   *collection[structs.CalendarFeed]
+	constraints     *collection[structs.Constraint]
+	worktime        *collection[structs.WorkTime]
+	dutyRosters     *collection[structs.DutyRoster]
+	dutyRosterTypes *collection[structs.RosterType]
+	localHolidays   *collection[structs.LocalHoliday]
+	auditEvents     *collection[structs.AuditEvent]
+	rosterVersions  *collection[structs.RosterVersion]
+	logger          *logrus.Entry
+}
+
+// New returns a new, empty in-memory datastore.
+func New(logger *logrus.Entry) *Database {
+	return &Database{
+		shifts: newCollection(database.ShiftCollection, func(ws *structs.WorkShift) any {
+			return ws.ID
+		}),
+		offTime: newCollection(database.OffTimeRequestCollection, func(e *structs.OffTimeEntry) any {
+			return e.ID
+		}),
+		offTimeCosts: newCollection(database.OffTimeCostsCollection, func(c *structs.OffTimeCosts) any {
+			return c.ID
+		}),
+		offTimeRules: newCollection(database.OffTimeRuleCollection, func(r *structs.OffTimeRule) any {
+			return r.ID
+		}),
+		shiftSwaps: newCollection(database.ShiftSwapCollection, func(s *structs.ShiftSwap) any {
+			return s.ID
+		}),
+		openShifts: newCollection(database.OpenShiftCollection, func(os *structs.OpenShift) any {
+			return os.ID
+		}),
+		calendarFeeds: newCollection(database.CalendarFeedCollection,
+			func(f *structs.CalendarFeed) any { return f.ID },
+			func(f *structs.CalendarFeed) any { return f.UserID },
+			func(f *structs.CalendarFeed) any { return f.TokenHash },
+		),
+		constraints: newCollection(database.ConstraintCollection, func(c *structs.Constraint) any {
+			return c.ID
+		}),
+		worktime: newCollection(database.WorktimeCollection, func(wt *structs.WorkTime) any {
+			return wt.ID
+		}),
+		dutyRosters: newCollection(database.DutyRosterCollection, func(r *structs.DutyRoster) any {
+			return r.ID
+		}),
+		dutyRosterTypes: newCollection(database.RosterTypeCollection, func(rt *structs.RosterType) any {
+			return rt.UniqueName
+		}),
+		localHolidays: newCollection(database.LocalHolidayCollection,
+			func(hd *structs.LocalHoliday) any { return hd.ID },
+			func(hd *structs.LocalHoliday) any { return hd.Date },
+		),
+		auditEvents: newCollection(database.AuditEventCollection, func(e *structs.AuditEvent) any {
+			return e.ID
+		}),
+		rosterVersions: newCollection(database.RosterVersionCollection,
+			func(v *structs.RosterVersion) any { return v.ID },
+			func(v *structs.RosterVersion) any {
+				return [2]any{v.RosterID, v.CASIndex}
+			},
+		),
+		logger: logger,
+	}
+}
+
+// collection holds BSON encoded documents in insertion order. The first key
+// function returns the document ID, additional key functions emulate unique
+// indexes.
+type collection[T any] struct {
+	name string
+	docs []bson.Raw
+	keys []func(*T) any
+}
+
+func newCollection[T any](name string, keys ...func(*T) any) *collection[T] {
+	return &collection[T]{
+		name: name,
+		keys: keys,
+	}
+}
+
+func (c *collection[T]) decode(raw bson.Raw) (T, error) {
+	var doc T
+	if err := bson.Unmarshal(raw, &doc); err != nil {
+		return doc, fmt.Errorf("failed to decode document from %s: %w", c.name, err)
+	}
+
+	return doc, nil
+}
+
+// find returns all documents that match fn in insertion order.
+func (c *collection[T]) find(fn func(*T) bool) ([]T, error) {
+	var result []T
+
+	for _, raw := range c.docs {
+		doc, err := c.decode(raw)
+		if err != nil {
+			return nil, err
+		}
+
+		if fn == nil || fn(&doc) {
+			result = append(result, doc)
+		}
+	}
+
+	return result, nil
+}
+
+// findOne returns the index and the first document matching fn or
+// mongo.ErrNoDocuments.
+func (c *collection[T]) findOne(fn func(*T) bool) (int, *T, error) {
+	for idx, raw := range c.docs {
+		doc, err := c.decode(raw)
+		if err != nil {
+			return -1, nil, err
+		}
+
+		if fn(&doc) {
+			return idx, &doc, nil
+		}
+	}
+
+	return -1, nil, mongo.ErrNoDocuments
+}
+
+func (c *collection[T]) encode(doc *T, skip int) (bson.Raw, error) {
+	raw, err := bson.Marshal(doc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode document for %s: %w", c.name, err)
+	}
+
+	// decode the document again so unique keys are compared the same way
+	// they are stored.
+	stored, err := c.decode(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	for idx, other := range c.docs {
+		if idx == skip {
+			continue
+		}
+
+		existing, err := c.decode(other)
+		if err != nil {
+			return nil, err
+		}
+
+		for kidx, key := range c.keys {
+			if key(&stored) == key(&existing) {
+				return nil, duplicateKeyError(c.name, kidx)
+			}
+		}
+	}
+
+	return raw, nil
+}
+
+// insert appends doc to the collection.
+func (c *collection[T]) insert(doc *T) error {
+	raw, err := c.encode(doc, -1)
+	if err != nil {
+		return err
+	}
+
+	c.docs = append(c.docs, raw)
+
+	return nil
+}
+
+// replace replaces the document at idx and reports whether the document
+// has actually been modified.
+func (c *collection[T]) replace(idx int, doc *T) (bool, error) {
+	raw, err := c.encode(doc, idx)
+	if err != nil {
+		return false, err
+	}
+
+	if bytes.Equal(raw, c.docs[idx]) {
+		return false, nil
+	}
+
+	c.docs[idx] = raw
+
+	return true, nil
+}
+
+// remove deletes all documents that match fn and returns the number of
+// deleted documents.
+func (c *collection[T]) remove(fn func(*T) bool) (int, error) {
+	var (
+		kept    []bson.Raw
+		deleted int
+	)
+
+	for _, raw := range c.docs {
+		doc, err := c.decode(raw)
+		if err != nil {
+			return 0, err
+		}
+
+		if fn(&doc) {
+			deleted++
+		} else {
+			kept = append(kept, raw)
+		}
+	}
+
+	c.docs = kept
+
+	return deleted, nil
+}
+
+func duplicateKeyError(collection string, key int) error {
+	return mongo.WriteException{
+		WriteErrors: mongo.WriteErrors{
+			{
+				Code:    11000,
+				Message: fmt.Sprintf("E11000 duplicate key error collection: %s index: %d", collection, key),
+			},
+		},
+	}
+}
+
+// dbTime returns t with the precision of a BSON datetime.
+func dbTime(t time.Time) time.Time {
+	return time.UnixMilli(t.UnixMilli()).UTC()
+}
+
+// overlaps reports whether the period between docFrom and docTo contains
+// from or to or is contained in both. Zero values of from and to are
+// ignored, if both are zero all periods match.
+func overlaps(from, to, docFrom, docTo time.Time) bool {
+	contains := func(t time.Time) bool {
+		t = dbTime(t)
+
+		return !docFrom.After(t) && !docTo.Before(t)
+	}
+
+	switch {
+	case !from.IsZero() && !to.IsZero():
+		return contains(from) || contains(to) ||
+			(!docFrom.Before(dbTime(from)) && !docTo.After(dbTime(to)))
+	case !from.IsZero():
+		return contains(from)
+	case !to.IsZero():
+		return contains(to)
+	}
+
+	return true
+}
+
+// Interfaces check
+var _ database.Datastore = new(Database)
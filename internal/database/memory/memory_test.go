@@ -0,0 +1,317 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func newTestDatabase() *Database {
+	return New(logrus.NewEntry(logrus.StandardLogger()))
+}
+
+func Test_SaveDutyRoster(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDatabase()
+
+	roster := &structs.DutyRoster{
+		From: "2024-05-01",
+		To:   "2024-05-31",
+	}
+
+	saved, err := db.SaveDutyRoster(ctx, roster, nil)
+	require.NoError(t, err)
+	require.True(t, saved)
+	require.False(t, roster.ID.IsZero())
+	require.Equal(t, uint64(1), roster.CASIndex)
+
+	// a stale CAS index is rejected
+	stale := uint64(0)
+	other := *roster
+	_, err = db.SaveDutyRoster(ctx, &other, &stale)
+	require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+
+	// the current CAS index is accepted
+	current := roster.CASIndex
+	roster.LastModifiedBy = "alice"
+	saved, err = db.SaveDutyRoster(ctx, roster, &current)
+	require.NoError(t, err)
+	require.True(t, saved)
+	require.Equal(t, uint64(2), roster.CASIndex)
+
+	loaded, err := db.DutyRosterByID(ctx, roster.ID.Hex())
+	require.NoError(t, err)
+	require.Equal(t, "alice", loaded.LastModifiedBy)
+	require.Equal(t, uint64(2), loaded.CASIndex)
+
+	// each successful save stores a version
+	versions, err := db.ListRosterVersions(ctx, roster.ID)
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+	require.Equal(t, uint64(1), versions[0].CASIndex)
+	require.Equal(t, uint64(2), versions[1].CASIndex)
+
+	version, err := db.GetRosterVersion(ctx, roster.ID, 1)
+	require.NoError(t, err)
+	require.Empty(t, version.Roster.LastModifiedBy)
+
+	_, err = db.GetRosterVersion(ctx, roster.ID, 3)
+	require.ErrorIs(t, err, mongo.ErrNoDocuments)
+
+	// unknown rosters cannot be saved with a CAS index
+	_, err = db.SaveDutyRoster(ctx, &structs.DutyRoster{}, &current)
+	require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+}
+
+func Test_DeleteDutyRoster(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDatabase()
+
+	old := &structs.DutyRoster{From: "2024-05-01", To: "2024-05-31", Approved: true}
+	_, err := db.SaveDutyRoster(ctx, old, nil)
+	require.NoError(t, err)
+
+	replacement := &structs.DutyRoster{From: "2024-05-01", To: "2024-05-31"}
+	_, err = db.SaveDutyRoster(ctx, replacement, nil)
+	require.NoError(t, err)
+
+	// nothing has been superseded yet
+	_, err = db.GetSupersededDutyRoster(ctx, primitive.NilObjectID)
+	require.ErrorIs(t, err, mongo.ErrNoDocuments)
+
+	require.NoError(t, db.DeleteDutyRoster(ctx, old.ID.Hex(), replacement.ID))
+
+	superseded, err := db.GetSupersededDutyRoster(ctx, replacement.ID)
+	require.NoError(t, err)
+	require.Equal(t, old.ID, superseded.ID)
+	require.True(t, superseded.Deleted)
+
+	// soft-deleted rosters can still be loaded by ID but are not listed
+	_, err = db.DutyRosterByID(ctx, old.ID.Hex())
+	require.NoError(t, err)
+
+	all, err := db.LoadDutyRosters(ctx)
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+	require.Equal(t, replacement.ID, all[0].ID)
+
+	byTime, err := db.DutyRostersByTime(ctx, time.Date(2024, time.May, 10, 12, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.Len(t, byTime, 1)
+	require.Equal(t, replacement.ID, byTime[0].ID)
+
+	// rosters that are not superseded are removed
+	require.NoError(t, db.DeleteDutyRoster(ctx, replacement.ID.Hex(), primitive.NilObjectID))
+	_, err = db.DutyRosterByID(ctx, replacement.ID.Hex())
+	require.ErrorIs(t, err, mongo.ErrNoDocuments)
+
+	err = db.DeleteDutyRoster(ctx, replacement.ID.Hex(), primitive.NilObjectID)
+	require.ErrorIs(t, err, mongo.ErrNoDocuments)
+}
+
+func Test_FindRostersWithActiveShiftsInRange(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDatabase()
+
+	day := func(d, h int) time.Time {
+		return time.Date(2024, time.May, d, h, 0, 0, 0, time.UTC)
+	}
+
+	roster := &structs.DutyRoster{
+		From: "2024-05-01",
+		To:   "2024-05-31",
+		Shifts: []structs.PlannedShift{
+			{From: day(10, 8), To: day(10, 16)},
+		},
+	}
+	_, err := db.SaveDutyRoster(ctx, roster, nil)
+	require.NoError(t, err)
+
+	cases := []struct {
+		from, to time.Time
+		found    bool
+	}{
+		{day(10, 10), time.Time{}, true},
+		{day(10, 17), time.Time{}, false},
+		{time.Time{}, day(10, 8), true},
+		{day(9, 0), day(11, 0), true},
+		{day(10, 12), day(11, 0), true},
+		{day(11, 0), day(12, 0), false},
+		{time.Time{}, time.Time{}, true},
+	}
+
+	for idx, c := range cases {
+		res, err := db.FindRostersWithActiveShiftsInRange(ctx, c.from, c.to)
+		require.NoError(t, err)
+
+		if c.found {
+			require.Len(t, res, 1, "case #%d", idx)
+		} else {
+			require.Empty(t, res, "case #%d", idx)
+		}
+	}
+
+	res, err := db.FindRostersWithActiveShifts(ctx, day(10, 16))
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+}
+
+func Test_GetCurrentWorkTimes(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDatabase()
+
+	entries := []structs.WorkTime{
+		{UserID: "alice", TimePerWeek: 40 * time.Hour, ApplicableFrom: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC), OvertimeAllowancePerMonth: time.Hour},
+		{UserID: "alice", TimePerWeek: 20 * time.Hour, ApplicableFrom: time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC)},
+		{UserID: "bob", TimePerWeek: 30 * time.Hour, ApplicableFrom: time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)},
+		{UserID: "carol", TimePerWeek: 10 * time.Hour, ApplicableFrom: time.Date(2024, time.July, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for idx := range entries {
+		require.NoError(t, db.SaveWorkTimePerWeek(ctx, &entries[idx]))
+	}
+
+	result, err := db.GetCurrentWorkTimes(ctx, time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	require.Equal(t, 40*time.Hour, result["alice"].TimePerWeek)
+	require.Equal(t, entries[0].ID, result["alice"].ID)
+	require.Equal(t, 30*time.Hour, result["bob"].TimePerWeek)
+
+	// only the grouped fields are returned
+	require.Zero(t, result["alice"].OvertimeAllowancePerMonth)
+
+	result, err = db.GetCurrentWorkTimes(ctx, time.Date(2024, time.December, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.Len(t, result, 3)
+	require.Equal(t, 20*time.Hour, result["alice"].TimePerWeek)
+
+	history, err := db.WorkTimeHistoryForStaff(ctx, "alice")
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	require.Equal(t, time.Hour, history[0].OvertimeAllowancePerMonth)
+}
+
+func Test_FindOffTimeRequests(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDatabase()
+
+	approved := &structs.OffTimeEntry{
+		RequestorId: "alice",
+		From:        time.Date(2024, time.May, 6, 0, 0, 0, 0, time.UTC),
+		To:          time.Date(2024, time.May, 10, 23, 59, 0, 0, time.UTC),
+	}
+	pending := &structs.OffTimeEntry{
+		RequestorId: "bob",
+		From:        time.Date(2024, time.May, 20, 0, 0, 0, 0, time.UTC),
+		To:          time.Date(2024, time.May, 21, 23, 59, 0, 0, time.UTC),
+	}
+
+	require.NoError(t, db.CreateOffTimeRequest(ctx, approved))
+	require.NoError(t, db.CreateOffTimeRequest(ctx, pending))
+
+	approval := &structs.Approval{Approved: true, ApproverID: "carol"}
+	require.NoError(t, db.ApproveOffTimeRequest(ctx, approved.ID.Hex(), approval))
+	require.Error(t, db.ApproveOffTimeRequest(ctx, approved.ID.Hex(), approval))
+
+	yes := true
+	no := false
+
+	res, err := db.FindOffTimeRequests(ctx, time.Time{}, time.Time{}, &yes, nil)
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	require.Equal(t, approved.ID, res[0].ID)
+
+	// requests without approval match neither approved nor rejected
+	res, err = db.FindOffTimeRequests(ctx, time.Time{}, time.Time{}, &no, nil)
+	require.NoError(t, err)
+	require.Empty(t, res)
+
+	res, err = db.FindOffTimeRequests(ctx, time.Date(2024, time.May, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, time.May, 31, 0, 0, 0, 0, time.UTC), nil, nil)
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+
+	res, err = db.FindOffTimeRequests(ctx, time.Date(2024, time.May, 8, 0, 0, 0, 0, time.UTC), time.Date(2024, time.May, 15, 0, 0, 0, 0, time.UTC), nil, nil)
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	require.Equal(t, approved.ID, res[0].ID)
+
+	res, err = db.FindOffTimeRequests(ctx, time.Time{}, time.Time{}, nil, []string{"bob"})
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	require.Equal(t, pending.ID, res[0].ID)
+
+	require.NoError(t, db.DeleteOffTimeRequest(ctx, pending.ID.Hex()))
+	require.Error(t, db.DeleteOffTimeRequest(ctx, pending.ID.Hex()))
+}
+
+func Test_WorkShiftSoftDelete(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDatabase()
+
+	shifts := []structs.WorkShift{
+		{Name: "late", Order: 2, Days: []time.Weekday{time.Monday}},
+		{Name: "early", Order: 1, Days: []time.Weekday{time.Monday, time.Tuesday}},
+		{Name: "holiday", Order: 0, Days: []time.Weekday{time.Monday}, OnHoliday: true},
+	}
+
+	for idx := range shifts {
+		require.NoError(t, db.SaveWorkShift(ctx, &shifts[idx]))
+	}
+
+	res, err := db.GetShiftsForDay(ctx, time.Monday, false)
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+	require.Equal(t, "early", res[0].Name)
+	require.Equal(t, "late", res[1].Name)
+
+	require.NoError(t, db.DeleteWorkShift(ctx, shifts[1].ID.Hex()))
+	require.ErrorIs(t, db.DeleteWorkShift(ctx, shifts[1].ID.Hex()), mongo.ErrNoDocuments)
+
+	res, err = db.GetShiftsForDay(ctx, time.Monday, false)
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+
+	// deleted shifts are still listed
+	all, err := db.ListWorkShifts(ctx)
+	require.NoError(t, err)
+	require.Len(t, all, 3)
+	require.True(t, all[1].Deleted)
+
+	// saving an unmodified shift fails like ReplaceOne without modifications
+	err = db.SaveWorkShift(ctx, &shifts[0])
+	require.ErrorIs(t, err, mongo.ErrNoDocuments)
+}
+
+func Test_UniqueIndexes(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDatabase()
+
+	require.NoError(t, db.CreateLocalHoliday(ctx, &structs.LocalHoliday{Date: "2024-12-24"}))
+	err := db.CreateLocalHoliday(ctx, &structs.LocalHoliday{Date: "2024-12-24"})
+	require.True(t, mongo.IsDuplicateKeyError(err))
+
+	require.NoError(t, db.SaveCalendarFeed(ctx, &structs.CalendarFeed{UserID: "alice", TokenHash: "a"}))
+	first, err := db.GetCalendarFeed(ctx, "alice")
+	require.NoError(t, err)
+
+	// saving the feed again revokes the previous token but keeps the ID
+	require.NoError(t, db.SaveCalendarFeed(ctx, &structs.CalendarFeed{UserID: "alice", TokenHash: "b"}))
+	second, err := db.GetCalendarFeedByToken(ctx, "b")
+	require.NoError(t, err)
+	require.Equal(t, first.ID, second.ID)
+
+	_, err = db.GetCalendarFeedByToken(ctx, "a")
+	require.True(t, errors.Is(err, mongo.ErrNoDocuments))
+
+	err = db.SaveCalendarFeed(ctx, &structs.CalendarFeed{UserID: "bob", TokenHash: "b"})
+	require.True(t, mongo.IsDuplicateKeyError(err))
+}
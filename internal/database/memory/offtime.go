@@ -0,0 +1,274 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"golang.org/x/exp/slices"
+)
+
+func objectIDs(ids []string) ([]primitive.ObjectID, error) {
+	objids := make([]primitive.ObjectID, len(ids))
+	for idx, id := range ids {
+		o, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return nil, err
+		}
+		objids[idx] = o
+	}
+
+	return objids, nil
+}
+
+func (db *Database) GetOffTimeRequest(ctx context.Context, ids ...string) ([]structs.OffTimeEntry, error) {
+	objids, err := objectIDs(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	return db.offTime.find(func(e *structs.OffTimeEntry) bool {
+		return slices.Contains(objids, e.ID)
+	})
+}
+
+func (db *Database) CreateOffTimeRequest(ctx context.Context, req *structs.OffTimeEntry) error {
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	id := req.ID
+	if id.IsZero() {
+		id = primitive.NewObjectID()
+	}
+
+	doc := *req
+	doc.ID = id
+
+	if err := db.offTime.insert(&doc); err != nil {
+		return err
+	}
+
+	req.ID = id
+
+	return nil
+}
+
+func (db *Database) UpdateOffTimeRequest(ctx context.Context, req *structs.OffTimeEntry) error {
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	idx, _, err := db.offTime.findOne(func(e *structs.OffTimeEntry) bool {
+		return e.ID == req.ID
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = db.offTime.replace(idx, req)
+
+	return err
+}
+
+func (db *Database) DeleteOffTimeRequest(ctx context.Context, ids ...string) error {
+	objids, err := objectIDs(ids)
+	if err != nil {
+		return err
+	}
+
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	count, err := db.offTime.remove(func(e *structs.OffTimeEntry) bool {
+		return slices.Contains(objids, e.ID)
+	})
+	if err != nil {
+		return err
+	}
+
+	if count != len(objids) {
+		return fmt.Errorf("failed to delete one or more offtime request")
+	}
+
+	return nil
+}
+
+func (db *Database) FindOffTimeRequests(ctx context.Context, from, to time.Time, approved *bool, staff []string) ([]structs.OffTimeEntry, error) {
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	return db.offTime.find(func(e *structs.OffTimeEntry) bool {
+		if !overlaps(from, to, e.From, e.To) {
+			return false
+		}
+
+		if approved != nil && (e.Approval == nil || e.Approval.Approved != *approved) {
+			return false
+		}
+
+		if len(staff) > 0 && !slices.Contains(staff, e.RequestorId) {
+			return false
+		}
+
+		return true
+	})
+}
+
+func (db *Database) ApproveOffTimeRequest(ctx context.Context, id string, approval *structs.Approval) error {
+	obid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	idx, e, err := db.offTime.findOne(func(e *structs.OffTimeEntry) bool {
+		return e.ID == obid
+	})
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return fmt.Errorf("failed to approved request: not found")
+		}
+
+		return err
+	}
+
+	// round-trip the approval so it is stored the same way as by $set.
+	if approval != nil {
+		blob, err := bson.Marshal(approval)
+		if err != nil {
+			return err
+		}
+
+		e.Approval = new(structs.Approval)
+		if err := bson.Unmarshal(blob, e.Approval); err != nil {
+			return err
+		}
+	} else {
+		e.Approval = nil
+	}
+
+	modified, err := db.offTime.replace(idx, e)
+	if err != nil {
+		return err
+	}
+
+	if !modified {
+		return fmt.Errorf("failed to approved request: already approved")
+	}
+
+	return nil
+}
+
+func (db *Database) AddOffTimeCost(ctx context.Context, costs *structs.OffTimeCosts) error {
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	costs.ID = primitive.NewObjectID()
+
+	return db.offTimeCosts.insert(costs)
+}
+
+func (db *Database) DeleteOffTimeCostsByRoster(ctx context.Context, rosterID string) error {
+	objID, err := primitive.ObjectIDFromHex(rosterID)
+	if err != nil {
+		return err
+	}
+
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	_, err = db.offTimeCosts.remove(func(c *structs.OffTimeCosts) bool {
+		return c.RosterID == objID
+	})
+
+	return err
+}
+
+// DeleteOffTimeCostsByRosterAndUser deletes all off-time costs that have been
+// booked for userIds when approving the roster with the given ID.
+func (db *Database) DeleteOffTimeCostsByRosterAndUser(ctx context.Context, rosterID string, userIds ...string) error {
+	objID, err := primitive.ObjectIDFromHex(rosterID)
+	if err != nil {
+		return err
+	}
+
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	_, err = db.offTimeCosts.remove(func(c *structs.OffTimeCosts) bool {
+		return c.RosterID == objID && slices.Contains(userIds, c.UserID)
+	})
+
+	return err
+}
+
+func (db *Database) DeleteOffTimeCostsByOffTime(ctx context.Context, offTimeID string) error {
+	objID, err := primitive.ObjectIDFromHex(offTimeID)
+	if err != nil {
+		return err
+	}
+
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	_, err = db.offTimeCosts.remove(func(c *structs.OffTimeCosts) bool {
+		return c.OfftimeID == objID
+	})
+
+	return err
+}
+
+func (db *Database) DeleteOffTimeCosts(ctx context.Context, ids ...string) error {
+	objids, err := objectIDs(ids)
+	if err != nil {
+		return err
+	}
+
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	count, err := db.offTimeCosts.remove(func(c *structs.OffTimeCosts) bool {
+		return slices.Contains(objids, c.ID)
+	})
+	if err != nil {
+		return err
+	}
+
+	if count != len(ids) {
+		return fmt.Errorf("failed to delete some off-time-cost entries")
+	}
+
+	return nil
+}
+
+// GetOffTimeCostsByID returns the off-time costs with the given IDs.
+func (db *Database) GetOffTimeCostsByID(ctx context.Context, ids ...string) ([]structs.OffTimeCosts, error) {
+	objids, err := objectIDs(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	return db.offTimeCosts.find(func(c *structs.OffTimeCosts) bool {
+		return slices.Contains(objids, c.ID)
+	})
+}
+
+func (db *Database) GetOffTimeCosts(ctx context.Context, user_ids ...string) ([]structs.OffTimeCosts, error) {
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	return db.offTimeCosts.find(func(c *structs.OffTimeCosts) bool {
+		return len(user_ids) == 0 || slices.Contains(user_ids, c.UserID)
+	})
+}
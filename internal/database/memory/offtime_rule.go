@@ -0,0 +1,93 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func (db *Database) CreateOffTimeRule(ctx context.Context, rule *structs.OffTimeRule) error {
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	rule.ID = primitive.NewObjectID()
+
+	return db.offTimeRules.insert(rule)
+}
+
+func (db *Database) UpdateOffTimeRule(ctx context.Context, rule *structs.OffTimeRule) error {
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	idx, _, err := db.offTimeRules.findOne(func(r *structs.OffTimeRule) bool {
+		return r.ID == rule.ID
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = db.offTimeRules.replace(idx, rule)
+
+	return err
+}
+
+func (db *Database) GetOffTimeRule(ctx context.Context, id string) (*structs.OffTimeRule, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, err
+	}
+
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	_, rule, err := db.offTimeRules.findOne(func(r *structs.OffTimeRule) bool {
+		return r.ID == oid
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+func (db *Database) DeleteOffTimeRule(ctx context.Context, id string) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	count, err := db.offTimeRules.remove(func(r *structs.OffTimeRule) bool {
+		return r.ID == oid
+	})
+	if err != nil {
+		return err
+	}
+
+	if count == 0 {
+		return mongo.ErrNoDocuments
+	}
+
+	return nil
+}
+
+func (db *Database) ListOffTimeRules(ctx context.Context) ([]structs.OffTimeRule, error) {
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	result, err := db.offTimeRules.find(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].From.Before(result[j].From)
+	})
+
+	return result, nil
+}
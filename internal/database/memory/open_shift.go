@@ -0,0 +1,93 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"golang.org/x/exp/slices"
+)
+
+func (db *Database) CreateOpenShift(ctx context.Context, os *structs.OpenShift) error {
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	os.ID = primitive.NewObjectID()
+
+	return db.openShifts.insert(os)
+}
+
+// UpdateOpenShift replaces os if it has not been modified since it has been
+// loaded and increments the version. It returns mongo.ErrNoDocuments if the
+// open shift does not exist or has been modified concurrently.
+func (db *Database) UpdateOpenShift(ctx context.Context, os *structs.OpenShift) error {
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	version := os.Version
+
+	idx, _, err := db.openShifts.findOne(func(o *structs.OpenShift) bool {
+		return o.ID == os.ID && o.Version == version
+	})
+	if err != nil {
+		return err
+	}
+
+	os.Version++
+
+	if _, err := db.openShifts.replace(idx, os); err != nil {
+		os.Version = version
+
+		return err
+	}
+
+	return nil
+}
+
+func (db *Database) GetOpenShift(ctx context.Context, id string) (*structs.OpenShift, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, err
+	}
+
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	_, os, err := db.openShifts.findOne(func(o *structs.OpenShift) bool {
+		return o.ID == oid
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return os, nil
+}
+
+func (db *Database) FindOpenShifts(ctx context.Context, rosterID string, states []structs.OpenShiftState) ([]structs.OpenShift, error) {
+	var oid primitive.ObjectID
+	if rosterID != "" {
+		var err error
+		oid, err = primitive.ObjectIDFromHex(rosterID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	result, err := db.openShifts.find(func(o *structs.OpenShift) bool {
+		return (rosterID == "" || o.RosterID == oid) &&
+			(len(states) == 0 || slices.Contains(states, o.State))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Shift.From.Before(result[j].Shift.From)
+	})
+
+	return result, nil
+}
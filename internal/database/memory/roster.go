@@ -0,0 +1,298 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"golang.org/x/exp/slices"
+)
+
+func (db *Database) SaveRosterType(ctx context.Context, model structs.RosterType) error {
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	idx, _, err := db.dutyRosterTypes.findOne(func(rt *structs.RosterType) bool {
+		return rt.UniqueName == model.UniqueName
+	})
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return db.dutyRosterTypes.insert(&model)
+		}
+
+		return err
+	}
+
+	_, err = db.dutyRosterTypes.replace(idx, &model)
+
+	return err
+}
+
+func (db *Database) DeleteRosterType(ctx context.Context, rosterTypeName string) error {
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	count, err := db.dutyRosterTypes.remove(func(rt *structs.RosterType) bool {
+		return rt.UniqueName == rosterTypeName
+	})
+	if err != nil {
+		return err
+	}
+
+	if count == 0 {
+		return mongo.ErrNoDocuments
+	}
+
+	return nil
+}
+
+func (db *Database) GetRosterType(ctx context.Context, name string) (structs.RosterType, error) {
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	_, rt, err := db.dutyRosterTypes.findOne(func(rt *structs.RosterType) bool {
+		return rt.UniqueName == name
+	})
+	if err != nil {
+		return structs.RosterType{}, err
+	}
+
+	return *rt, nil
+}
+
+func (db *Database) GetRosterTypes(ctx context.Context) ([]structs.RosterType, error) {
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	return db.dutyRosterTypes.find(nil)
+}
+
+func (db *Database) SaveDutyRoster(ctx context.Context, roster *structs.DutyRoster, casIndex *uint64) (bool, error) {
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	if roster.ID.IsZero() {
+		roster.ID = primitive.NewObjectID()
+	}
+
+	// increase roster CAS index everytime we attempt to save it
+	roster.CASIndex++
+
+	idx, _, err := db.dutyRosters.findOne(func(r *structs.DutyRoster) bool {
+		return r.ID == roster.ID && (casIndex == nil || r.CASIndex == *casIndex)
+	})
+
+	var modified bool
+	switch {
+	case err == nil:
+		modified, err = db.dutyRosters.replace(idx, roster)
+		if err != nil {
+			return false, err
+		}
+
+	case err != mongo.ErrNoDocuments:
+		return false, err
+
+	case casIndex != nil:
+		return false, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("CAS index conflict"))
+
+	default:
+		// If there's no CAS index given we upsert the roster.
+		if err := db.dutyRosters.insert(roster); err != nil {
+			return false, err
+		}
+
+		modified = true
+	}
+
+	if !modified {
+		return false, nil
+	}
+
+	// the roster has already been saved so failing to store the version
+	// snapshot is only logged.
+	if err := db.saveRosterVersion(*roster); err != nil {
+		db.logger.WithError(err).Errorf("failed to save version %d of roster %s", roster.CASIndex, roster.ID.Hex())
+	}
+
+	return true, nil
+}
+
+func (db *Database) DeleteDutyRoster(ctx context.Context, id string, supersededBy primitive.ObjectID) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	// if the roster is not superseded by a different one, we can just remove
+	// it from the collection
+	if supersededBy.IsZero() {
+		count, err := db.dutyRosters.remove(func(r *structs.DutyRoster) bool {
+			return r.ID == oid
+		})
+		if err != nil {
+			return err
+		}
+
+		if count == 0 {
+			return mongo.ErrNoDocuments
+		}
+
+		return nil
+	}
+
+	// the roster has been superseded by a new version so we only mark it as
+	// deleted.
+	idx, r, err := db.dutyRosters.findOne(func(r *structs.DutyRoster) bool {
+		return r.ID == oid
+	})
+	if err != nil {
+		return err
+	}
+
+	r.Deleted = true
+	r.SupersededBy = supersededBy
+
+	_, err = db.dutyRosters.replace(idx, r)
+
+	return err
+}
+
+func (db *Database) ApproveDutyRoster(ctx context.Context, rosterID string, approverID string) error {
+	oid, err := primitive.ObjectIDFromHex(rosterID)
+	if err != nil {
+		return err
+	}
+
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	idx, r, err := db.dutyRosters.findOne(func(r *structs.DutyRoster) bool {
+		return r.ID == oid
+	})
+	if err != nil {
+		return err
+	}
+
+	r.Approved = true
+	r.ApprovedAt = time.Now()
+	r.ApproverUserId = approverID
+
+	modified, err := db.dutyRosters.replace(idx, r)
+	if err != nil {
+		return err
+	}
+
+	if !modified {
+		return mongo.ErrNoDocuments
+	}
+
+	return nil
+}
+
+func (db *Database) DutyRosterByID(ctx context.Context, id string) (structs.DutyRoster, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return structs.DutyRoster{}, err
+	}
+
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	_, r, err := db.dutyRosters.findOne(func(r *structs.DutyRoster) bool {
+		return r.ID == oid
+	})
+	if err != nil {
+		return structs.DutyRoster{}, err
+	}
+
+	return *r, nil
+}
+
+func (db *Database) GetSupersededDutyRoster(ctx context.Context, rosterID primitive.ObjectID) (*structs.DutyRoster, error) {
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	_, r, err := db.dutyRosters.findOne(func(r *structs.DutyRoster) bool {
+		// supersededBy is omitted if empty so a zero ID never matches.
+		return !r.SupersededBy.IsZero() && r.SupersededBy == rosterID
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (db *Database) LoadDutyRosters(ctx context.Context) ([]structs.DutyRoster, error) {
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	return db.dutyRosters.find(func(r *structs.DutyRoster) bool {
+		return !r.Deleted
+	})
+}
+
+func (db *Database) FindRostersWithActiveShiftsInRange(ctx context.Context, from, to time.Time) ([]structs.DutyRoster, error) {
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	return db.dutyRosters.find(func(r *structs.DutyRoster) bool {
+		return !r.Deleted && slices.ContainsFunc(r.Shifts, func(s structs.PlannedShift) bool {
+			return overlaps(from, to, s.From, s.To)
+		})
+	})
+}
+
+func (db *Database) FindRostersWithActiveShifts(ctx context.Context, t time.Time) ([]structs.DutyRoster, error) {
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	return db.dutyRosters.find(func(r *structs.DutyRoster) bool {
+		return !r.Deleted && slices.ContainsFunc(r.Shifts, func(s structs.PlannedShift) bool {
+			return overlaps(t, time.Time{}, s.From, s.To)
+		})
+	})
+}
+
+func (db *Database) DutyRostersByTime(ctx context.Context, t time.Time) ([]structs.DutyRoster, error) {
+	// make sure we use correct hours/minutes for the from/to query
+	from := dbTime(time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location()))
+	to := dbTime(time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, -1, t.Location()))
+
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	all, err := db.dutyRosters.find(func(r *structs.DutyRoster) bool {
+		return !r.Deleted
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var result []structs.DutyRoster
+	for _, r := range all {
+		rosterFrom, err := time.Parse("2006-01-02", r.From)
+		if err != nil {
+			return nil, fmt.Errorf("roster %s: invalid from date: %w", r.ID.Hex(), err)
+		}
+
+		rosterTo, err := time.Parse("2006-01-02", r.To)
+		if err != nil {
+			return nil, fmt.Errorf("roster %s: invalid to date: %w", r.ID.Hex(), err)
+		}
+
+		if !rosterFrom.After(to) && !rosterTo.Before(from) {
+			result = append(result, r)
+		}
+	}
+
+	return result, nil
+}
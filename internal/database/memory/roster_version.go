@@ -0,0 +1,66 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"golang.org/x/exp/slices"
+)
+
+// saveRosterVersion stores a snapshot of roster. It is called by
+// SaveDutyRoster with the lock held.
+func (db *Database) saveRosterVersion(roster structs.DutyRoster) error {
+	version := structs.RosterVersion{
+		ID:       primitive.NewObjectID(),
+		RosterID: roster.ID,
+		CASIndex: roster.CASIndex,
+		SavedAt:  time.Now(),
+		Roster:   roster,
+	}
+
+	return db.rosterVersions.insert(&version)
+}
+
+// ListRosterVersions returns all stored versions of the given roster
+// documents ordered by the time they have been saved.
+func (db *Database) ListRosterVersions(ctx context.Context, rosterIds ...primitive.ObjectID) ([]structs.RosterVersion, error) {
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	result, err := db.rosterVersions.find(func(v *structs.RosterVersion) bool {
+		return slices.Contains(rosterIds, v.RosterID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		if !result[i].SavedAt.Equal(result[j].SavedAt) {
+			return result[i].SavedAt.Before(result[j].SavedAt)
+		}
+
+		return result[i].CASIndex < result[j].CASIndex
+	})
+
+	return result, nil
+}
+
+// GetRosterVersion returns the version of the roster with the given ID and
+// CAS index. It returns mongo.ErrNoDocuments if no such version has been
+// stored.
+func (db *Database) GetRosterVersion(ctx context.Context, rosterID primitive.ObjectID, casIndex uint64) (*structs.RosterVersion, error) {
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	_, version, err := db.rosterVersions.findOne(func(v *structs.RosterVersion) bool {
+		return v.RosterID == rosterID && v.CASIndex == casIndex
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return version, nil
+}
@@ -0,0 +1,105 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"golang.org/x/exp/slices"
+)
+
+func (db *Database) CreateShiftSwap(ctx context.Context, swap *structs.ShiftSwap) error {
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	swap.ID = primitive.NewObjectID()
+
+	return db.shiftSwaps.insert(swap)
+}
+
+// UpdateShiftSwap replaces swap but only if it is still in the state
+// expected. It returns mongo.ErrNoDocuments if the swap does not exist or
+// has been modified concurrently.
+func (db *Database) UpdateShiftSwap(ctx context.Context, swap *structs.ShiftSwap, expected structs.ShiftSwapState) error {
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	idx, _, err := db.shiftSwaps.findOne(func(s *structs.ShiftSwap) bool {
+		return s.ID == swap.ID && s.State == expected
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = db.shiftSwaps.replace(idx, swap)
+
+	return err
+}
+
+func (db *Database) GetShiftSwap(ctx context.Context, id string) (*structs.ShiftSwap, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, err
+	}
+
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	_, swap, err := db.shiftSwaps.findOne(func(s *structs.ShiftSwap) bool {
+		return s.ID == oid
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return swap, nil
+}
+
+func (db *Database) FindShiftSwaps(ctx context.Context, filter structs.ShiftSwapFilter) ([]structs.ShiftSwap, error) {
+	var rosterID primitive.ObjectID
+	if filter.RosterID != "" {
+		oid, err := primitive.ObjectIDFromHex(filter.RosterID)
+		if err != nil {
+			return nil, err
+		}
+
+		rosterID = oid
+	}
+
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	result, err := db.shiftSwaps.find(func(s *structs.ShiftSwap) bool {
+		if filter.RosterID != "" && s.RosterID != rosterID {
+			return false
+		}
+
+		if filter.UserID != "" || filter.IncludeOpenOffers {
+			matches := filter.UserID != "" && (s.RequestorID == filter.UserID || s.TargetUserID == filter.UserID)
+
+			if filter.IncludeOpenOffers && s.State == structs.ShiftSwapStateOffered && s.TargetUserID == "" {
+				matches = true
+			}
+
+			if !matches {
+				return false
+			}
+		}
+
+		if len(filter.States) > 0 && !slices.Contains(filter.States, s.State) {
+			return false
+		}
+
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].CreatedAt.After(result[j].CreatedAt)
+	})
+
+	return result, nil
+}
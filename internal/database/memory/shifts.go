@@ -0,0 +1,131 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"golang.org/x/exp/slices"
+)
+
+func (db *Database) SaveWorkShift(ctx context.Context, workShift *structs.WorkShift) error {
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	if workShift.ID.IsZero() {
+		workShift.ID = primitive.NewObjectID()
+
+		if err := db.shifts.insert(workShift); err != nil {
+			return fmt.Errorf("failed to insert: %w", err)
+		}
+
+		return nil
+	}
+
+	idx, _, err := db.shifts.findOne(func(ws *structs.WorkShift) bool {
+		return ws.ID == workShift.ID
+	})
+	if err != nil {
+		return fmt.Errorf("failed to replace document with id %s: %w", workShift.ID, err)
+	}
+
+	modified, err := db.shifts.replace(idx, workShift)
+	if err != nil {
+		return fmt.Errorf("failed to replace document with id %s: %w", workShift.ID, err)
+	}
+
+	if !modified {
+		return fmt.Errorf("failed to replace document with id %s: %w", workShift.ID, mongo.ErrNoDocuments)
+	}
+
+	return nil
+}
+
+func (db *Database) GetShiftsForDay(ctx context.Context, weekDay time.Weekday, isHoliday bool) ([]structs.WorkShift, error) {
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	result, err := db.shifts.find(func(ws *structs.WorkShift) bool {
+		return !ws.Deleted && ws.OnHoliday == isHoliday && slices.Contains(ws.Days, weekDay)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sortByOrder(result)
+
+	return result, nil
+}
+
+func (db *Database) DeleteWorkShift(ctx context.Context, id string) error {
+	objId, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	idx, ws, err := db.shifts.findOne(func(ws *structs.WorkShift) bool {
+		return ws.ID == objId
+	})
+	if err != nil {
+		return err
+	}
+
+	ws.Deleted = true
+
+	modified, err := db.shifts.replace(idx, ws)
+	if err != nil {
+		return err
+	}
+
+	if !modified {
+		return mongo.ErrNoDocuments
+	}
+
+	return nil
+}
+
+func (db *Database) ListWorkShifts(ctx context.Context) ([]structs.WorkShift, error) {
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	result, err := db.shifts.find(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	sortByOrder(result)
+
+	return result, nil
+}
+
+func (db *Database) GetWorkShiftById(ctx context.Context, id string) (structs.WorkShift, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return structs.WorkShift{}, err
+	}
+
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	_, ws, err := db.shifts.findOne(func(ws *structs.WorkShift) bool {
+		return ws.ID == oid
+	})
+	if err != nil {
+		return structs.WorkShift{}, err
+	}
+
+	return *ws, nil
+}
+
+func sortByOrder(list []structs.WorkShift) {
+	sort.SliceStable(list, func(i, j int) bool {
+		return list[i].Order < list[j].Order
+	})
+}
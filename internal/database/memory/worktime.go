@@ -0,0 +1,138 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"golang.org/x/exp/slices"
+)
+
+func (db *Database) SaveWorkTimePerWeek(ctx context.Context, wt *structs.WorkTime) error {
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	wt.ID = primitive.NewObjectID()
+
+	return db.worktime.insert(wt)
+}
+
+func (db *Database) UpdateWorkTime(ctx context.Context, wt *structs.WorkTime) error {
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	idx, _, err := db.worktime.findOne(func(w *structs.WorkTime) bool {
+		return w.ID == wt.ID
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = db.worktime.replace(idx, wt)
+
+	return err
+}
+
+func (db *Database) DeleteWorkTime(ctx context.Context, ids ...string) error {
+	objids, err := objectIDs(ids)
+	if err != nil {
+		return err
+	}
+
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	count, err := db.worktime.remove(func(wt *structs.WorkTime) bool {
+		return slices.Contains(objids, wt.ID)
+	})
+	if err != nil {
+		return err
+	}
+
+	if count != len(ids) {
+		return fmt.Errorf("failed to delete some work-time entries")
+	}
+
+	return nil
+}
+
+func (db *Database) WorkTimeHistoryForStaff(ctx context.Context, userID string) ([]structs.WorkTime, error) {
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	result, err := db.worktime.find(func(wt *structs.WorkTime) bool {
+		return wt.UserID == userID
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sortByApplicableFrom(result)
+
+	return result, nil
+}
+
+func (db *Database) GetWorktimeByID(ctx context.Context, id string) (*structs.WorkTime, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, err
+	}
+
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	_, wt, err := db.worktime.findOne(func(wt *structs.WorkTime) bool {
+		return wt.ID == oid
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return wt, nil
+}
+
+// GetCurrentWorkTimes returns the latest work time of each user that is
+// applicable at until. Like the aggregation of the MongoDB implementation
+// only the grouped fields are populated.
+func (db *Database) GetCurrentWorkTimes(ctx context.Context, until time.Time) (map[string]structs.WorkTime, error) {
+	if until.IsZero() {
+		until = time.Now()
+	}
+	until = dbTime(until)
+
+	db.l.Lock()
+	defer db.l.Unlock()
+
+	all, err := db.worktime.find(func(wt *structs.WorkTime) bool {
+		return !wt.ApplicableFrom.After(until)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sortByApplicableFrom(all)
+
+	var m = make(map[string]structs.WorkTime)
+	for _, wt := range all {
+		m[wt.UserID] = structs.WorkTime{
+			ID:                      wt.ID,
+			UserID:                  wt.UserID,
+			TimePerWeek:             wt.TimePerWeek,
+			ApplicableFrom:          wt.ApplicableFrom,
+			VacationWeeksPerYear:    wt.VacationWeeksPerYear,
+			EndsWith:                wt.EndsWith,
+			ExcludeFromTimeTracking: wt.ExcludeFromTimeTracking,
+		}
+	}
+
+	return m, nil
+}
+
+func sortByApplicableFrom(list []structs.WorkTime) {
+	sort.SliceStable(list, func(i, j int) bool {
+		return list[i].ApplicableFrom.Before(list[j].ApplicableFrom)
+	})
+}
@@ -217,6 +217,40 @@ func (db *DatabaseImpl) DeleteOffTimeCostsByRoster(ctx context.Context, rosterID
 	return nil
 }
 
+// DeleteOffTimeCostsByRosterAndUser deletes all off-time costs that have been
+// booked for userIds when approving the roster with the given ID.
+func (db *DatabaseImpl) DeleteOffTimeCostsByRosterAndUser(ctx context.Context, rosterID string, userIds ...string) error {
+	objID, err := primitive.ObjectIDFromHex(rosterID)
+	if err != nil {
+		return err
+	}
+
+	_, err = db.offTimeCosts.DeleteMany(ctx, bson.M{
+		"rosterId": objID,
+		"userId": bson.M{
+			"$in": userIds,
+		},
+	})
+
+	return err
+}
+
+func (db *DatabaseImpl) DeleteOffTimeCostsByOffTime(ctx context.Context, offTimeID string) error {
+	objID, err := primitive.ObjectIDFromHex(offTimeID)
+	if err != nil {
+		return err
+	}
+
+	_, err = db.offTimeCosts.DeleteMany(ctx, bson.M{
+		"offtimeId": objID,
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (db *DatabaseImpl) DeleteOffTimeCosts(ctx context.Context, ids ...string) error {
 	objids := make([]primitive.ObjectID, len(ids))
 	for idx, id := range ids {
@@ -242,6 +276,34 @@ func (db *DatabaseImpl) DeleteOffTimeCosts(ctx context.Context, ids ...string) e
 	return nil
 }
 
+// GetOffTimeCostsByID returns the off-time costs with the given IDs.
+func (db *DatabaseImpl) GetOffTimeCostsByID(ctx context.Context, ids ...string) ([]structs.OffTimeCosts, error) {
+	objids := make([]primitive.ObjectID, len(ids))
+	for idx, id := range ids {
+		o, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return nil, err
+		}
+		objids[idx] = o
+	}
+
+	res, err := db.offTimeCosts.Find(ctx, bson.M{
+		"_id": bson.M{
+			"$in": objids,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var results []structs.OffTimeCosts
+	if err := res.All(ctx, &results); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
 func (db *DatabaseImpl) DeleteWorkTime(ctx context.Context, ids ...string) error {
 	objids := make([]primitive.ObjectID, len(ids))
 	for idx, id := range ids {
@@ -0,0 +1,87 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func (db *DatabaseImpl) CreateOffTimeRule(ctx context.Context, rule *structs.OffTimeRule) error {
+	rule.ID = primitive.NewObjectID()
+	if _, err := db.offTimeRules.InsertOne(ctx, rule); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *DatabaseImpl) UpdateOffTimeRule(ctx context.Context, rule *structs.OffTimeRule) error {
+	res, err := db.offTimeRules.ReplaceOne(ctx, bson.M{"_id": rule.ID}, rule)
+	if err != nil {
+		return err
+	}
+
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+
+	return nil
+}
+
+func (db *DatabaseImpl) GetOffTimeRule(ctx context.Context, id string) (*structs.OffTimeRule, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, err
+	}
+
+	res := db.offTimeRules.FindOne(ctx, bson.M{"_id": oid})
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+
+	var rule structs.OffTimeRule
+	if err := res.Decode(&rule); err != nil {
+		return nil, err
+	}
+
+	return &rule, nil
+}
+
+func (db *DatabaseImpl) DeleteOffTimeRule(ctx context.Context, id string) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+
+	res, err := db.offTimeRules.DeleteOne(ctx, bson.M{"_id": oid})
+	if err != nil {
+		return err
+	}
+
+	if res.DeletedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+
+	return nil
+}
+
+func (db *DatabaseImpl) ListOffTimeRules(ctx context.Context) ([]structs.OffTimeRule, error) {
+	res, err := db.offTimeRules.Find(ctx, bson.M{}, options.Find().SetSort(bson.D{
+		{Key: "from", Value: 1},
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	var result []structs.OffTimeRule
+	if err := res.All(ctx, &result); err != nil {
+		return nil, fmt.Errorf("failed to decode off-time rules: %w", err)
+	}
+
+	return result, nil
+}
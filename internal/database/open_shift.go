@@ -0,0 +1,94 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func (db *DatabaseImpl) CreateOpenShift(ctx context.Context, os *structs.OpenShift) error {
+	os.ID = primitive.NewObjectID()
+	if _, err := db.openShifts.InsertOne(ctx, os); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateOpenShift replaces os if it has not been modified since it has been
+// loaded and increments the version. It returns mongo.ErrNoDocuments if the
+// open shift does not exist or has been modified concurrently.
+func (db *DatabaseImpl) UpdateOpenShift(ctx context.Context, os *structs.OpenShift) error {
+	version := os.Version
+	os.Version++
+
+	res, err := db.openShifts.ReplaceOne(ctx, bson.M{"_id": os.ID, "version": version}, os)
+	if err != nil {
+		os.Version = version
+
+		return err
+	}
+
+	if res.MatchedCount == 0 {
+		os.Version = version
+
+		return mongo.ErrNoDocuments
+	}
+
+	return nil
+}
+
+func (db *DatabaseImpl) GetOpenShift(ctx context.Context, id string) (*structs.OpenShift, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, err
+	}
+
+	res := db.openShifts.FindOne(ctx, bson.M{"_id": oid})
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+
+	var os structs.OpenShift
+	if err := res.Decode(&os); err != nil {
+		return nil, err
+	}
+
+	return &os, nil
+}
+
+func (db *DatabaseImpl) FindOpenShifts(ctx context.Context, rosterID string, states []structs.OpenShiftState) ([]structs.OpenShift, error) {
+	filter := bson.M{}
+
+	if rosterID != "" {
+		oid, err := primitive.ObjectIDFromHex(rosterID)
+		if err != nil {
+			return nil, err
+		}
+
+		filter["rosterId"] = oid
+	}
+
+	if len(states) > 0 {
+		filter["state"] = bson.M{"$in": states}
+	}
+
+	res, err := db.openShifts.Find(ctx, filter, options.Find().SetSort(bson.D{
+		{Key: "shift.from", Value: 1},
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	var result []structs.OpenShift
+	if err := res.All(ctx, &result); err != nil {
+		return nil, fmt.Errorf("failed to decode open shifts: %w", err)
+	}
+
+	return result, nil
+}
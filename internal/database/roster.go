@@ -107,6 +107,12 @@ func (db *DatabaseImpl) SaveDutyRoster(ctx context.Context, roster *structs.Duty
 		return false, nil
 	}
 
+	// the roster has already been saved so failing to store the version
+	// snapshot is only logged.
+	if err := db.saveRosterVersion(ctx, *roster); err != nil {
+		db.logger.WithError(err).Errorf("failed to save version %d of roster %s", roster.CASIndex, roster.ID.Hex())
+	}
+
 	return true, nil
 }
 
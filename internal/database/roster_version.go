@@ -0,0 +1,77 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// saveRosterVersion stores a snapshot of roster. It is called by
+// SaveDutyRoster after the roster has been saved successfully.
+func (db *DatabaseImpl) saveRosterVersion(ctx context.Context, roster structs.DutyRoster) error {
+	version := structs.RosterVersion{
+		ID:       primitive.NewObjectID(),
+		RosterID: roster.ID,
+		CASIndex: roster.CASIndex,
+		SavedAt:  time.Now(),
+		Roster:   roster,
+	}
+
+	if _, err := db.rosterVersions.InsertOne(ctx, version); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ListRosterVersions returns all stored versions of the given roster
+// documents ordered by the time they have been saved.
+func (db *DatabaseImpl) ListRosterVersions(ctx context.Context, rosterIds ...primitive.ObjectID) ([]structs.RosterVersion, error) {
+	filter := bson.M{
+		"rosterId": bson.M{
+			"$in": rosterIds,
+		},
+	}
+
+	opts := options.Find().SetSort(bson.D{
+		{Key: "savedAt", Value: 1},
+		{Key: "casIndex", Value: 1},
+	})
+
+	res, err := db.rosterVersions.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []structs.RosterVersion
+	if err := res.All(ctx, &result); err != nil {
+		return nil, fmt.Errorf("failed to decode roster versions: %w", err)
+	}
+
+	return result, nil
+}
+
+// GetRosterVersion returns the version of the roster with the given ID and
+// CAS index. It returns mongo.ErrNoDocuments if no such version has been
+// stored.
+func (db *DatabaseImpl) GetRosterVersion(ctx context.Context, rosterID primitive.ObjectID, casIndex uint64) (*structs.RosterVersion, error) {
+	res := db.rosterVersions.FindOne(ctx, bson.M{
+		"rosterId": rosterID,
+		"casIndex": casIndex,
+	})
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+
+	var version structs.RosterVersion
+	if err := res.Decode(&version); err != nil {
+		return nil, err
+	}
+
+	return &version, nil
+}
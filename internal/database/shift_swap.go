@@ -0,0 +1,108 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func (db *DatabaseImpl) CreateShiftSwap(ctx context.Context, swap *structs.ShiftSwap) error {
+	swap.ID = primitive.NewObjectID()
+	if _, err := db.shiftSwaps.InsertOne(ctx, swap); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateShiftSwap replaces swap but only if it is still in the state
+// expected. It returns mongo.ErrNoDocuments if the swap does not exist or
+// has been modified concurrently.
+func (db *DatabaseImpl) UpdateShiftSwap(ctx context.Context, swap *structs.ShiftSwap, expected structs.ShiftSwapState) error {
+	res, err := db.shiftSwaps.ReplaceOne(ctx, bson.M{"_id": swap.ID, "state": expected}, swap)
+	if err != nil {
+		return err
+	}
+
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+
+	return nil
+}
+
+func (db *DatabaseImpl) GetShiftSwap(ctx context.Context, id string) (*structs.ShiftSwap, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, err
+	}
+
+	res := db.shiftSwaps.FindOne(ctx, bson.M{"_id": oid})
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+
+	var swap structs.ShiftSwap
+	if err := res.Decode(&swap); err != nil {
+		return nil, err
+	}
+
+	return &swap, nil
+}
+
+func (db *DatabaseImpl) FindShiftSwaps(ctx context.Context, filter structs.ShiftSwapFilter) ([]structs.ShiftSwap, error) {
+	query := bson.M{}
+
+	if filter.RosterID != "" {
+		oid, err := primitive.ObjectIDFromHex(filter.RosterID)
+		if err != nil {
+			return nil, err
+		}
+
+		query["rosterId"] = oid
+	}
+
+	var or bson.A
+	if filter.UserID != "" {
+		or = append(or,
+			bson.M{"requestorId": filter.UserID},
+			bson.M{"targetUserId": filter.UserID},
+		)
+	}
+
+	if filter.IncludeOpenOffers {
+		or = append(or, bson.M{
+			"state":        structs.ShiftSwapStateOffered,
+			"targetUserId": bson.M{"$exists": false},
+		})
+	}
+
+	if len(or) > 0 {
+		query["$or"] = or
+	}
+
+	if len(filter.States) > 0 {
+		query["state"] = bson.M{"$in": filter.States}
+	}
+
+	db.dumpFilter("find shift swaps", query)
+
+	res, err := db.shiftSwaps.Find(ctx, query, options.Find().SetSort(bson.D{
+		{Key: "createdAt", Value: -1},
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	var result []structs.ShiftSwap
+	if err := res.All(ctx, &result); err != nil {
+		return nil, fmt.Errorf("failed to decode shift swaps: %w", err)
+	}
+
+	return result, nil
+}
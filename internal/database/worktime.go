@@ -63,7 +63,7 @@ func (db *DatabaseImpl) GetWorktimeByID(ctx context.Context, id string) (*struct
 	})
 
 	if res.Err() != nil {
-		return nil, err
+		return nil, res.Err()
 	}
 
 	var result structs.WorkTime
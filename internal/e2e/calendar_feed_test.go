@@ -0,0 +1,82 @@
+package e2e
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	rosterdv1 "github.com/tierklinik-dobersberg/rosterd/gen/go/rosterd/v1"
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+)
+
+func Test_CalendarFeedAudit(t *testing.T) {
+	ctx := context.Background()
+	h := newHarness(t)
+
+	auditEvents := func() []structs.AuditEvent {
+		t.Helper()
+
+		events, err := h.db.FindAuditEvents(ctx, structs.AuditEventFilter{
+			Entity:   structs.AuditEntityCalendarFeed,
+			EntityID: "alice",
+		})
+		require.NoError(t, err)
+
+		return events
+	}
+
+	requireNoToken := func(event structs.AuditEvent, token string) {
+		t.Helper()
+
+		for _, c := range event.Changes {
+			require.NotContains(t, c.Before, token)
+			require.NotContains(t, c.After, token)
+		}
+	}
+
+	feedToken := func(feedURL string) string {
+		t.Helper()
+
+		u, err := url.Parse(feedURL)
+		require.NoError(t, err)
+
+		token := u.Query().Get("token")
+		require.NotEmpty(t, token)
+
+		return token
+	}
+
+	created, err := h.calendarFeeds.CreateCalendarFeed(ctx, as(h.alice, &rosterdv1.CreateCalendarFeedRequest{}))
+	require.NoError(t, err)
+	first := feedToken(created.Msg.Url)
+
+	// a new feed replaces the previous one
+	created, err = h.calendarFeeds.CreateCalendarFeed(ctx, as(h.alice, &rosterdv1.CreateCalendarFeedRequest{}))
+	require.NoError(t, err)
+	second := feedToken(created.Msg.Url)
+
+	_, err = h.calendarFeeds.RevokeCalendarFeed(ctx, as(h.alice, &rosterdv1.RevokeCalendarFeedRequest{}))
+	require.NoError(t, err)
+
+	events := auditEvents()
+	require.Len(t, events, 3)
+
+	operations := make([]structs.AuditOperation, len(events))
+	for idx, e := range events {
+		require.Equal(t, "alice", e.ActorID)
+		require.Equal(t, []string{"alice"}, e.UserIDs)
+		require.NotEmpty(t, e.Changes)
+
+		requireNoToken(e, first)
+		requireNoToken(e, second)
+
+		operations[idx] = e.Operation
+	}
+
+	require.ElementsMatch(t, []structs.AuditOperation{
+		structs.AuditOperationCreate,
+		structs.AuditOperationUpdate,
+		structs.AuditOperationDelete,
+	}, operations)
+}
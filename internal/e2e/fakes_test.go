@@ -0,0 +1,223 @@
+package e2e
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/bufbuild/connect-go"
+	calendarv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/calendar/v1"
+	"github.com/tierklinik-dobersberg/apis/gen/go/tkd/calendar/v1/calendarv1connect"
+	eventsv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/events/v1"
+	"github.com/tierklinik-dobersberg/apis/gen/go/tkd/events/v1/eventsv1connect"
+	idmv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/idm/v1"
+	"github.com/tierklinik-dobersberg/apis/gen/go/tkd/idm/v1/idmv1connect"
+	"github.com/tierklinik-dobersberg/apis/pkg/data"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// fakeIDM implements the user, role and notify services of cis-idm. All
+// sent notifications are recorded.
+type fakeIDM struct {
+	idmv1connect.UnimplementedUserServiceHandler
+	idmv1connect.UnimplementedRoleServiceHandler
+	idmv1connect.UnimplementedNotifyServiceHandler
+
+	l             sync.Mutex
+	roles         []*idmv1.Role
+	profiles      []*idmv1.Profile
+	notifications []*idmv1.SendNotificationRequest
+
+	// listUsersErr is returned by ListUsers if set.
+	listUsersErr error
+}
+
+func (f *fakeIDM) failListUsers(err error) {
+	f.l.Lock()
+	defer f.l.Unlock()
+
+	f.listUsersErr = err
+}
+
+func (f *fakeIDM) addRole(id, name string) *idmv1.Role {
+	f.l.Lock()
+	defer f.l.Unlock()
+
+	role := &idmv1.Role{
+		Id:   id,
+		Name: name,
+	}
+	f.roles = append(f.roles, role)
+
+	return role
+}
+
+func (f *fakeIDM) addUser(id string, roles ...*idmv1.Role) *idmv1.Profile {
+	f.l.Lock()
+	defer f.l.Unlock()
+
+	profile := &idmv1.Profile{
+		User: &idmv1.User{
+			Id:       id,
+			Username: id,
+		},
+		Roles: roles,
+	}
+	f.profiles = append(f.profiles, profile)
+
+	return profile
+}
+
+// sentNotifications returns all notifications that have been sent to
+// userId.
+func (f *fakeIDM) sentNotifications(userId string) []*idmv1.SendNotificationRequest {
+	f.l.Lock()
+	defer f.l.Unlock()
+
+	var result []*idmv1.SendNotificationRequest
+	for _, n := range f.notifications {
+		if data.ElemInBothSlices(n.TargetUsers, []string{userId}) {
+			result = append(result, n)
+		}
+	}
+
+	return result
+}
+
+func (f *fakeIDM) ListUsers(_ context.Context, req *connect.Request[idmv1.ListUsersRequest]) (*connect.Response[idmv1.ListUsersResponse], error) {
+	f.l.Lock()
+	defer f.l.Unlock()
+
+	if f.listUsersErr != nil {
+		return nil, f.listUsersErr
+	}
+
+	res := &idmv1.ListUsersResponse{}
+	for _, p := range f.profiles {
+		if len(req.Msg.FilterByRoles) > 0 {
+			hasRole := data.ElemInBothSlicesFunc(req.Msg.FilterByRoles, p.Roles, func(r *idmv1.Role) string {
+				return r.Id
+			})
+
+			if !hasRole {
+				continue
+			}
+		}
+
+		res.Users = append(res.Users, proto.Clone(p).(*idmv1.Profile))
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+func (f *fakeIDM) GetUser(_ context.Context, req *connect.Request[idmv1.GetUserRequest]) (*connect.Response[idmv1.GetUserResponse], error) {
+	f.l.Lock()
+	defer f.l.Unlock()
+
+	for _, p := range f.profiles {
+		if p.User.Id == req.Msg.GetId() {
+			return connect.NewResponse(&idmv1.GetUserResponse{
+				Profile: proto.Clone(p).(*idmv1.Profile),
+			}), nil
+		}
+	}
+
+	return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("user %q not found", req.Msg.GetId()))
+}
+
+func (f *fakeIDM) GetRole(_ context.Context, req *connect.Request[idmv1.GetRoleRequest]) (*connect.Response[idmv1.GetRoleResponse], error) {
+	f.l.Lock()
+	defer f.l.Unlock()
+
+	for _, r := range f.roles {
+		if r.Id == req.Msg.GetId() || (req.Msg.GetName() != "" && r.Name == req.Msg.GetName()) {
+			return connect.NewResponse(&idmv1.GetRoleResponse{
+				Role: proto.Clone(r).(*idmv1.Role),
+			}), nil
+		}
+	}
+
+	return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("role not found"))
+}
+
+func (f *fakeIDM) SendNotification(_ context.Context, req *connect.Request[idmv1.SendNotificationRequest]) (*connect.Response[idmv1.SendNotificationResponse], error) {
+	f.l.Lock()
+	defer f.l.Unlock()
+
+	f.notifications = append(f.notifications, proto.Clone(req.Msg).(*idmv1.SendNotificationRequest))
+
+	return connect.NewResponse(&idmv1.SendNotificationResponse{}), nil
+}
+
+// fakeHolidays returns the configured public holidays, keyed by date.
+type fakeHolidays struct {
+	calendarv1connect.UnimplementedHolidayServiceHandler
+
+	l        sync.Mutex
+	holidays map[string]*calendarv1.PublicHoliday
+}
+
+func (f *fakeHolidays) add(date string) {
+	f.l.Lock()
+	defer f.l.Unlock()
+
+	if f.holidays == nil {
+		f.holidays = make(map[string]*calendarv1.PublicHoliday)
+	}
+
+	f.holidays[date] = &calendarv1.PublicHoliday{
+		Date: date,
+		Type: calendarv1.HolidayType_PUBLIC,
+	}
+}
+
+func (f *fakeHolidays) GetHoliday(_ context.Context, req *connect.Request[calendarv1.GetHolidayRequest]) (*connect.Response[calendarv1.GetHolidayResponse], error) {
+	f.l.Lock()
+	defer f.l.Unlock()
+
+	prefix := fmt.Sprintf("%04d-%02d-", req.Msg.Year, req.Msg.Month)
+
+	res := &calendarv1.GetHolidayResponse{}
+	for date, hd := range f.holidays {
+		if strings.HasPrefix(date, prefix) {
+			res.Holidays = append(res.Holidays, proto.Clone(hd).(*calendarv1.PublicHoliday))
+		}
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+// fakeEvents records all published events. Only Publish is implemented.
+type fakeEvents struct {
+	eventsv1connect.EventServiceClient
+
+	l      sync.Mutex
+	events []*eventsv1.Event
+}
+
+func (f *fakeEvents) Publish(_ context.Context, req *connect.Request[eventsv1.Event]) (*connect.Response[emptypb.Empty], error) {
+	f.l.Lock()
+	defer f.l.Unlock()
+
+	f.events = append(f.events, proto.Clone(req.Msg).(*eventsv1.Event))
+
+	return connect.NewResponse(new(emptypb.Empty)), nil
+}
+
+// published returns the number of published events with the given message
+// type.
+func (f *fakeEvents) published(msg proto.Message) int {
+	f.l.Lock()
+	defer f.l.Unlock()
+
+	var count int
+	for _, e := range f.events {
+		if e.Event.MessageIs(msg) {
+			count++
+		}
+	}
+
+	return count
+}
@@ -0,0 +1,150 @@
+// Package e2e contains end-to-end tests that run the rosterd services
+// against the in-memory datastore and fake cis-idm, calendar and event
+// services.
+package e2e
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/sirupsen/logrus"
+	idmv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/idm/v1"
+	"github.com/tierklinik-dobersberg/apis/gen/go/tkd/roster/v1/rosterv1connect"
+	"github.com/tierklinik-dobersberg/apis/pkg/auth"
+	"github.com/tierklinik-dobersberg/rosterd/gen/go/rosterd/v1/rosterdv1connect"
+	"github.com/tierklinik-dobersberg/rosterd/internal/audit"
+	"github.com/tierklinik-dobersberg/rosterd/internal/config"
+	"github.com/tierklinik-dobersberg/rosterd/internal/constraints"
+	"github.com/tierklinik-dobersberg/rosterd/internal/database/memory"
+	"github.com/tierklinik-dobersberg/rosterd/internal/holiday"
+	"github.com/tierklinik-dobersberg/rosterd/internal/services/offtime"
+	"github.com/tierklinik-dobersberg/rosterd/internal/services/roster"
+	"github.com/tierklinik-dobersberg/rosterd/internal/services/workshift"
+	"github.com/tierklinik-dobersberg/rosterd/internal/services/worktime"
+	"github.com/tierklinik-dobersberg/rosterd/internal/timecalc"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// harness serves the rosterd services on a local HTTP server. Requests
+// are authenticated using the X-Remote-* headers, see as.
+type harness struct {
+	idm      *fakeIDM
+	holidays *fakeHolidays
+	events   *fakeEvents
+	db       *memory.Database
+
+	vetRole     *idmv1.Role
+	managerRole *idmv1.Role
+
+	// alice and bob are veterinarians, manager is a roster_manager.
+	alice   *idmv1.Profile
+	bob     *idmv1.Profile
+	manager *idmv1.Profile
+
+	workShifts rosterv1connect.WorkShiftServiceClient
+	workTimes  rosterv1connect.WorkTimeServiceClient
+	offTimes   rosterv1connect.OffTimeServiceClient
+	rosters    rosterv1connect.RosterServiceClient
+
+	// rosterd and rosterdWorkTimes are the clients for the roster and
+	// work-time extensions of rosterd.
+	rosterd          rosterdv1connect.RosterServiceClient
+	rosterdWorkTimes rosterdv1connect.WorkTimeServiceClient
+	calendarFeeds    rosterdv1connect.CalendarFeedServiceClient
+}
+
+func newHarness(t *testing.T) *harness {
+	t.Helper()
+
+	h := &harness{
+		idm:      new(fakeIDM),
+		holidays: new(fakeHolidays),
+		events:   new(fakeEvents),
+		db:       memory.New(logrus.NewEntry(logrus.StandardLogger())),
+	}
+
+	h.vetRole = h.idm.addRole("role-vet", "vet")
+	h.managerRole = h.idm.addRole("role-manager", "roster_manager")
+
+	h.alice = h.idm.addUser("alice", h.vetRole)
+	h.bob = h.idm.addUser("bob", h.vetRole)
+	h.manager = h.idm.addUser("manager", h.managerRole)
+
+	holidayCache := holiday.NewCache(h.holidays, time.Hour)
+
+	p := &config.Providers{
+		Users:           h.idm,
+		Roles:           h.idm,
+		Notify:          h.idm,
+		Events:          h.events,
+		Holidays:        h.holidays,
+		HolidayCache:    holidayCache,
+		HolidayProvider: holiday.NewMerged(holidayCache, h.db),
+		Templates: fstest.MapFS{
+			"mails/dist/offtime-notification.html": {Data: []byte("{{ .Type }}")},
+		},
+		Datastore:   h.db,
+		Constraints: constraints.NewEvaluator(),
+		Audit:       audit.NewLogger(h.db),
+		Config: &config.ServiceConfig{
+			PublicURL:            "https://rosterd.example.com",
+			RosterManagerRoleID:  h.managerRole.Id,
+			Weekend:              timecalc.DefaultWeekend,
+			VacationBalanceCheck: config.VacationBalanceCheckWarn,
+		},
+	}
+
+	interceptors := connect.WithInterceptors(
+		auth.NewAuthAnnotationInterceptor(protoregistry.GlobalFiles, auth.NewIDMRoleResolver(p.Roles), auth.RemoteHeaderExtractor),
+	)
+
+	mux := http.NewServeMux()
+	mux.Handle(rosterv1connect.NewWorkShiftServiceHandler(workshift.New(p), interceptors))
+	workTimeService := worktime.New(p)
+
+	mux.Handle(rosterv1connect.NewWorkTimeServiceHandler(workTimeService, interceptors))
+	mux.Handle(rosterdv1connect.NewWorkTimeServiceHandler(workTimeService, interceptors))
+	mux.Handle(rosterv1connect.NewOffTimeServiceHandler(offtime.New(p), interceptors))
+	rosterService := roster.NewRosterService(p)
+
+	mux.Handle(rosterv1connect.NewRosterServiceHandler(rosterService, interceptors))
+	mux.Handle(rosterdv1connect.NewRosterServiceHandler(rosterService, interceptors))
+	mux.Handle(rosterdv1connect.NewCalendarFeedServiceHandler(rosterService, interceptors))
+
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	h.workShifts = rosterv1connect.NewWorkShiftServiceClient(srv.Client(), srv.URL)
+	h.workTimes = rosterv1connect.NewWorkTimeServiceClient(srv.Client(), srv.URL)
+	h.offTimes = rosterv1connect.NewOffTimeServiceClient(srv.Client(), srv.URL)
+	h.rosters = rosterv1connect.NewRosterServiceClient(srv.Client(), srv.URL)
+	h.rosterd = rosterdv1connect.NewRosterServiceClient(srv.Client(), srv.URL)
+	h.rosterdWorkTimes = rosterdv1connect.NewWorkTimeServiceClient(srv.Client(), srv.URL)
+	h.calendarFeeds = rosterdv1connect.NewCalendarFeedServiceClient(srv.Client(), srv.URL)
+
+	return h
+}
+
+// as returns a request for msg that is sent on behalf of user.
+func as[T any](user *idmv1.Profile, msg *T) *connect.Request[T] {
+	req := connect.NewRequest(msg)
+
+	req.Header().Set("X-Remote-User-ID", user.User.Id)
+	req.Header().Set("X-Remote-User", user.User.Username)
+
+	for _, role := range user.Roles {
+		req.Header().Add("X-Remote-Role", role.Id)
+	}
+
+	return req
+}
+
+// date returns midnight of the given day in the local timezone which is
+// used by all services.
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.Local)
+}
@@ -0,0 +1,161 @@
+package e2e
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/stretchr/testify/require"
+	idmv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/idm/v1"
+	rosterv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/roster/v1"
+	"github.com/tierklinik-dobersberg/rosterd/internal/services/offtime"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// vacationCredits returns the vacation credits of user at the end of 2024
+// as seen by the user.
+func (h *harness) vacationCredits(t *testing.T, user *idmv1.Profile) time.Duration {
+	t.Helper()
+
+	res, err := h.workTimes.GetVacationCreditsLeft(context.Background(), as(user, &rosterv1.GetVacationCreditsLeftRequest{
+		Until: timestamppb.New(date(2024, time.December, 31)),
+	}))
+	require.NoError(t, err)
+	require.Len(t, res.Msg.Results, 1)
+	require.Equal(t, user.User.Id, res.Msg.Results[0].UserId)
+
+	return res.Msg.Results[0].VacationCreditsLeft.AsDuration()
+}
+
+func Test_VacationRequest(t *testing.T) {
+	ctx := context.Background()
+	h := newHarness(t)
+
+	// 2024-07-10 is a wednesday
+	h.holidays.add("2024-07-10")
+
+	h.setWorkTime(t, h.alice, 40*time.Hour, 5)
+
+	credits := h.vacationCredits(t, h.alice)
+	require.Positive(t, credits)
+
+	// users may not look at the credits of other users.
+	_, err := h.workTimes.GetVacationCreditsLeft(ctx, as(h.bob, &rosterv1.GetVacationCreditsLeftRequest{
+		ForUsers: &rosterv1.SumForUsers{UserIds: []string{"alice"}},
+	}))
+	require.Error(t, err)
+
+	// alice requests vacation from monday to friday.
+	entry, err := h.offTimes.CreateOffTimeRequest(ctx, as(h.alice, &rosterv1.CreateOffTimeRequestRequest{
+		From:        timestamppb.New(date(2024, time.July, 8)),
+		To:          timestamppb.New(date(2024, time.July, 12)),
+		Description: "summer vacation",
+		RequestType: rosterv1.OffTimeType_OFF_TIME_TYPE_VACATION,
+	}))
+	require.NoError(t, err)
+	require.Equal(t, "alice", entry.Msg.Entry.RequestorId)
+	require.Nil(t, entry.Msg.Entry.Approval)
+
+	// roster managers are notified about the new request.
+	require.Eventually(t, func() bool {
+		return len(h.idm.sentNotifications("manager")) == 1
+	}, time.Second, 10*time.Millisecond)
+	require.Empty(t, h.idm.sentNotifications("bob"))
+
+	// pending requests do not cost anything.
+	require.Equal(t, credits, h.vacationCredits(t, h.alice))
+
+	// only roster managers may approve requests.
+	_, err = h.offTimes.ApproveOrReject(ctx, as(h.alice, &rosterv1.ApproveOrRejectRequest{
+		Id:   entry.Msg.Entry.Id,
+		Type: rosterv1.ApprovalRequestType_APPROVAL_REQUEST_TYPE_APPROVED,
+	}))
+	require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+	approved, err := h.offTimes.ApproveOrReject(ctx, as(h.manager, &rosterv1.ApproveOrRejectRequest{
+		Id:      entry.Msg.Entry.Id,
+		Type:    rosterv1.ApprovalRequestType_APPROVAL_REQUEST_TYPE_APPROVED,
+		Comment: "enjoy",
+	}))
+	require.NoError(t, err)
+	require.True(t, approved.Msg.Entry.Approval.Approved)
+	require.Equal(t, "manager", approved.Msg.Entry.Approval.ApproverId)
+
+	// alice receives a web-push and a mail notification.
+	require.Len(t, h.idm.sentNotifications("alice"), 2)
+
+	// the public holiday is not charged.
+	costs := h.costsByUser(t)["alice"]
+	require.Len(t, costs, 4)
+	for _, c := range costs {
+		require.Equal(t, -8*time.Hour, c.Costs.AsDuration())
+		require.True(t, c.IsVacation)
+		require.Equal(t, entry.Msg.Entry.Id, c.OfftimeId)
+		require.NotEqual(t, "2024-07-10", c.Date.AsTime().Local().Format("2006-01-02"))
+	}
+
+	require.Equal(t, credits-32*time.Hour, h.vacationCredits(t, h.alice))
+
+	// rejecting a previously approved request removes the booked costs.
+	_, err = h.offTimes.ApproveOrReject(ctx, as(h.manager, &rosterv1.ApproveOrRejectRequest{
+		Id:   entry.Msg.Entry.Id,
+		Type: rosterv1.ApprovalRequestType_APPROVAL_REQUEST_TYPE_REJECTED,
+	}))
+	require.NoError(t, err)
+
+	require.Empty(t, h.costsByUser(t)["alice"])
+	require.Equal(t, credits, h.vacationCredits(t, h.alice))
+}
+
+func Test_VacationRequestExceedingCredits(t *testing.T) {
+	ctx := context.Background()
+	h := newHarness(t)
+
+	// bob has only been employed since the beginning of the year and
+	// requests half a year of vacation.
+	h.setWorkTime(t, h.bob, 40*time.Hour, 5)
+
+	res, err := h.offTimes.CreateOffTimeRequest(ctx, as(h.bob, &rosterv1.CreateOffTimeRequestRequest{
+		From:        timestamppb.New(date(2024, time.February, 1)),
+		To:          timestamppb.New(date(2024, time.July, 31)),
+		RequestType: rosterv1.OffTimeType_OFF_TIME_TYPE_VACATION,
+	}))
+	require.NoError(t, err)
+
+	// the balance check is configured to warn only.
+	require.NotEmpty(t, res.Header().Values(offtime.WarningHeader))
+
+	_, err = h.offTimes.ApproveOrReject(ctx, as(h.manager, &rosterv1.ApproveOrRejectRequest{
+		Id:   res.Msg.Entry.Id,
+		Type: rosterv1.ApprovalRequestType_APPROVAL_REQUEST_TYPE_APPROVED,
+	}))
+	require.NoError(t, err)
+
+	require.Negative(t, h.vacationCredits(t, h.bob))
+}
+
+func Test_OffTimeRequestWithoutManagers(t *testing.T) {
+	ctx := context.Background()
+	h := newHarness(t)
+
+	h.setWorkTime(t, h.alice, 40*time.Hour, 5)
+	h.idm.failListUsers(connect.NewError(connect.CodeUnavailable, errors.New("idm unavailable")))
+
+	// failing to look up the roster managers must not affect the request.
+	_, err := h.offTimes.CreateOffTimeRequest(ctx, as(h.alice, &rosterv1.CreateOffTimeRequestRequest{
+		From:        timestamppb.New(date(2024, time.July, 8)),
+		To:          timestamppb.New(date(2024, time.July, 8)),
+		RequestType: rosterv1.OffTimeType_OFF_TIME_TYPE_TIME_OFF,
+	}))
+	require.NoError(t, err)
+
+	requests, err := h.offTimes.FindOffTimeRequests(ctx, as(h.alice, &rosterv1.FindOffTimeRequestsRequest{}))
+	require.NoError(t, err)
+	require.Len(t, requests.Msg.Results, 1)
+
+	require.Never(t, func() bool {
+		return len(h.idm.sentNotifications("manager")) > 0
+	}, 100*time.Millisecond, 10*time.Millisecond)
+}
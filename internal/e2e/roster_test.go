@@ -0,0 +1,412 @@
+package e2e
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/stretchr/testify/require"
+	idmv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/idm/v1"
+	rosterv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/roster/v1"
+	rosterdv1 "github.com/tierklinik-dobersberg/rosterd/gen/go/rosterd/v1"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (h *harness) setWorkTime(t *testing.T, user *idmv1.Profile, perWeek time.Duration, vacationWeeks float32) {
+	t.Helper()
+
+	_, err := h.workTimes.SetWorkTime(context.Background(), as(h.manager, &rosterv1.SetWorkTimeRequest{
+		WorkTimes: []*rosterv1.WorkTime{
+			{
+				UserId:               user.User.Id,
+				TimePerWeek:          durationpb.New(perWeek),
+				ApplicableAfter:      "2024-01-01",
+				VacationWeeksPerYear: vacationWeeks,
+			},
+		},
+	}))
+	require.NoError(t, err)
+}
+
+// setupRoster creates the "default" roster type and a 10 hour day shift
+// from monday to friday that may be worked by veterinarians.
+func (h *harness) setupRoster(t *testing.T) *rosterv1.WorkShift {
+	t.Helper()
+
+	ctx := context.Background()
+
+	_, err := h.rosters.CreateRosterType(ctx, as(h.manager, &rosterv1.CreateRosterTypeRequest{
+		RosterType: &rosterv1.RosterType{
+			UniqueName: "default",
+			ShiftTags:  []string{"day"},
+		},
+	}))
+	require.NoError(t, err)
+
+	res, err := h.workShifts.CreateWorkShift(ctx, as(h.manager, &rosterv1.CreateWorkShiftRequest{
+		From:               &rosterv1.Daytime{Hour: 8},
+		Duration:           durationpb.New(10 * time.Hour),
+		Days:               []int32{1, 2, 3, 4, 5},
+		Name:               "Day",
+		DisplayName:        "D",
+		EligibleRoleIds:    []string{h.vetRole.Id},
+		RequiredStaffCount: 1,
+		Tags:               []string{"day"},
+	}))
+	require.NoError(t, err)
+
+	return res.Msg.WorkShift
+}
+
+// planShifts assigns all required shifts to user.
+func planShifts(required []*rosterv1.RequiredShift, user *idmv1.Profile) []*rosterv1.PlannedShift {
+	result := make([]*rosterv1.PlannedShift, len(required))
+	for idx, r := range required {
+		result[idx] = &rosterv1.PlannedShift{
+			From:            r.From,
+			To:              r.To,
+			WorkShiftId:     r.WorkShiftId,
+			AssignedUserIds: []string{user.User.Id},
+		}
+	}
+
+	return result
+}
+
+// costsByUser returns the off-time costs of all users keyed by user ID.
+func (h *harness) costsByUser(t *testing.T) map[string][]*rosterv1.OffTimeCosts {
+	t.Helper()
+
+	res, err := h.offTimes.GetOffTimeCosts(context.Background(), as(h.manager, &rosterv1.GetOffTimeCostsRequest{}))
+	require.NoError(t, err)
+
+	result := make(map[string][]*rosterv1.OffTimeCosts)
+	for _, r := range res.Msg.Results {
+		result[r.UserId] = r.Costs
+	}
+
+	return result
+}
+
+func Test_RosterApproval(t *testing.T) {
+	ctx := context.Background()
+	h := newHarness(t)
+
+	// 2024-06-14 is a friday
+	h.holidays.add("2024-06-14")
+
+	h.setWorkTime(t, h.alice, 40*time.Hour, 5)
+	h.setWorkTime(t, h.bob, 40*time.Hour, 5)
+	shift := h.setupRoster(t)
+
+	// June 2024 has 20 working days, one of them is a public holiday.
+	required, err := h.rosters.GetRequiredShifts(ctx, as(h.manager, &rosterv1.GetRequiredShiftsRequest{
+		From:           "2024-06-01",
+		To:             "2024-06-30",
+		RosterTypeName: "default",
+	}))
+	require.NoError(t, err)
+	require.Len(t, required.Msg.RequiredShifts, 19)
+
+	for _, r := range required.Msg.RequiredShifts {
+		require.Equal(t, shift.Id, r.WorkShiftId)
+		require.ElementsMatch(t, []string{"alice", "bob"}, r.EligibleUserIds)
+		require.NotEqual(t, "2024-06-14", r.From.AsTime().Local().Format("2006-01-02"))
+	}
+
+	// alice works all shifts while bob stays at home.
+	saved, err := h.rosters.SaveRoster(ctx, as(h.manager, &rosterv1.SaveRosterRequest{
+		From:           "2024-06-01",
+		To:             "2024-06-30",
+		RosterTypeName: "default",
+		Shifts:         planShifts(required.Msg.RequiredShifts, h.alice),
+	}))
+	require.NoError(t, err)
+
+	analysis := make(map[string]*rosterv1.WorkTimeAnalysis)
+	for _, a := range saved.Msg.WorkTimeAnalysis {
+		analysis[a.UserId] = a
+	}
+	require.NotContains(t, analysis, "manager")
+	require.Equal(t, 190*time.Hour, analysis["alice"].PlannedTime.AsDuration())
+	require.Equal(t, 152*time.Hour, analysis["alice"].ExpectedTime.AsDuration())
+	require.Equal(t, 38*time.Hour, analysis["alice"].Overtime.AsDuration())
+	require.Equal(t, time.Duration(0), analysis["bob"].PlannedTime.AsDuration())
+	require.Equal(t, -152*time.Hour, analysis["bob"].Overtime.AsDuration())
+
+	// no costs are booked before the roster is approved.
+	require.Empty(t, h.costsByUser(t))
+
+	// only roster managers may approve rosters.
+	_, err = h.rosters.ApproveRoster(ctx, as(h.alice, &rosterv1.ApproveRosterRequest{
+		Id: saved.Msg.Roster.Id,
+	}))
+	require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+	// bob's undertime is split between time-off and vacation.
+	_, err = h.rosters.ApproveRoster(ctx, as(h.manager, &rosterv1.ApproveRosterRequest{
+		Id: saved.Msg.Roster.Id,
+		WorkTimeSplit: map[string]*rosterv1.ApproveRosterWorkTimeSplit{
+			"bob": {
+				UserId:   "bob",
+				TimeOff:  durationpb.New(-112 * time.Hour),
+				Vacation: durationpb.New(-40 * time.Hour),
+			},
+		},
+	}))
+	require.NoError(t, err)
+
+	costs := h.costsByUser(t)
+	require.Len(t, costs["alice"], 1)
+	require.Equal(t, 38*time.Hour, costs["alice"][0].Costs.AsDuration())
+	require.False(t, costs["alice"][0].IsVacation)
+	require.Equal(t, saved.Msg.Roster.Id, costs["alice"][0].RosterId)
+	require.Equal(t, "manager", costs["alice"][0].CreatorId)
+	require.True(t, date(2024, time.June, 1).Equal(costs["alice"][0].Date.AsTime()))
+
+	require.Len(t, costs["bob"], 2)
+	require.Equal(t, -112*time.Hour, costs["bob"][0].Costs.AsDuration())
+	require.False(t, costs["bob"][0].IsVacation)
+	require.Equal(t, -40*time.Hour, costs["bob"][1].Costs.AsDuration())
+	require.True(t, costs["bob"][1].IsVacation)
+
+	// changing an approved roster supersedes it and removes the booked
+	// costs until the new roster is approved.
+	approved, err := h.rosters.GetRoster(ctx, as(h.manager, &rosterv1.GetRosterRequest{
+		Search: &rosterv1.GetRosterRequest_Id{Id: saved.Msg.Roster.Id},
+	}))
+	require.NoError(t, err)
+	require.Len(t, approved.Msg.Roster, 1)
+	require.True(t, approved.Msg.Roster[0].Approved)
+
+	shifts := planShifts(required.Msg.RequiredShifts, h.alice)
+	shifts[0].AssignedUserIds = []string{"bob"}
+
+	updated, err := h.rosters.SaveRoster(ctx, as(h.manager, &rosterv1.SaveRosterRequest{
+		Id:       saved.Msg.Roster.Id,
+		CasIndex: approved.Msg.Roster[0].CasIndex,
+		Shifts:   shifts,
+	}))
+	require.NoError(t, err)
+	require.NotEqual(t, saved.Msg.Roster.Id, updated.Msg.Roster.Id)
+	require.False(t, updated.Msg.Roster.Approved)
+	require.Empty(t, h.costsByUser(t))
+
+	_, err = h.rosters.ApproveRoster(ctx, as(h.manager, &rosterv1.ApproveRosterRequest{
+		Id: updated.Msg.Roster.Id,
+	}))
+	require.NoError(t, err)
+
+	costs = h.costsByUser(t)
+	require.Len(t, costs["alice"], 1)
+	require.Equal(t, 28*time.Hour, costs["alice"][0].Costs.AsDuration())
+	require.Equal(t, updated.Msg.Roster.Id, costs["alice"][0].RosterId)
+	require.Len(t, costs["bob"], 1)
+	require.Equal(t, -142*time.Hour, costs["bob"][0].Costs.AsDuration())
+	require.Equal(t, updated.Msg.Roster.Id, costs["bob"][0].RosterId)
+
+	require.Eventually(t, func() bool {
+		return h.events.published(new(rosterv1.RosterChangedEvent)) == 2
+	}, time.Second, 10*time.Millisecond)
+}
+
+func Test_RosterApprovalOvertimeAllowance(t *testing.T) {
+	ctx := context.Background()
+	h := newHarness(t)
+
+	// 2024-06-14 is a friday
+	h.holidays.add("2024-06-14")
+
+	_, err := h.workTimes.SetWorkTime(ctx, as(h.manager, &rosterv1.SetWorkTimeRequest{
+		WorkTimes: []*rosterv1.WorkTime{
+			{
+				UserId:                    "alice",
+				TimePerWeek:               durationpb.New(40 * time.Hour),
+				ApplicableAfter:           "2024-01-01",
+				VacationWeeksPerYear:      5,
+				OvertimeAllowancePerMonth: durationpb.New(10 * time.Hour),
+			},
+		},
+	}))
+	require.NoError(t, err)
+	h.setupRoster(t)
+
+	required, err := h.rosters.GetRequiredShifts(ctx, as(h.manager, &rosterv1.GetRequiredShiftsRequest{
+		From:           "2024-06-01",
+		To:             "2024-06-30",
+		RosterTypeName: "default",
+	}))
+	require.NoError(t, err)
+
+	saved, err := h.rosters.SaveRoster(ctx, as(h.manager, &rosterv1.SaveRosterRequest{
+		From:           "2024-06-01",
+		To:             "2024-06-30",
+		RosterTypeName: "default",
+		Shifts:         planShifts(required.Msg.RequiredShifts, h.alice),
+	}))
+	require.NoError(t, err)
+
+	// the analysis reports the raw overtime
+	var analysis *rosterv1.WorkTimeAnalysis
+	for _, a := range saved.Msg.WorkTimeAnalysis {
+		if a.UserId == "alice" {
+			analysis = a
+		}
+	}
+	require.NotNil(t, analysis)
+	require.Equal(t, 38*time.Hour, analysis.Overtime.AsDuration())
+
+	overtime, err := h.rosterd.AnalyzeOvertime(ctx, as(h.manager, &rosterdv1.AnalyzeOvertimeRequest{
+		Users:            &rosterv1.UsersToAnalyze{UserIds: []string{"alice"}},
+		From:             "2024-06-01",
+		To:               "2024-06-30",
+		TimeTrackingOnly: true,
+	}))
+	require.NoError(t, err)
+	require.Len(t, overtime.Msg.Results, 1)
+	require.Equal(t, 38*time.Hour, overtime.Msg.Results[0].RawOvertime.AsDuration())
+	require.Equal(t, 28*time.Hour, overtime.Msg.Results[0].Overtime.AsDuration())
+
+	// overtime within the allowance is already paid and not booked.
+	_, err = h.rosters.ApproveRoster(ctx, as(h.manager, &rosterv1.ApproveRosterRequest{
+		Id: saved.Msg.Roster.Id,
+	}))
+	require.NoError(t, err)
+
+	costs := h.costsByUser(t)
+	require.Len(t, costs["alice"], 1)
+	require.Equal(t, 28*time.Hour, costs["alice"][0].Costs.AsDuration())
+}
+
+func Test_UnderstaffedRosterBlocksApproval(t *testing.T) {
+	ctx := context.Background()
+	h := newHarness(t)
+
+	h.setWorkTime(t, h.alice, 40*time.Hour, 5)
+	h.setupRoster(t)
+
+	required, err := h.rosters.GetRequiredShifts(ctx, as(h.manager, &rosterv1.GetRequiredShiftsRequest{
+		From:           "2024-06-01",
+		To:             "2024-06-30",
+		RosterTypeName: "default",
+	}))
+	require.NoError(t, err)
+
+	// nobody is assigned to the first shift of the month.
+	shifts := planShifts(required.Msg.RequiredShifts, h.alice)
+	shifts[0].AssignedUserIds = nil
+
+	saved, err := h.rosters.SaveRoster(ctx, as(h.manager, &rosterv1.SaveRosterRequest{
+		From:           "2024-06-01",
+		To:             "2024-06-30",
+		RosterTypeName: "default",
+		Shifts:         shifts,
+	}))
+	require.NoError(t, err)
+
+	validation, err := h.rosterd.ValidateRoster(ctx, as(h.manager, &rosterdv1.ValidateRosterRequest{
+		Roster: &rosterdv1.ValidateRosterRequest_RosterId{RosterId: saved.Msg.Roster.Id},
+	}))
+	require.NoError(t, err)
+	require.True(t, validation.Msg.HasBlockingErrors)
+	require.Len(t, validation.Msg.Findings, 1)
+	require.Equal(t, rosterdv1.FindingKind_FINDING_KIND_UNDERSTAFFED, validation.Msg.Findings[0].Kind)
+	require.Equal(t, rosterdv1.FindingSeverity_FINDING_SEVERITY_ERROR, validation.Msg.Findings[0].Severity)
+
+	res, err := h.rosterd.ValidateAndApproveRoster(ctx, as(h.manager, &rosterdv1.ValidateAndApproveRosterRequest{
+		Approval: &rosterv1.ApproveRosterRequest{Id: saved.Msg.Roster.Id},
+	}))
+	require.NoError(t, err)
+	require.False(t, res.Msg.Approved)
+
+	res, err = h.rosterd.ValidateAndApproveRoster(ctx, as(h.manager, &rosterdv1.ValidateAndApproveRosterRequest{
+		Approval: &rosterv1.ApproveRosterRequest{Id: saved.Msg.Roster.Id},
+		Force:    true,
+	}))
+	require.NoError(t, err)
+	require.True(t, res.Msg.Approved)
+}
+
+func Test_RosterApprovalDeductsOffTime(t *testing.T) {
+	ctx := context.Background()
+	h := newHarness(t)
+
+	h.setWorkTime(t, h.alice, 40*time.Hour, 5)
+	h.setWorkTime(t, h.bob, 40*time.Hour, 5)
+	h.setupRoster(t)
+
+	// bob is on vacation during the first week of June.
+	entry, err := h.offTimes.CreateOffTimeRequest(ctx, as(h.bob, &rosterv1.CreateOffTimeRequestRequest{
+		From:        timestamppb.New(date(2024, time.June, 3)),
+		To:          timestamppb.New(date(2024, time.June, 7).Add(23*time.Hour + 59*time.Minute)),
+		RequestType: rosterv1.OffTimeType_OFF_TIME_TYPE_VACATION,
+	}))
+	require.NoError(t, err)
+
+	_, err = h.offTimes.ApproveOrReject(ctx, as(h.manager, &rosterv1.ApproveOrRejectRequest{
+		Id:   entry.Msg.Entry.Id,
+		Type: rosterv1.ApprovalRequestType_APPROVAL_REQUEST_TYPE_APPROVED,
+	}))
+	require.NoError(t, err)
+
+	required, err := h.rosters.GetRequiredShifts(ctx, as(h.manager, &rosterv1.GetRequiredShiftsRequest{
+		From:           "2024-06-01",
+		To:             "2024-06-30",
+		RosterTypeName: "default",
+	}))
+	require.NoError(t, err)
+	require.Len(t, required.Msg.RequiredShifts, 20)
+
+	// bob is not eligible while being on vacation.
+	for _, r := range required.Msg.RequiredShifts {
+		day := r.From.AsTime().Local()
+
+		if day.Before(date(2024, time.June, 8)) {
+			require.Equal(t, []string{"alice"}, r.EligibleUserIds, day.String())
+		} else {
+			require.ElementsMatch(t, []string{"alice", "bob"}, r.EligibleUserIds, day.String())
+		}
+	}
+
+	// alice works the first 6 shifts (60h), bob the remaining 14 (140h).
+	shifts := append(
+		planShifts(required.Msg.RequiredShifts[:6], h.alice),
+		planShifts(required.Msg.RequiredShifts[6:], h.bob)...,
+	)
+
+	saved, err := h.rosters.SaveRoster(ctx, as(h.manager, &rosterv1.SaveRosterRequest{
+		From:           "2024-06-01",
+		To:             "2024-06-30",
+		RosterTypeName: "default",
+		Shifts:         shifts,
+	}))
+	require.NoError(t, err)
+
+	_, err = h.rosters.ApproveRoster(ctx, as(h.manager, &rosterv1.ApproveRosterRequest{
+		Id: saved.Msg.Roster.Id,
+	}))
+	require.NoError(t, err)
+
+	// the vacation of bob has already been booked and must not be booked
+	// again as undertime.
+	costs := h.costsByUser(t)
+	require.Len(t, costs["alice"], 1)
+	require.Equal(t, -100*time.Hour, costs["alice"][0].Costs.AsDuration())
+
+	require.Len(t, costs["bob"], 6)
+
+	var vacation, timeOff time.Duration
+	for _, c := range costs["bob"] {
+		if c.IsVacation {
+			require.Equal(t, entry.Msg.Entry.Id, c.OfftimeId)
+			vacation += c.Costs.AsDuration()
+		} else {
+			require.Equal(t, saved.Msg.Roster.Id, c.RosterId)
+			timeOff += c.Costs.AsDuration()
+		}
+	}
+	require.Equal(t, -40*time.Hour, vacation)
+	require.Equal(t, 20*time.Hour, timeOff)
+}
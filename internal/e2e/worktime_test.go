@@ -0,0 +1,88 @@
+package e2e
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/stretchr/testify/require"
+	rosterv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/roster/v1"
+	rosterdv1 "github.com/tierklinik-dobersberg/rosterd/gen/go/rosterd/v1"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func Test_SetWorkTimeSchedule(t *testing.T) {
+	ctx := context.Background()
+	h := newHarness(t)
+
+	h.setWorkTime(t, h.alice, 40*time.Hour, 5)
+
+	res, err := h.workTimes.GetWorkTime(ctx, as(h.manager, &rosterv1.GetWorkTimeRequest{
+		UserIds: []string{"alice"},
+	}))
+	require.NoError(t, err)
+	require.Len(t, res.Msg.Results, 1)
+
+	current := res.Msg.Results[0].Current
+
+	schedule := &rosterdv1.WorkTimeSchedule{
+		Days: []int32{1, 2, 3, 4},
+		TimePerWeekday: map[int32]*durationpb.Duration{
+			1: durationpb.New(10 * time.Hour),
+			2: durationpb.New(10 * time.Hour),
+			3: durationpb.New(10 * time.Hour),
+			4: durationpb.New(10 * time.Hour),
+		},
+	}
+
+	// the schedule must start after the work-time it is based on
+	_, err = h.rosterdWorkTimes.SetWorkTimeSchedule(ctx, as(h.manager, &rosterdv1.SetWorkTimeScheduleRequest{
+		WorkTimeId:      current.Id,
+		Schedule:        schedule,
+		ApplicableAfter: "2024-01-01",
+	}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	// time per weekday may only be set for scheduled days
+	_, err = h.rosterdWorkTimes.SetWorkTimeSchedule(ctx, as(h.manager, &rosterdv1.SetWorkTimeScheduleRequest{
+		WorkTimeId: current.Id,
+		Schedule: &rosterdv1.WorkTimeSchedule{
+			Days: []int32{1, 2, 3},
+			TimePerWeekday: map[int32]*durationpb.Duration{
+				1: durationpb.New(10 * time.Hour),
+				2: durationpb.New(10 * time.Hour),
+				3: durationpb.New(10 * time.Hour),
+				5: durationpb.New(10 * time.Hour),
+			},
+		},
+		ApplicableAfter: "2024-07-01",
+	}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	created, err := h.rosterdWorkTimes.SetWorkTimeSchedule(ctx, as(h.manager, &rosterdv1.SetWorkTimeScheduleRequest{
+		WorkTimeId:      current.Id,
+		Schedule:        schedule,
+		ApplicableAfter: "2024-07-01",
+	}))
+	require.NoError(t, err)
+	require.NotEqual(t, current.Id, created.Msg.WorkTime.Id)
+	require.Equal(t, "2024-07-01", created.Msg.WorkTime.ApplicableAfter)
+	require.Equal(t, 40*time.Hour, created.Msg.WorkTime.TimePerWeek.AsDuration())
+	require.Equal(t, current.VacationWeeksPerYear, created.Msg.WorkTime.VacationWeeksPerYear)
+	require.Equal(t, []int32{1, 2, 3, 4}, created.Msg.Schedule.Days)
+
+	// the previous work-time keeps its schedule
+	previous, err := h.rosterdWorkTimes.GetWorkTimeSchedule(ctx, as(h.manager, &rosterdv1.GetWorkTimeScheduleRequest{
+		WorkTimeId: current.Id,
+	}))
+	require.NoError(t, err)
+	require.Empty(t, previous.Msg.Schedule.Days)
+	require.Empty(t, previous.Msg.Schedule.TimePerWeekday)
+
+	res, err = h.workTimes.GetWorkTime(ctx, as(h.manager, &rosterv1.GetWorkTimeRequest{
+		UserIds: []string{"alice"},
+	}))
+	require.NoError(t, err)
+	require.Len(t, res.Msg.Results[0].History, 2)
+}
@@ -0,0 +1,140 @@
+// Package holiday provides cached access to the public holidays of the
+// calendar service.
+package holiday
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	calendarv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/calendar/v1"
+	"github.com/tierklinik-dobersberg/apis/gen/go/tkd/calendar/v1/calendarv1connect"
+	"github.com/tierklinik-dobersberg/apis/pkg/log"
+	"golang.org/x/sync/singleflight"
+)
+
+// Provider returns all holidays between two dates indexed by their date
+// (YYYY-MM-DD).
+type Provider interface {
+	For(ctx context.Context, from, to time.Time) (Holidays, error)
+}
+
+type cacheEntry struct {
+	holidays  Holidays
+	fetchedAt time.Time
+}
+
+// Cache is a Provider that fetches holidays per month from the calendar
+// service and caches them for a configurable time.
+type Cache struct {
+	client calendarv1connect.HolidayServiceClient
+	ttl    time.Duration
+
+	// now is replaced in tests.
+	now func() time.Time
+
+	group singleflight.Group
+
+	rw     sync.RWMutex
+	months map[string]cacheEntry
+}
+
+var _ Provider = (*Cache)(nil)
+
+// NewCache returns a new holiday cache that keeps holidays for ttl. A ttl of
+// zero disables caching.
+func NewCache(client calendarv1connect.HolidayServiceClient, ttl time.Duration) *Cache {
+	return &Cache{
+		client: client,
+		ttl:    ttl,
+		now:    time.Now,
+		months: make(map[string]cacheEntry),
+	}
+}
+
+// For returns all holidays between from and to. Holidays are fetched for
+// every month touched by the range.
+func (cache *Cache) For(ctx context.Context, from, to time.Time) (Holidays, error) {
+	from = from.Local()
+	to = to.Local()
+
+	result := make(Holidays)
+
+	last := time.Date(to.Year(), to.Month(), 1, 0, 0, 0, 0, time.Local)
+	for iter := time.Date(from.Year(), from.Month(), 1, 0, 0, 0, 0, time.Local); !iter.After(last); iter = iter.AddDate(0, 1, 0) {
+		month, err := cache.month(ctx, iter)
+		if err != nil {
+			return nil, err
+		}
+
+		for key, value := range month {
+			result[key] = value
+		}
+	}
+
+	return result, nil
+}
+
+// Invalidate removes the given months from the cache. If no month is
+// specified, the whole cache is cleared.
+func (cache *Cache) Invalidate(months ...time.Time) {
+	cache.rw.Lock()
+	defer cache.rw.Unlock()
+
+	if len(months) == 0 {
+		cache.months = make(map[string]cacheEntry)
+
+		return
+	}
+
+	for _, m := range months {
+		delete(cache.months, m.Local().Format("2006-01"))
+	}
+}
+
+func (cache *Cache) month(ctx context.Context, month time.Time) (Holidays, error) {
+	key := month.Format("2006-01")
+
+	cache.rw.RLock()
+	entry, ok := cache.months[key]
+	cache.rw.RUnlock()
+
+	if ok && cache.now().Sub(entry.fetchedAt) < cache.ttl {
+		return entry.holidays, nil
+	}
+
+	res, err, _ := cache.group.Do(key, func() (any, error) {
+		log.L(ctx).Debug("holiday cache miss, fetching ...", "month", key)
+
+		res, err := cache.client.GetHoliday(ctx, connect.NewRequest(&calendarv1.GetHolidayRequest{
+			Year:  uint64(month.Year()),
+			Month: uint64(month.Month()),
+		}))
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch holidays for %s: %w", key, err)
+		}
+
+		holidays := make(Holidays, len(res.Msg.Holidays))
+		for _, hd := range res.Msg.Holidays {
+			holidays[hd.Date] = FromPublicHoliday(hd)
+		}
+
+		if cache.ttl > 0 {
+			cache.rw.Lock()
+			cache.months[key] = cacheEntry{
+				holidays:  holidays,
+				fetchedAt: cache.now(),
+			}
+			cache.rw.Unlock()
+		}
+
+		return holidays, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(Holidays), nil
+}
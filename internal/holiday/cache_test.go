@@ -0,0 +1,190 @@
+package holiday
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/stretchr/testify/require"
+	calendarv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/calendar/v1"
+	"github.com/tierklinik-dobersberg/apis/gen/go/tkd/calendar/v1/calendarv1connect"
+)
+
+type fakeHolidayClient struct {
+	calendarv1connect.UnimplementedHolidayServiceHandler
+
+	l     sync.Mutex
+	calls map[string]int
+	err   error
+}
+
+func (f *fakeHolidayClient) GetHoliday(_ context.Context, req *connect.Request[calendarv1.GetHolidayRequest]) (*connect.Response[calendarv1.GetHolidayResponse], error) {
+	f.l.Lock()
+	defer f.l.Unlock()
+
+	key := fmt.Sprintf("%04d-%02d", req.Msg.Year, req.Msg.Month)
+	if f.calls == nil {
+		f.calls = make(map[string]int)
+	}
+	f.calls[key]++
+
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	// every month has a single public holiday on the 15th.
+	return connect.NewResponse(&calendarv1.GetHolidayResponse{
+		Holidays: []*calendarv1.PublicHoliday{
+			{
+				Date: key + "-15",
+				Type: calendarv1.HolidayType_PUBLIC,
+			},
+		},
+	}), nil
+}
+
+func (f *fakeHolidayClient) callCount() map[string]int {
+	f.l.Lock()
+	defer f.l.Unlock()
+
+	result := make(map[string]int, len(f.calls))
+	for key, value := range f.calls {
+		result[key] = value
+	}
+
+	return result
+}
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.Local)
+}
+
+func Test_Cache_For(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("single month", func(t *testing.T) {
+		client := &fakeHolidayClient{}
+		cache := NewCache(client, time.Hour)
+
+		result, err := cache.For(ctx, date(2024, time.May, 1), date(2024, time.May, 31))
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		require.Contains(t, result, "2024-05-15")
+		require.Equal(t, map[string]int{"2024-05": 1}, client.callCount())
+	})
+
+	t.Run("multiple months", func(t *testing.T) {
+		client := &fakeHolidayClient{}
+		cache := NewCache(client, time.Hour)
+
+		result, err := cache.For(ctx, date(2024, time.March, 20), date(2024, time.June, 2))
+		require.NoError(t, err)
+		require.Len(t, result, 4)
+		require.Equal(t, map[string]int{
+			"2024-03": 1,
+			"2024-04": 1,
+			"2024-05": 1,
+			"2024-06": 1,
+		}, client.callCount())
+	})
+
+	t.Run("across years", func(t *testing.T) {
+		client := &fakeHolidayClient{}
+		cache := NewCache(client, time.Hour)
+
+		result, err := cache.For(ctx, date(2023, time.November, 30), date(2024, time.February, 1))
+		require.NoError(t, err)
+		require.Len(t, result, 4)
+		require.Contains(t, result, "2023-11-15")
+		require.Contains(t, result, "2023-12-15")
+		require.Contains(t, result, "2024-01-15")
+		require.Contains(t, result, "2024-02-15")
+	})
+
+	t.Run("same month in different years", func(t *testing.T) {
+		client := &fakeHolidayClient{}
+		cache := NewCache(client, time.Hour)
+
+		result, err := cache.For(ctx, date(2023, time.May, 1), date(2024, time.May, 1))
+		require.NoError(t, err)
+		require.Len(t, result, 13)
+	})
+
+	t.Run("errors are returned", func(t *testing.T) {
+		client := &fakeHolidayClient{err: errors.New("unavailable")}
+		cache := NewCache(client, time.Hour)
+
+		_, err := cache.For(ctx, date(2024, time.May, 1), date(2024, time.May, 31))
+		require.Error(t, err)
+
+		// failed lookups are not cached
+		client.err = nil
+		_, err = cache.For(ctx, date(2024, time.May, 1), date(2024, time.May, 31))
+		require.NoError(t, err)
+		require.Equal(t, map[string]int{"2024-05": 2}, client.callCount())
+	})
+}
+
+func Test_Cache_TTL(t *testing.T) {
+	ctx := context.Background()
+
+	client := &fakeHolidayClient{}
+	cache := NewCache(client, time.Hour)
+
+	now := date(2024, time.May, 1)
+	cache.now = func() time.Time { return now }
+
+	_, err := cache.For(ctx, date(2024, time.May, 1), date(2024, time.June, 30))
+	require.NoError(t, err)
+
+	// cache hit
+	now = now.Add(30 * time.Minute)
+	_, err = cache.For(ctx, date(2024, time.May, 1), date(2024, time.May, 31))
+	require.NoError(t, err)
+	require.Equal(t, map[string]int{"2024-05": 1, "2024-06": 1}, client.callCount())
+
+	// expired
+	now = now.Add(time.Hour)
+	_, err = cache.For(ctx, date(2024, time.May, 1), date(2024, time.May, 31))
+	require.NoError(t, err)
+	require.Equal(t, map[string]int{"2024-05": 2, "2024-06": 1}, client.callCount())
+}
+
+func Test_Cache_Disabled(t *testing.T) {
+	ctx := context.Background()
+
+	client := &fakeHolidayClient{}
+	cache := NewCache(client, 0)
+
+	for i := 0; i < 3; i++ {
+		_, err := cache.For(ctx, date(2024, time.May, 1), date(2024, time.May, 31))
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, map[string]int{"2024-05": 3}, client.callCount())
+}
+
+func Test_Cache_Invalidate(t *testing.T) {
+	ctx := context.Background()
+
+	client := &fakeHolidayClient{}
+	cache := NewCache(client, time.Hour)
+
+	load := func() {
+		_, err := cache.For(ctx, date(2024, time.May, 1), date(2024, time.June, 30))
+		require.NoError(t, err)
+	}
+
+	load()
+	cache.Invalidate(date(2024, time.June, 10))
+	load()
+	require.Equal(t, map[string]int{"2024-05": 1, "2024-06": 2}, client.callCount())
+
+	cache.Invalidate()
+	load()
+	require.Equal(t, map[string]int{"2024-05": 2, "2024-06": 3}, client.callCount())
+}
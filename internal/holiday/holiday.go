@@ -0,0 +1,132 @@
+package holiday
+
+import (
+	"time"
+
+	calendarv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/calendar/v1"
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+)
+
+// Holiday is a public holiday of the calendar service or a clinic specific
+// holiday from the local holiday store.
+type Holiday struct {
+	*calendarv1.PublicHoliday
+
+	// Local is set if the holiday is defined in the local holiday store.
+	Local *structs.LocalHoliday
+
+	// WorkDay is set if the day still counts as a working day when
+	// calculating the expected work time.
+	WorkDay bool
+
+	// WorkdayFraction is the part of a regular working day that is expected
+	// on the holiday. It is zero if WorkDay is not set.
+	WorkdayFraction float64
+
+	// HolidayShifts is set if work-shifts marked with OnHoliday apply.
+	HolidayShifts bool
+}
+
+// FromPublicHoliday wraps a holiday of the calendar service. Only holidays
+// of type PUBLIC are days off, work-shifts marked with OnHoliday apply to
+// all of them.
+func FromPublicHoliday(hd *calendarv1.PublicHoliday) *Holiday {
+	workDay := hd.Type != calendarv1.HolidayType_PUBLIC
+
+	fraction := 0.0
+	if workDay {
+		fraction = 1
+	}
+
+	return &Holiday{
+		PublicHoliday:   hd,
+		WorkDay:         workDay,
+		WorkdayFraction: fraction,
+		HolidayShifts:   true,
+	}
+}
+
+// FromLocalHoliday wraps a holiday of the local holiday store.
+func FromLocalHoliday(local structs.LocalHoliday) *Holiday {
+	hdType := calendarv1.HolidayType_PUBLIC
+	fraction := 0.0
+	if local.CountsAsWorkday {
+		hdType = calendarv1.HolidayType_OBSERVANCE
+
+		fraction = local.WorkdayFraction
+		if fraction <= 0 {
+			fraction = 1
+		}
+	}
+
+	return &Holiday{
+		PublicHoliday: &calendarv1.PublicHoliday{
+			Date:      local.Date,
+			LocalName: local.Name,
+			Name:      local.Name,
+			Type:      hdType,
+		},
+		Local:           &local,
+		WorkDay:         local.CountsAsWorkday,
+		WorkdayFraction: fraction,
+		HolidayShifts:   local.HolidayShifts,
+	}
+}
+
+// Holidays holds holidays indexed by their date (YYYY-MM-DD).
+type Holidays map[string]*Holiday
+
+// FromPublicHolidays converts a map of calendar service holidays.
+func FromPublicHolidays(m map[string]*calendarv1.PublicHoliday) Holidays {
+	result := make(Holidays, len(m))
+	for key, hd := range m {
+		result[key] = FromPublicHoliday(hd)
+	}
+
+	return result
+}
+
+// Get returns the holiday at the date of t, if any.
+func (h Holidays) Get(t time.Time) (*Holiday, bool) {
+	hd, ok := h[t.Format("2006-01-02")]
+
+	return hd, ok
+}
+
+// IsDayOff returns true if the date of t is a holiday that does not count
+// as a working day.
+func (h Holidays) IsDayOff(t time.Time) bool {
+	hd, ok := h.Get(t)
+
+	return ok && !hd.WorkDay
+}
+
+// WorkdayFraction returns the part of a regular working day that is
+// expected at the date of t. Days that are not holidays are full working
+// days while days off are not working days at all.
+func (h Holidays) WorkdayFraction(t time.Time) float64 {
+	hd, ok := h.Get(t)
+	if !ok {
+		return 1
+	}
+
+	return hd.WorkdayFraction
+}
+
+// HolidayShifts returns true if work-shifts marked with OnHoliday apply at
+// the date of t.
+func (h Holidays) HolidayShifts(t time.Time) bool {
+	hd, ok := h.Get(t)
+
+	return ok && hd.HolidayShifts
+}
+
+// Public returns the calendar representation of the holiday at the date of
+// t or nil.
+func (h Holidays) Public(t time.Time) *calendarv1.PublicHoliday {
+	if hd, ok := h.Get(t); ok {
+		return hd.PublicHoliday
+	}
+
+	return nil
+}
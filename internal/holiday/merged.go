@@ -0,0 +1,68 @@
+package holiday
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+)
+
+// LocalStore returns the clinic specific holidays between two dates
+// (inclusive, YYYY-MM-DD).
+type LocalStore interface {
+	ListLocalHolidays(ctx context.Context, from, to string) ([]structs.LocalHoliday, error)
+}
+
+// Merged is a Provider that merges the holidays of another provider with
+// the holidays of the local holiday store. Local holidays take precedence.
+// Local holidays are not cached so changes apply immediately.
+type Merged struct {
+	provider Provider
+	store    LocalStore
+}
+
+var _ Provider = (*Merged)(nil)
+
+// NewMerged returns a provider that merges the holidays of provider with the
+// local holidays from store.
+func NewMerged(provider Provider, store LocalStore) *Merged {
+	return &Merged{
+		provider: provider,
+		store:    store,
+	}
+}
+
+// For returns all holidays between from and to.
+func (m *Merged) For(ctx context.Context, from, to time.Time) (Holidays, error) {
+	result, err := m.provider.For(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	// the provider covers whole months so do the same for local holidays.
+	from = from.Local()
+	to = to.Local()
+	first := time.Date(from.Year(), from.Month(), 1, 0, 0, 0, 0, time.Local)
+	last := time.Date(to.Year(), to.Month()+1, 0, 0, 0, 0, 0, time.Local)
+
+	local, err := m.store.ListLocalHolidays(ctx, first.Format("2006-01-02"), last.Format("2006-01-02"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load local holidays: %w", err)
+	}
+
+	if len(local) == 0 {
+		return result, nil
+	}
+
+	merged := make(Holidays, len(result)+len(local))
+	for key, value := range result {
+		merged[key] = value
+	}
+
+	for _, l := range local {
+		merged[l.Date] = FromLocalHoliday(l)
+	}
+
+	return merged, nil
+}
@@ -0,0 +1,89 @@
+package holiday
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	calendarv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/calendar/v1"
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+)
+
+type fakeLocalStore struct {
+	holidays []structs.LocalHoliday
+	from, to string
+	err      error
+}
+
+func (f *fakeLocalStore) ListLocalHolidays(_ context.Context, from, to string) ([]structs.LocalHoliday, error) {
+	f.from, f.to = from, to
+
+	return f.holidays, f.err
+}
+
+func Test_Merged_For(t *testing.T) {
+	ctx := context.Background()
+
+	store := &fakeLocalStore{
+		holidays: []structs.LocalHoliday{
+			{Date: "2024-05-15", Name: "Betriebsurlaub", HolidayShifts: false},
+			{Date: "2024-05-24", Name: "Halbtag", CountsAsWorkday: true, WorkdayFraction: 0.5, HolidayShifts: true},
+		},
+	}
+
+	merged := NewMerged(NewCache(&fakeHolidayClient{}, time.Hour), store)
+
+	result, err := merged.For(ctx, date(2024, time.May, 10), date(2024, time.June, 3))
+	require.NoError(t, err)
+
+	// local holidays are loaded for all months covered by the range
+	require.Equal(t, "2024-05-01", store.from)
+	require.Equal(t, "2024-06-30", store.to)
+
+	require.Len(t, result, 3)
+
+	// local holidays take precedence over public holidays
+	closure := result["2024-05-15"]
+	require.NotNil(t, closure.Local)
+	require.Equal(t, "Betriebsurlaub", closure.LocalName)
+	require.True(t, result.IsDayOff(date(2024, time.May, 15)))
+	require.False(t, result.HolidayShifts(date(2024, time.May, 15)))
+
+	require.Equal(t, 0.0, result.WorkdayFraction(date(2024, time.May, 15)))
+
+	require.False(t, result.IsDayOff(date(2024, time.May, 24)))
+	require.True(t, result.HolidayShifts(date(2024, time.May, 24)))
+	require.Equal(t, 0.5, result.WorkdayFraction(date(2024, time.May, 24)))
+
+	// public holidays of the calendar service
+	require.Nil(t, result["2024-06-15"].Local)
+	require.True(t, result.IsDayOff(date(2024, time.June, 15)))
+	require.True(t, result.HolidayShifts(date(2024, time.June, 15)))
+
+	store.err = errors.New("database unavailable")
+	_, err = merged.For(ctx, date(2024, time.May, 10), date(2024, time.June, 3))
+	require.Error(t, err)
+}
+
+func Test_FromPublicHoliday(t *testing.T) {
+	holidays := FromPublicHolidays(map[string]*calendarv1.PublicHoliday{
+		"2024-05-01": {Date: "2024-05-01", Type: calendarv1.HolidayType_PUBLIC},
+		"2024-05-02": {Date: "2024-05-02", Type: calendarv1.HolidayType_SCHOOL},
+	})
+
+	require.True(t, holidays.IsDayOff(date(2024, time.May, 1)))
+	require.False(t, holidays.IsDayOff(date(2024, time.May, 2)))
+	require.False(t, holidays.IsDayOff(date(2024, time.May, 3)))
+
+	require.Equal(t, 0.0, holidays.WorkdayFraction(date(2024, time.May, 1)))
+	require.Equal(t, 1.0, holidays.WorkdayFraction(date(2024, time.May, 2)))
+	require.Equal(t, 1.0, holidays.WorkdayFraction(date(2024, time.May, 3)))
+
+	require.True(t, holidays.HolidayShifts(date(2024, time.May, 2)))
+	require.False(t, holidays.HolidayShifts(date(2024, time.May, 3)))
+
+	require.Equal(t, "2024-05-01", holidays.Public(date(2024, time.May, 1)).Date)
+	require.Nil(t, holidays.Public(date(2024, time.May, 3)))
+}
@@ -11,31 +11,77 @@ import (
 )
 
 type Event struct {
+	// UID uniquely identifies the event across calendar updates. If empty,
+	// a UID is derived from the name and time of the event.
+	UID string
+
+	// Sequence overwrites the sequence of the calendar for this event if
+	// set.
+	Sequence int
+
 	From  time.Time
 	To    time.Time
 	Name  string
 	Users []*idmv1.Profile
 }
 
+// EventUID returns a deterministic UID for the planned work-shift at date.
+// rosterID should be the ID of the first roster version so that superseding
+// rosters update events instead of creating duplicates. userID may be empty
+// for events that are shared by all assigned users.
+func EventUID(rosterID, workShiftID string, date time.Time, userID string) string {
+	h := sha1.New()
+	_, _ = h.Write([]byte(fmt.Sprintf("%s-%s-%s-%s", rosterID, workShiftID, date.Local().Format("2006-01-02"), userID)))
+
+	return hex.EncodeToString(h.Sum(nil)) + "@dobersberg.vet"
+}
+
 func (e Event) id() string {
+	if e.UID != "" {
+		return e.UID
+	}
+
 	h := sha1.New()
-	_, _ = h.Write([]byte(fmt.Sprintf("%s-%s-%s-%s", e.Name, e.From, e.To, time.Now())))
+	_, _ = h.Write([]byte(fmt.Sprintf("%s-%s-%s", e.Name, e.From, e.To)))
 
 	return hex.EncodeToString(h.Sum(nil))
 }
 
 type Calendar struct {
 	Events []Event
+
+	// Name is the name of the calendar. Defaults to the month of the
+	// roster.
+	Name string
+
+	// Sequence is the revision number of all events in the calendar and
+	// must be incremented whenever previously sent events are updated.
+	Sequence int
+
+	// Cancel marks all events of the calendar as cancelled.
+	Cancel bool
+}
+
+// Method returns the iTIP method of the calendar.
+func (c Calendar) Method() string {
+	if c.Cancel {
+		return string(ics.MethodCancel)
+	}
+
+	return string(ics.MethodPublish)
 }
 
 func (c Calendar) ToICS(rosterFrom time.Time) string {
 	cal := ics.NewCalendar()
-	cal.SetMethod(ics.MethodAdd)
+	cal.SetMethod(ics.Method(c.Method()))
 	cal.SetProductId("-//dobersberg.vet//Tierklinik Dobersberg 2023c//EN")
-	cal.SetName("Dienstplan " + rosterFrom.Format("01/2006"))
+	if c.Name != "" {
+		cal.SetName(c.Name)
+	} else {
+		cal.SetName("Dienstplan " + rosterFrom.Format("01/2006"))
+	}
 	cal.SetTzid("Europe/Vienna")
 
-	seq := 1
 	dtTime := time.Now()
 	for _, e := range c.Events {
 		evt := cal.AddEvent(e.id())
@@ -45,6 +91,10 @@ func (c Calendar) ToICS(rosterFrom time.Time) string {
 		evt.SetDtStampTime(dtTime)
 		evt.SetOrganizer("office@tierklinikdobersberg.at", ics.WithCN("Tierklinik Dobersberg"))
 
+		if c.Cancel {
+			evt.SetStatus(ics.ObjectStatusCancelled)
+		}
+
 		for _, user := range e.Users {
 			userDisplayName := user.User.DisplayName
 			if userDisplayName == "" {
@@ -58,7 +108,11 @@ func (c Calendar) ToICS(rosterFrom time.Time) string {
 			evt.AddAttendee(userPrimaryMail, ics.WithCN(userDisplayName), ics.ParticipationRoleReqParticipant, ics.ParticipationStatusAccepted)
 		}
 
-		evt.SetSequence(seq)
+		if e.Sequence > 0 {
+			evt.SetSequence(e.Sequence)
+		} else {
+			evt.SetSequence(c.Sequence)
+		}
 	}
 
 	blob := cal.Serialize()
@@ -0,0 +1,57 @@
+package ical
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventUID(t *testing.T) {
+	from := time.Date(2024, time.March, 4, 8, 0, 0, 0, time.Local)
+
+	uid := EventUID("roster", "shift", from, "alice")
+	require.Equal(t, uid, EventUID("roster", "shift", from.Add(time.Hour), "alice"))
+	require.Equal(t, uid, EventUID("roster", "shift", from.UTC(), "alice"))
+
+	require.NotEqual(t, uid, EventUID("roster", "shift", from, "bob"))
+	require.NotEqual(t, uid, EventUID("roster", "shift", from.AddDate(0, 0, 1), "alice"))
+	require.NotEqual(t, uid, EventUID("other", "shift", from, "alice"))
+}
+
+func TestCalendar_ToICS(t *testing.T) {
+	from := time.Date(2024, time.March, 4, 8, 0, 0, 0, time.Local)
+
+	cal := Calendar{
+		Sequence: 2,
+		Events: []Event{
+			{UID: "uid-1@dobersberg.vet", From: from, To: from.Add(8 * time.Hour), Name: "Früh"},
+		},
+	}
+
+	blob := cal.ToICS(from)
+	require.Contains(t, blob, "METHOD:PUBLISH")
+	require.Contains(t, blob, "UID:uid-1@dobersberg.vet")
+	require.Contains(t, blob, "SEQUENCE:2")
+	require.NotContains(t, blob, "STATUS:CANCELLED")
+
+	// the output must be stable apart from the DTSTAMP
+	require.Equal(t, stripStamp(blob), stripStamp(cal.ToICS(from)))
+
+	cal.Cancel = true
+	blob = cal.ToICS(from)
+	require.Contains(t, blob, "METHOD:CANCEL")
+	require.Contains(t, blob, "STATUS:CANCELLED")
+}
+
+func stripStamp(blob string) string {
+	var lines []string
+	for _, line := range strings.Split(blob, "\n") {
+		if !strings.HasPrefix(line, "DTSTAMP") {
+			lines = append(lines, line)
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,248 @@
+// Package labour implements built-in labour rules like minimum rest periods
+// and maximum working hours that are checked for each planned shift of a
+// user.
+package labour
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	rosterv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/roster/v1"
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"github.com/tierklinik-dobersberg/rosterd/internal/timecalc"
+)
+
+// Identifiers of the built-in labour rules. They are used as the ID of
+// the resulting constraint violations.
+const (
+	RuleMinRest            = "labour/min-rest"
+	RuleMaxConsecutiveDays = "labour/max-consecutive-days"
+	RuleMaxWorkTimePerDay  = "labour/max-work-time-per-day"
+	RuleMaxWorkTimePerWeek = "labour/max-work-time-per-week"
+)
+
+// Rules configures the built-in labour rules. A zero value disables the
+// respective rule.
+type Rules struct {
+	// MinRest is the minimum rest period between two work periods of a
+	// user. Shifts that overlap or directly follow each other count as a
+	// single work period.
+	MinRest time.Duration
+
+	// MaxConsecutiveDays is the maximum number of consecutive days a user
+	// may work.
+	MaxConsecutiveDays int
+
+	// MaxWorkTimePerDay is the maximum work time per day.
+	MaxWorkTimePerDay time.Duration
+
+	// MaxWorkTimePerWeek is the maximum work time per week, starting on
+	// Monday.
+	MaxWorkTimePerWeek time.Duration
+}
+
+// Violation describes a violated labour rule.
+type Violation struct {
+	// Rule is the identifier of the violated rule.
+	Rule string
+
+	// Message is a human readable description of the violation.
+	Message string
+}
+
+// ToProto converts the violation to a hard constraint violation.
+func (v Violation) ToProto() *rosterv1.ConstraintViolation {
+	return &rosterv1.ConstraintViolation{
+		Hard: true,
+		Kind: &rosterv1.ConstraintViolation_Evaluation{
+			Evaluation: &rosterv1.ConstraintEvaluationViolation{
+				Id:          v.Rule,
+				Description: v.Message,
+			},
+		},
+	}
+}
+
+// Enabled returns true if at least one rule is configured.
+func (r Rules) Enabled() bool {
+	return r.MinRest > 0 || r.MaxConsecutiveDays > 0 || r.MaxWorkTimePerDay > 0 || r.MaxWorkTimePerWeek > 0
+}
+
+// Range extends the time range between from and to so it includes all
+// shifts that are required to check the rules for shifts between from
+// and to.
+func (r Rules) Range(from, to time.Time) (time.Time, time.Time) {
+	from = timecalc.StartOfWeek(from)
+	to = timecalc.EndOfWeek(to).AddDate(0, 0, 1)
+
+	if r.MaxConsecutiveDays > 0 {
+		from = from.AddDate(0, 0, -r.MaxConsecutiveDays)
+		to = to.AddDate(0, 0, r.MaxConsecutiveDays)
+	}
+
+	return from, to
+}
+
+// Check checks shift against all configured rules. Planned holds all other
+// shifts of the same user and must not contain shift itself.
+func (r Rules) Check(shift structs.PlannedShift, planned []structs.PlannedShift) []Violation {
+	var result []Violation
+
+	if r.MinRest > 0 {
+		if v, ok := r.checkRest(shift, planned); ok {
+			result = append(result, v)
+		}
+	}
+
+	if r.MaxConsecutiveDays > 0 {
+		if days := consecutiveDays(shift, planned); days > r.MaxConsecutiveDays {
+			result = append(result, Violation{
+				Rule:    RuleMaxConsecutiveDays,
+				Message: fmt.Sprintf("%d consecutive working days exceed the maximum of %d", days, r.MaxConsecutiveDays),
+			})
+		}
+	}
+
+	if r.MaxWorkTimePerDay > 0 {
+		day := dateOf(shift.From)
+
+		total := workTime(shift, planned, func(p structs.PlannedShift) bool {
+			return dateOf(p.From).Equal(day)
+		})
+
+		if total > r.MaxWorkTimePerDay {
+			result = append(result, Violation{
+				Rule:    RuleMaxWorkTimePerDay,
+				Message: fmt.Sprintf("work time of %s on %s exceeds the maximum of %s", total, day.Format("2006-01-02"), r.MaxWorkTimePerDay),
+			})
+		}
+	}
+
+	if r.MaxWorkTimePerWeek > 0 {
+		week := timecalc.StartOfWeek(dateOf(shift.From))
+
+		total := workTime(shift, planned, func(p structs.PlannedShift) bool {
+			return timecalc.StartOfWeek(dateOf(p.From)).Equal(week)
+		})
+
+		if total > r.MaxWorkTimePerWeek {
+			result = append(result, Violation{
+				Rule:    RuleMaxWorkTimePerWeek,
+				Message: fmt.Sprintf("work time of %s in the week of %s exceeds the maximum of %s", total, week.Format("2006-01-02"), r.MaxWorkTimePerWeek),
+			})
+		}
+	}
+
+	return result
+}
+
+// checkRest merges all shifts into work periods and checks the rest period
+// before and after the work period that contains shift.
+func (r Rules) checkRest(shift structs.PlannedShift, planned []structs.PlannedShift) (Violation, bool) {
+	type period struct {
+		from, to time.Time
+	}
+
+	shifts := make([]structs.PlannedShift, 0, len(planned)+1)
+	shifts = append(shifts, planned...)
+	shifts = append(shifts, shift)
+
+	sort.SliceStable(shifts, func(i, j int) bool {
+		return shifts[i].From.Before(shifts[j].From)
+	})
+
+	var periods []period
+	for _, s := range shifts {
+		if len(periods) > 0 && !s.From.After(periods[len(periods)-1].to) {
+			if s.To.After(periods[len(periods)-1].to) {
+				periods[len(periods)-1].to = s.To
+			}
+
+			continue
+		}
+
+		periods = append(periods, period{from: s.From, to: s.To})
+	}
+
+	for idx, p := range periods {
+		if shift.From.Before(p.from) || shift.To.After(p.to) {
+			continue
+		}
+
+		if idx > 0 {
+			if rest := p.from.Sub(periods[idx-1].to); rest < r.MinRest {
+				return Violation{
+					Rule:    RuleMinRest,
+					Message: fmt.Sprintf("rest period of %s before %s is shorter than %s", rest, p.from.Format("2006-01-02 15:04"), r.MinRest),
+				}, true
+			}
+		}
+
+		if idx < len(periods)-1 {
+			if rest := periods[idx+1].from.Sub(p.to); rest < r.MinRest {
+				return Violation{
+					Rule:    RuleMinRest,
+					Message: fmt.Sprintf("rest period of %s after %s is shorter than %s", rest, p.to.Format("2006-01-02 15:04"), r.MinRest),
+				}, true
+			}
+		}
+
+		break
+	}
+
+	return Violation{}, false
+}
+
+// consecutiveDays returns the number of consecutive working days including
+// the day of shift.
+func consecutiveDays(shift structs.PlannedShift, planned []structs.PlannedShift) int {
+	days := make(map[time.Time]struct{}, len(planned))
+	for _, p := range planned {
+		days[dateOf(p.From)] = struct{}{}
+	}
+
+	day := dateOf(shift.From)
+
+	count := 1
+	for iter := day.AddDate(0, 0, -1); ; iter = iter.AddDate(0, 0, -1) {
+		if _, ok := days[iter]; !ok {
+			break
+		}
+		count++
+	}
+
+	for iter := day.AddDate(0, 0, 1); ; iter = iter.AddDate(0, 0, 1) {
+		if _, ok := days[iter]; !ok {
+			break
+		}
+		count++
+	}
+
+	return count
+}
+
+// workTime returns the work time of shift and all planned shifts that match
+// filter.
+func workTime(shift structs.PlannedShift, planned []structs.PlannedShift, filter func(structs.PlannedShift) bool) time.Duration {
+	total := shiftWorkTime(shift)
+	for _, p := range planned {
+		if filter(p) {
+			total += shiftWorkTime(p)
+		}
+	}
+
+	return total
+}
+
+// shiftWorkTime returns the time shift is worth. Shifts with a time-worth
+// of zero, like stand-by shifts, do not count as work time.
+func shiftWorkTime(shift structs.PlannedShift) time.Duration {
+	return shift.TimeWorth
+}
+
+func dateOf(t time.Time) time.Time {
+	t = t.Local()
+
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.Local)
+}
@@ -0,0 +1,107 @@
+package labour_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tierklinik-dobersberg/rosterd/internal/labour"
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+)
+
+func shift(day int, fromHour, hours int) structs.PlannedShift {
+	from := time.Date(2024, time.January, day, fromHour, 0, 0, 0, time.Local)
+
+	return structs.PlannedShift{
+		From:      from,
+		To:        from.Add(time.Duration(hours) * time.Hour),
+		TimeWorth: time.Duration(hours) * time.Hour,
+	}
+}
+
+func rules(violations []labour.Violation) []string {
+	var result []string
+	for _, v := range violations {
+		result = append(result, v.Rule)
+	}
+
+	return result
+}
+
+func Test_Rules_MinRest(t *testing.T) {
+	r := labour.Rules{MinRest: 11 * time.Hour}
+
+	cases := []struct {
+		name     string
+		shift    structs.PlannedShift
+		planned  []structs.PlannedShift
+		violated bool
+	}{
+		{"no other shifts", shift(2, 8, 8), nil, false},
+		{"enough rest before", shift(2, 8, 8), []structs.PlannedShift{shift(1, 8, 8)}, false},
+		{"short rest before", shift(2, 6, 8), []structs.PlannedShift{shift(1, 14, 8)}, true},
+		{"short rest after", shift(1, 14, 8), []structs.PlannedShift{shift(2, 6, 8)}, true},
+		{"directly following shifts", shift(1, 16, 4), []structs.PlannedShift{shift(1, 8, 8)}, false},
+		{"short rest after merged period", shift(1, 8, 8), []structs.PlannedShift{shift(1, 16, 4), shift(2, 6, 8)}, true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			violations := r.Check(c.shift, c.planned)
+
+			if c.violated {
+				require.Equal(t, []string{labour.RuleMinRest}, rules(violations))
+			} else {
+				require.Empty(t, violations)
+			}
+		})
+	}
+}
+
+func Test_Rules_MaxConsecutiveDays(t *testing.T) {
+	r := labour.Rules{MaxConsecutiveDays: 3}
+
+	planned := []structs.PlannedShift{
+		shift(1, 8, 8),
+		shift(2, 8, 8),
+		shift(4, 8, 8),
+	}
+
+	require.Empty(t, r.Check(shift(5, 8, 8), planned))
+	require.Equal(t, []string{labour.RuleMaxConsecutiveDays}, rules(r.Check(shift(3, 8, 8), planned)))
+}
+
+func Test_Rules_MaxWorkTime(t *testing.T) {
+	r := labour.Rules{
+		MaxWorkTimePerDay:  10 * time.Hour,
+		MaxWorkTimePerWeek: 40 * time.Hour,
+	}
+
+	// 2024-01-01 is a Monday
+	planned := []structs.PlannedShift{
+		shift(1, 8, 8),
+		shift(2, 8, 8),
+		shift(3, 8, 8),
+		shift(4, 8, 8),
+	}
+
+	require.Empty(t, r.Check(shift(5, 8, 8), planned))
+	require.Empty(t, r.Check(shift(8, 8, 8), append(planned, shift(5, 8, 8))))
+
+	require.Equal(t, []string{labour.RuleMaxWorkTimePerWeek}, rules(r.Check(shift(6, 8, 8), append(planned, shift(5, 8, 8)))))
+	require.Equal(t, []string{labour.RuleMaxWorkTimePerDay}, rules(r.Check(shift(4, 18, 4), planned)))
+
+	// the time worth is used if set
+	s := shift(4, 18, 4)
+	s.TimeWorth = time.Hour
+	require.Empty(t, r.Check(s, planned))
+
+	// stand-by shifts with a time worth of zero do not count as work time
+	standBy := shift(5, 18, 12)
+	standBy.TimeWorth = 0
+	require.Empty(t, r.Check(standBy, append(planned, shift(5, 8, 8))))
+
+	standBy = shift(4, 8, 8)
+	standBy.TimeWorth = 0
+	require.Empty(t, r.Check(shift(5, 8, 8), append(planned, standBy)))
+}
@@ -0,0 +1,217 @@
+// Package offtimerules checks off-time requests against blackout periods and
+// concurrency limits.
+package offtimerules
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"github.com/tierklinik-dobersberg/rosterd/internal/timecalc"
+	"golang.org/x/exp/slices"
+)
+
+// Conflict describes an off-time rule that is violated by an off-time
+// request.
+type Conflict struct {
+	Rule structs.OffTimeRule
+
+	// Message is a human readable description of the conflict.
+	Message string
+
+	// ConflictingRequests holds the IDs of the approved off-time requests
+	// that cause the concurrency limit to be exceeded.
+	ConflictingRequests []string
+}
+
+// Checker checks off-time requests against a set of rules.
+type Checker struct {
+	// Rules holds all off-time rules.
+	Rules []structs.OffTimeRule
+
+	// Roles holds the role IDs of each user. It is only required for rules
+	// that apply to roles.
+	Roles map[string][]string
+}
+
+// Check checks entry against all rules. Approved holds the approved
+// off-time requests that overlap with entry. Requests of the requestor of
+// entry and entry itself are ignored.
+func (c Checker) Check(entry structs.OffTimeEntry, approved []structs.OffTimeEntry) []Conflict {
+	var result []Conflict
+
+	from, to := timecalc.OffTimeRange(entry.From, entry.To)
+
+	for _, rule := range c.Rules {
+		if !c.appliesTo(rule, entry.RequestorId) {
+			continue
+		}
+
+		ruleFrom, ruleTo, ok := clip(rule, from, to)
+		if !ok {
+			continue
+		}
+
+		if rule.IsBlackout() {
+			result = append(result, Conflict{
+				Rule:    rule,
+				Message: fmt.Sprintf("off-time is not allowed between %s (rule %q)", formatRange(rule), rule.Name),
+			})
+
+			continue
+		}
+
+		// collect all other requests that fall within the window of the rule.
+		var others []period
+		for _, other := range approved {
+			if other.ID == entry.ID || other.RequestorId == entry.RequestorId {
+				continue
+			}
+
+			if !c.appliesTo(rule, other.RequestorId) {
+				continue
+			}
+
+			oFrom, oTo := timecalc.OffTimeRange(other.From, other.To)
+			if oFrom.Before(ruleFrom) {
+				oFrom = ruleFrom
+			}
+			if oTo.After(ruleTo) {
+				oTo = ruleTo
+			}
+
+			if !oFrom.Before(oTo) {
+				continue
+			}
+
+			others = append(others, period{
+				id:   other.ID.Hex(),
+				user: other.RequestorId,
+				from: oFrom,
+				to:   oTo,
+			})
+		}
+
+		absent, conflicting := maxConcurrent(others, rule.MaxConcurrent)
+
+		// the requestor of entry is absent as well.
+		if absent+1 > rule.MaxConcurrent {
+			result = append(result, Conflict{
+				Rule:                rule,
+				Message:             fmt.Sprintf("%d users would be absent at the same time but only %d are allowed (rule %q)", absent+1, rule.MaxConcurrent, rule.Name),
+				ConflictingRequests: conflicting,
+			})
+		}
+	}
+
+	return result
+}
+
+func (c Checker) appliesTo(rule structs.OffTimeRule, userId string) bool {
+	if len(rule.UserIDs) == 0 && len(rule.RoleIDs) == 0 {
+		return true
+	}
+
+	if slices.Contains(rule.UserIDs, userId) {
+		return true
+	}
+
+	for _, role := range c.Roles[userId] {
+		if slices.Contains(rule.RoleIDs, role) {
+			return true
+		}
+	}
+
+	return false
+}
+
+type period struct {
+	id       string
+	user     string
+	from, to time.Time
+}
+
+// maxConcurrent returns the maximum number of distinct users that are absent
+// at the same time. It also returns the IDs of all requests that are active
+// whenever limit users are already absent.
+func maxConcurrent(periods []period, limit int) (int, []string) {
+	var (
+		maxAbsent   int
+		conflicting = make(map[string]struct{})
+	)
+
+	// the number of concurrently absent users only increases at the start
+	// of a period so it's enough to check those.
+	for _, p := range periods {
+		users := make(map[string]struct{})
+		var active []string
+
+		for _, o := range periods {
+			if o.from.After(p.from) || !o.to.After(p.from) {
+				continue
+			}
+
+			users[o.user] = struct{}{}
+			active = append(active, o.id)
+		}
+
+		if len(users) > maxAbsent {
+			maxAbsent = len(users)
+		}
+
+		if len(users) >= limit {
+			for _, id := range active {
+				conflicting[id] = struct{}{}
+			}
+		}
+	}
+
+	result := make([]string, 0, len(conflicting))
+	for id := range conflicting {
+		result = append(result, id)
+	}
+	sort.Strings(result)
+
+	return maxAbsent, result
+}
+
+// clip returns the part of the time range between from and to that is
+// covered by rule.
+func clip(rule structs.OffTimeRule, from, to time.Time) (time.Time, time.Time, bool) {
+	if !rule.From.IsZero() {
+		ruleFrom := startOfDay(rule.From)
+		if ruleFrom.After(from) {
+			from = ruleFrom
+		}
+	}
+
+	if !rule.To.IsZero() {
+		ruleTo := startOfDay(rule.To).AddDate(0, 0, 1)
+		if ruleTo.Before(to) {
+			to = ruleTo
+		}
+	}
+
+	return from, to, from.Before(to)
+}
+
+func formatRange(rule structs.OffTimeRule) string {
+	from := "-"
+	if !rule.From.IsZero() {
+		from = rule.From.Local().Format("2006-01-02")
+	}
+
+	to := "-"
+	if !rule.To.IsZero() {
+		to = rule.To.Local().Format("2006-01-02")
+	}
+
+	return from + " and " + to
+}
+
+func startOfDay(t time.Time) time.Time {
+	t = t.Local()
+
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.Local)
+}
@@ -0,0 +1,114 @@
+package offtimerules_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tierklinik-dobersberg/rosterd/internal/offtimerules"
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func date(month time.Month, day int) time.Time {
+	return time.Date(2024, month, day, 0, 0, 0, 0, time.Local)
+}
+
+func request(user string, from, to time.Time) structs.OffTimeEntry {
+	return structs.OffTimeEntry{
+		ID:          primitive.NewObjectID(),
+		RequestorId: user,
+		From:        from,
+		To:          to,
+	}
+}
+
+func Test_Checker_Blackout(t *testing.T) {
+	checker := offtimerules.Checker{
+		Rules: []structs.OffTimeRule{
+			{
+				Name: "new year",
+				From: date(time.December, 28),
+				To:   date(time.December, 31),
+			},
+		},
+	}
+
+	require.Empty(t, checker.Check(request("alice", date(time.December, 20), date(time.December, 27)), nil))
+
+	// the last day of the rule is inclusive
+	conflicts := checker.Check(request("alice", date(time.December, 31), date(time.December, 31)), nil)
+	require.Len(t, conflicts, 1)
+	require.Equal(t, "new year", conflicts[0].Rule.Name)
+	require.Empty(t, conflicts[0].ConflictingRequests)
+}
+
+func Test_Checker_MaxConcurrent(t *testing.T) {
+	checker := offtimerules.Checker{
+		Rules: []structs.OffTimeRule{
+			{
+				Name:          "nurses",
+				RoleIDs:       []string{"nurse"},
+				MaxConcurrent: 2,
+			},
+		},
+		Roles: map[string][]string{
+			"alice": {"nurse"},
+			"bob":   {"nurse"},
+			"carol": {"nurse"},
+			"dave":  {"nurse"},
+			"vet":   {"vet"},
+		},
+	}
+
+	bob := request("bob", date(time.May, 1), date(time.May, 10))
+	carol := request("carol", date(time.May, 8), date(time.May, 15))
+	vet := request("vet", date(time.May, 1), date(time.May, 31))
+	approved := []structs.OffTimeEntry{bob, carol, vet}
+
+	// only bob is absent
+	require.Empty(t, checker.Check(request("alice", date(time.May, 2), date(time.May, 7)), approved))
+
+	// bob and carol are absent on May 8th to 10th
+	conflicts := checker.Check(request("alice", date(time.May, 5), date(time.May, 9)), approved)
+	require.Len(t, conflicts, 1)
+	require.ElementsMatch(t, []string{bob.ID.Hex(), carol.ID.Hex()}, conflicts[0].ConflictingRequests)
+
+	// the rule does not apply to vets
+	require.Empty(t, checker.Check(request("vet", date(time.May, 5), date(time.May, 9)), approved))
+
+	// requests of the same user do not count
+	require.Empty(t, checker.Check(request("bob", date(time.May, 11), date(time.May, 12)), approved))
+
+	// hourly requests that do not overlap
+	hourly := []structs.OffTimeEntry{
+		request("bob", date(time.June, 3).Add(8*time.Hour), date(time.June, 3).Add(10*time.Hour)),
+		request("carol", date(time.June, 3).Add(10*time.Hour), date(time.June, 3).Add(12*time.Hour)),
+	}
+	require.Empty(t, checker.Check(request("dave", date(time.June, 3), date(time.June, 3)), hourly))
+}
+
+func Test_Checker_RuleRange(t *testing.T) {
+	checker := offtimerules.Checker{
+		Rules: []structs.OffTimeRule{
+			{
+				Name:          "summer",
+				UserIDs:       []string{"alice", "bob"},
+				From:          date(time.July, 1),
+				To:            date(time.August, 31),
+				MaxConcurrent: 1,
+			},
+		},
+	}
+
+	approved := []structs.OffTimeEntry{
+		request("bob", date(time.June, 20), date(time.July, 2)),
+	}
+
+	// the overlap is outside of the rule
+	require.Empty(t, checker.Check(request("alice", date(time.June, 15), date(time.June, 30)), approved))
+
+	conflicts := checker.Check(request("alice", date(time.June, 15), date(time.July, 1)), approved)
+	require.Len(t, conflicts, 1)
+	require.Equal(t, []string{approved[0].ID.Hex()}, conflicts[0].ConflictingRequests)
+}
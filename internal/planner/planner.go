@@ -0,0 +1,492 @@
+// Package planner implements a deterministic solver that staffs the required
+// shifts of a duty roster.
+//
+// The solver first builds an initial solution using a greedy heuristic that
+// staffs the most constrained shifts first and then improves the solution
+// using a local search that replaces assigned users as long as the total
+// penalty decreases. The total penalty is the sum of all constraint penalties
+// and the deviation of each user from the expected work time.
+package planner
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"golang.org/x/exp/slices"
+)
+
+// Slot is a required shift that should be staffed by the solver.
+type Slot struct {
+	// Shift is the required shift. Only users from Shift.EligibleUserIds are
+	// assigned to the slot.
+	Shift structs.RequiredShift
+
+	// StaffCount is the number of users that should be assigned to the slot.
+	StaffCount int
+
+	// TimeWorth is how much the shift is worth for time-tracking.
+	TimeWorth time.Duration
+
+	// Fixed holds users that are already assigned to the slot. They are
+	// never removed by the solver.
+	Fixed []string
+}
+
+// CostFunc returns the penalty for assigning the user to slot given all other
+// shifts that are planned for the user. If blocked is true the user must not
+// be assigned to the slot.
+type CostFunc func(slot Slot, userID string, planned []structs.PlannedShift) (penalty float64, blocked bool)
+
+// Problem describes the roster that should be generated.
+type Problem struct {
+	// Slots holds all shifts that should be staffed.
+	Slots []Slot
+
+	// Expected holds the expected work time for each user in the planned
+	// period.
+	Expected map[string]time.Duration
+
+	// Planned holds shifts that are already planned for each user but are
+	// not part of the problem. They count towards the work time of the user
+	// and are passed to Cost.
+	Planned map[string][]structs.PlannedShift
+
+	// Surrounding holds shifts for each user that are planned outside of
+	// the planned period. They are passed to Cost but do not count towards
+	// the work time of the user.
+	Surrounding map[string][]structs.PlannedShift
+
+	// Cost is used to calculate constraint penalties. If nil, only the work
+	// time deviation is taken into account.
+	Cost CostFunc
+
+	// WorkTimePenaltyPerHour is the penalty for each hour a user deviates
+	// from the expected work time.
+	WorkTimePenaltyPerHour float64
+
+	// MaxPasses limits the number of local-search passes. Defaults to
+	// DefaultMaxPasses.
+	MaxPasses int
+}
+
+// Solution is the result of Solve.
+type Solution struct {
+	// Shifts holds one planned shift for each slot of the problem, in the
+	// same order.
+	Shifts []structs.PlannedShift
+
+	// Penalty is the total penalty of the solution.
+	Penalty float64
+
+	// Unstaffed holds all slots that could not be fully staffed.
+	Unstaffed []Unstaffed
+}
+
+// Reason describes why a slot could not be fully staffed.
+type Reason int
+
+const (
+	// ReasonNoEligibleUsers is used if there are no eligible users for the
+	// slot.
+	ReasonNoEligibleUsers Reason = iota + 1
+
+	// ReasonHardConstraints is used if all remaining candidates are blocked
+	// by the cost function.
+	ReasonHardConstraints
+
+	// ReasonUnavailable is used if all remaining candidates are already
+	// assigned to overlapping slots.
+	ReasonUnavailable
+
+	// ReasonNotEnoughEligibleUsers is used if all eligible users are assigned
+	// to the slot but more are required.
+	ReasonNotEnoughEligibleUsers
+)
+
+// Unstaffed describes a slot that could not be fully staffed.
+type Unstaffed struct {
+	// Slot is the index of the slot in Problem.Slots.
+	Slot int
+
+	// Reason describes why the slot could not be fully staffed.
+	Reason Reason
+
+	// Blocked holds all eligible users that are blocked by the cost
+	// function.
+	Blocked []string
+
+	// Unavailable holds all eligible users that are assigned to an
+	// overlapping slot.
+	Unavailable []string
+}
+
+// DefaultMaxPasses is the default number of local-search passes.
+const DefaultMaxPasses = 10
+
+type solver struct {
+	problem Problem
+
+	assigned [][]string
+	byUser   map[string][]int
+	worked   map[string]time.Duration
+	users    []string
+}
+
+// Solve staffs all slots of p. Solve is deterministic and will always return
+// the same solution for the same problem.
+func Solve(p Problem) Solution {
+	if p.MaxPasses <= 0 {
+		p.MaxPasses = DefaultMaxPasses
+	}
+
+	s := &solver{
+		problem:  p,
+		assigned: make([][]string, len(p.Slots)),
+		byUser:   make(map[string][]int),
+		worked:   make(map[string]time.Duration),
+	}
+
+	users := make(map[string]struct{})
+	for id := range p.Expected {
+		users[id] = struct{}{}
+	}
+
+	for id, planned := range p.Planned {
+		users[id] = struct{}{}
+
+		for _, shift := range planned {
+			s.worked[id] += shift.TimeWorth
+		}
+	}
+
+	for idx := range p.Slots {
+		for _, id := range p.Slots[idx].Shift.EligibleUserIds {
+			users[id] = struct{}{}
+		}
+	}
+
+	// fixed assignments are added first so they count towards the work time
+	// of the user.
+	for idx, slot := range p.Slots {
+		for _, user := range slot.Fixed {
+			users[user] = struct{}{}
+			s.assign(idx, user)
+		}
+	}
+
+	for id := range users {
+		s.users = append(s.users, id)
+	}
+	sort.Strings(s.users)
+
+	s.construct()
+
+	for pass := 0; pass < p.MaxPasses; pass++ {
+		if !s.improve() {
+			break
+		}
+	}
+
+	return s.solution()
+}
+
+// construct builds the initial solution by staffing the most constrained
+// slots first.
+func (s *solver) construct() {
+	order := make([]int, len(s.problem.Slots))
+	for idx := range order {
+		order[idx] = idx
+	}
+
+	sort.SliceStable(order, func(i, j int) bool {
+		a, b := s.problem.Slots[order[i]], s.problem.Slots[order[j]]
+
+		slackA := len(a.Shift.EligibleUserIds) - a.StaffCount
+		slackB := len(b.Shift.EligibleUserIds) - b.StaffCount
+		if slackA != slackB {
+			return slackA < slackB
+		}
+
+		if !a.Shift.From.Equal(b.Shift.From) {
+			return a.Shift.From.Before(b.Shift.From)
+		}
+
+		return a.Shift.WorkShiftID.Hex() < b.Shift.WorkShiftID.Hex()
+	})
+
+	for _, idx := range order {
+		slot := s.problem.Slots[idx]
+		if len(s.assigned[idx]) >= slot.StaffCount {
+			continue
+		}
+
+		for len(s.assigned[idx]) < slot.StaffCount {
+			var (
+				best     string
+				bestCost = math.Inf(1)
+			)
+
+			for _, user := range sortedCopy(slot.Shift.EligibleUserIds) {
+				if !s.canAssign(idx, user) {
+					continue
+				}
+
+				// the new assignment might also affect the penalties of
+				// other shifts of the user so always compare the total
+				// costs.
+				before := s.userCost(user)
+
+				s.assign(idx, user)
+				after := s.userCost(user)
+				s.unassign(idx, user)
+
+				if math.IsInf(after, 1) {
+					continue
+				}
+
+				cost := after - before
+
+				if cost < bestCost {
+					best = user
+					bestCost = cost
+				}
+			}
+
+			if best == "" {
+				break
+			}
+
+			s.assign(idx, best)
+		}
+	}
+}
+
+// improve runs one local-search pass and reports whether or not the solution
+// has been improved.
+func (s *solver) improve() bool {
+	improved := false
+
+	for idx, slot := range s.problem.Slots {
+		// fixed assignments are always at the beginning and must not be
+		// replaced.
+		for pos := len(slot.Fixed); pos < len(s.assigned[idx]); pos++ {
+			current := s.assigned[idx][pos]
+
+			for _, candidate := range sortedCopy(slot.Shift.EligibleUserIds) {
+				if candidate == current || !s.canAssign(idx, candidate) {
+					continue
+				}
+
+				before := s.userCost(current) + s.userCost(candidate)
+
+				s.replace(idx, pos, candidate)
+
+				after := s.userCost(current) + s.userCost(candidate)
+
+				// require a minimal improvement so rounding errors do not
+				// cause endless replacements.
+				if after < before-1e-9 {
+					improved = true
+					current = candidate
+
+					continue
+				}
+
+				s.replace(idx, pos, current)
+			}
+		}
+	}
+
+	return improved
+}
+
+func (s *solver) canAssign(idx int, user string) bool {
+	if slices.Contains(s.assigned[idx], user) {
+		return false
+	}
+
+	shift := s.problem.Slots[idx].Shift
+
+	for _, other := range s.byUser[user] {
+		if other == idx {
+			continue
+		}
+
+		o := s.problem.Slots[other].Shift
+		if shift.From.Before(o.To) && o.From.Before(shift.To) {
+			return false
+		}
+	}
+
+	for _, o := range s.problem.Planned[user] {
+		if shift.From.Before(o.To) && o.From.Before(shift.To) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (s *solver) assign(idx int, user string) {
+	s.assigned[idx] = append(s.assigned[idx], user)
+	s.byUser[user] = append(s.byUser[user], idx)
+	s.worked[user] += s.problem.Slots[idx].TimeWorth
+}
+
+func (s *solver) unassign(idx int, user string) {
+	s.assigned[idx] = slices.DeleteFunc(s.assigned[idx], func(u string) bool { return u == user })
+	s.byUser[user] = slices.DeleteFunc(s.byUser[user], func(i int) bool { return i == idx })
+	s.worked[user] -= s.problem.Slots[idx].TimeWorth
+}
+
+func (s *solver) replace(idx int, pos int, user string) {
+	old := s.assigned[idx][pos]
+	worth := s.problem.Slots[idx].TimeWorth
+
+	s.byUser[old] = slices.DeleteFunc(s.byUser[old], func(i int) bool { return i == idx })
+	s.worked[old] -= worth
+
+	s.assigned[idx][pos] = user
+	s.byUser[user] = append(s.byUser[user], idx)
+	s.worked[user] += worth
+}
+
+// cost returns the constraint penalty of assigning user to the slot at idx.
+func (s *solver) cost(idx int, user string) (float64, bool) {
+	if s.problem.Cost == nil {
+		return 0, false
+	}
+
+	return s.problem.Cost(s.problem.Slots[idx], user, s.plannedFor(user, idx))
+}
+
+// userCost returns the total penalty of all shifts assigned to user including
+// the work time deviation.
+func (s *solver) userCost(user string) float64 {
+	total := s.deviation(user, s.worked[user])
+
+	for _, idx := range s.byUser[user] {
+		penalty, blocked := s.cost(idx, user)
+		if blocked {
+			// fixed assignments are accepted as they are.
+			if slices.Contains(s.problem.Slots[idx].Fixed, user) {
+				continue
+			}
+
+			return math.Inf(1)
+		}
+
+		total += penalty
+	}
+
+	return total
+}
+
+func (s *solver) deviation(user string, worked time.Duration) float64 {
+	diff := worked - s.problem.Expected[user]
+
+	return math.Abs(diff.Hours()) * s.problem.WorkTimePenaltyPerHour
+}
+
+// plannedFor returns all shifts planned for user except the slot at exclude.
+func (s *solver) plannedFor(user string, exclude int) []structs.PlannedShift {
+	planned := make([]structs.PlannedShift, 0, len(s.byUser[user])+len(s.problem.Planned[user])+len(s.problem.Surrounding[user]))
+	planned = append(planned, s.problem.Planned[user]...)
+	planned = append(planned, s.problem.Surrounding[user]...)
+
+	for _, idx := range s.byUser[user] {
+		if idx == exclude {
+			continue
+		}
+
+		planned = append(planned, s.plannedShift(idx))
+	}
+
+	return planned
+}
+
+func (s *solver) plannedShift(idx int) structs.PlannedShift {
+	slot := s.problem.Slots[idx]
+
+	return structs.PlannedShift{
+		From:            slot.Shift.From,
+		To:              slot.Shift.To,
+		WorkShiftID:     slot.Shift.WorkShiftID,
+		TimeWorth:       slot.TimeWorth,
+		AssignedUserIds: slices.Clone(s.assigned[idx]),
+	}
+}
+
+func (s *solver) solution() Solution {
+	result := Solution{
+		Shifts: make([]structs.PlannedShift, len(s.problem.Slots)),
+	}
+
+	for idx := range s.problem.Slots {
+		result.Shifts[idx] = s.plannedShift(idx)
+		if result.Shifts[idx].AssignedUserIds == nil {
+			result.Shifts[idx].AssignedUserIds = []string{}
+		}
+	}
+
+	for _, user := range s.users {
+		result.Penalty += s.userCost(user)
+	}
+
+	for idx, slot := range s.problem.Slots {
+		if len(s.assigned[idx]) < slot.StaffCount {
+			result.Unstaffed = append(result.Unstaffed, s.unstaffed(idx))
+		}
+	}
+
+	return result
+}
+
+// unstaffed determines why the slot at idx could not be fully staffed.
+func (s *solver) unstaffed(idx int) Unstaffed {
+	result := Unstaffed{
+		Slot: idx,
+	}
+
+	eligible := s.problem.Slots[idx].Shift.EligibleUserIds
+	if len(eligible) == 0 {
+		result.Reason = ReasonNoEligibleUsers
+
+		return result
+	}
+
+	for _, user := range sortedCopy(eligible) {
+		if slices.Contains(s.assigned[idx], user) {
+			continue
+		}
+
+		if !s.canAssign(idx, user) {
+			result.Unavailable = append(result.Unavailable, user)
+			continue
+		}
+
+		if _, blocked := s.cost(idx, user); blocked {
+			result.Blocked = append(result.Blocked, user)
+		}
+	}
+
+	switch {
+	case len(result.Blocked) == 0 && len(result.Unavailable) == 0:
+		result.Reason = ReasonNotEnoughEligibleUsers
+	case len(result.Unavailable) == 0:
+		result.Reason = ReasonHardConstraints
+	default:
+		result.Reason = ReasonUnavailable
+	}
+
+	return result
+}
+
+func sortedCopy(s []string) []string {
+	c := slices.Clone(s)
+	sort.Strings(c)
+
+	return c
+}
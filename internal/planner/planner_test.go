@@ -0,0 +1,148 @@
+package planner_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tierklinik-dobersberg/rosterd/internal/planner"
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func makeSlots(days int, staff int, eligible ...string) []planner.Slot {
+	var (
+		slots []planner.Slot
+		start = time.Date(2024, time.January, 1, 8, 0, 0, 0, time.Local)
+		id    = primitive.NewObjectID()
+	)
+
+	for day := 0; day < days; day++ {
+		from := start.AddDate(0, 0, day)
+
+		slots = append(slots, planner.Slot{
+			Shift: structs.RequiredShift{
+				From:            from,
+				To:              from.Add(8 * time.Hour),
+				WorkShiftID:     id,
+				EligibleUserIds: eligible,
+			},
+			StaffCount: staff,
+			TimeWorth:  8 * time.Hour,
+		})
+	}
+
+	return slots
+}
+
+func countAssignments(solution planner.Solution) map[string]int {
+	result := make(map[string]int)
+	for _, shift := range solution.Shifts {
+		for _, user := range shift.AssignedUserIds {
+			result[user]++
+		}
+	}
+
+	return result
+}
+
+func Test_Solve_BalancesWorkTime(t *testing.T) {
+	problem := planner.Problem{
+		Slots: makeSlots(10, 1, "alice", "bob"),
+		Expected: map[string]time.Duration{
+			"alice": 56 * time.Hour,
+			"bob":   24 * time.Hour,
+		},
+		WorkTimePenaltyPerHour: 1,
+	}
+
+	solution := planner.Solve(problem)
+
+	require.Len(t, solution.Shifts, 10)
+	require.Equal(t, map[string]int{"alice": 7, "bob": 3}, countAssignments(solution))
+	require.Zero(t, solution.Penalty)
+
+	// the solver must be deterministic
+	require.Equal(t, solution, planner.Solve(problem))
+}
+
+func Test_Solve_RespectsCosts(t *testing.T) {
+	problem := planner.Problem{
+		Slots: makeSlots(4, 2, "alice", "bob", "carol"),
+		Expected: map[string]time.Duration{
+			"alice": 16 * time.Hour,
+			"bob":   16 * time.Hour,
+			"carol": 16 * time.Hour,
+		},
+		WorkTimePenaltyPerHour: 1,
+		Cost: func(slot planner.Slot, user string, planned []structs.PlannedShift) (float64, bool) {
+			// carol must not work on mondays
+			if user == "carol" && slot.Shift.From.Weekday() == time.Monday {
+				return 0, true
+			}
+
+			// bob should not work more than one shift in a row
+			if user == "bob" {
+				for _, p := range planned {
+					if p.From.Equal(slot.Shift.From.AddDate(0, 0, -1)) {
+						return 100, false
+					}
+				}
+			}
+
+			return 0, false
+		},
+	}
+
+	solution := planner.Solve(problem)
+
+	for _, shift := range solution.Shifts {
+		require.Len(t, shift.AssignedUserIds, 2)
+
+		if shift.From.Weekday() == time.Monday {
+			require.NotContains(t, shift.AssignedUserIds, "carol")
+		}
+	}
+
+	require.Less(t, solution.Penalty, 100.0)
+}
+
+func Test_Solve_NoEligibleUsers(t *testing.T) {
+	solution := planner.Solve(planner.Problem{
+		Slots: makeSlots(2, 1),
+	})
+
+	require.Len(t, solution.Shifts, 2)
+	for _, shift := range solution.Shifts {
+		require.Empty(t, shift.AssignedUserIds)
+	}
+}
+
+func Test_Solve_KeepsFixedAssignments(t *testing.T) {
+	slots := makeSlots(3, 2, "alice", "bob")
+	slots[0].Fixed = []string{"carol"}
+	slots[1].Fixed = []string{"alice", "bob"}
+
+	solution := planner.Solve(planner.Problem{
+		Slots: slots,
+		Expected: map[string]time.Duration{
+			"alice": 8 * time.Hour,
+			"bob":   8 * time.Hour,
+		},
+		WorkTimePenaltyPerHour: 1,
+		Cost: func(slot planner.Slot, user string, planned []structs.PlannedShift) (float64, bool) {
+			// bob cannot work on the last day
+			return 0, user == "bob" && slot.Shift.From.Day() == 3
+		},
+	})
+
+	require.Equal(t, "carol", solution.Shifts[0].AssignedUserIds[0])
+	require.Len(t, solution.Shifts[0].AssignedUserIds, 2)
+	require.Equal(t, []string{"alice", "bob"}, solution.Shifts[1].AssignedUserIds)
+	require.Equal(t, []string{"alice"}, solution.Shifts[2].AssignedUserIds)
+
+	require.Len(t, solution.Unstaffed, 1)
+	require.Equal(t, 2, solution.Unstaffed[0].Slot)
+	require.Equal(t, planner.ReasonHardConstraints, solution.Unstaffed[0].Reason)
+	require.Equal(t, []string{"bob"}, solution.Unstaffed[0].Blocked)
+}
@@ -0,0 +1,92 @@
+package render
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/dcaraxes/gotenberg-go-client/v8"
+	"github.com/dcaraxes/gotenberg-go-client/v8/document"
+	"github.com/tierklinik-dobersberg/rosterd/templates"
+)
+
+// GotenbergRenderer renders the HTML roster templates into PDF documents
+// using a Gotenberg server.
+type GotenbergRenderer struct {
+	URL    string
+	Client *http.Client
+}
+
+// NewGotenberg returns a renderer that uses the Gotenberg server at url.
+func NewGotenberg(url string) *GotenbergRenderer {
+	return &GotenbergRenderer{
+		URL:    url,
+		Client: http.DefaultClient,
+	}
+}
+
+func (g *GotenbergRenderer) RenderRoster(ctx context.Context, rc templates.RosterContext) (io.ReadCloser, error) {
+	buf, err := templates.RenderRosterTemplate(ctx, rc)
+	if err != nil {
+		return nil, err
+	}
+
+	blob, err := io.ReadAll(buf)
+	if err != nil {
+		return nil, err
+	}
+
+	return g.RenderHTML(ctx, string(blob), true)
+}
+
+func (g *GotenbergRenderer) RenderUserRoster(ctx context.Context, rc templates.UserRosterContext) (io.ReadCloser, error) {
+	buf, err := templates.RenderUserRosterTemplate(ctx, rc)
+	if err != nil {
+		return nil, err
+	}
+
+	blob, err := io.ReadAll(buf)
+	if err != nil {
+		return nil, err
+	}
+
+	return g.RenderHTML(ctx, string(blob), false)
+}
+
+// RenderHTML converts the HTML document index into an A4 PDF.
+func (g *GotenbergRenderer) RenderHTML(ctx context.Context, index string, landscape bool) (io.ReadCloser, error) {
+	client, err := gotenberg.NewClient(g.URL, g.Client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gotenberg client: %w", err)
+	}
+
+	indexDocument, err := document.FromString("index.html", index)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare document: %w", err)
+	}
+
+	req := gotenberg.NewHTMLRequest(indexDocument)
+	req.PaperSize(gotenberg.A4)
+	if landscape {
+		req.Landscape()
+	}
+	req.Margins(gotenberg.NoMargins)
+	req.SkipNetworkIdleEvent()
+	req.WaitDelay(time.Second * 3)
+	req.Scale(0.75)
+
+	res, err := client.Send(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request to gotenberg: %w", err)
+	}
+
+	if res.StatusCode != 200 {
+		res.Body.Close()
+
+		return nil, fmt.Errorf("unexpected response from gotenberg: %s", res.Status)
+	}
+
+	return res.Body, nil
+}
@@ -0,0 +1,441 @@
+package render
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/jung-kurt/gofpdf"
+	"github.com/tierklinik-dobersberg/rosterd/templates"
+)
+
+// Page layout in millimeters. The values resemble the roster-table HTML
+// template rendered on a landscape A4 page.
+const (
+	pageMargin   = 8.0
+	headerHeight = 7.0
+	footerHeight = 10.0
+	cellPadding  = 1.5
+	titleHeight  = 4.0
+	titleGap     = 1.5
+	chipHeight   = 3.4
+	chipPadding  = 0.8
+	chipGap      = 0.8
+	shiftPadding = 0.8
+)
+
+// Colors used by the roster-table template.
+var (
+	colorWhite    = rgb{255, 255, 255}
+	colorGray100  = rgb{243, 244, 246}
+	colorGray200  = rgb{229, 231, 235}
+	colorGray700  = rgb{55, 65, 81}
+	colorText     = rgb{17, 24, 39}
+	colorPrimary  = rgb{3, 105, 161}
+	colorTitleBg  = colorGray200.blend(colorWhite, 0.5)
+	weekdayTitles = []string{"Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag", "Sonntag"}
+)
+
+// shiftAlpha is the opacity of shift colors. The HTML template appends 50
+// (hex) to the shift color.
+const shiftAlpha = float64(0x50) / 0xff
+
+// PDFRenderer draws roster documents in-process without requiring any
+// external service.
+type PDFRenderer struct{}
+
+// NewPDF returns a new in-process PDF renderer.
+func NewPDF() *PDFRenderer {
+	return &PDFRenderer{}
+}
+
+func (*PDFRenderer) RenderRoster(_ context.Context, rc templates.RosterContext) (io.ReadCloser, error) {
+	pdf := gofpdf.New("L", "mm", "A4", "")
+	pdf.SetMargins(pageMargin, pageMargin, pageMargin)
+	pdf.SetAutoPageBreak(false, 0)
+	pdf.AliasNbPages("{nb}")
+	pdf.SetTitle("Dienstplan", true)
+	pdf.SetCreator("rosterd", true)
+
+	t := &tableWriter{
+		canvas: newCanvas(pdf),
+	}
+
+	pageWidth, pageHeight := pdf.GetPageSize()
+	t.colWidth = (pageWidth - 2*pageMargin) / 7
+
+	available := pageHeight - 2*pageMargin - headerHeight - footerHeight
+
+	heights := make([]float64, len(rc.Weeks))
+	for idx, week := range rc.Weeks {
+		heights[idx] = t.weekHeight(week)
+	}
+
+	for _, page := range paginateWeeks(heights, available) {
+		pdf.AddPage()
+		t.drawHeader()
+
+		y := pageMargin + headerHeight
+		for _, row := range page {
+			t.drawWeek(rc.Weeks[row.index], y, row.height)
+			y += row.height
+		}
+
+		t.drawFooter(pageHeight)
+	}
+
+	if len(rc.Weeks) == 0 {
+		pdf.AddPage()
+		t.drawHeader()
+		t.drawFooter(pageHeight)
+	}
+
+	return output(pdf)
+}
+
+func output(pdf *gofpdf.Fpdf) (io.ReadCloser, error) {
+	buf := new(bytes.Buffer)
+	if err := pdf.Output(buf); err != nil {
+		return nil, fmt.Errorf("failed to render PDF: %w", err)
+	}
+
+	return io.NopCloser(buf), nil
+}
+
+type weekRow struct {
+	index  int
+	height float64
+}
+
+// paginateWeeks distributes weeks with the given minimum heights across
+// pages with the available height. Like the flex layout of the HTML
+// template, remaining space on a page is distributed evenly across its weeks.
+// Weeks that exceed the available height get a page of their own.
+func paginateWeeks(heights []float64, available float64) [][]weekRow {
+	var (
+		pages [][]weekRow
+		page  []weekRow
+		used  float64
+	)
+
+	flush := func() {
+		if len(page) == 0 {
+			return
+		}
+
+		if extra := (available - used) / float64(len(page)); extra > 0 {
+			for idx := range page {
+				page[idx].height += extra
+			}
+		}
+
+		pages = append(pages, page)
+		page = nil
+		used = 0
+	}
+
+	for idx, h := range heights {
+		if len(page) > 0 && used+h > available {
+			flush()
+		}
+
+		page = append(page, weekRow{index: idx, height: h})
+		used += h
+	}
+	flush()
+
+	return pages
+}
+
+// canvas wraps a PDF document with helpers shared by all documents.
+type canvas struct {
+	pdf *gofpdf.Fpdf
+	tr  func(string) string
+}
+
+func newCanvas(pdf *gofpdf.Fpdf) canvas {
+	return canvas{
+		pdf: pdf,
+		tr:  pdf.UnicodeTranslatorFromDescriptor(""),
+	}
+}
+
+type tableWriter struct {
+	canvas
+
+	colWidth float64
+}
+
+func (t *tableWriter) drawHeader() {
+	pdf := t.pdf
+
+	pdf.SetFont("Helvetica", "B", 7)
+	pdf.SetLineWidth(0.2)
+
+	for idx, title := range weekdayTitles {
+		x := pageMargin + float64(idx)*t.colWidth
+
+		t.fill(colorWhite)
+		pdf.Rect(x, pageMargin, t.colWidth, headerHeight, "F")
+
+		t.fill(colorWhite)
+		t.draw(colorGray200)
+		pdf.RoundedRect(x+1, pageMargin+1, t.colWidth-2, headerHeight-2, 1.5, "1234", "FD")
+
+		t.textColor(colorText)
+		pdf.SetXY(x+1, pageMargin+1)
+		pdf.CellFormat(t.colWidth-2, headerHeight-2, t.tr(title), "", 0, "CM", false, 0, "")
+	}
+
+	t.draw(colorGray200)
+	pdf.SetLineWidth(0.6)
+	pdf.Line(pageMargin, pageMargin+headerHeight, pageMargin+7*t.colWidth, pageMargin+headerHeight)
+	pdf.SetLineWidth(0.2)
+}
+
+func (t *tableWriter) drawFooter(pageHeight float64) {
+	pdf := t.pdf
+	y := pageHeight - pageMargin - footerHeight + 3
+
+	pdf.SetXY(pageMargin, y)
+	t.textColor(colorText)
+	pdf.SetFont("Helvetica", "B", 10)
+	name := "Tierklinik Dobersberg"
+	pdf.CellFormat(pdf.GetStringWidth(name)+2, footerHeight-3, name, "", 0, "LM", false, 0, "")
+
+	t.textColor(colorGray700)
+	pdf.SetFont("Helvetica", "", 10)
+	pdf.CellFormat(10, footerHeight-3, "| IT", "", 0, "LM", false, 0, "")
+
+	pdf.SetXY(pageMargin, y)
+	pdf.CellFormat(7*t.colWidth, footerHeight-3, fmt.Sprintf("%d von {nb}", pdf.PageNo()), "", 0, "RM", false, 0, "")
+}
+
+// weekHeight returns the minimum height required to draw week.
+func (t *tableWriter) weekHeight(week templates.RosterWeek) float64 {
+	var maxHeight float64
+	for _, day := range week.Days {
+		h := 2*cellPadding + titleHeight + titleGap
+		for _, shift := range day.Shifts {
+			h += t.shiftHeight(shift)
+		}
+
+		if h > maxHeight {
+			maxHeight = h
+		}
+	}
+
+	return maxHeight
+}
+
+func (t *tableWriter) shiftHeight(shift templates.RosterShift) float64 {
+	lines := len(t.chipLines(shift.Users))
+	if lines == 0 {
+		lines = 1
+	}
+
+	return 2*shiftPadding + float64(lines)*chipHeight + float64(lines-1)*chipGap
+}
+
+// chipLines wraps the user chips of a shift into lines that fit into the
+// user column of a day cell.
+func (t *tableWriter) chipLines(users []templates.RosterUser) [][]templates.RosterUser {
+	t.pdf.SetFont("Helvetica", "", 6.5)
+
+	var (
+		lines    [][]templates.RosterUser
+		line     []templates.RosterUser
+		width    float64
+		maxWidth = t.userColumnWidth()
+	)
+
+	for _, u := range users {
+		w := t.chipWidth(u)
+		if len(line) > 0 && width+chipGap+w > maxWidth {
+			lines = append(lines, line)
+			line = nil
+			width = 0
+		}
+
+		if len(line) > 0 {
+			width += chipGap
+		}
+
+		line = append(line, u)
+		width += w
+	}
+
+	if len(line) > 0 {
+		lines = append(lines, line)
+	}
+
+	return lines
+}
+
+func (t *tableWriter) chipWidth(u templates.RosterUser) float64 {
+	return t.pdf.GetStringWidth(t.tr(u.Name)) + 2*chipPadding
+}
+
+func (t *tableWriter) nameColumnWidth() float64 {
+	return (t.colWidth - 2*cellPadding) / 4
+}
+
+func (t *tableWriter) userColumnWidth() float64 {
+	return t.colWidth - 2*cellPadding - t.nameColumnWidth() - 2*chipGap
+}
+
+func (t *tableWriter) drawWeek(week templates.RosterWeek, y, height float64) {
+	for idx, day := range week.Days {
+		t.drawDay(day, pageMargin+float64(idx)*t.colWidth, y, height)
+	}
+}
+
+func (t *tableWriter) drawDay(day templates.RosterDay, x, y, height float64) {
+	pdf := t.pdf
+
+	bg := colorWhite
+	if day.Disabled {
+		bg = colorGray100
+	}
+
+	t.fill(bg)
+	t.draw(colorGray200)
+	pdf.Rect(x, y, t.colWidth, height, "FD")
+
+	// day title
+	title := day.DayTitle
+	titleBg, titleFg := colorTitleBg, colorText
+	if day.Holiday != nil {
+		title += " | " + day.Holiday.LocalName
+		titleBg, titleFg = colorPrimary, colorWhite
+	}
+
+	pdf.SetFont("Helvetica", "", 6.5)
+	title = t.fit(t.tr(title), t.colWidth-2*cellPadding-4)
+	titleWidth := pdf.GetStringWidth(title) + 4
+
+	t.fill(titleBg)
+	pdf.RoundedRect(x+(t.colWidth-titleWidth)/2, y+cellPadding, titleWidth, titleHeight, titleHeight/2, "1234", "F")
+	t.textColor(titleFg)
+	pdf.SetXY(x+(t.colWidth-titleWidth)/2, y+cellPadding)
+	pdf.CellFormat(titleWidth, titleHeight, title, "", 0, "CM", false, 0, "")
+
+	// shifts stretch to fill the remaining height of the cell
+	top := y + cellPadding + titleHeight + titleGap
+	remaining := height - (top - y) - cellPadding
+
+	var needed float64
+	for _, shift := range day.Shifts {
+		needed += t.shiftHeight(shift)
+	}
+
+	var extra float64
+	if len(day.Shifts) > 0 && remaining > needed {
+		extra = (remaining - needed) / float64(len(day.Shifts))
+	}
+
+	for _, shift := range day.Shifts {
+		h := t.shiftHeight(shift) + extra
+		t.drawShift(shift, bg, x+cellPadding, top, h)
+		top += h
+	}
+}
+
+func (t *tableWriter) drawShift(shift templates.RosterShift, bg rgb, x, y, height float64) {
+	pdf := t.pdf
+	width := t.colWidth - 2*cellPadding
+
+	t.fill(parseColor(shift.Color, bg).blend(bg, shiftAlpha))
+	pdf.Rect(x, y, width, height, "F")
+
+	t.draw(colorGray200)
+	pdf.Line(x, y, x+width, y)
+	pdf.Line(x, y+height, x+width, y+height)
+
+	// shift name
+	nameWidth := t.nameColumnWidth()
+	pdf.SetFont("Helvetica", "B", 6.5)
+	t.textColor(colorText)
+	pdf.SetXY(x, y+shiftPadding)
+	pdf.CellFormat(nameWidth-1, chipHeight, t.fit(t.tr(shift.ShiftName), nameWidth-1), "", 0, "RM", false, 0, "")
+	pdf.Line(x+nameWidth, y+shiftPadding, x+nameWidth, y+height-shiftPadding)
+
+	// user chips
+	lineY := y + shiftPadding
+	for _, line := range t.chipLines(shift.Users) {
+		chipX := x + nameWidth + chipGap
+
+		for _, u := range line {
+			w := t.chipWidth(u)
+
+			t.fill(parseColor(u.Color, colorWhite))
+			pdf.RoundedRect(chipX, lineY, w, chipHeight, 0.6, "1234", "F")
+
+			t.textColor(parseColor(u.ContrastColor, colorText))
+			pdf.SetXY(chipX, lineY)
+			pdf.CellFormat(w, chipHeight, t.tr(u.Name), "", 0, "CM", false, 0, "")
+
+			chipX += w + chipGap
+		}
+
+		lineY += chipHeight + chipGap
+	}
+}
+
+// fit truncates s so it fits into width using the current font.
+func (t canvas) fit(s string, width float64) string {
+	if t.pdf.GetStringWidth(s) <= width {
+		return s
+	}
+
+	ellipsis := "..."
+	for len(s) > 0 && t.pdf.GetStringWidth(s+ellipsis) > width {
+		s = s[:len(s)-1]
+	}
+
+	return s + ellipsis
+}
+
+func (t canvas) fill(c rgb)      { t.pdf.SetFillColor(c.r, c.g, c.b) }
+func (t canvas) draw(c rgb)      { t.pdf.SetDrawColor(c.r, c.g, c.b) }
+func (t canvas) textColor(c rgb) { t.pdf.SetTextColor(c.r, c.g, c.b) }
+
+type rgb struct {
+	r, g, b int
+}
+
+// blend returns c with the given opacity drawn on top of bg.
+func (c rgb) blend(bg rgb, alpha float64) rgb {
+	mix := func(fg, bg int) int {
+		return int(float64(fg)*alpha + float64(bg)*(1-alpha) + 0.5)
+	}
+
+	return rgb{mix(c.r, bg.r), mix(c.g, bg.g), mix(c.b, bg.b)}
+}
+
+// parseColor parses CSS hex colors in the form #rgb, #rrggbb or #rrggbbaa.
+// The alpha channel is ignored. If s is not a valid color, fallback is
+// returned.
+func parseColor(s string, fallback rgb) rgb {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "#")
+
+	switch len(s) {
+	case 3:
+		s = string([]byte{s[0], s[0], s[1], s[1], s[2], s[2]})
+	case 6:
+	case 8:
+		s = s[:6]
+	default:
+		return fallback
+	}
+
+	v, err := strconv.ParseUint(s, 16, 32)
+	if err != nil {
+		return fallback
+	}
+
+	return rgb{int(v >> 16 & 0xff), int(v >> 8 & 0xff), int(v & 0xff)}
+}
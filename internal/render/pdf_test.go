@@ -0,0 +1,150 @@
+package render
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	calendarv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/calendar/v1"
+	"github.com/tierklinik-dobersberg/rosterd/templates"
+)
+
+func Test_parseColor(t *testing.T) {
+	fallback := rgb{1, 2, 3}
+
+	cases := map[string]rgb{
+		"#fff":      {255, 255, 255},
+		"#1e40af":   {30, 64, 175},
+		"#1e40af80": {30, 64, 175},
+		"1e40af":    {30, 64, 175},
+		"":          fallback,
+		"#12345":    fallback,
+		"#zzzzzz":   fallback,
+	}
+
+	for input, expected := range cases {
+		require.Equal(t, expected, parseColor(input, fallback), input)
+	}
+}
+
+func Test_rgb_blend(t *testing.T) {
+	require.Equal(t, rgb{0, 0, 0}, rgb{0, 0, 0}.blend(colorWhite, 1))
+	require.Equal(t, colorWhite, rgb{0, 0, 0}.blend(colorWhite, 0))
+	require.Equal(t, rgb{128, 128, 128}, rgb{0, 0, 0}.blend(colorWhite, 0.5))
+}
+
+func Test_paginateWeeks(t *testing.T) {
+	t.Run("single page", func(t *testing.T) {
+		pages := paginateWeeks([]float64{10, 20, 30}, 90)
+
+		require.Equal(t, [][]weekRow{
+			{{0, 20}, {1, 30}, {2, 40}},
+		}, pages)
+	})
+
+	t.Run("multiple pages", func(t *testing.T) {
+		pages := paginateWeeks([]float64{40, 40, 40, 120}, 100)
+
+		require.Equal(t, [][]weekRow{
+			{{0, 50}, {1, 50}},
+			{{2, 100}},
+			{{3, 120}},
+		}, pages)
+	})
+
+	t.Run("no weeks", func(t *testing.T) {
+		require.Empty(t, paginateWeeks(nil, 100))
+	})
+}
+
+func Test_PDFRenderer_RenderRoster(t *testing.T) {
+	day := templates.RosterDay{
+		DayTitle: "01.05",
+		Holiday: &calendarv1.PublicHoliday{
+			LocalName: "Staatsfeiertag",
+		},
+		Shifts: []templates.RosterShift{
+			{
+				ShiftName: "Tag",
+				Color:     "#1e40af",
+				Users: []templates.RosterUser{
+					{Name: "AL", Color: "#ff0000", ContrastColor: "#ffffff"},
+					{Name: "BÖ", Color: "#fff", ContrastColor: "#000"},
+				},
+			},
+		},
+	}
+
+	week := templates.RosterWeek{}
+	for i := 0; i < 7; i++ {
+		week.Days = append(week.Days, day)
+	}
+
+	rc := templates.RosterContext{
+		Weeks: []templates.RosterWeek{week, week, week, week, week},
+	}
+
+	res, err := NewPDF().RenderRoster(context.Background(), rc)
+	require.NoError(t, err)
+	defer res.Close()
+
+	blob, err := io.ReadAll(res)
+	require.NoError(t, err)
+	require.True(t, bytes.HasPrefix(blob, []byte("%PDF-")))
+
+	// an empty roster still renders a page
+	res, err = NewPDF().RenderRoster(context.Background(), templates.RosterContext{})
+	require.NoError(t, err)
+	defer res.Close()
+}
+
+func Test_New(t *testing.T) {
+	r, err := New("", "")
+	require.NoError(t, err)
+	require.IsType(t, &PDFRenderer{}, r)
+
+	r, err = New("", "http://gotenberg:3000")
+	require.NoError(t, err)
+	require.IsType(t, &GotenbergRenderer{}, r)
+
+	_, err = New(Gotenberg, "")
+	require.Error(t, err)
+
+	_, err = New("wkhtmltopdf", "")
+	require.Error(t, err)
+}
+
+func Test_PDFRenderer_RenderUserRoster(t *testing.T) {
+	rc := templates.UserRosterContext{
+		UserName:     "Alice Müller",
+		Month:        "05/2024",
+		Preview:      true,
+		ExpectedTime: "160:00 h",
+		PlannedTime:  "150:00 h",
+		Overtime:     "-10:00 h",
+	}
+
+	for i := 0; i < 31; i++ {
+		rc.Days = append(rc.Days, templates.UserRosterDay{
+			Date:    "01.05.2024",
+			Weekday: "Mi",
+			Holiday: &calendarv1.PublicHoliday{LocalName: "Staatsfeiertag"},
+			Shifts: []templates.UserRosterShift{
+				{Name: "Früh", From: "08:00", To: "14:00", Color: "#ff0000"},
+				{Name: "Spät", From: "14:00", To: "20:00", Color: "#00ff00"},
+				{Name: "Bereitschaft", From: "20:00", To: "08:00"},
+			},
+			OffTime: "Urlaub",
+		})
+	}
+
+	res, err := NewPDF().RenderUserRoster(context.Background(), rc)
+	require.NoError(t, err)
+	defer res.Close()
+
+	blob, err := io.ReadAll(res)
+	require.NoError(t, err)
+	require.True(t, bytes.HasPrefix(blob, []byte("%PDF-")))
+}
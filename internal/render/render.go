@@ -0,0 +1,52 @@
+// Package render renders duty rosters into PDF documents. Rosters can either
+// be rendered in-process or by converting the HTML templates using a
+// Gotenberg server.
+package render
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/tierklinik-dobersberg/rosterd/templates"
+)
+
+// Renderer renders roster documents as PDF.
+type Renderer interface {
+	// RenderRoster renders the monthly roster table described by rc.
+	RenderRoster(ctx context.Context, rc templates.RosterContext) (io.ReadCloser, error)
+
+	// RenderUserRoster renders the personal roster of a single user.
+	RenderUserRoster(ctx context.Context, rc templates.UserRosterContext) (io.ReadCloser, error)
+}
+
+// Supported renderer names.
+const (
+	Builtin   = "builtin"
+	Gotenberg = "gotenberg"
+)
+
+// New returns the renderer with the given name. If name is empty, Gotenberg
+// is used if gotenbergURL is set and the builtin renderer otherwise.
+func New(name string, gotenbergURL string) (Renderer, error) {
+	if name == "" {
+		name = Builtin
+		if gotenbergURL != "" {
+			name = Gotenberg
+		}
+	}
+
+	switch name {
+	case Builtin:
+		return NewPDF(), nil
+
+	case Gotenberg:
+		if gotenbergURL == "" {
+			return nil, fmt.Errorf("no gotenberg server configured")
+		}
+
+		return NewGotenberg(gotenbergURL), nil
+	}
+
+	return nil, fmt.Errorf("unknown renderer %q", name)
+}
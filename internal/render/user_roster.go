@@ -0,0 +1,291 @@
+package render
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/jung-kurt/gofpdf"
+	"github.com/tierklinik-dobersberg/rosterd/templates"
+)
+
+// Layout of the personal roster in millimeters.
+const (
+	userPageMargin   = 12.0
+	userHeaderHeight = 18.0
+	userRowHeight    = 6.5
+	userDateWidth    = 34.0
+	userOffTimeWidth = 42.0
+	userSummaryRow   = 6.0
+)
+
+func (*PDFRenderer) RenderUserRoster(_ context.Context, rc templates.UserRosterContext) (io.ReadCloser, error) {
+	pdf := gofpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(userPageMargin, userPageMargin, userPageMargin)
+	pdf.SetAutoPageBreak(false, 0)
+	pdf.SetTitle("Dienstplan "+rc.UserName+" "+rc.Month, true)
+	pdf.SetCreator("rosterd", true)
+
+	w := &userRosterWriter{
+		canvas: newCanvas(pdf),
+	}
+
+	pageWidth, pageHeight := pdf.GetPageSize()
+	w.width = pageWidth - 2*userPageMargin
+	w.bottom = pageHeight - userPageMargin
+
+	w.addPage(rc)
+
+	for _, day := range rc.Days {
+		h := w.rowHeight(day)
+		if w.y+h > w.bottom {
+			w.addPage(rc)
+		}
+
+		w.drawDay(day, h)
+	}
+
+	w.drawSummary(rc)
+
+	return output(pdf)
+}
+
+type userRosterWriter struct {
+	canvas
+
+	width  float64
+	bottom float64
+	y      float64
+}
+
+func (w *userRosterWriter) shiftColumnWidth() float64 {
+	return w.width - userDateWidth - userOffTimeWidth
+}
+
+func (w *userRosterWriter) addPage(rc templates.UserRosterContext) {
+	pdf := w.pdf
+	pdf.AddPage()
+
+	// title
+	w.textColor(colorText)
+	pdf.SetFont("Helvetica", "B", 16)
+	pdf.SetXY(userPageMargin, userPageMargin)
+	pdf.CellFormat(w.width/2, 8, w.tr(rc.UserName), "", 0, "LM", false, 0, "")
+
+	pdf.SetFont("Helvetica", "B", 11)
+	pdf.SetXY(userPageMargin, userPageMargin)
+	pdf.CellFormat(w.width, 8, "Tierklinik Dobersberg", "", 0, "RM", false, 0, "")
+
+	subtitle := "Dienstplan " + rc.Month
+	if rc.Preview {
+		subtitle += " (vorläufig)"
+	}
+
+	w.textColor(colorGray700)
+	pdf.SetFont("Helvetica", "", 10)
+	pdf.SetXY(userPageMargin, userPageMargin+8)
+	pdf.CellFormat(w.width, 6, w.tr(subtitle), "", 0, "LM", false, 0, "")
+
+	w.draw(colorGray200)
+	pdf.SetLineWidth(0.6)
+	pdf.Line(userPageMargin, userPageMargin+userHeaderHeight-2, userPageMargin+w.width, userPageMargin+userHeaderHeight-2)
+	pdf.SetLineWidth(0.2)
+
+	// table header
+	w.y = userPageMargin + userHeaderHeight
+	w.textColor(colorText)
+	pdf.SetFont("Helvetica", "B", 8)
+
+	x := userPageMargin
+	for _, col := range []struct {
+		title string
+		width float64
+	}{
+		{"Datum", userDateWidth},
+		{"Dienste", w.shiftColumnWidth()},
+		{"Abwesenheit", userOffTimeWidth},
+	} {
+		pdf.SetXY(x, w.y)
+		pdf.CellFormat(col.width, userRowHeight, col.title, "", 0, "LM", false, 0, "")
+		x += col.width
+	}
+
+	w.y += userRowHeight
+	pdf.SetLineWidth(0.6)
+	pdf.Line(userPageMargin, w.y, userPageMargin+w.width, w.y)
+	pdf.SetLineWidth(0.2)
+}
+
+// shiftLabel returns the text of a shift chip.
+func (w *userRosterWriter) shiftLabel(shift templates.UserRosterShift) string {
+	return w.tr(fmt.Sprintf("%s %s - %s", shift.Name, shift.From, shift.To))
+}
+
+// shiftLines wraps the shift chips of a day into lines that fit into the
+// shift column.
+func (w *userRosterWriter) shiftLines(shifts []templates.UserRosterShift) [][]templates.UserRosterShift {
+	w.pdf.SetFont("Helvetica", "", 7.5)
+
+	var (
+		lines    [][]templates.UserRosterShift
+		line     []templates.UserRosterShift
+		width    float64
+		maxWidth = w.shiftColumnWidth() - 2
+	)
+
+	for _, shift := range shifts {
+		sw := w.pdf.GetStringWidth(w.shiftLabel(shift)) + 4
+		if len(line) > 0 && width+chipGap+sw > maxWidth {
+			lines = append(lines, line)
+			line = nil
+			width = 0
+		}
+
+		if len(line) > 0 {
+			width += chipGap
+		}
+
+		line = append(line, shift)
+		width += sw
+	}
+
+	if len(line) > 0 {
+		lines = append(lines, line)
+	}
+
+	return lines
+}
+
+func (w *userRosterWriter) rowHeight(day templates.UserRosterDay) float64 {
+	h := userRowHeight
+	if lines := len(w.shiftLines(day.Shifts)); lines > 1 {
+		h += float64(lines-1) * (chipHeight + 1 + chipGap)
+	}
+
+	if day.Holiday != nil && h < 2*userRowHeight-2 {
+		h = 2*userRowHeight - 2
+	}
+
+	return h
+}
+
+func (w *userRosterWriter) drawDay(day templates.UserRosterDay, height float64) {
+	pdf := w.pdf
+
+	bg := colorWhite
+	switch {
+	case day.Holiday != nil:
+		bg = colorPrimary.blend(colorWhite, 0.1)
+	case day.Weekend:
+		bg = colorGray100
+	}
+
+	w.fill(bg)
+	pdf.Rect(userPageMargin, w.y, w.width, height, "F")
+	w.draw(colorGray200)
+	pdf.Line(userPageMargin, w.y+height, userPageMargin+w.width, w.y+height)
+
+	// date
+	w.textColor(colorText)
+	pdf.SetFont("Helvetica", "B", 8)
+	pdf.SetXY(userPageMargin, w.y)
+	pdf.CellFormat(8, userRowHeight, w.tr(day.Weekday), "", 0, "LM", false, 0, "")
+	pdf.SetFont("Helvetica", "", 8)
+	pdf.CellFormat(userDateWidth-8, userRowHeight, w.tr(day.Date), "", 0, "LM", false, 0, "")
+
+	if day.Holiday != nil {
+		w.textColor(colorPrimary)
+		pdf.SetFont("Helvetica", "", 7)
+		pdf.SetXY(userPageMargin, w.y+userRowHeight-2)
+		pdf.CellFormat(userDateWidth, userRowHeight-2, w.fit(w.tr(day.Holiday.LocalName), userDateWidth-1), "", 0, "LM", false, 0, "")
+	}
+
+	// shifts
+	lineY := w.y + (userRowHeight-chipHeight-1)/2
+	for _, line := range w.shiftLines(day.Shifts) {
+		x := userPageMargin + userDateWidth
+
+		for _, shift := range line {
+			label := w.shiftLabel(shift)
+			sw := pdf.GetStringWidth(label) + 4
+
+			w.fill(parseColor(shift.Color, bg).blend(bg, shiftAlpha))
+			pdf.RoundedRect(x, lineY, sw, chipHeight+1, 0.8, "1234", "F")
+
+			w.textColor(colorText)
+			pdf.SetXY(x, lineY)
+			pdf.CellFormat(sw, chipHeight+1, label, "", 0, "CM", false, 0, "")
+
+			x += sw + chipGap
+		}
+
+		lineY += chipHeight + 1 + chipGap
+	}
+
+	// off-time
+	if day.OffTime != "" {
+		w.textColor(colorText)
+		pdf.SetFont("Helvetica", "", 8)
+		pdf.SetXY(userPageMargin+userDateWidth+w.shiftColumnWidth(), w.y)
+		pdf.CellFormat(userOffTimeWidth, userRowHeight, w.fit(w.tr(day.OffTime), userOffTimeWidth-1), "", 0, "LM", false, 0, "")
+	}
+
+	w.y += height
+}
+
+func (w *userRosterWriter) drawSummary(rc templates.UserRosterContext) {
+	pdf := w.pdf
+
+	type row struct {
+		title string
+		value string
+		bold  bool
+	}
+
+	var left []row
+	if !rc.ExcludeFromTimeTracking {
+		left = append(left, row{"Soll-Arbeitszeit", rc.ExpectedTime, false})
+	}
+	left = append(left, row{"Geplante Arbeitszeit", rc.PlannedTime, false})
+	if !rc.ExcludeFromTimeTracking {
+		left = append(left, row{"Überstunden", rc.Overtime, true})
+	}
+
+	right := []row{
+		{"Resturlaub", rc.VacationBalance, false},
+		{"Zeitausgleich", rc.TimeOffBalance, false},
+	}
+
+	height := float64(max(len(left), len(right)))*userSummaryRow + 6
+	if w.y+height > w.bottom {
+		w.addPage(rc)
+	}
+
+	w.y += 6
+	colWidth := (w.width - 10) / 2
+
+	for col, rows := range [][]row{left, right} {
+		x := userPageMargin + float64(col)*(colWidth+10)
+		y := w.y
+
+		for _, r := range rows {
+			style := ""
+			if r.bold {
+				style = "B"
+
+				w.draw(colorGray200)
+				pdf.Line(x, y, x+colWidth, y)
+			}
+
+			w.textColor(colorText)
+			pdf.SetFont("Helvetica", style, 9)
+			pdf.SetXY(x, y)
+			pdf.CellFormat(colWidth/2, userSummaryRow, w.tr(r.title), "", 0, "LM", false, 0, "")
+			pdf.CellFormat(colWidth/2, userSummaryRow, w.tr(r.value), "", 0, "RM", false, 0, "")
+
+			y += userSummaryRow
+		}
+	}
+
+	w.y += height - 6
+}
@@ -0,0 +1,278 @@
+package offtime
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	rosterv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/roster/v1"
+	"github.com/tierklinik-dobersberg/apis/pkg/auth"
+	rosterdv1 "github.com/tierklinik-dobersberg/rosterd/gen/go/rosterd/v1"
+	"github.com/tierklinik-dobersberg/rosterd/gen/go/rosterd/v1/rosterdv1connect"
+	"github.com/tierklinik-dobersberg/rosterd/internal/config"
+	"github.com/tierklinik-dobersberg/rosterd/internal/holiday"
+	"github.com/tierklinik-dobersberg/rosterd/internal/offtimerules"
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"github.com/tierklinik-dobersberg/rosterd/internal/timecalc"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var _ rosterdv1connect.OffTimeServiceHandler = (*Service)(nil)
+
+// offTimeCheck holds the result of checking a new off-time request.
+type offTimeCheck struct {
+	Balance   *rosterdv1.VacationBalance
+	Conflicts []offtimerules.Conflict
+	Warnings  []string
+	Rejected  bool
+}
+
+func (svc *Service) GetVacationBalance(ctx context.Context, req *connect.Request[rosterdv1.GetVacationBalanceRequest]) (*connect.Response[rosterdv1.GetVacationBalanceResponse], error) {
+	remoteUser := auth.From(ctx)
+	if remoteUser == nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, nil)
+	}
+
+	userIds := req.Msg.UserIds
+	if len(userIds) == 0 && len(req.Msg.OfftimeIds) == 0 {
+		userIds = []string{remoteUser.ID}
+	}
+
+	response := new(rosterdv1.GetVacationBalanceResponse)
+
+	for _, id := range userIds {
+		if id != remoteUser.ID && !remoteUser.Admin {
+			return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("you're not allowed to perform this operation"))
+		}
+
+		balance, err := svc.vacationBalance(ctx, id, time.Now(), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		response.Results = append(response.Results, balance)
+	}
+
+	if len(req.Msg.OfftimeIds) > 0 {
+		entries, err := svc.Datastore.GetOffTimeRequest(ctx, req.Msg.OfftimeIds...)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(entries) != len(req.Msg.OfftimeIds) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("failed to find one or more off-time requests"))
+		}
+
+		for idx := range entries {
+			entry := entries[idx]
+
+			if entry.RequestorId != remoteUser.ID && !remoteUser.Admin {
+				return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("you're not allowed to perform this operation"))
+			}
+
+			balance, err := svc.vacationBalance(ctx, entry.RequestorId, entry.To, &entry)
+			if err != nil {
+				return nil, err
+			}
+
+			response.Results = append(response.Results, balance)
+		}
+	}
+
+	return connect.NewResponse(response), nil
+}
+
+func (svc *Service) CheckOffTimeRequest(ctx context.Context, req *connect.Request[rosterdv1.CheckOffTimeRequestRequest]) (*connect.Response[rosterdv1.CheckOffTimeRequestResponse], error) {
+	remoteUser := auth.From(ctx)
+	if remoteUser == nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, nil)
+	}
+
+	if req.Msg.Request == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("missing request"))
+	}
+
+	entry, err := svc.entryFromCreateRequest(ctx, remoteUser, req.Msg.Request)
+	if err != nil {
+		return nil, err
+	}
+
+	check, err := svc.checkOffTimeRequest(ctx, entry)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&rosterdv1.CheckOffTimeRequestResponse{
+		Balance:   check.Balance,
+		Warnings:  check.Warnings,
+		Rejected:  check.Rejected,
+		Conflicts: conflictsToProto(check.Conflicts),
+	}), nil
+}
+
+// entryFromCreateRequest prepares a new off-time entry from a
+// CreateOffTimeRequestRequest.
+func (svc *Service) entryFromCreateRequest(ctx context.Context, remoteUser *auth.RemoteUser, msg *rosterv1.CreateOffTimeRequestRequest) (structs.OffTimeEntry, error) {
+	requestorId := msg.RequestorId
+
+	// figure out for which user we want to create the offtime request
+	if requestorId == "" || !remoteUser.Admin {
+		requestorId = remoteUser.ID
+	}
+
+	if requestorId == "" {
+		return structs.OffTimeEntry{}, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("failed to determine target user"))
+	}
+
+	// verify the user actually exists.
+	if err := svc.verifyUsersExists(ctx, requestorId); err != nil {
+		return structs.OffTimeEntry{}, fmt.Errorf("failed to fetch user: %w", err)
+	}
+
+	if !msg.From.IsValid() || !msg.To.IsValid() {
+		return structs.OffTimeEntry{}, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("from and to must be set"))
+	}
+
+	return structs.OffTimeEntry{
+		From:        msg.From.AsTime(),
+		To:          msg.To.AsTime(),
+		Description: msg.Description,
+		RequestorId: requestorId,
+		CreatedAt:   time.Now(),
+		CreatorId:   remoteUser.ID,
+		RequestType: requestTypeFromProto(msg.RequestType),
+	}, nil
+}
+
+// checkOffTimeRequest checks a new off-time request against the off-time
+// rules and the vacation balance of the requestor.
+func (svc *Service) checkOffTimeRequest(ctx context.Context, entry structs.OffTimeEntry) (*offTimeCheck, error) {
+	result := new(offTimeCheck)
+
+	conflicts, err := svc.checkRules(ctx, entry)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(conflicts) > 0 {
+		result.Conflicts = conflicts
+		result.Rejected = true
+
+		for _, c := range conflicts {
+			result.Warnings = append(result.Warnings, c.Message)
+		}
+	}
+
+	if entry.RequestType != structs.RequestTypeVacation || svc.Config.VacationBalanceCheck == config.VacationBalanceCheckOff {
+		return result, nil
+	}
+
+	balance, err := svc.vacationBalance(ctx, entry.RequestorId, entry.To, &entry)
+	if err != nil {
+		return nil, err
+	}
+
+	result.Balance = balance
+
+	if balance.Exceeded {
+		result.Warnings = append(result.Warnings, fmt.Sprintf("vacation request exceeds the remaining vacation credits by %s", (-balance.Projected.AsDuration()).Round(time.Minute)))
+		result.Rejected = result.Rejected || svc.Config.VacationBalanceCheck == config.VacationBalanceCheckReject
+	}
+
+	return result, nil
+}
+
+// vacationBalance calculates the vacation balance of userId at until. If
+// entry is set and still pending, the balance is projected for entry.
+func (svc *Service) vacationBalance(ctx context.Context, userId string, until time.Time, entry *structs.OffTimeEntry) (*rosterdv1.VacationBalance, error) {
+	workTimes, err := svc.Datastore.WorkTimeHistoryForStaff(ctx, userId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get work-time history for user %q: %w", userId, err)
+	}
+
+	costs, err := svc.Datastore.GetOffTimeCosts(ctx, userId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get off-time costs for user %q: %w", userId, err)
+	}
+
+	requests, err := svc.Datastore.FindOffTimeRequests(ctx, time.Time{}, time.Time{}, nil, []string{userId})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get off-time requests for user %q: %w", userId, err)
+	}
+
+	// collect all pending vacation requests, including entry.
+	var pending []structs.OffTimeEntry
+	for _, r := range requests {
+		if r.Approval != nil || r.RequestType != structs.RequestTypeVacation {
+			continue
+		}
+
+		if entry != nil && r.ID == entry.ID {
+			continue
+		}
+
+		pending = append(pending, r)
+	}
+
+	isPending := entry != nil && entry.Approval == nil && entry.RequestType == structs.RequestTypeVacation
+	if isPending {
+		pending = append(pending, *entry)
+	}
+
+	var holidays holiday.Holidays
+	if len(pending) > 0 {
+		from, to := pending[0].From, pending[0].To
+		for _, p := range pending[1:] {
+			if p.From.Before(from) {
+				from = p.From
+			}
+			if p.To.After(to) {
+				to = p.To
+			}
+		}
+
+		holidays, err = svc.HolidayProvider.For(ctx, from, to)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var (
+		pendingCosts time.Duration
+		requestCosts time.Duration
+	)
+
+	for idx, p := range pending {
+		var sum time.Duration
+		for _, d := range timecalc.CalculateOffTimeCosts(p.From, p.To, workTimes, holidays, svc.Config.Weekend) {
+			sum += d.Costs
+		}
+
+		if isPending && idx == len(pending)-1 {
+			requestCosts = sum
+		} else {
+			pendingCosts += sum
+		}
+	}
+
+	credits := timecalc.CalculateVacationBalance(workTimes, costs, until).Vacation
+	projected := credits - pendingCosts - requestCosts
+
+	result := &rosterdv1.VacationBalance{
+		UserId:       userId,
+		Until:        timestamppb.New(until),
+		CreditsLeft:  durationpb.New(credits.Round(time.Minute)),
+		Pending:      durationpb.New(pendingCosts),
+		RequestCosts: durationpb.New(requestCosts),
+		Projected:    durationpb.New(projected.Round(time.Minute)),
+		Exceeded:     requestCosts > 0 && projected < 0,
+	}
+
+	if entry != nil && !entry.ID.IsZero() {
+		result.OfftimeId = entry.ID.Hex()
+	}
+
+	return result, nil
+}
@@ -0,0 +1,60 @@
+package offtime
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/tierklinik-dobersberg/apis/pkg/log"
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"github.com/tierklinik-dobersberg/rosterd/internal/timecalc"
+)
+
+// calculateCosts returns the off-time costs that need to be booked when
+// entry is approved. Requests with type "auto" are booked as time-off.
+func (svc *Service) calculateCosts(ctx context.Context, entry structs.OffTimeEntry, creator string) ([]structs.OffTimeCosts, error) {
+	workTimes, err := svc.Datastore.WorkTimeHistoryForStaff(ctx, entry.RequestorId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get work-time history for user %q: %w", entry.RequestorId, err)
+	}
+
+	holidays, err := svc.HolidayProvider.For(ctx, entry.From, entry.To)
+	if err != nil {
+		return nil, err
+	}
+
+	days := timecalc.CalculateOffTimeCosts(entry.From, entry.To, workTimes, holidays, svc.Config.Weekend)
+
+	now := time.Now()
+	result := make([]structs.OffTimeCosts, len(days))
+	for idx, d := range days {
+		result[idx] = structs.OffTimeCosts{
+			UserID:     entry.RequestorId,
+			OfftimeID:  entry.ID,
+			CreatedAt:  now,
+			CreatorId:  creator,
+			Costs:      -d.Costs,
+			IsVacation: entry.RequestType == structs.RequestTypeVacation,
+			Date:       d.Date,
+		}
+	}
+
+	return result, nil
+}
+
+// bookCosts replaces all off-time costs linked to entry with costs.
+func (svc *Service) bookCosts(ctx context.Context, entry structs.OffTimeEntry, costs []structs.OffTimeCosts) error {
+	if err := svc.Datastore.DeleteOffTimeCostsByOffTime(ctx, entry.ID.Hex()); err != nil {
+		return fmt.Errorf("failed to remove off-time costs of request %s: %w", entry.ID.Hex(), err)
+	}
+
+	for idx := range costs {
+		if err := svc.Datastore.AddOffTimeCost(ctx, &costs[idx]); err != nil {
+			return fmt.Errorf("failed to add off-time costs for request %s: %w", entry.ID.Hex(), err)
+		}
+	}
+
+	log.L(ctx).Info("booked off-time costs for request", "id", entry.ID.Hex(), "user", entry.RequestorId, "days", len(costs))
+
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"io/fs"
+	"strings"
 	"time"
 
 	"github.com/bufbuild/connect-go"
@@ -25,6 +26,10 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// WarningHeader is the response header used to report warnings for a new
+// off-time request.
+const WarningHeader = "X-Off-Time-Warning"
+
 type Database interface {
 	database.OffTimeDatabase
 	database.WorkTimeDatabase
@@ -64,38 +69,30 @@ func (svc *Service) CreateOffTimeRequest(ctx context.Context, req *connect.Reque
 		return nil, connect.NewError(connect.CodePermissionDenied, nil)
 	}
 
-	// figure out for which user we want to create the offtime request
-	if req.Msg.RequestorId == "" || !remoteUser.Admin {
-		req.Msg.RequestorId = remoteUser.ID
+	entry, err := svc.entryFromCreateRequest(ctx, remoteUser, req.Msg)
+	if err != nil {
+		return nil, err
 	}
 
-	if req.Msg.RequestorId == "" {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("failed to determine target user"))
+	check, err := svc.checkOffTimeRequest(ctx, entry)
+	if err != nil {
+		return nil, err
 	}
 
-	// verify the user actually exists.
-	if err := svc.verifyUsersExists(ctx, req.Msg.RequestorId); err != nil {
-		return nil, fmt.Errorf("failed to fetch user: %w", err)
+	if len(check.Conflicts) > 0 {
+		return nil, conflictsError(check.Conflicts)
 	}
 
-	from := req.Msg.From.AsTime()
-	to := req.Msg.To.AsTime()
-
-	// actually create the off-time entry and store it in the database.
-	entry := structs.OffTimeEntry{
-		From:        from,
-		To:          to,
-		Description: req.Msg.Description,
-		RequestorId: req.Msg.RequestorId,
-		CreatedAt:   time.Now(),
-		CreatorId:   remoteUser.ID,
-		RequestType: requestTypeFromProto(req.Msg.RequestType),
+	if check.Rejected {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("%s", strings.Join(check.Warnings, ", ")))
 	}
 
 	if err := svc.Datastore.CreateOffTimeRequest(ctx, &entry); err != nil {
 		return nil, err
 	}
 
+	svc.Audit.Record(ctx, structs.AuditEntityOffTimeRequest, entry.ID.Hex(), structs.AuditOperationCreate, nil, entry, entry.RequestorId)
+
 	go func() {
 		managerUsers, err := svc.Providers.Users.ListUsers(context.Background(), connect.NewRequest(&idmv1.ListUsersRequest{
 			FilterByRoles: []string{svc.Config.RosterManagerRoleID},
@@ -104,14 +101,16 @@ func (svc *Service) CreateOffTimeRequest(ctx context.Context, req *connect.Reque
 			},
 		}))
 
-		userIds := maps.Keys(data.IndexSlice(managerUsers.Msg.Users, func(p *idmv1.Profile) string {
-			return p.User.Id
-		}))
-
 		if err != nil {
 			log.L(context.Background()).Error("failed to get roster_manager users", "error", err)
+
+			return
 		}
 
+		userIds := maps.Keys(data.IndexSlice(managerUsers.Msg.Users, func(p *idmv1.Profile) string {
+			return p.User.Id
+		}))
+
 		if res, err := svc.Providers.Notify.SendNotification(context.Background(), connect.NewRequest(&idmv1.SendNotificationRequest{
 			Message: &idmv1.SendNotificationRequest_Webpush{
 				Webpush: &idmv1.WebPushNotification{
@@ -140,9 +139,17 @@ func (svc *Service) CreateOffTimeRequest(ctx context.Context, req *connect.Reque
 		}
 	}()
 
-	return connect.NewResponse(&rosterv1.CreateOffTimeRequestResponse{
+	response := connect.NewResponse(&rosterv1.CreateOffTimeRequestResponse{
 		Entry: entry.ToProto(),
-	}), nil
+	})
+
+	// the upstream response does not have a field for warnings so we pass
+	// them as a header. Use CheckOffTimeRequest to get the full result.
+	for _, w := range check.Warnings {
+		response.Header().Add(WarningHeader, w)
+	}
+
+	return response, nil
 }
 
 func (svc *Service) UpdateOffTimeRequest(ctx context.Context, req *connect.Request[rosterv1.UpdateOffTimeRequestRequest]) (*connect.Response[rosterv1.UpdateOffTimeRequestResponse], error) {
@@ -165,6 +172,7 @@ func (svc *Service) UpdateOffTimeRequest(ctx context.Context, req *connect.Reque
 	}
 
 	entry := entries[0]
+	before := entry
 
 	if entry.Approval != nil {
 		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("off-time request has already been approved or rejected and cannot be modified anymore"))
@@ -230,6 +238,8 @@ func (svc *Service) UpdateOffTimeRequest(ctx context.Context, req *connect.Reque
 		return nil, err
 	}
 
+	svc.Audit.Record(ctx, structs.AuditEntityOffTimeRequest, entry.ID.Hex(), structs.AuditOperationUpdate, before, entry, before.RequestorId, entry.RequestorId)
+
 	return connect.NewResponse(&rosterv1.UpdateOffTimeRequestResponse{
 		Entry: entry.ToProto(),
 	}), nil
@@ -274,6 +284,17 @@ func (svc *Service) DeleteOffTimeRequest(ctx context.Context, req *connect.Reque
 		return nil, err
 	}
 
+	// remove all costs that have been booked for approved requests.
+	for _, id := range req.Msg.Id {
+		if err := svc.Datastore.DeleteOffTimeCostsByOffTime(ctx, id); err != nil {
+			return nil, fmt.Errorf("failed to remove off-time costs of request %s: %w", id, err)
+		}
+	}
+
+	for _, model := range models {
+		svc.Audit.Record(ctx, structs.AuditEntityOffTimeRequest, model.ID.Hex(), structs.AuditOperationDelete, model, nil, model.RequestorId)
+	}
+
 	return connect.NewResponse(new(rosterv1.DeleteOffTimeRequestResponse)), nil
 }
 
@@ -330,6 +351,7 @@ func (svc *Service) ApproveOrReject(ctx context.Context, req *connect.Request[ro
 	if len(models) == 0 {
 		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("failed to find request"))
 	}
+	before := models[0]
 
 	approval := structs.Approval{
 		Approved:   req.Msg.Type == rosterv1.ApprovalRequestType_APPROVAL_REQUEST_TYPE_APPROVED,
@@ -338,10 +360,35 @@ func (svc *Service) ApproveOrReject(ctx context.Context, req *connect.Request[ro
 		Comment:    req.Msg.Comment,
 	}
 
+	// calculate the costs before changing the approval so we don't end up
+	// with an approved request without costs.
+	var costs []structs.OffTimeCosts
+	if approval.Approved {
+		conflicts, err := svc.checkRules(ctx, models[0])
+		if err != nil {
+			return nil, err
+		}
+
+		if len(conflicts) > 0 {
+			return nil, conflictsError(conflicts)
+		}
+
+		costs, err = svc.calculateCosts(ctx, models[0], remoteUser.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to calculate off-time costs: %w", err)
+		}
+	}
+
 	if err := svc.Datastore.ApproveOffTimeRequest(ctx, req.Msg.Id, &approval); err != nil {
 		return nil, err
 	}
 
+	// book the costs of the request. If a previous approval has been
+	// withdrawn, costs is empty and all linked costs are removed.
+	if err := svc.bookCosts(ctx, models[0], costs); err != nil {
+		return nil, err
+	}
+
 	models, err = svc.Datastore.GetOffTimeRequest(ctx, req.Msg.Id)
 	if err != nil {
 		return nil, err
@@ -351,6 +398,12 @@ func (svc *Service) ApproveOrReject(ctx context.Context, req *connect.Request[ro
 		return nil, fmt.Errorf("failed to find approved request")
 	}
 
+	op := structs.AuditOperationReject
+	if approval.Approved {
+		op = structs.AuditOperationApprove
+	}
+	svc.Audit.Record(ctx, structs.AuditEntityOffTimeRequest, req.Msg.Id, op, before, models[0], models[0].RequestorId)
+
 	if err := svc.sendApprovalNotice(ctx, remoteUser.ID, models[0]); err != nil {
 		log.L(ctx).Error("failed to send approval notice", "target", remoteUser.ID, "error", err)
 	}
@@ -437,6 +490,8 @@ func (svc *Service) AddOffTimeCosts(ctx context.Context, req *connect.Request[ro
 		if err := svc.Datastore.AddOffTimeCost(ctx, &model); err != nil {
 			return nil, err
 		}
+
+		svc.Audit.Record(ctx, structs.AuditEntityOffTimeCosts, model.ID.Hex(), structs.AuditOperationCreate, nil, model, model.UserID)
 	}
 
 	return connect.NewResponse(new(rosterv1.AddOffTimeCostsResponse)), nil
@@ -561,10 +616,20 @@ func (svc *Service) GetOffTimeCosts(ctx context.Context, req *connect.Request[ro
 }
 
 func (svc *Service) DeleteOffTimeCosts(ctx context.Context, req *connect.Request[rosterv1.DeleteOffTimeCostsRequest]) (*connect.Response[rosterv1.DeleteOffTimeCostsResponse], error) {
+	// load the costs first so the audit log contains the deleted values.
+	costs, err := svc.Datastore.GetOffTimeCostsByID(ctx, req.Msg.Ids...)
+	if err != nil {
+		return nil, err
+	}
+
 	if err := svc.Datastore.DeleteOffTimeCosts(ctx, req.Msg.Ids...); err != nil {
 		return nil, err
 	}
 
+	for _, c := range costs {
+		svc.Audit.Record(ctx, structs.AuditEntityOffTimeCosts, c.ID.Hex(), structs.AuditOperationDelete, c, nil, c.UserID)
+	}
+
 	return connect.NewResponse(new(rosterv1.DeleteOffTimeCostsResponse)), nil
 }
 
@@ -0,0 +1,272 @@
+package offtime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/tierklinik-dobersberg/apis/pkg/auth"
+	rosterdv1 "github.com/tierklinik-dobersberg/rosterd/gen/go/rosterd/v1"
+	"github.com/tierklinik-dobersberg/rosterd/internal/offtimerules"
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"github.com/tierklinik-dobersberg/rosterd/internal/timecalc"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (svc *Service) CreateOffTimeRule(ctx context.Context, req *connect.Request[rosterdv1.CreateOffTimeRuleRequest]) (*connect.Response[rosterdv1.CreateOffTimeRuleResponse], error) {
+	remoteUser := auth.From(ctx)
+	if remoteUser == nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, nil)
+	}
+
+	rule, err := ruleFromProto(req.Msg.Rule)
+	if err != nil {
+		return nil, err
+	}
+
+	rule.ID = primitive.NilObjectID
+	rule.CreatedAt = time.Now()
+	rule.CreatorId = remoteUser.ID
+
+	if err := svc.Datastore.CreateOffTimeRule(ctx, &rule); err != nil {
+		return nil, fmt.Errorf("failed to create off-time rule: %w", err)
+	}
+
+	svc.Audit.Record(ctx, structs.AuditEntityOffTimeRule, rule.ID.Hex(), structs.AuditOperationCreate, nil, rule)
+
+	return connect.NewResponse(&rosterdv1.CreateOffTimeRuleResponse{
+		Rule: ruleToProto(rule),
+	}), nil
+}
+
+func (svc *Service) UpdateOffTimeRule(ctx context.Context, req *connect.Request[rosterdv1.UpdateOffTimeRuleRequest]) (*connect.Response[rosterdv1.UpdateOffTimeRuleResponse], error) {
+	rule, err := ruleFromProto(req.Msg.Rule)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := svc.Datastore.GetOffTimeRule(ctx, req.Msg.Rule.GetId())
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("off-time rule with id %q not found", req.Msg.Rule.GetId()))
+		}
+
+		return nil, err
+	}
+
+	rule.CreatedAt = existing.CreatedAt
+	rule.CreatorId = existing.CreatorId
+
+	if err := svc.Datastore.UpdateOffTimeRule(ctx, &rule); err != nil {
+		return nil, fmt.Errorf("failed to update off-time rule: %w", err)
+	}
+
+	svc.Audit.Record(ctx, structs.AuditEntityOffTimeRule, rule.ID.Hex(), structs.AuditOperationUpdate, existing, rule)
+
+	return connect.NewResponse(&rosterdv1.UpdateOffTimeRuleResponse{
+		Rule: ruleToProto(rule),
+	}), nil
+}
+
+func (svc *Service) DeleteOffTimeRule(ctx context.Context, req *connect.Request[rosterdv1.DeleteOffTimeRuleRequest]) (*connect.Response[rosterdv1.DeleteOffTimeRuleResponse], error) {
+	existing, err := svc.Datastore.GetOffTimeRule(ctx, req.Msg.Id)
+	if err == nil {
+		err = svc.Datastore.DeleteOffTimeRule(ctx, req.Msg.Id)
+	}
+
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("off-time rule with id %q not found", req.Msg.Id))
+		}
+
+		return nil, err
+	}
+
+	svc.Audit.Record(ctx, structs.AuditEntityOffTimeRule, req.Msg.Id, structs.AuditOperationDelete, existing, nil)
+
+	return connect.NewResponse(new(rosterdv1.DeleteOffTimeRuleResponse)), nil
+}
+
+func (svc *Service) ListOffTimeRules(ctx context.Context, req *connect.Request[rosterdv1.ListOffTimeRulesRequest]) (*connect.Response[rosterdv1.ListOffTimeRulesResponse], error) {
+	rules, err := svc.Datastore.ListOffTimeRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &rosterdv1.ListOffTimeRulesResponse{
+		Rules: make([]*rosterdv1.OffTimeRule, len(rules)),
+	}
+
+	for idx, r := range rules {
+		response.Rules[idx] = ruleToProto(r)
+	}
+
+	return connect.NewResponse(response), nil
+}
+
+// checkRules checks entry against all off-time rules.
+func (svc *Service) checkRules(ctx context.Context, entry structs.OffTimeEntry) ([]offtimerules.Conflict, error) {
+	rules, err := svc.Datastore.ListOffTimeRules(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load off-time rules: %w", err)
+	}
+
+	if len(rules) == 0 {
+		return nil, nil
+	}
+
+	checker := offtimerules.Checker{
+		Rules: rules,
+	}
+
+	needsRoles := false
+	for _, r := range rules {
+		if len(r.RoleIDs) > 0 {
+			needsRoles = true
+		}
+	}
+
+	if needsRoles {
+		profiles, err := svc.FetchAllUserProfiles(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch user profiles: %w", err)
+		}
+
+		checker.Roles = make(map[string][]string, len(profiles))
+		for _, p := range profiles {
+			for _, role := range p.Roles {
+				checker.Roles[p.User.Id] = append(checker.Roles[p.User.Id], role.Id)
+			}
+		}
+	}
+
+	// date-only requests are stored with To at midnight so we need to
+	// search one more day to find all overlapping requests.
+	approved := true
+	from, to := timecalc.OffTimeRange(entry.From, entry.To)
+	others, err := svc.Datastore.FindOffTimeRequests(ctx, from.AddDate(0, 0, -1), to, &approved, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find approved off-time requests: %w", err)
+	}
+
+	return checker.Check(entry, others), nil
+}
+
+// conflictsError returns a FailedPrecondition error with the conflicts
+// attached as error details.
+func conflictsError(conflicts []offtimerules.Conflict) error {
+	messages := make([]string, len(conflicts))
+	for idx, c := range conflicts {
+		messages[idx] = c.Message
+
+		if len(c.ConflictingRequests) > 0 {
+			messages[idx] += fmt.Sprintf(", conflicting requests: %s", strings.Join(c.ConflictingRequests, ", "))
+		}
+	}
+
+	cerr := connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("off-time rules violated: %s", strings.Join(messages, "; ")))
+
+	if detail, err := connect.NewErrorDetail(&rosterdv1.OffTimeRuleConflicts{
+		Conflicts: conflictsToProto(conflicts),
+	}); err == nil {
+		cerr.AddDetail(detail)
+	}
+
+	return cerr
+}
+
+func conflictsToProto(conflicts []offtimerules.Conflict) []*rosterdv1.OffTimeRuleConflict {
+	result := make([]*rosterdv1.OffTimeRuleConflict, len(conflicts))
+	for idx, c := range conflicts {
+		result[idx] = &rosterdv1.OffTimeRuleConflict{
+			Rule:                  ruleToProto(c.Rule),
+			Message:               c.Message,
+			ConflictingRequestIds: c.ConflictingRequests,
+		}
+	}
+
+	return result
+}
+
+func ruleFromProto(pb *rosterdv1.OffTimeRule) (structs.OffTimeRule, error) {
+	if pb == nil {
+		return structs.OffTimeRule{}, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("missing rule"))
+	}
+
+	rule := structs.OffTimeRule{
+		Name:          pb.Name,
+		Description:   pb.Description,
+		RoleIDs:       pb.RoleIds,
+		UserIDs:       pb.UserIds,
+		MaxConcurrent: int(pb.MaxConcurrent),
+	}
+
+	if rule.Name == "" {
+		return rule, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name must be set"))
+	}
+
+	if pb.MaxConcurrent < 0 {
+		return rule, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("max_concurrent must not be negative"))
+	}
+
+	if pb.Id != "" {
+		var err error
+		rule.ID, err = primitive.ObjectIDFromHex(pb.Id)
+		if err != nil {
+			return rule, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid id: %w", err))
+		}
+	}
+
+	if pb.From != "" {
+		var err error
+		rule.From, err = time.ParseInLocation("2006-01-02", pb.From, time.Local)
+		if err != nil {
+			return rule, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid value for from: %w", err))
+		}
+	}
+
+	if pb.To != "" {
+		var err error
+		rule.To, err = time.ParseInLocation("2006-01-02", pb.To, time.Local)
+		if err != nil {
+			return rule, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid value for to: %w", err))
+		}
+	}
+
+	if !rule.From.IsZero() && !rule.To.IsZero() && rule.To.Before(rule.From) {
+		return rule, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("to must not be before from"))
+	}
+
+	return rule, nil
+}
+
+func ruleToProto(rule structs.OffTimeRule) *rosterdv1.OffTimeRule {
+	pb := &rosterdv1.OffTimeRule{
+		Id:            rule.ID.Hex(),
+		Name:          rule.Name,
+		Description:   rule.Description,
+		RoleIds:       rule.RoleIDs,
+		UserIds:       rule.UserIDs,
+		MaxConcurrent: int32(rule.MaxConcurrent),
+		CreatorId:     rule.CreatorId,
+	}
+
+	if !rule.From.IsZero() {
+		pb.From = rule.From.Local().Format("2006-01-02")
+	}
+
+	if !rule.To.IsZero() {
+		pb.To = rule.To.Local().Format("2006-01-02")
+	}
+
+	if !rule.CreatedAt.IsZero() {
+		pb.CreatedAt = timestamppb.New(rule.CreatedAt)
+	}
+
+	return pb
+}
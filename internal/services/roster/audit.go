@@ -0,0 +1,109 @@
+package roster
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bufbuild/connect-go"
+	rosterdv1 "github.com/tierklinik-dobersberg/rosterd/gen/go/rosterd/v1"
+	"github.com/tierklinik-dobersberg/rosterd/gen/go/rosterd/v1/rosterdv1connect"
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var _ rosterdv1connect.AuditServiceHandler = (*RosterService)(nil)
+
+func (svc *RosterService) ListAuditEvents(ctx context.Context, req *connect.Request[rosterdv1.ListAuditEventsRequest]) (*connect.Response[rosterdv1.ListAuditEventsResponse], error) {
+	filter := structs.AuditEventFilter{
+		Entity:   structs.AuditEntity(req.Msg.Entity),
+		EntityID: req.Msg.EntityId,
+		ActorID:  req.Msg.ActorId,
+		UserID:   req.Msg.UserId,
+		Limit:    req.Msg.Limit,
+	}
+
+	if req.Msg.From.IsValid() {
+		filter.From = req.Msg.From.AsTime()
+	}
+
+	if req.Msg.To.IsValid() {
+		filter.To = req.Msg.To.AsTime()
+	}
+
+	if filter.Limit < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("limit must not be negative"))
+	}
+
+	events, err := svc.Datastore.FindAuditEvents(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load audit events: %w", err)
+	}
+
+	response := &rosterdv1.ListAuditEventsResponse{
+		Events: make([]*rosterdv1.AuditEvent, len(events)),
+	}
+
+	for idx, e := range events {
+		response.Events[idx] = auditEventToProto(e)
+	}
+
+	return connect.NewResponse(response), nil
+}
+
+func auditEventToProto(e structs.AuditEvent) *rosterdv1.AuditEvent {
+	pb := &rosterdv1.AuditEvent{
+		Id:        e.ID.Hex(),
+		Time:      timestamppb.New(e.Time),
+		ActorId:   e.ActorID,
+		Entity:    string(e.Entity),
+		EntityId:  e.EntityID,
+		Operation: string(e.Operation),
+		UserIds:   e.UserIDs,
+		Changes:   make([]*rosterdv1.AuditChange, len(e.Changes)),
+	}
+
+	for idx, c := range e.Changes {
+		pb.Changes[idx] = &rosterdv1.AuditChange{
+			Path:   c.Path,
+			Before: c.Before,
+			After:  c.After,
+		}
+	}
+
+	return pb
+}
+
+// rosterUserIds returns the IDs of all users that are assigned to at least
+// one shift of the given rosters. It is used to record the affected users of
+// roster changes in the audit log.
+func rosterUserIds(rosters ...structs.DutyRoster) []string {
+	var (
+		result []string
+		seen   = make(map[string]struct{})
+	)
+
+	for _, roster := range rosters {
+		for _, shift := range roster.Shifts {
+			for _, id := range shift.AssignedUserIds {
+				if _, ok := seen[id]; ok {
+					continue
+				}
+
+				seen[id] = struct{}{}
+				result = append(result, id)
+			}
+		}
+	}
+
+	return result
+}
+
+// claimUserIds returns the IDs of all users that claimed the open shift.
+func claimUserIds(os structs.OpenShift) []string {
+	result := make([]string, len(os.Claims))
+	for idx, claim := range os.Claims {
+		result[idx] = claim.UserID
+	}
+
+	return result
+}
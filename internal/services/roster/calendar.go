@@ -0,0 +1,99 @@
+package roster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	idmv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/idm/v1"
+	"github.com/tierklinik-dobersberg/rosterd/internal/ical"
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"golang.org/x/exp/slices"
+)
+
+// rosterLineage follows the chain of superseded rosters and returns the ID of
+// the first roster version together with the number of versions that have
+// been superseded since. Both are used to create stable calendar events.
+func (svc *RosterService) rosterLineage(ctx context.Context, roster structs.DutyRoster) (primitive.ObjectID, int, error) {
+	var (
+		root     = roster.ID
+		sequence = 0
+		seen     = map[primitive.ObjectID]struct{}{root: {}}
+	)
+
+	for {
+		old, err := svc.Datastore.GetSupersededDutyRoster(ctx, root)
+		if err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				return root, sequence, nil
+			}
+
+			return root, sequence, fmt.Errorf("failed to load superseded roster: %w", err)
+		}
+
+		if _, ok := seen[old.ID]; ok {
+			return root, sequence, fmt.Errorf("roster %s is part of a superseded cycle", old.ID.Hex())
+		}
+		seen[old.ID] = struct{}{}
+
+		root = old.ID
+		sequence++
+	}
+}
+
+// userCalendars returns the calendar with all shifts of userId as well as a
+// calendar that cancels all shifts the user has been removed from.
+// rootID and sequence are the values returned by rosterLineage.
+func userCalendars(roster structs.DutyRoster, rootID primitive.ObjectID, sequence int, userId string, profile *idmv1.Profile, diff []ShiftDiff, definitions map[string]structs.WorkShift) (assigned, cancelled ical.Calendar) {
+	assigned.Sequence = sequence
+	cancelled.Sequence = sequence
+	cancelled.Cancel = true
+
+	var users []*idmv1.Profile
+	if profile != nil {
+		users = []*idmv1.Profile{profile}
+	}
+
+	for _, shift := range roster.Shifts {
+		if !slices.Contains(shift.AssignedUserIds, userId) {
+			continue
+		}
+
+		assigned.Events = append(assigned.Events, ical.Event{
+			UID:   ical.EventUID(rootID.Hex(), shift.WorkShiftID.Hex(), shift.From, userId),
+			From:  shift.From,
+			To:    shift.To,
+			Name:  definitions[shift.WorkShiftID.Hex()].Name,
+			Users: users,
+		})
+	}
+
+	for _, d := range diff {
+		if d.Assigned {
+			continue
+		}
+
+		from, err := time.Parse(time.RFC3339, d.From)
+		if err != nil {
+			continue
+		}
+
+		to, err := time.Parse(time.RFC3339, d.To)
+		if err != nil {
+			continue
+		}
+
+		cancelled.Events = append(cancelled.Events, ical.Event{
+			UID:   ical.EventUID(rootID.Hex(), d.ID, from, userId),
+			From:  from,
+			To:    to,
+			Name:  definitions[d.ID].Name,
+			Users: users,
+		})
+	}
+
+	return assigned, cancelled
+}
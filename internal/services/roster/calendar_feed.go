@@ -0,0 +1,377 @@
+package roster
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/tierklinik-dobersberg/apis/pkg/auth"
+	"github.com/tierklinik-dobersberg/apis/pkg/data"
+	"github.com/tierklinik-dobersberg/apis/pkg/log"
+	rosterdv1 "github.com/tierklinik-dobersberg/rosterd/gen/go/rosterd/v1"
+	"github.com/tierklinik-dobersberg/rosterd/gen/go/rosterd/v1/rosterdv1connect"
+	"github.com/tierklinik-dobersberg/rosterd/internal/ical"
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"golang.org/x/exp/slices"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// CalendarFeedPath is the path of the HTTP endpoint that serves personal
+// calendar feeds.
+const CalendarFeedPath = "/calendar/feed.ics"
+
+var _ rosterdv1connect.CalendarFeedServiceHandler = (*RosterService)(nil)
+
+func (svc *RosterService) CreateCalendarFeed(ctx context.Context, req *connect.Request[rosterdv1.CreateCalendarFeedRequest]) (*connect.Response[rosterdv1.CreateCalendarFeedResponse], error) {
+	remoteUser, userId, err := calendarFeedUser(ctx, req.Msg.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := newCalendarFeedToken()
+	if err != nil {
+		return nil, err
+	}
+
+	feed := &structs.CalendarFeed{
+		UserID:       userId,
+		TokenHash:    hashCalendarFeedToken(token),
+		CreatedAt:    time.Now(),
+		CreatorID:    remoteUser.ID,
+		ApprovedOnly: req.Msg.ApprovedOnly,
+	}
+
+	// an existing feed of the user is replaced by the new one.
+	previous, err := svc.Datastore.GetCalendarFeed(ctx, userId)
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, fmt.Errorf("failed to load calendar feed: %w", err)
+	}
+
+	if err := svc.Datastore.SaveCalendarFeed(ctx, feed); err != nil {
+		return nil, fmt.Errorf("failed to save calendar feed: %w", err)
+	}
+
+	// only the hash of the token is part of the audit log.
+	if previous != nil {
+		svc.Audit.Record(ctx, structs.AuditEntityCalendarFeed, userId, structs.AuditOperationUpdate, *previous, *feed, userId)
+	} else {
+		svc.Audit.Record(ctx, structs.AuditEntityCalendarFeed, userId, structs.AuditOperationCreate, nil, *feed, userId)
+	}
+
+	return connect.NewResponse(&rosterdv1.CreateCalendarFeedResponse{
+		Feed: calendarFeedToProto(*feed),
+		Url:  fmt.Sprintf("%s%s?token=%s", svc.Config.PublicURL, CalendarFeedPath, url.QueryEscape(token)),
+	}), nil
+}
+
+func (svc *RosterService) GetCalendarFeed(ctx context.Context, req *connect.Request[rosterdv1.GetCalendarFeedRequest]) (*connect.Response[rosterdv1.GetCalendarFeedResponse], error) {
+	_, userId, err := calendarFeedUser(ctx, req.Msg.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	feed, err := svc.Datastore.GetCalendarFeed(ctx, userId)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("no calendar feed for user %q", userId))
+		}
+
+		return nil, err
+	}
+
+	return connect.NewResponse(&rosterdv1.GetCalendarFeedResponse{
+		Feed: calendarFeedToProto(*feed),
+	}), nil
+}
+
+func (svc *RosterService) RevokeCalendarFeed(ctx context.Context, req *connect.Request[rosterdv1.RevokeCalendarFeedRequest]) (*connect.Response[rosterdv1.RevokeCalendarFeedResponse], error) {
+	_, userId, err := calendarFeedUser(ctx, req.Msg.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	feed, err := svc.Datastore.GetCalendarFeed(ctx, userId)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("no calendar feed for user %q", userId))
+		}
+
+		return nil, err
+	}
+
+	if err := svc.Datastore.DeleteCalendarFeed(ctx, userId); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("no calendar feed for user %q", userId))
+		}
+
+		return nil, err
+	}
+
+	svc.Audit.Record(ctx, structs.AuditEntityCalendarFeed, userId, structs.AuditOperationDelete, *feed, nil, userId)
+
+	return connect.NewResponse(&rosterdv1.RevokeCalendarFeedResponse{}), nil
+}
+
+// CalendarFeedHandler returns the HTTP handler that serves personal calendar
+// feeds. The feed is authenticated using the token query parameter and may
+// be filtered using the rosterType (repeatable) and onCall (true or false)
+// query parameters.
+func (svc *RosterService) CalendarFeedHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+
+			return
+		}
+
+		ctx := r.Context()
+		query := r.URL.Query()
+
+		token := query.Get("token")
+		if token == "" {
+			http.Error(w, "missing token", http.StatusUnauthorized)
+
+			return
+		}
+
+		feed, err := svc.Datastore.GetCalendarFeedByToken(ctx, hashCalendarFeedToken(token))
+		if err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				http.Error(w, "invalid token", http.StatusUnauthorized)
+			} else {
+				log.L(ctx).Error("failed to load calendar feed", "error", err)
+				http.Error(w, "internal server error", http.StatusInternalServerError)
+			}
+
+			return
+		}
+
+		filter := calendarFeedFilter{
+			ApprovedOnly: feed.ApprovedOnly,
+			RosterTypes:  query["rosterType"],
+		}
+
+		if v := query.Get("onCall"); v != "" {
+			onCall, err := strconv.ParseBool(v)
+			if err != nil {
+				http.Error(w, "invalid value for onCall", http.StatusBadRequest)
+
+				return
+			}
+
+			filter.OnCall = &onCall
+		}
+
+		cal, err := svc.buildCalendarFeed(ctx, feed.UserID, filter)
+		if err != nil {
+			log.L(ctx).Error("failed to build calendar feed", "user", feed.UserID, "error", err)
+			http.Error(w, "internal server error", http.StatusInternalServerError)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+		w.Header().Set("Content-Disposition", `inline; filename="Dienstplan.ics"`)
+		w.Header().Set("Cache-Control", "no-cache")
+
+		_, _ = w.Write([]byte(cal.ToICS(time.Now())))
+	})
+}
+
+// calendarFeedFilter restricts the shifts that are part of a calendar feed.
+type calendarFeedFilter struct {
+	// ApprovedOnly may be set to only include shifts of approved rosters.
+	ApprovedOnly bool
+
+	// RosterTypes may be set to only include shifts of the given roster
+	// types.
+	RosterTypes []string
+
+	// OnCall may be set to only include (true) or exclude (false) shifts
+	// that are tagged with the on-call tags of the roster type.
+	OnCall *bool
+}
+
+// feedRoster is a duty roster together with the values returned by
+// rosterLineage.
+type feedRoster struct {
+	structs.DutyRoster
+
+	RootID   primitive.ObjectID
+	Sequence int
+}
+
+// buildCalendarFeed returns the calendar feed of userId. Like
+// GetUserShifts, all rosters that have not been deleted are included unless
+// filter.ApprovedOnly is set.
+func (svc *RosterService) buildCalendarFeed(ctx context.Context, userId string, filter calendarFeedFilter) (ical.Calendar, error) {
+	rosters, err := svc.Datastore.LoadDutyRosters(ctx)
+	if err != nil {
+		return ical.Calendar{}, fmt.Errorf("failed to load duty rosters: %w", err)
+	}
+
+	var feedRosters []feedRoster
+	for _, roster := range rosters {
+		if filter.ApprovedOnly && !roster.IsApproved() {
+			continue
+		}
+
+		if len(filter.RosterTypes) > 0 && !slices.Contains(filter.RosterTypes, roster.RosterTypeName) {
+			continue
+		}
+
+		if !slices.ContainsFunc(roster.Shifts, func(shift structs.PlannedShift) bool {
+			return slices.Contains(shift.AssignedUserIds, userId)
+		}) {
+			continue
+		}
+
+		rootID, sequence, err := svc.rosterLineage(ctx, roster)
+		if err != nil {
+			return ical.Calendar{}, err
+		}
+
+		feedRosters = append(feedRosters, feedRoster{
+			DutyRoster: roster,
+			RootID:     rootID,
+			Sequence:   sequence,
+		})
+	}
+
+	definitions, err := svc.Datastore.ListWorkShifts(ctx)
+	if err != nil {
+		return ical.Calendar{}, fmt.Errorf("failed to load work-shift definitions: %w", err)
+	}
+
+	rosterTypes, err := svc.Datastore.GetRosterTypes(ctx)
+	if err != nil {
+		return ical.Calendar{}, fmt.Errorf("failed to load roster types: %w", err)
+	}
+
+	approved := true
+	offTimes, err := svc.Datastore.FindOffTimeRequests(ctx, time.Time{}, time.Time{}, &approved, []string{userId})
+	if err != nil {
+		return ical.Calendar{}, fmt.Errorf("failed to load off-time requests: %w", err)
+	}
+
+	return userCalendarFeed(
+		userId,
+		feedRosters,
+		data.IndexSlice(definitions, func(ws structs.WorkShift) string { return ws.ID.Hex() }),
+		data.IndexSlice(rosterTypes, func(rt structs.RosterType) string { return rt.UniqueName }),
+		offTimes,
+		filter,
+	), nil
+}
+
+// userCalendarFeed returns the calendar feed with all shifts of userId in
+// rosters and the approved off-time requests of the user.
+func userCalendarFeed(userId string, rosters []feedRoster, definitions map[string]structs.WorkShift, rosterTypes map[string]structs.RosterType, offTimes []structs.OffTimeEntry, filter calendarFeedFilter) ical.Calendar {
+	cal := ical.Calendar{
+		Name: "Dienstplan",
+	}
+
+	for _, roster := range rosters {
+		onCallTags := rosterTypes[roster.RosterTypeName].OnCallTags
+
+		for _, shift := range roster.Shifts {
+			if !slices.Contains(shift.AssignedUserIds, userId) {
+				continue
+			}
+
+			def := definitions[shift.WorkShiftID.Hex()]
+
+			if filter.OnCall != nil && *filter.OnCall != data.ElemInBothSlices(def.Tags, onCallTags) {
+				continue
+			}
+
+			cal.Events = append(cal.Events, ical.Event{
+				UID:      ical.EventUID(roster.RootID.Hex(), shift.WorkShiftID.Hex(), shift.From, userId),
+				Sequence: roster.Sequence,
+				From:     shift.From,
+				To:       shift.To,
+				Name:     def.Name,
+			})
+		}
+	}
+
+	for _, entry := range offTimes {
+		if entry.RequestorId != userId || entry.Approval == nil || !entry.Approval.Approved {
+			continue
+		}
+
+		cal.Events = append(cal.Events, ical.Event{
+			UID:  entry.ID.Hex() + "@dobersberg.vet",
+			From: entry.From,
+			To:   entry.To,
+			Name: offTimeEventName(entry.RequestType),
+		})
+	}
+
+	return cal
+}
+
+func offTimeEventName(requestType structs.RequestType) string {
+	switch requestType {
+	case structs.RequestTypeVacation:
+		return "Urlaub"
+	case structs.RequestTypeTimeOff:
+		return "Zeitausgleich"
+	default:
+		return "Abwesenheit"
+	}
+}
+
+// calendarFeedUser returns the remote user and the ID of the user whose feed
+// should be managed. Only roster managers may manage feeds of other users.
+func calendarFeedUser(ctx context.Context, requested string) (*auth.RemoteUser, string, error) {
+	remoteUser := auth.From(ctx)
+	if remoteUser == nil {
+		return nil, "", connect.NewError(connect.CodePermissionDenied, nil)
+	}
+
+	if requested == "" || requested == remoteUser.ID {
+		return remoteUser, remoteUser.ID, nil
+	}
+
+	if !remoteUser.Admin {
+		return nil, "", connect.NewError(connect.CodePermissionDenied, fmt.Errorf("only roster managers may manage calendar feeds of other users"))
+	}
+
+	return remoteUser, requested, nil
+}
+
+func newCalendarFeedToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate feed token: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+func hashCalendarFeedToken(token string) string {
+	h := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(h[:])
+}
+
+func calendarFeedToProto(feed structs.CalendarFeed) *rosterdv1.CalendarFeed {
+	return &rosterdv1.CalendarFeed{
+		UserId:       feed.UserID,
+		CreatedAt:    timestamppb.New(feed.CreatedAt),
+		CreatorId:    feed.CreatorID,
+		ApprovedOnly: feed.ApprovedOnly,
+	}
+}
@@ -0,0 +1,135 @@
+package roster
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	"github.com/tierklinik-dobersberg/rosterd/internal/config"
+	"github.com/tierklinik-dobersberg/rosterd/internal/database/memory"
+	"github.com/tierklinik-dobersberg/rosterd/internal/ical"
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func Test_userCalendarFeed(t *testing.T) {
+	var (
+		root   = primitive.NewObjectID()
+		early  = structs.WorkShift{ID: primitive.NewObjectID(), Name: "Früh", Tags: []string{"regular"}}
+		onCall = structs.WorkShift{ID: primitive.NewObjectID(), Name: "Bereitschaft", Tags: []string{"oncall"}}
+		day    = time.Date(2024, time.March, 4, 0, 0, 0, 0, time.Local)
+	)
+
+	definitions := map[string]structs.WorkShift{
+		early.ID.Hex():  early,
+		onCall.ID.Hex(): onCall,
+	}
+
+	rosterTypes := map[string]structs.RosterType{
+		"Tierarzt": {UniqueName: "Tierarzt", ShiftTags: []string{"regular"}, OnCallTags: []string{"oncall"}},
+	}
+
+	rosters := []feedRoster{
+		{
+			DutyRoster: structs.DutyRoster{
+				ID:             primitive.NewObjectID(),
+				RosterTypeName: "Tierarzt",
+				Shifts: []structs.PlannedShift{
+					{WorkShiftID: early.ID, From: day.Add(8 * time.Hour), To: day.Add(14 * time.Hour), AssignedUserIds: []string{"alice", "bob"}},
+					{WorkShiftID: onCall.ID, From: day.Add(20 * time.Hour), To: day.Add(32 * time.Hour), AssignedUserIds: []string{"alice"}},
+					{WorkShiftID: early.ID, From: day.Add(32 * time.Hour), To: day.Add(38 * time.Hour), AssignedUserIds: []string{"bob"}},
+				},
+			},
+			RootID:   root,
+			Sequence: 2,
+		},
+	}
+
+	offTimes := []structs.OffTimeEntry{
+		{ID: primitive.NewObjectID(), RequestorId: "alice", RequestType: structs.RequestTypeVacation, From: day.AddDate(0, 0, 7), To: day.AddDate(0, 0, 9), Approval: &structs.Approval{Approved: true}},
+		{ID: primitive.NewObjectID(), RequestorId: "alice", RequestType: structs.RequestTypeTimeOff, From: day.AddDate(0, 0, 10), To: day.AddDate(0, 0, 11), Approval: &structs.Approval{Approved: false}},
+	}
+
+	cal := userCalendarFeed("alice", rosters, definitions, rosterTypes, offTimes, calendarFeedFilter{})
+	require.Len(t, cal.Events, 3)
+
+	require.Equal(t, "Früh", cal.Events[0].Name)
+	require.Equal(t, ical.EventUID(root.Hex(), early.ID.Hex(), day, "alice"), cal.Events[0].UID)
+	require.Equal(t, 2, cal.Events[0].Sequence)
+	require.Equal(t, "Bereitschaft", cal.Events[1].Name)
+	require.Equal(t, "Urlaub", cal.Events[2].Name)
+
+	yes, no := true, false
+
+	cal = userCalendarFeed("alice", rosters, definitions, rosterTypes, nil, calendarFeedFilter{OnCall: &yes})
+	require.Len(t, cal.Events, 1)
+	require.Equal(t, "Bereitschaft", cal.Events[0].Name)
+
+	cal = userCalendarFeed("alice", rosters, definitions, rosterTypes, nil, calendarFeedFilter{OnCall: &no})
+	require.Len(t, cal.Events, 1)
+	require.Equal(t, "Früh", cal.Events[0].Name)
+}
+
+func Test_buildCalendarFeed(t *testing.T) {
+	ctx := context.Background()
+	db := memory.New(logrus.NewEntry(logrus.StandardLogger()))
+	svc := NewRosterService(&config.Providers{Datastore: db})
+
+	early := structs.WorkShift{Name: "Früh"}
+	require.NoError(t, db.SaveWorkShift(ctx, &early))
+
+	newRoster := func(from string, day time.Time) *structs.DutyRoster {
+		roster := &structs.DutyRoster{
+			From: from,
+			To:   from,
+			Shifts: []structs.PlannedShift{
+				{WorkShiftID: early.ID, From: day.Add(8 * time.Hour), To: day.Add(14 * time.Hour), AssignedUserIds: []string{"alice"}},
+			},
+		}
+
+		_, err := db.SaveDutyRoster(ctx, roster, nil)
+		require.NoError(t, err)
+
+		return roster
+	}
+
+	approved := newRoster("2024-03-04", time.Date(2024, time.March, 4, 0, 0, 0, 0, time.Local))
+	require.NoError(t, db.ApproveDutyRoster(ctx, approved.ID.Hex(), "manager"))
+
+	draft := newRoster("2024-03-05", time.Date(2024, time.March, 5, 0, 0, 0, 0, time.Local))
+
+	deleted := newRoster("2024-03-06", time.Date(2024, time.March, 6, 0, 0, 0, 0, time.Local))
+	require.NoError(t, db.DeleteDutyRoster(ctx, deleted.ID.Hex(), primitive.NilObjectID))
+
+	// by default, all rosters that have not been deleted are included
+	cal, err := svc.buildCalendarFeed(ctx, "alice", calendarFeedFilter{})
+	require.NoError(t, err)
+	require.Len(t, cal.Events, 2)
+
+	uids := []string{cal.Events[0].UID, cal.Events[1].UID}
+	require.ElementsMatch(t, []string{
+		ical.EventUID(approved.ID.Hex(), early.ID.Hex(), approved.Shifts[0].From, "alice"),
+		ical.EventUID(draft.ID.Hex(), early.ID.Hex(), draft.Shifts[0].From, "alice"),
+	}, uids)
+
+	// drafts are excluded from approved-only feeds
+	cal, err = svc.buildCalendarFeed(ctx, "alice", calendarFeedFilter{ApprovedOnly: true})
+	require.NoError(t, err)
+	require.Len(t, cal.Events, 1)
+	require.Equal(t, ical.EventUID(approved.ID.Hex(), early.ID.Hex(), approved.Shifts[0].From, "alice"), cal.Events[0].UID)
+}
+
+func Test_hashCalendarFeedToken(t *testing.T) {
+	a, err := newCalendarFeedToken()
+	require.NoError(t, err)
+
+	b, err := newCalendarFeedToken()
+	require.NoError(t, err)
+
+	require.NotEqual(t, a, b)
+	require.Equal(t, hashCalendarFeedToken(a), hashCalendarFeedToken(a))
+	require.NotEqual(t, hashCalendarFeedToken(a), hashCalendarFeedToken(b))
+	require.NotContains(t, hashCalendarFeedToken(a), a)
+}
@@ -0,0 +1,104 @@
+package roster
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tierklinik-dobersberg/rosterd/internal/ical"
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func Test_userCalendars(t *testing.T) {
+	var (
+		root  = primitive.NewObjectID()
+		early = structs.WorkShift{ID: primitive.NewObjectID(), Name: "Früh"}
+		late  = structs.WorkShift{ID: primitive.NewObjectID(), Name: "Spät"}
+		day   = time.Date(2024, time.March, 4, 0, 0, 0, 0, time.Local)
+	)
+
+	definitions := map[string]structs.WorkShift{
+		early.ID.Hex(): early,
+		late.ID.Hex():  late,
+	}
+
+	roster := structs.DutyRoster{
+		ID: primitive.NewObjectID(),
+		Shifts: []structs.PlannedShift{
+			{WorkShiftID: early.ID, From: day.Add(8 * time.Hour), To: day.Add(14 * time.Hour), AssignedUserIds: []string{"alice", "bob"}},
+			{WorkShiftID: late.ID, From: day.Add(14 * time.Hour), To: day.Add(20 * time.Hour), AssignedUserIds: []string{"bob"}},
+		},
+	}
+
+	diff := []ShiftDiff{
+		{ID: early.ID.Hex(), From: day.Add(8 * time.Hour).Format(time.RFC3339), To: day.Add(14 * time.Hour).Format(time.RFC3339), Assigned: true},
+		{ID: late.ID.Hex(), From: day.Add(38 * time.Hour).Format(time.RFC3339), To: day.Add(44 * time.Hour).Format(time.RFC3339), Assigned: false},
+	}
+
+	assigned, cancelled := userCalendars(roster, root, 2, "alice", nil, diff, definitions)
+
+	require.False(t, assigned.Cancel)
+	require.Equal(t, 2, assigned.Sequence)
+	require.Len(t, assigned.Events, 1)
+	require.Equal(t, "Früh", assigned.Events[0].Name)
+	require.Equal(t, ical.EventUID(root.Hex(), early.ID.Hex(), day, "alice"), assigned.Events[0].UID)
+
+	require.True(t, cancelled.Cancel)
+	require.Equal(t, 2, cancelled.Sequence)
+	require.Len(t, cancelled.Events, 1)
+	require.Equal(t, "Spät", cancelled.Events[0].Name)
+	require.Equal(t, ical.EventUID(root.Hex(), late.ID.Hex(), day.AddDate(0, 0, 1), "alice"), cancelled.Events[0].UID)
+}
+
+func Test_userCalendars_diffRosters(t *testing.T) {
+	var (
+		early = structs.WorkShift{ID: primitive.NewObjectID(), Name: "Früh"}
+		late  = structs.WorkShift{ID: primitive.NewObjectID(), Name: "Spät"}
+		day   = time.Date(2024, time.March, 4, 0, 0, 0, 0, time.Local)
+	)
+
+	definitions := map[string]structs.WorkShift{
+		early.ID.Hex(): early,
+		late.ID.Hex():  late,
+	}
+
+	updated := structs.DutyRoster{
+		ID:   primitive.NewObjectID(),
+		From: "2024-03-04",
+		To:   "2024-03-05",
+		Shifts: []structs.PlannedShift{
+			{WorkShiftID: early.ID, From: day.Add(8 * time.Hour), To: day.Add(14 * time.Hour), AssignedUserIds: []string{"alice"}},
+		},
+	}
+
+	previous := structs.DutyRoster{
+		ID:           primitive.NewObjectID(),
+		From:         updated.From,
+		To:           updated.To,
+		SupersededBy: updated.ID,
+		Shifts: []structs.PlannedShift{
+			{WorkShiftID: early.ID, From: day.Add(8 * time.Hour), To: day.Add(14 * time.Hour), AssignedUserIds: []string{"alice", "bob"}},
+			// removed from the updated roster
+			{WorkShiftID: late.ID, From: day.Add(38 * time.Hour), To: day.Add(44 * time.Hour), AssignedUserIds: []string{"alice"}},
+		},
+	}
+
+	diff, err := diffRosters(context.Background(), &previous, &updated)
+	require.NoError(t, err)
+
+	// alice keeps the early shift and must receive a cancellation for the
+	// removed late shift.
+	assigned, cancelled := userCalendars(updated, previous.ID, 1, "alice", nil, diff["alice"], definitions)
+	require.Len(t, assigned.Events, 1)
+	require.Equal(t, "Früh", assigned.Events[0].Name)
+	require.Len(t, cancelled.Events, 1)
+	require.Equal(t, ical.EventUID(previous.ID.Hex(), late.ID.Hex(), day.AddDate(0, 0, 1), "alice"), cancelled.Events[0].UID)
+
+	// bob has been unassigned from the early shift.
+	assigned, cancelled = userCalendars(updated, previous.ID, 1, "bob", nil, diff["bob"], definitions)
+	require.Empty(t, assigned.Events)
+	require.Len(t, cancelled.Events, 1)
+	require.Equal(t, ical.EventUID(previous.ID.Hex(), early.ID.Hex(), day, "bob"), cancelled.Events[0].UID)
+}
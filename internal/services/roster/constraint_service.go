@@ -2,6 +2,7 @@ package roster
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/tierklinik-dobersberg/apis/pkg/auth"
 	"github.com/tierklinik-dobersberg/rosterd/internal/config"
 	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"go.mongodb.org/mongo-driver/mongo"
 	"golang.org/x/exp/slices"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
@@ -57,6 +59,8 @@ func (svc *ConstraintService) CreateConstraint(ctx context.Context, req *connect
 		return nil, err
 	}
 
+	svc.Audit.Record(ctx, structs.AuditEntityConstraint, model.ID.Hex(), structs.AuditOperationCreate, nil, model, model.AppliesToUser...)
+
 	return connect.NewResponse(&rosterv1.CreateConstraintResponse{
 		Constraint: model.ToProto(),
 	}), nil
@@ -72,6 +76,7 @@ func (svc *ConstraintService) UpdateConstraint(ctx context.Context, req *connect
 	if err != nil {
 		return nil, err
 	}
+	before := *model
 
 	model.UpdatedAt = time.Now()
 	model.LastUpdatedBy = remoteUser.ID
@@ -122,16 +127,29 @@ func (svc *ConstraintService) UpdateConstraint(ctx context.Context, req *connect
 		return nil, err
 	}
 
+	svc.Audit.Record(ctx, structs.AuditEntityConstraint, model.ID.Hex(), structs.AuditOperationUpdate, before, *model, append(append([]string{}, before.AppliesToUser...), model.AppliesToUser...)...)
+
 	return connect.NewResponse(&rosterv1.UpdateConstraintResponse{
 		Constraint: model.ToProto(),
 	}), nil
 }
 
 func (svc *ConstraintService) DeleteConstraint(ctx context.Context, req *connect.Request[rosterv1.DeleteConstraintRequest]) (*connect.Response[rosterv1.DeleteConstraintResponse], error) {
+	model, err := svc.Datastore.GetConstraintByID(ctx, req.Msg.Id)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("constraint with id %q not found", req.Msg.Id))
+		}
+
+		return nil, err
+	}
+
 	if err := svc.Datastore.DeleteConstraint(ctx, req.Msg.Id); err != nil {
 		return nil, err
 	}
 
+	svc.Audit.Record(ctx, structs.AuditEntityConstraint, model.ID.Hex(), structs.AuditOperationDelete, *model, nil, model.AppliesToUser...)
+
 	return connect.NewResponse(new(rosterv1.DeleteConstraintResponse)), nil
 }
 
@@ -153,6 +171,10 @@ func (svc *ConstraintService) FindConstraints(ctx context.Context, req *connect.
 }
 
 func (svc *ConstraintService) validateModel(ctx context.Context, model *structs.Constraint) error {
+	// verify the expression
+	if _, err := svc.Constraints.Compile(model.Expression); err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid expression: %w", err))
+	}
 
 	// verify role ids
 	if len(model.AppliesToRole) > 0 {
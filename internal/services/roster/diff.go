@@ -3,10 +3,11 @@ package roster
 import (
 	"context"
 	"fmt"
+	"sort"
 	"time"
 
-	"github.com/tierklinik-dobersberg/apis/pkg/data"
 	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"golang.org/x/exp/slices"
 )
 
@@ -22,84 +23,279 @@ type ShiftDiff struct {
 	Assigned bool
 }
 
-func diffRosters(ctx context.Context, old, new *structs.DutyRoster) (map[string] /*userId*/ []ShiftDiff, error) {
+// ShiftChangeKind describes how a planned shift changed between two rosters.
+type ShiftChangeKind int
+
+const (
+	ShiftAdded ShiftChangeKind = iota + 1
+	ShiftRemoved
+	ShiftTimesChanged
+	ShiftAssignmentsChanged
+)
+
+// ShiftChange describes the change of a single planned shift. For removed
+// shifts From and To hold the times of the removed shift. PreviousFrom and
+// PreviousTo are only set for ShiftTimesChanged.
+type ShiftChange struct {
+	Kind         ShiftChangeKind
+	WorkShiftID  primitive.ObjectID
+	From         time.Time
+	To           time.Time
+	PreviousFrom time.Time
+	PreviousTo   time.Time
+	Assigned     []string
+	Unassigned   []string
+}
+
+// UserChangeKind describes how the shifts of a user changed between two
+// rosters.
+type UserChangeKind int
+
+const (
+	UserAssigned UserChangeKind = iota + 1
+	UserUnassigned
+	UserTimesChanged
+)
+
+// UserShiftChange describes the change of a single shift for a user.
+// PreviousFrom and PreviousTo are only set for UserTimesChanged.
+type UserShiftChange struct {
+	Kind         UserChangeKind
+	WorkShiftID  primitive.ObjectID
+	From         time.Time
+	To           time.Time
+	PreviousFrom time.Time
+	PreviousTo   time.Time
+}
+
+// RosterChanges holds the differences between two rosters.
+type RosterChanges struct {
+	// Shifts holds all changed shifts ordered by their start time.
+	Shifts []ShiftChange
+
+	// Users holds the changes per user ID ordered by the start time of the
+	// shift.
+	Users map[string][]UserShiftChange
+}
+
+// compareRosters returns all differences between two rosters or roster
+// versions that cover the same period. Shifts are matched by their work-shift
+// and their times. Shifts that do not match exactly are paired by work-shift
+// and day and reported as ShiftTimesChanged.
+func compareRosters(old, new structs.DutyRoster) (RosterChanges, error) {
+	result := RosterChanges{
+		Users: make(map[string][]UserShiftChange),
+	}
+
 	if old.From != new.From || old.To != new.To {
-		return nil, fmt.Errorf("cannot diff rosters with different from/to times")
+		return result, fmt.Errorf("cannot diff rosters with different from/to times")
 	}
 
-	if old.SupersededBy.String() != new.ID.String() {
-		return nil, fmt.Errorf("can only diff rosters where one superseded the other")
+	oldShifts := slices.Clone(old.Shifts)
+	oldMatched := make([]bool, len(oldShifts))
+
+	match := func(fn func(o, n structs.PlannedShift) bool, n structs.PlannedShift) (structs.PlannedShift, bool) {
+		for idx, o := range oldShifts {
+			if oldMatched[idx] || !fn(o, n) {
+				continue
+			}
+
+			oldMatched[idx] = true
+
+			return o, true
+		}
+
+		return structs.PlannedShift{}, false
 	}
 
-	result := make(map[string][]ShiftDiff)
+	sameTimes := func(o, n structs.PlannedShift) bool {
+		return o.WorkShiftID == n.WorkShiftID && o.From.Equal(n.From) && o.To.Equal(n.To)
+	}
 
-	plannedShiftKey := func(p structs.PlannedShift) string {
-		return fmt.Sprintf("%s/%s/%s", p.WorkShiftID, p.From.Format(time.RFC3339), p.To.Format(time.RFC3339))
+	sameDay := func(o, n structs.PlannedShift) bool {
+		return o.WorkShiftID == n.WorkShiftID && o.From.Local().Format("2006-01-02") == n.From.Local().Format("2006-01-02")
 	}
 
-	// convert our planned shifts to a lookup map
-	oldShifts := data.IndexSlice(old.Shifts, plannedShiftKey)
-	newShifts := data.IndexSlice(new.Shifts, plannedShiftKey)
+	// exact matches are resolved first so a shift with changed times is not
+	// paired with an unchanged shift of the same work-shift and day.
+	newShifts := slices.Clone(new.Shifts)
+	paired := make([]*structs.PlannedShift, len(newShifts))
 
-	// iterate over all "newShifts" and check if a user has been assigned/removed from the related oldShifts
-	for shiftID, shift := range newShifts {
-		oldShift, ok := oldShifts[shiftID]
+	for idx, n := range newShifts {
+		if o, ok := match(sameTimes, n); ok {
+			paired[idx] = &o
+		}
+	}
 
-		if !ok {
-			// this shift has not even been planned in the old roster
-			// so add an assignment for all users of this shift
+	for idx, n := range newShifts {
+		if paired[idx] != nil {
+			continue
+		}
 
-			for _, userId := range shift.AssignedUserIds {
-				result[userId] = append(result[userId], ShiftDiff{
-					ID:       shift.WorkShiftID.Hex(),
-					From:     shift.From.Format(time.RFC3339),
-					To:       shift.To.Format(time.RFC3339),
-					Assigned: true,
-				})
-			}
+		if o, ok := match(sameDay, n); ok {
+			paired[idx] = &o
+		}
+	}
+
+	for idx, n := range newShifts {
+		o := paired[idx]
+
+		if o == nil {
+			result.addShift(ShiftChange{
+				Kind:        ShiftAdded,
+				WorkShiftID: n.WorkShiftID,
+				From:        n.From,
+				To:          n.To,
+				Assigned:    slices.Clone(n.AssignedUserIds),
+			}, nil)
 
 			continue
 		}
 
-		// check for new assignments
-		for _, userId := range shift.AssignedUserIds {
-			if !slices.Contains(oldShift.AssignedUserIds, userId) {
-				// this user has been assigned
-				result[userId] = append(result[userId], ShiftDiff{
-					ID:       shift.WorkShiftID.Hex(),
-					From:     shift.From.Format(time.RFC3339),
-					To:       shift.To.Format(time.RFC3339),
-					Assigned: true,
-				})
+		change := ShiftChange{
+			Kind:        ShiftAssignmentsChanged,
+			WorkShiftID: n.WorkShiftID,
+			From:        n.From,
+			To:          n.To,
+		}
+
+		var kept []string
+		for _, user := range n.AssignedUserIds {
+			if slices.Contains(o.AssignedUserIds, user) {
+				kept = append(kept, user)
+			} else {
+				change.Assigned = append(change.Assigned, user)
+			}
+		}
+
+		for _, user := range o.AssignedUserIds {
+			if !slices.Contains(n.AssignedUserIds, user) {
+				change.Unassigned = append(change.Unassigned, user)
 			}
 		}
 
-		// check for new unassignments
-		for _, userId := range oldShift.AssignedUserIds {
-			if !slices.Contains(shift.AssignedUserIds, userId) {
-				// this user has been unassigned
-				result[userId] = append(result[userId], ShiftDiff{
-					ID:       shift.WorkShiftID.Hex(),
-					From:     shift.From.Format(time.RFC3339),
-					To:       shift.To.Format(time.RFC3339),
-					Assigned: false,
-				})
+		if !sameTimes(*o, n) {
+			change.Kind = ShiftTimesChanged
+			change.PreviousFrom = o.From
+			change.PreviousTo = o.To
+		} else {
+			kept = nil
+
+			if len(change.Assigned) == 0 && len(change.Unassigned) == 0 {
+				continue
+			}
+		}
+
+		result.addShift(change, kept)
+	}
+
+	for idx, o := range oldShifts {
+		if oldMatched[idx] {
+			continue
+		}
+
+		result.addShift(ShiftChange{
+			Kind:        ShiftRemoved,
+			WorkShiftID: o.WorkShiftID,
+			From:        o.From,
+			To:          o.To,
+			Unassigned:  slices.Clone(o.AssignedUserIds),
+		}, nil)
+	}
+
+	sort.SliceStable(result.Shifts, func(i, j int) bool {
+		return lessShift(result.Shifts[i].From, result.Shifts[i].WorkShiftID, result.Shifts[j].From, result.Shifts[j].WorkShiftID)
+	})
+
+	for _, changes := range result.Users {
+		sort.SliceStable(changes, func(i, j int) bool {
+			return lessShift(changes[i].From, changes[i].WorkShiftID, changes[j].From, changes[j].WorkShiftID)
+		})
+	}
+
+	return result, nil
+}
+
+// addShift adds change to the list of changed shifts and records the
+// resulting changes for all affected users. kept holds the users that stay
+// assigned to a shift whose times changed.
+func (rc *RosterChanges) addShift(change ShiftChange, kept []string) {
+	rc.Shifts = append(rc.Shifts, change)
+
+	userChange := func(kind UserChangeKind) UserShiftChange {
+		uc := UserShiftChange{
+			Kind:        kind,
+			WorkShiftID: change.WorkShiftID,
+			From:        change.From,
+			To:          change.To,
+		}
+
+		switch kind {
+		case UserUnassigned:
+			// users removed from a shift that has been moved lose the shift
+			// at its previous time.
+			if change.Kind == ShiftTimesChanged {
+				uc.From, uc.To = change.PreviousFrom, change.PreviousTo
 			}
+		case UserTimesChanged:
+			uc.PreviousFrom, uc.PreviousTo = change.PreviousFrom, change.PreviousTo
 		}
 
-		// delete the shift from the oldShifts map
-		delete(oldShifts, shiftID)
+		return uc
 	}
 
-	// check which shifts has been planned but got removed
-	for _, shift := range oldShifts {
-		for _, userId := range shift.AssignedUserIds {
-			result[userId] = append(result[userId], ShiftDiff{
-				ID:       shift.WorkShiftID.Hex(),
-				From:     shift.From.Format(time.RFC3339),
-				To:       shift.To.Format(time.RFC3339),
-				Assigned: true,
-			})
+	for _, user := range change.Assigned {
+		rc.Users[user] = append(rc.Users[user], userChange(UserAssigned))
+	}
+
+	for _, user := range change.Unassigned {
+		rc.Users[user] = append(rc.Users[user], userChange(UserUnassigned))
+	}
+
+	for _, user := range kept {
+		rc.Users[user] = append(rc.Users[user], userChange(UserTimesChanged))
+	}
+}
+
+func lessShift(aFrom time.Time, aID primitive.ObjectID, bFrom time.Time, bID primitive.ObjectID) bool {
+	if !aFrom.Equal(bFrom) {
+		return aFrom.Before(bFrom)
+	}
+
+	return aID.Hex() < bID.Hex()
+}
+
+// diffRosters returns the assignment changes per user between two rosters
+// or roster versions that cover the same period. A shift whose times changed
+// is reported as removed at the previous and assigned at the new time.
+func diffRosters(ctx context.Context, old, new *structs.DutyRoster) (map[string] /*userId*/ []ShiftDiff, error) {
+	changes, err := compareRosters(*old, *new)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string][]ShiftDiff)
+
+	add := func(userId string, id primitive.ObjectID, from, to time.Time, assigned bool) {
+		result[userId] = append(result[userId], ShiftDiff{
+			ID:       id.Hex(),
+			From:     from.Format(time.RFC3339),
+			To:       to.Format(time.RFC3339),
+			Assigned: assigned,
+		})
+	}
+
+	for userId, list := range changes.Users {
+		for _, c := range list {
+			switch c.Kind {
+			case UserAssigned:
+				add(userId, c.WorkShiftID, c.From, c.To, true)
+			case UserUnassigned:
+				add(userId, c.WorkShiftID, c.From, c.To, false)
+			case UserTimesChanged:
+				add(userId, c.WorkShiftID, c.PreviousFrom, c.PreviousTo, false)
+				add(userId, c.WorkShiftID, c.From, c.To, true)
+			}
 		}
 	}
 
@@ -0,0 +1,213 @@
+package roster
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	rosterdv1 "github.com/tierklinik-dobersberg/rosterd/gen/go/rosterd/v1"
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func Test_compareRosters(t *testing.T) {
+	early, late := primitive.NewObjectID(), primitive.NewObjectID()
+
+	at := func(day, hour int) time.Time {
+		return time.Date(2024, time.May, day, hour, 0, 0, 0, time.Local)
+	}
+
+	shift := func(id primitive.ObjectID, day, from, to int, users ...string) structs.PlannedShift {
+		return structs.PlannedShift{
+			WorkShiftID:     id,
+			From:            at(day, from),
+			To:              at(day, to),
+			AssignedUserIds: users,
+		}
+	}
+
+	roster := func(shifts ...structs.PlannedShift) structs.DutyRoster {
+		return structs.DutyRoster{
+			From:   "2024-05-01",
+			To:     "2024-05-31",
+			Shifts: shifts,
+		}
+	}
+
+	cases := []struct {
+		name   string
+		old    structs.DutyRoster
+		new    structs.DutyRoster
+		shifts []ShiftChange
+		users  map[string][]UserShiftChange
+	}{
+		{
+			name:  "unchanged",
+			old:   roster(shift(early, 1, 8, 16, "alice")),
+			new:   roster(shift(early, 1, 8, 16, "alice")),
+			users: map[string][]UserShiftChange{},
+		},
+		{
+			name: "assignments changed",
+			old:  roster(shift(early, 1, 8, 16, "alice", "bob")),
+			new:  roster(shift(early, 1, 8, 16, "bob", "carol")),
+			shifts: []ShiftChange{
+				{Kind: ShiftAssignmentsChanged, WorkShiftID: early, From: at(1, 8), To: at(1, 16), Assigned: []string{"carol"}, Unassigned: []string{"alice"}},
+			},
+			users: map[string][]UserShiftChange{
+				"alice": {{Kind: UserUnassigned, WorkShiftID: early, From: at(1, 8), To: at(1, 16)}},
+				"carol": {{Kind: UserAssigned, WorkShiftID: early, From: at(1, 8), To: at(1, 16)}},
+			},
+		},
+		{
+			name: "shift added",
+			old:  roster(),
+			new:  roster(shift(early, 2, 8, 16, "alice")),
+			shifts: []ShiftChange{
+				{Kind: ShiftAdded, WorkShiftID: early, From: at(2, 8), To: at(2, 16), Assigned: []string{"alice"}},
+			},
+			users: map[string][]UserShiftChange{
+				"alice": {{Kind: UserAssigned, WorkShiftID: early, From: at(2, 8), To: at(2, 16)}},
+			},
+		},
+		{
+			name: "shift removed",
+			old:  roster(shift(early, 2, 8, 16, "alice")),
+			new:  roster(),
+			shifts: []ShiftChange{
+				{Kind: ShiftRemoved, WorkShiftID: early, From: at(2, 8), To: at(2, 16), Unassigned: []string{"alice"}},
+			},
+			users: map[string][]UserShiftChange{
+				"alice": {{Kind: UserUnassigned, WorkShiftID: early, From: at(2, 8), To: at(2, 16)}},
+			},
+		},
+		{
+			name: "times changed",
+			old:  roster(shift(early, 3, 8, 16, "alice", "bob")),
+			new:  roster(shift(early, 3, 9, 17, "alice", "carol")),
+			shifts: []ShiftChange{
+				{
+					Kind:         ShiftTimesChanged,
+					WorkShiftID:  early,
+					From:         at(3, 9),
+					To:           at(3, 17),
+					PreviousFrom: at(3, 8),
+					PreviousTo:   at(3, 16),
+					Assigned:     []string{"carol"},
+					Unassigned:   []string{"bob"},
+				},
+			},
+			users: map[string][]UserShiftChange{
+				"alice": {{Kind: UserTimesChanged, WorkShiftID: early, From: at(3, 9), To: at(3, 17), PreviousFrom: at(3, 8), PreviousTo: at(3, 16)}},
+				"bob":   {{Kind: UserUnassigned, WorkShiftID: early, From: at(3, 8), To: at(3, 16)}},
+				"carol": {{Kind: UserAssigned, WorkShiftID: early, From: at(3, 9), To: at(3, 17)}},
+			},
+		},
+		{
+			name: "exact matches take precedence over moved shifts",
+			old:  roster(shift(early, 4, 8, 16, "alice")),
+			new:  roster(shift(early, 4, 10, 18, "bob"), shift(early, 4, 8, 16, "alice")),
+			shifts: []ShiftChange{
+				{Kind: ShiftAdded, WorkShiftID: early, From: at(4, 10), To: at(4, 18), Assigned: []string{"bob"}},
+			},
+			users: map[string][]UserShiftChange{
+				"bob": {{Kind: UserAssigned, WorkShiftID: early, From: at(4, 10), To: at(4, 18)}},
+			},
+		},
+		{
+			name: "different work-shifts are not paired",
+			old:  roster(shift(early, 5, 8, 16, "alice")),
+			new:  roster(shift(late, 5, 12, 20, "alice")),
+			shifts: []ShiftChange{
+				{Kind: ShiftRemoved, WorkShiftID: early, From: at(5, 8), To: at(5, 16), Unassigned: []string{"alice"}},
+				{Kind: ShiftAdded, WorkShiftID: late, From: at(5, 12), To: at(5, 20), Assigned: []string{"alice"}},
+			},
+			users: map[string][]UserShiftChange{
+				"alice": {
+					{Kind: UserUnassigned, WorkShiftID: early, From: at(5, 8), To: at(5, 16)},
+					{Kind: UserAssigned, WorkShiftID: late, From: at(5, 12), To: at(5, 20)},
+				},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			changes, err := compareRosters(c.old, c.new)
+			require.NoError(t, err)
+			require.Equal(t, c.shifts, changes.Shifts)
+			require.Equal(t, c.users, changes.Users)
+		})
+	}
+
+	t.Run("different periods", func(t *testing.T) {
+		other := roster()
+		other.To = "2024-06-30"
+
+		_, err := compareRosters(roster(), other)
+		require.Error(t, err)
+	})
+}
+
+func Test_diffRosters(t *testing.T) {
+	id := primitive.NewObjectID()
+	from := time.Date(2024, time.May, 1, 8, 0, 0, 0, time.UTC)
+
+	old := structs.DutyRoster{
+		From: "2024-05-01",
+		To:   "2024-05-31",
+		Shifts: []structs.PlannedShift{
+			{WorkShiftID: id, From: from, To: from.Add(8 * time.Hour), AssignedUserIds: []string{"alice", "bob"}},
+			{WorkShiftID: id, From: from.Add(24 * time.Hour), To: from.Add(32 * time.Hour), AssignedUserIds: []string{"carol"}},
+		},
+	}
+
+	new := old
+	new.Shifts = []structs.PlannedShift{
+		{WorkShiftID: id, From: from.Add(time.Hour), To: from.Add(9 * time.Hour), AssignedUserIds: []string{"alice"}},
+	}
+
+	diff, err := diffRosters(context.Background(), &old, &new)
+	require.NoError(t, err)
+
+	// moved shifts are reported as removed at the old and assigned at the
+	// new time.
+	require.Equal(t, []ShiftDiff{
+		{ID: id.Hex(), From: "2024-05-01T08:00:00Z", To: "2024-05-01T16:00:00Z", Assigned: false},
+		{ID: id.Hex(), From: "2024-05-01T09:00:00Z", To: "2024-05-01T17:00:00Z", Assigned: true},
+	}, diff["alice"])
+
+	require.Equal(t, []ShiftDiff{
+		{ID: id.Hex(), From: "2024-05-01T08:00:00Z", To: "2024-05-01T16:00:00Z", Assigned: false},
+	}, diff["bob"])
+
+	// users of removed shifts are reported as unassigned.
+	require.Equal(t, []ShiftDiff{
+		{ID: id.Hex(), From: "2024-05-02T08:00:00Z", To: "2024-05-02T16:00:00Z", Assigned: false},
+	}, diff["carol"])
+}
+
+func Test_rosterChangesToProto(t *testing.T) {
+	id := primitive.NewObjectID()
+	from := time.Date(2024, time.May, 1, 8, 0, 0, 0, time.UTC)
+
+	response := rosterChangesToProto(RosterChanges{
+		Shifts: []ShiftChange{
+			{Kind: ShiftAdded, WorkShiftID: id, From: from, To: from.Add(8 * time.Hour), Assigned: []string{"bob", "alice"}},
+		},
+		Users: map[string][]UserShiftChange{
+			"bob":   {{Kind: UserAssigned, WorkShiftID: id, From: from, To: from.Add(8 * time.Hour)}},
+			"alice": {{Kind: UserAssigned, WorkShiftID: id, From: from, To: from.Add(8 * time.Hour)}},
+		},
+	})
+
+	require.Len(t, response.Shifts, 1)
+	require.Equal(t, rosterdv1.ShiftChangeKind_SHIFT_CHANGE_KIND_ADDED, response.Shifts[0].Kind)
+	require.Nil(t, response.Shifts[0].PreviousFrom)
+
+	require.Len(t, response.Users, 2)
+	require.Equal(t, "alice", response.Users[0].UserId)
+	require.Equal(t, "bob", response.Users[1].UserId)
+	require.Equal(t, rosterdv1.UserShiftChangeKind_USER_SHIFT_CHANGE_KIND_ASSIGNED, response.Users[1].Changes[0].Kind)
+}
@@ -49,32 +49,32 @@ func (svc *RosterService) ExportRoster(ctx context.Context, req *connect.Request
 	uslm := data.IndexSlice(allUsers, func(p *idmv1.Profile) string { return p.User.Id })
 
 	// holiday
-	holidays, err := svc.getHolidayLookupMap(ctx, roster.FromTime(), roster.ToTime())
+	holidays, err := svc.HolidayProvider.For(ctx, roster.FromTime(), roster.ToTime())
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch holidays: %w", err)
 	}
 
-	filterShift := func(def structs.WorkShift) bool {
-		// apply any filter
-		switch v := req.Msg.Filter.(type) {
-		case *rosterv1.ExportRosterRequest_ShiftIds:
-			if len(v.ShiftIds.GetValues()) > 0 && !slices.Contains(v.ShiftIds.Values, def.ID.Hex()) {
-				return false
-			}
-
-		case *rosterv1.ExportRosterRequest_ShiftTags:
-			if len(v.ShiftTags.GetValues()) > 0 && !data.ElemInBothSlices(def.Tags, v.ShiftTags.GetValues()) {
-				return false
-			}
-		}
-
-		return true
+	var shiftIds, shiftTags []string
+	switch v := req.Msg.Filter.(type) {
+	case *rosterv1.ExportRosterRequest_ShiftIds:
+		shiftIds = v.ShiftIds.GetValues()
+	case *rosterv1.ExportRosterRequest_ShiftTags:
+		shiftTags = v.ShiftTags.GetValues()
 	}
 
+	filterShift := shiftFilter(shiftIds, shiftTags)
+
 	// finally, create the export based on the requested type
 	switch req.Msg.Type {
 	case rosterv1.ExportRosterType_EXPORT_ROSTER_TYPE_ICAL:
-		cal := new(ical.Calendar)
+		rootID, sequence, err := svc.rosterLineage(ctx, roster)
+		if err != nil {
+			return nil, err
+		}
+
+		cal := &ical.Calendar{
+			Sequence: sequence,
+		}
 
 		for _, shift := range roster.Shifts {
 			def := wslm[shift.WorkShiftID.Hex()]
@@ -89,6 +89,7 @@ func (svc *RosterService) ExportRoster(ctx context.Context, req *connect.Request
 			}
 
 			cal.Events = append(cal.Events, ical.Event{
+				UID:   ical.EventUID(rootID.Hex(), shift.WorkShiftID.Hex(), shift.From, ""),
 				From:  shift.From,
 				To:    shift.To,
 				Name:  def.Name,
@@ -119,7 +120,7 @@ func (svc *RosterService) ExportRoster(ctx context.Context, req *connect.Request
 		for iter := timecalc.StartOfWeek(rosterFromTime); iter.Before(toTime) || iter.Equal(toTime); iter = iter.AddDate(0, 0, 1) {
 			day := templates.RosterDay{
 				DayTitle: iter.Format("02.01"),
-				Holiday:  holidays[iter.Format("2006-01-02")],
+				Holiday:  holidays.Public(iter),
 				Disabled: iter.Before(rosterFromTime) || iter.After(rosterToTime),
 			}
 
@@ -161,17 +162,17 @@ func (svc *RosterService) ExportRoster(ctx context.Context, req *connect.Request
 			}
 		}
 
-		buf, err := templates.RenderRosterTemplate(ctx, rosterContext)
-		if err != nil {
-			return nil, err
-		}
+		if req.Msg.Type == rosterv1.ExportRosterType_EXPORT_ROSTER_TYPE_HTML {
+			buf, err := templates.RenderRosterTemplate(ctx, rosterContext)
+			if err != nil {
+				return nil, err
+			}
 
-		blob, err := io.ReadAll(buf)
-		if err != nil {
-			return nil, err
-		}
+			blob, err := io.ReadAll(buf)
+			if err != nil {
+				return nil, err
+			}
 
-		if req.Msg.Type == rosterv1.ExportRosterType_EXPORT_ROSTER_TYPE_HTML {
 			return connect.NewResponse(&rosterv1.ExportRosterResponse{
 				ContentType: "text/html",
 				FileName:    fmt.Sprintf("%s_%s.html", roster.From, roster.To),
@@ -180,9 +181,9 @@ func (svc *RosterService) ExportRoster(ctx context.Context, req *connect.Request
 		}
 
 		// PDF
-		pdf, err := svc.Providers.RenderHTML(ctx, string(blob))
+		pdf, err := svc.Providers.Renderer.RenderRoster(ctx, rosterContext)
 		if err != nil {
-			return nil, fmt.Errorf("failed to render HTML: %w", err)
+			return nil, fmt.Errorf("failed to render PDF: %w", err)
 		}
 		defer pdf.Close()
 
@@ -202,6 +203,22 @@ func (svc *RosterService) ExportRoster(ctx context.Context, req *connect.Request
 	return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown export type %q", req.Msg.Type.String()))
 }
 
+// shiftFilter returns a function that reports whether shifts of a work-shift
+// definition should be part of an export.
+func shiftFilter(shiftIds, shiftTags []string) func(structs.WorkShift) bool {
+	return func(def structs.WorkShift) bool {
+		if len(shiftIds) > 0 && !slices.Contains(shiftIds, def.ID.Hex()) {
+			return false
+		}
+
+		if len(shiftTags) > 0 && !data.ElemInBothSlices(def.Tags, shiftTags) {
+			return false
+		}
+
+		return true
+	}
+}
+
 func getUserIdentifier(p *idmv1.Profile) string {
 	if p.User.DisplayName != "" {
 		return p.User.DisplayName[0:2]
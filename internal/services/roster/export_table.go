@@ -0,0 +1,296 @@
+package roster
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	idmv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/idm/v1"
+	rosterv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/roster/v1"
+	"github.com/tierklinik-dobersberg/apis/pkg/data"
+	rosterdv1 "github.com/tierklinik-dobersberg/rosterd/gen/go/rosterd/v1"
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"github.com/xuri/excelize/v2"
+	"go.mongodb.org/mongo-driver/mongo"
+	"golang.org/x/exp/slices"
+)
+
+func (svc *RosterService) ExportRosterTable(ctx context.Context, req *connect.Request[rosterdv1.ExportRosterTableRequest]) (*connect.Response[rosterv1.ExportRosterResponse], error) {
+	roster, err := svc.Datastore.DutyRosterByID(ctx, req.Msg.Id)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("no roster with id %q found", req.Msg.Id))
+		}
+
+		return nil, err
+	}
+
+	workShifts, err := svc.Datastore.ListWorkShifts(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load work-shift definitions: %w", err)
+	}
+	wslm := data.IndexSlice(workShifts, func(shift structs.WorkShift) string { return shift.ID.Hex() })
+
+	allUsers, err := svc.FetchAllUserProfiles(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get users: %w", err)
+	}
+	uslm := data.IndexSlice(allUsers, func(p *idmv1.Profile) string { return p.User.Id })
+
+	var shiftIds, shiftTags []string
+	switch v := req.Msg.Filter.(type) {
+	case *rosterdv1.ExportRosterTableRequest_ShiftIds:
+		shiftIds = v.ShiftIds.GetValues()
+	case *rosterdv1.ExportRosterTableRequest_ShiftTags:
+		shiftTags = v.ShiftTags.GetValues()
+	}
+
+	filterShift := shiftFilter(shiftIds, shiftTags)
+
+	var rows [][]any
+	switch req.Msg.Layout {
+	case rosterdv1.RosterTableLayout_ROSTER_TABLE_LAYOUT_UNSPECIFIED,
+		rosterdv1.RosterTableLayout_ROSTER_TABLE_LAYOUT_SHIFTS:
+		rows = rosterShiftTable(roster, wslm, uslm, filterShift)
+
+	case rosterdv1.RosterTableLayout_ROSTER_TABLE_LAYOUT_MATRIX:
+		rows = rosterMatrixTable(roster, wslm, uslm, filterShift)
+
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown table layout %q", req.Msg.Layout.String()))
+	}
+
+	fileName := fmt.Sprintf("%s_%s", roster.From, roster.To)
+
+	switch req.Msg.Format {
+	case rosterdv1.RosterTableFormat_ROSTER_TABLE_FORMAT_UNSPECIFIED,
+		rosterdv1.RosterTableFormat_ROSTER_TABLE_FORMAT_CSV:
+		blob, err := encodeCSVTable(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		return connect.NewResponse(&rosterv1.ExportRosterResponse{
+			ContentType: "text/csv",
+			FileName:    fileName + ".csv",
+			Payload:     blob,
+		}), nil
+
+	case rosterdv1.RosterTableFormat_ROSTER_TABLE_FORMAT_XLSX:
+		blob, err := encodeXLSXTable("Dienstplan", rows)
+		if err != nil {
+			return nil, err
+		}
+
+		return connect.NewResponse(&rosterv1.ExportRosterResponse{
+			ContentType: "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+			FileName:    fileName + ".xlsx",
+			Payload:     blob,
+		}), nil
+	}
+
+	return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown table format %q", req.Msg.Format.String()))
+}
+
+// rosterShiftTable returns a table with one row per planned shift of roster.
+// The first row holds the column headers.
+func rosterShiftTable(roster structs.DutyRoster, definitions map[string]structs.WorkShift, users map[string]*idmv1.Profile, filter func(structs.WorkShift) bool) [][]any {
+	rows := [][]any{
+		{"Datum", "Dienst", "Von", "Bis", "Stunden", "Mitarbeiter"},
+	}
+
+	for _, shift := range sortedShifts(roster.Shifts) {
+		def := definitions[shift.WorkShiftID.Hex()]
+		if !filter(def) {
+			continue
+		}
+
+		names := make([]string, len(shift.AssignedUserIds))
+		for idx, id := range shift.AssignedUserIds {
+			names[idx] = userDisplayName(id, users[id])
+		}
+
+		rows = append(rows, []any{
+			shift.From.Local().Format("2006-01-02"),
+			def.Name,
+			shift.From.Local().Format("15:04"),
+			shift.To.Local().Format("15:04"),
+			shift.TimeWorth.Hours(),
+			strings.Join(names, ", "),
+		})
+	}
+
+	return rows
+}
+
+// rosterMatrixTable returns a table with one row per assigned user and one
+// column per day of roster. Each cell holds the short names of the shifts
+// the user is assigned to on that day. The first row holds the column
+// headers and the last column the total time-worth of the user's shifts.
+func rosterMatrixTable(roster structs.DutyRoster, definitions map[string]structs.WorkShift, users map[string]*idmv1.Profile, filter func(structs.WorkShift) bool) [][]any {
+	var days []string
+	for iter := roster.FromTime(); !iter.After(roster.ToTime()); iter = iter.AddDate(0, 0, 1) {
+		days = append(days, iter.Format("2006-01-02"))
+	}
+
+	header := []any{"Mitarbeiter"}
+	for _, day := range days {
+		header = append(header, day)
+	}
+	header = append(header, "Stunden")
+
+	var (
+		cells = make(map[string]map[string][]string)
+		hours = make(map[string]time.Duration)
+	)
+
+	for _, shift := range sortedShifts(roster.Shifts) {
+		def := definitions[shift.WorkShiftID.Hex()]
+		if !filter(def) {
+			continue
+		}
+
+		name := def.ShortName
+		if name == "" {
+			name = def.Name
+		}
+
+		day := shift.From.Local().Format("2006-01-02")
+
+		for _, id := range shift.AssignedUserIds {
+			if cells[id] == nil {
+				cells[id] = make(map[string][]string)
+			}
+
+			cells[id][day] = append(cells[id][day], name)
+			hours[id] += shift.TimeWorth
+		}
+	}
+
+	userIds := make([]string, 0, len(cells))
+	for id := range cells {
+		userIds = append(userIds, id)
+	}
+	sort.Slice(userIds, func(i, j int) bool {
+		return userDisplayName(userIds[i], users[userIds[i]]) < userDisplayName(userIds[j], users[userIds[j]])
+	})
+
+	rows := [][]any{header}
+	for _, id := range userIds {
+		row := []any{userDisplayName(id, users[id])}
+
+		for _, day := range days {
+			row = append(row, strings.Join(cells[id][day], ", "))
+		}
+
+		row = append(row, hours[id].Hours())
+		rows = append(rows, row)
+	}
+
+	return rows
+}
+
+// sortedShifts returns a copy of shifts sorted by their start time.
+func sortedShifts(shifts []structs.PlannedShift) []structs.PlannedShift {
+	sorted := slices.Clone(shifts)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].From.Before(sorted[j].From)
+	})
+
+	return sorted
+}
+
+// userDisplayName returns the display name of the user or falls back to the
+// username or ID if the profile is unknown.
+func userDisplayName(id string, p *idmv1.Profile) string {
+	if p.GetUser().GetDisplayName() != "" {
+		return p.User.DisplayName
+	}
+
+	if p.GetUser().GetUsername() != "" {
+		return p.User.Username
+	}
+
+	return id
+}
+
+func encodeCSVTable(rows [][]any) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	w := csv.NewWriter(buf)
+
+	for _, row := range rows {
+		record := make([]string, len(row))
+		for idx, cell := range row {
+			switch v := cell.(type) {
+			case float64:
+				record[idx] = fmt.Sprintf("%.2f", v)
+			default:
+				record[idx] = fmt.Sprint(v)
+			}
+		}
+
+		if err := w.Write(record); err != nil {
+			return nil, fmt.Errorf("failed to write CSV record: %w", err)
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, fmt.Errorf("failed to write CSV: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func encodeXLSXTable(sheet string, rows [][]any) ([]byte, error) {
+	f := excelize.NewFile()
+	defer f.Close()
+
+	if err := f.SetSheetName(f.GetSheetName(0), sheet); err != nil {
+		return nil, fmt.Errorf("failed to rename sheet: %w", err)
+	}
+
+	for idx, row := range rows {
+		cell, err := excelize.CoordinatesToCellName(1, idx+1)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := f.SetSheetRow(sheet, cell, &row); err != nil {
+			return nil, fmt.Errorf("failed to write row %d: %w", idx+1, err)
+		}
+	}
+
+	if len(rows) > 0 {
+		bold, err := f.NewStyle(&excelize.Style{Font: &excelize.Font{Bold: true}})
+		if err != nil {
+			return nil, err
+		}
+
+		last, err := excelize.CoordinatesToCellName(len(rows[0]), 1)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := f.SetCellStyle(sheet, "A1", last, bold); err != nil {
+			return nil, err
+		}
+
+		if err := f.SetPanes(sheet, &excelize.Panes{Freeze: true, YSplit: 1, TopLeftCell: "A2", ActivePane: "bottomLeft"}); err != nil {
+			return nil, err
+		}
+	}
+
+	buf, err := f.WriteToBuffer()
+	if err != nil {
+		return nil, fmt.Errorf("failed to write XLSX: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,103 @@
+package roster
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	idmv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/idm/v1"
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"github.com/xuri/excelize/v2"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func exportTestRoster() (structs.DutyRoster, map[string]structs.WorkShift, map[string]*idmv1.Profile) {
+	var (
+		early = structs.WorkShift{ID: primitive.NewObjectID(), Name: "Frühdienst", ShortName: "F", Tags: []string{"regular"}}
+		night = structs.WorkShift{ID: primitive.NewObjectID(), Name: "Nachtdienst", ShortName: "N", Tags: []string{"oncall"}}
+		day   = time.Date(2024, time.March, 4, 0, 0, 0, 0, time.Local)
+	)
+
+	roster := structs.DutyRoster{
+		From: "2024-03-04",
+		To:   "2024-03-05",
+		Shifts: []structs.PlannedShift{
+			{WorkShiftID: night.ID, From: day.Add(20 * time.Hour), To: day.Add(32 * time.Hour), TimeWorth: 4 * time.Hour, AssignedUserIds: []string{"bob"}},
+			{WorkShiftID: early.ID, From: day.Add(8 * time.Hour), To: day.Add(14 * time.Hour), TimeWorth: 6 * time.Hour, AssignedUserIds: []string{"bob", "alice"}},
+			{WorkShiftID: early.ID, From: day.Add(32 * time.Hour), To: day.Add(38 * time.Hour), TimeWorth: 6 * time.Hour, AssignedUserIds: []string{"unknown"}},
+		},
+	}
+
+	definitions := map[string]structs.WorkShift{
+		early.ID.Hex(): early,
+		night.ID.Hex(): night,
+	}
+
+	users := map[string]*idmv1.Profile{
+		"alice": {User: &idmv1.User{Id: "alice", Username: "alice", DisplayName: "Alice"}},
+		"bob":   {User: &idmv1.User{Id: "bob", Username: "bob"}},
+	}
+
+	return roster, definitions, users
+}
+
+func Test_rosterShiftTable(t *testing.T) {
+	roster, definitions, users := exportTestRoster()
+
+	rows := rosterShiftTable(roster, definitions, users, shiftFilter(nil, nil))
+	require.Equal(t, [][]any{
+		{"Datum", "Dienst", "Von", "Bis", "Stunden", "Mitarbeiter"},
+		{"2024-03-04", "Frühdienst", "08:00", "14:00", 6.0, "bob, Alice"},
+		{"2024-03-04", "Nachtdienst", "20:00", "08:00", 4.0, "bob"},
+		{"2024-03-05", "Frühdienst", "08:00", "14:00", 6.0, "unknown"},
+	}, rows)
+
+	rows = rosterShiftTable(roster, definitions, users, shiftFilter(nil, []string{"oncall"}))
+	require.Len(t, rows, 2)
+	require.Equal(t, "Nachtdienst", rows[1][1])
+}
+
+func Test_rosterMatrixTable(t *testing.T) {
+	roster, definitions, users := exportTestRoster()
+
+	rows := rosterMatrixTable(roster, definitions, users, shiftFilter(nil, nil))
+	require.Equal(t, [][]any{
+		{"Mitarbeiter", "2024-03-04", "2024-03-05", "Stunden"},
+		{"Alice", "F", "", 6.0},
+		{"bob", "F, N", "", 10.0},
+		{"unknown", "", "F", 6.0},
+	}, rows)
+
+	rows = rosterMatrixTable(roster, definitions, users, shiftFilter(nil, []string{"oncall"}))
+	require.Equal(t, [][]any{
+		{"Mitarbeiter", "2024-03-04", "2024-03-05", "Stunden"},
+		{"bob", "N", "", 4.0},
+	}, rows)
+}
+
+func Test_encodeTables(t *testing.T) {
+	rows := [][]any{
+		{"Mitarbeiter", "Stunden"},
+		{"Alice, B.", 6.5},
+	}
+
+	blob, err := encodeCSVTable(rows)
+	require.NoError(t, err)
+	require.Equal(t, "Mitarbeiter,Stunden\n\"Alice, B.\",6.50\n", string(blob))
+
+	blob, err = encodeXLSXTable("Dienstplan", rows)
+	require.NoError(t, err)
+
+	f, err := excelize.OpenReader(bytes.NewReader(blob))
+	require.NoError(t, err)
+	defer f.Close()
+
+	value, err := f.GetCellValue("Dienstplan", "A2")
+	require.NoError(t, err)
+	require.Equal(t, "Alice, B.", value)
+
+	value, err = f.GetCellValue("Dienstplan", "B2")
+	require.NoError(t, err)
+	require.Equal(t, "6.5", value)
+}
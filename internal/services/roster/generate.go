@@ -0,0 +1,471 @@
+package roster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	idmv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/idm/v1"
+	rosterv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/roster/v1"
+	"github.com/tierklinik-dobersberg/apis/pkg/data"
+	"github.com/tierklinik-dobersberg/apis/pkg/log"
+	rosterdv1 "github.com/tierklinik-dobersberg/rosterd/gen/go/rosterd/v1"
+	"github.com/tierklinik-dobersberg/rosterd/internal/constraints"
+	"github.com/tierklinik-dobersberg/rosterd/internal/planner"
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"github.com/tierklinik-dobersberg/rosterd/internal/timecalc"
+	"go.mongodb.org/mongo-driver/mongo"
+	"golang.org/x/exp/slices"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// defaultWorkTimePenaltyPerHour is used when a generate request does not
+// specify a work-time penalty.
+const defaultWorkTimePenaltyPerHour = 1.0
+
+func (svc *RosterService) GenerateRoster(ctx context.Context, req *connect.Request[rosterdv1.GenerateRosterRequest]) (*connect.Response[rosterdv1.GenerateRosterResponse], error) {
+	from, err := time.ParseInLocation("2006-01-02", req.Msg.From, time.Local)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid from value: %w", err))
+	}
+
+	to, err := time.ParseInLocation("2006-01-02", req.Msg.To, time.Local)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid to value: %w", err))
+	}
+
+	if to.Before(from) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("to must not be before from"))
+	}
+
+	rosterType, err := svc.Datastore.GetRosterType(ctx, req.Msg.RosterTypeName)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("failed to get roster type %s", req.Msg.RosterTypeName))
+		}
+
+		return nil, err
+	}
+
+	tags := rosterType.ShiftTags
+	if req.Msg.OnCall {
+		tags = rosterType.OnCallTags
+
+		if len(tags) == 0 {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("roster type does not have on-call tags configured"))
+		}
+	}
+
+	draft := structs.DutyRoster{
+		From:           req.Msg.From,
+		To:             req.Msg.To,
+		ShiftTags:      tags,
+		RosterTypeName: rosterType.UniqueName,
+	}
+
+	penaltyPerHour := req.Msg.WorkTimePenaltyPerHour
+	if penaltyPerHour <= 0 {
+		penaltyPerHour = defaultWorkTimePenaltyPerHour
+	}
+
+	plan, err := svc.planRoster(ctx, draft, penaltyPerHour)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &rosterdv1.GenerateRosterResponse{
+		Roster:               plan.Roster.ToProto(),
+		WorkShiftDefinitions: make([]*rosterv1.WorkShift, len(plan.Definitions)),
+		Penalty:              plan.Penalty,
+		UnstaffedShifts:      plan.Unstaffed,
+	}
+
+	// the draft has not been saved so there's no ID yet.
+	response.Roster.Id = ""
+
+	for idx, def := range plan.Definitions {
+		response.WorkShiftDefinitions[idx] = def.ToProto()
+	}
+
+	return connect.NewResponse(response), nil
+}
+
+func (svc *RosterService) CompleteRoster(ctx context.Context, req *connect.Request[rosterdv1.CompleteRosterRequest]) (*connect.Response[rosterdv1.CompleteRosterResponse], error) {
+	roster, err := svc.Datastore.DutyRosterByID(ctx, req.Msg.RosterId)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+
+		return nil, err
+	}
+
+	roster.ShiftTags, err = svc.rosterShiftTags(ctx, roster)
+	if err != nil {
+		return nil, err
+	}
+
+	penaltyPerHour := req.Msg.WorkTimePenaltyPerHour
+	if penaltyPerHour <= 0 {
+		penaltyPerHour = defaultWorkTimePenaltyPerHour
+	}
+
+	plan, err := svc.planRoster(ctx, roster, penaltyPerHour)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &rosterdv1.CompleteRosterResponse{
+		Roster:               plan.Roster.ToProto(),
+		WorkShiftDefinitions: make([]*rosterv1.WorkShift, len(plan.Definitions)),
+		Penalty:              plan.Penalty,
+		UnstaffedShifts:      plan.Unstaffed,
+	}
+
+	for idx, def := range plan.Definitions {
+		response.WorkShiftDefinitions[idx] = def.ToProto()
+	}
+
+	return connect.NewResponse(response), nil
+}
+
+// rosterShiftTags returns the shift tags of roster. If the roster does not
+// have any shift tags, the shift tags of the roster type are returned.
+func (svc *RosterService) rosterShiftTags(ctx context.Context, roster structs.DutyRoster) ([]string, error) {
+	if len(roster.ShiftTags) > 0 {
+		return roster.ShiftTags, nil
+	}
+
+	rosterType, err := svc.Datastore.GetRosterType(ctx, roster.RosterTypeName)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("failed to find roster type with name %q", roster.RosterTypeName))
+		}
+
+		return nil, err
+	}
+
+	return rosterType.ShiftTags, nil
+}
+
+// splitPlannedShifts sorts the shifts of rosters by user into shifts that
+// are planned within the period of roster and shifts that surround it.
+// Shifts that do not overlap plannedFrom and plannedTo are ignored.
+//
+// Rosters of the same type as roster whose period overlaps the one of
+// roster would be replaced by it so their shifts within the period are
+// ignored. Rosters of the same type for other periods, like the previous
+// month, are taken into account as any other roster.
+func splitPlannedShifts(roster structs.DutyRoster, rosters []structs.DutyRoster, plannedFrom, plannedTo time.Time) (planned, surrounding map[string][]structs.PlannedShift) {
+	from, to := roster.FromTime(), roster.ToTime()
+
+	planned = make(map[string][]structs.PlannedShift)
+	surrounding = make(map[string][]structs.PlannedShift)
+
+	for _, other := range rosters {
+		if other.ID == roster.ID {
+			continue
+		}
+
+		replaced := other.RosterTypeName == roster.RosterTypeName &&
+			!other.FromTime().After(to) && !other.ToTime().Before(from)
+
+		for _, shift := range other.Shifts {
+			if shift.To.Before(plannedFrom) || shift.From.After(plannedTo) {
+				continue
+			}
+
+			inRange := !shift.From.Before(from) && shift.From.Before(to.AddDate(0, 0, 1))
+
+			for _, user := range shift.AssignedUserIds {
+				switch {
+				case !inRange:
+					surrounding[user] = append(surrounding[user], shift)
+				case !replaced:
+					planned[user] = append(planned[user], shift)
+				}
+			}
+		}
+	}
+
+	return planned, surrounding
+}
+
+// rosterPlan is the result of planRoster.
+type rosterPlan struct {
+	// Roster is the planned roster.
+	Roster structs.DutyRoster
+
+	// Definitions holds all work-shift definitions used by the roster.
+	Definitions []structs.WorkShift
+
+	// Penalty is the total penalty of the roster.
+	Penalty float64
+
+	// Unstaffed holds all shifts that could not be fully staffed.
+	Unstaffed []*rosterdv1.UnstaffedShift
+}
+
+// planRoster prepares and solves the staffing problem for roster using the
+// shift tags of the roster. Users that are already assigned to shifts of the
+// roster are kept.
+func (svc *RosterService) planRoster(ctx context.Context, roster structs.DutyRoster, penaltyPerHour float64) (*rosterPlan, error) {
+	from, to := roster.FromTime(), roster.ToTime()
+
+	var profiles []*idmv1.Profile
+
+	required, definitions, userIds, _, err := svc.getRequiredShifts(ctx, from, to, &profiles, roster.ShiftTags)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get required shifts: %w", err)
+	}
+
+	constraintList, err := svc.Datastore.FindConstraints(ctx, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load constraints: %w", err)
+	}
+
+	allDefinitions, err := svc.Datastore.ListWorkShifts(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load workshift definitions: %w", err)
+	}
+	definitionsByID := data.IndexSlice(allDefinitions, func(e structs.WorkShift) string { return e.ID.Hex() })
+
+	roleIds := make(map[string][]string, len(profiles))
+	for _, profile := range profiles {
+		roleIds[profile.User.Id] = profileRoleIds(profile)
+	}
+
+	// load all shifts that are planned in other rosters. The time range is
+	// extended so labour rules can take shifts of adjacent rosters into
+	// account.
+	labourRules := svc.Config.LabourRules()
+	plannedFrom, plannedTo := labourRules.Range(from, to)
+
+	rosters, err := svc.Datastore.FindRostersWithActiveShiftsInRange(ctx, plannedFrom, plannedTo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load duty rosters: %w", err)
+	}
+
+	planned, surrounding := splitPlannedShifts(roster, rosters, plannedFrom, plannedTo)
+
+	// calculate the expected work time for all users.
+	workTimes := make(map[string]timecalc.WorkTimeList, len(userIds))
+	for _, id := range userIds {
+		history, err := svc.Datastore.WorkTimeHistoryForStaff(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get work-time history for user %q: %w", id, err)
+		}
+
+		workTimes[id] = history
+	}
+
+	holidays, err := svc.HolidayProvider.For(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	monthlyWorkDays, err := timecalc.GatherWorkDaysByMonth(holidays, roster.From, roster.To, svc.Config.Weekend)
+	if err != nil {
+		return nil, fmt.Errorf("failed to gather monthly work-days: %w", err)
+	}
+
+	expectedWorkTimes, err := timecalc.CalculateExpectedWorkTime(ctx, monthlyWorkDays, workTimes, roster.From, roster.To, svc.Config.Weekend)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate expected work time: %w", err)
+	}
+
+	expected := make(map[string]time.Duration, len(expectedWorkTimes))
+	for id, list := range expectedWorkTimes {
+		expected[id] = list.TotalWorkTime()
+	}
+
+	// existing assignments are treated as fixed.
+	existing := make(map[string]structs.PlannedShift, len(roster.Shifts))
+	for _, shift := range roster.Shifts {
+		existing[plannedShiftKey(shift.WorkShiftID.Hex(), shift.From)] = shift
+	}
+
+	// prepare the slots that need to be staffed
+	slots := make([]planner.Slot, 0, len(required))
+	for _, shift := range required {
+		def := definitionsByID[shift.WorkShiftID.Hex()]
+
+		timeWorth := shift.To.Sub(shift.From)
+		if def.MinutesWorth != nil {
+			timeWorth = time.Duration(*def.MinutesWorth) * time.Minute
+		}
+
+		key := plannedShiftKey(shift.WorkShiftID.Hex(), shift.From)
+
+		var fixed []string
+		if e, ok := existing[key]; ok {
+			fixed = e.AssignedUserIds
+			delete(existing, key)
+		}
+
+		slots = append(slots, planner.Slot{
+			Shift:      shift,
+			StaffCount: def.RequiredStaffCount,
+			TimeWorth:  timeWorth,
+			Fixed:      fixed,
+		})
+	}
+
+	// existing shifts that do not match a required shift are kept as they
+	// are but still block the assigned users.
+	for _, shift := range existing {
+		for _, user := range shift.AssignedUserIds {
+			planned[user] = append(planned[user], shift)
+		}
+	}
+
+	// lookups of the current work-time are cached since the cost function is
+	// called quite often.
+	currentWorkTimes := make(map[string]*structs.WorkTime)
+	currentWorkTime := func(userId string, t time.Time) *structs.WorkTime {
+		key := userId + "/" + t.Format("2006-01-02")
+
+		if wt, ok := currentWorkTimes[key]; ok {
+			return wt
+		}
+
+		var result *structs.WorkTime
+		if wt, ok := workTimes[userId].FindForDate(t); ok {
+			result = &wt
+		}
+
+		currentWorkTimes[key] = result
+
+		return result
+	}
+
+	failedConstraints := make(map[string]struct{})
+	onError := func(c structs.Constraint, err error) {
+		if _, ok := failedConstraints[c.ID.Hex()]; ok {
+			return
+		}
+
+		failedConstraints[c.ID.Hex()] = struct{}{}
+		log.L(ctx).Error("failed to evaluate constraint", "id", c.ID.Hex(), "error", err)
+	}
+
+	problem := planner.Problem{
+		Slots:                  slots,
+		Expected:               expected,
+		Planned:                planned,
+		Surrounding:            surrounding,
+		WorkTimePenaltyPerHour: penaltyPerHour,
+		Cost: func(slot planner.Slot, userID string, plannedShifts []structs.PlannedShift) (float64, bool) {
+			input := constraints.Input{
+				Shift:       definitionsByID[slot.Shift.WorkShiftID.Hex()],
+				From:        slot.Shift.From,
+				To:          slot.Shift.To,
+				Holiday:     slot.Shift.OnHoliday,
+				Weekend:     slot.Shift.OnWeekend,
+				UserID:      userID,
+				WorkTime:    currentWorkTime(userID, slot.Shift.From),
+				Planned:     plannedShifts,
+				Definitions: definitionsByID,
+			}
+
+			_, penalty, blocked := evaluateConstraints(svc.Constraints, constraintList, input, roleIds[userID], true, onError)
+
+			candidate := structs.PlannedShift{
+				From:        slot.Shift.From,
+				To:          slot.Shift.To,
+				WorkShiftID: slot.Shift.WorkShiftID,
+				TimeWorth:   slot.TimeWorth,
+			}
+
+			if len(labourRules.Check(candidate, plannedShifts)) > 0 {
+				blocked = true
+			}
+
+			return float64(penalty), blocked
+		},
+	}
+
+	solution := planner.Solve(problem)
+
+	log.L(ctx).Info("generated duty roster", "from", roster.From, "to", roster.To, "type", roster.RosterTypeName, "penalty", solution.Penalty, "unstaffed", len(solution.Unstaffed))
+
+	result := &rosterPlan{
+		Roster:      roster,
+		Definitions: definitions,
+		Penalty:     solution.Penalty,
+	}
+
+	result.Roster.Shifts = make([]structs.PlannedShift, 0, len(solution.Shifts)+len(existing))
+	for _, shift := range solution.Shifts {
+		if len(shift.AssignedUserIds) > 0 {
+			result.Roster.Shifts = append(result.Roster.Shifts, shift)
+		}
+	}
+
+	for _, shift := range existing {
+		result.Roster.Shifts = append(result.Roster.Shifts, shift)
+	}
+
+	// existing shifts are taken from a map so we need a total order to
+	// keep the result deterministic.
+	sort.Slice(result.Roster.Shifts, func(i, j int) bool {
+		return lessPlannedShift(result.Roster.Shifts[i], result.Roster.Shifts[j])
+	})
+
+	for _, u := range solution.Unstaffed {
+		slot := slots[u.Slot]
+
+		unstaffed := &rosterdv1.UnstaffedShift{
+			From:                timestamppb.New(slot.Shift.From),
+			To:                  timestamppb.New(slot.Shift.To),
+			WorkShiftId:         slot.Shift.WorkShiftID.Hex(),
+			RequiredStaffCount:  int32(slot.StaffCount),
+			AssignedUserIds:     solution.Shifts[u.Slot].AssignedUserIds,
+			ViolationsPerUserId: slot.Shift.Violations,
+			BlockedUserIds:      u.Blocked,
+			UnavailableUserIds:  u.Unavailable,
+		}
+
+		switch u.Reason {
+		case planner.ReasonNoEligibleUsers:
+			unstaffed.Reason = rosterdv1.UnstaffedReason_UNSTAFFED_REASON_NO_ELIGIBLE_USERS
+
+			// users with an eligible role have been excluded due to hard
+			// constraints, off-time requests or missing work-times.
+			if len(slot.Shift.Violations) > 0 {
+				unstaffed.Reason = rosterdv1.UnstaffedReason_UNSTAFFED_REASON_HARD_CONSTRAINTS
+			}
+		case planner.ReasonHardConstraints:
+			unstaffed.Reason = rosterdv1.UnstaffedReason_UNSTAFFED_REASON_HARD_CONSTRAINTS
+		case planner.ReasonUnavailable:
+			unstaffed.Reason = rosterdv1.UnstaffedReason_UNSTAFFED_REASON_UNAVAILABLE
+		case planner.ReasonNotEnoughEligibleUsers:
+			unstaffed.Reason = rosterdv1.UnstaffedReason_UNSTAFFED_REASON_NOT_ENOUGH_ELIGIBLE_USERS
+		}
+
+		result.Unstaffed = append(result.Unstaffed, unstaffed)
+	}
+
+	return result, nil
+}
+
+func plannedShiftKey(workShiftID string, from time.Time) string {
+	return workShiftID + "/" + from.Format(time.RFC3339)
+}
+
+// lessPlannedShift orders planned shifts by start time, work-shift ID and
+// assigned users.
+func lessPlannedShift(a, b structs.PlannedShift) bool {
+	if !a.From.Equal(b.From) {
+		return a.From.Before(b.From)
+	}
+
+	if idA, idB := a.WorkShiftID.Hex(), b.WorkShiftID.Hex(); idA != idB {
+		return idA < idB
+	}
+
+	return slices.Compare(a.AssignedUserIds, b.AssignedUserIds) < 0
+}
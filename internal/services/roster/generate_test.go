@@ -0,0 +1,93 @@
+package roster
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func Test_lessPlannedShift(t *testing.T) {
+	morning := time.Date(2024, time.May, 6, 8, 0, 0, 0, time.UTC)
+	noon := morning.Add(4 * time.Hour)
+
+	first, second := primitive.NewObjectID(), primitive.NewObjectID()
+	if second.Hex() < first.Hex() {
+		first, second = second, first
+	}
+
+	expected := []structs.PlannedShift{
+		{From: morning, WorkShiftID: first, AssignedUserIds: []string{"alice"}},
+		{From: morning, WorkShiftID: first, AssignedUserIds: []string{"bob"}},
+		{From: morning, WorkShiftID: second, AssignedUserIds: []string{"alice"}},
+		{From: noon, WorkShiftID: first, AssignedUserIds: []string{"alice"}},
+	}
+
+	for i := 0; i < 10; i++ {
+		shifts := append([]structs.PlannedShift(nil), expected...)
+		rand.Shuffle(len(shifts), func(i, j int) {
+			shifts[i], shifts[j] = shifts[j], shifts[i]
+		})
+
+		sort.Slice(shifts, func(i, j int) bool {
+			return lessPlannedShift(shifts[i], shifts[j])
+		})
+
+		require.Equal(t, expected, shifts)
+	}
+}
+
+func Test_splitPlannedShifts(t *testing.T) {
+	roster := structs.DutyRoster{
+		ID:             primitive.NewObjectID(),
+		RosterTypeName: "default",
+		From:           "2024-05-01",
+		To:             "2024-05-31",
+	}
+
+	shift := func(from time.Time, users ...string) structs.PlannedShift {
+		return structs.PlannedShift{
+			WorkShiftID:     primitive.NewObjectID(),
+			From:            from,
+			To:              from.Add(8 * time.Hour),
+			AssignedUserIds: users,
+		}
+	}
+
+	var (
+		previousNight = shift(time.Date(2024, time.April, 30, 20, 0, 0, 0, time.Local), "alice")
+		replacedShift = shift(time.Date(2024, time.May, 10, 8, 0, 0, 0, time.Local), "bob")
+		otherShift    = shift(time.Date(2024, time.May, 10, 8, 0, 0, 0, time.Local), "carol")
+		ownShift      = shift(time.Date(2024, time.May, 11, 8, 0, 0, 0, time.Local), "dave")
+		outsideShift  = shift(time.Date(2024, time.April, 1, 8, 0, 0, 0, time.Local), "alice")
+		nextMorning   = shift(time.Date(2024, time.June, 1, 8, 0, 0, 0, time.Local), "erin")
+	)
+
+	rosters := []structs.DutyRoster{
+		// the previous period of the same roster type
+		{ID: primitive.NewObjectID(), RosterTypeName: "default", From: "2024-04-01", To: "2024-04-30", Shifts: []structs.PlannedShift{previousNight, outsideShift}},
+		// the next period of the same roster type
+		{ID: primitive.NewObjectID(), RosterTypeName: "default", From: "2024-06-01", To: "2024-06-30", Shifts: []structs.PlannedShift{nextMorning}},
+		// a roster of the same type for the same period is replaced
+		{ID: primitive.NewObjectID(), RosterTypeName: "default", From: "2024-05-01", To: "2024-05-31", Shifts: []structs.PlannedShift{replacedShift}},
+		// rosters of other types are always taken into account
+		{ID: primitive.NewObjectID(), RosterTypeName: "on-call", From: "2024-05-01", To: "2024-05-31", Shifts: []structs.PlannedShift{otherShift}},
+		// the roster itself
+		{ID: roster.ID, RosterTypeName: "default", From: "2024-05-01", To: "2024-05-31", Shifts: []structs.PlannedShift{ownShift}},
+	}
+
+	planned, surrounding := splitPlannedShifts(roster, rosters, time.Date(2024, time.April, 24, 0, 0, 0, 0, time.Local), time.Date(2024, time.June, 7, 0, 0, 0, 0, time.Local))
+
+	require.Equal(t, map[string][]structs.PlannedShift{
+		"carol": {otherShift},
+		"erin":  {nextMorning},
+	}, planned)
+
+	require.Equal(t, map[string][]structs.PlannedShift{
+		"alice": {previousNight},
+	}, surrounding)
+}
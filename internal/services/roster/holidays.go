@@ -0,0 +1,264 @@
+package roster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/tierklinik-dobersberg/apis/pkg/auth"
+	rosterdv1 "github.com/tierklinik-dobersberg/rosterd/gen/go/rosterd/v1"
+	"github.com/tierklinik-dobersberg/rosterd/gen/go/rosterd/v1/rosterdv1connect"
+	"github.com/tierklinik-dobersberg/rosterd/internal/holiday"
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var _ rosterdv1connect.HolidayServiceHandler = (*RosterService)(nil)
+
+func (svc *RosterService) CreateLocalHoliday(ctx context.Context, req *connect.Request[rosterdv1.CreateLocalHolidayRequest]) (*connect.Response[rosterdv1.CreateLocalHolidayResponse], error) {
+	remoteUser := auth.From(ctx)
+	if remoteUser == nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, nil)
+	}
+
+	hd, err := localHolidayFromProto(req.Msg.Holiday)
+	if err != nil {
+		return nil, err
+	}
+
+	hd.ID = primitive.NilObjectID
+	hd.CreatedAt = time.Now()
+	hd.CreatorId = remoteUser.ID
+
+	if err := svc.Datastore.CreateLocalHoliday(ctx, &hd); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("a local holiday at %s already exists", hd.Date))
+		}
+
+		return nil, fmt.Errorf("failed to create local holiday: %w", err)
+	}
+
+	svc.Audit.Record(ctx, structs.AuditEntityLocalHoliday, hd.ID.Hex(), structs.AuditOperationCreate, nil, hd)
+
+	return connect.NewResponse(&rosterdv1.CreateLocalHolidayResponse{
+		Holiday: localHolidayToProto(hd),
+	}), nil
+}
+
+func (svc *RosterService) UpdateLocalHoliday(ctx context.Context, req *connect.Request[rosterdv1.UpdateLocalHolidayRequest]) (*connect.Response[rosterdv1.UpdateLocalHolidayResponse], error) {
+	hd, err := localHolidayFromProto(req.Msg.Holiday)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := svc.Datastore.GetLocalHoliday(ctx, req.Msg.Holiday.GetId())
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("local holiday with id %q not found", req.Msg.Holiday.GetId()))
+		}
+
+		return nil, err
+	}
+
+	hd.CreatedAt = existing.CreatedAt
+	hd.CreatorId = existing.CreatorId
+
+	if err := svc.Datastore.UpdateLocalHoliday(ctx, &hd); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("a local holiday at %s already exists", hd.Date))
+		}
+
+		return nil, fmt.Errorf("failed to update local holiday: %w", err)
+	}
+
+	svc.Audit.Record(ctx, structs.AuditEntityLocalHoliday, hd.ID.Hex(), structs.AuditOperationUpdate, existing, hd)
+
+	return connect.NewResponse(&rosterdv1.UpdateLocalHolidayResponse{
+		Holiday: localHolidayToProto(hd),
+	}), nil
+}
+
+func (svc *RosterService) DeleteLocalHoliday(ctx context.Context, req *connect.Request[rosterdv1.DeleteLocalHolidayRequest]) (*connect.Response[rosterdv1.DeleteLocalHolidayResponse], error) {
+	existing, err := svc.Datastore.GetLocalHoliday(ctx, req.Msg.Id)
+	if err == nil {
+		err = svc.Datastore.DeleteLocalHoliday(ctx, req.Msg.Id)
+	}
+
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("local holiday with id %q not found", req.Msg.Id))
+		}
+
+		return nil, err
+	}
+
+	svc.Audit.Record(ctx, structs.AuditEntityLocalHoliday, req.Msg.Id, structs.AuditOperationDelete, existing, nil)
+
+	return connect.NewResponse(new(rosterdv1.DeleteLocalHolidayResponse)), nil
+}
+
+func (svc *RosterService) ListLocalHolidays(ctx context.Context, req *connect.Request[rosterdv1.ListLocalHolidaysRequest]) (*connect.Response[rosterdv1.ListLocalHolidaysResponse], error) {
+	for _, value := range []string{req.Msg.From, req.Msg.To} {
+		if value == "" {
+			continue
+		}
+
+		if _, err := time.ParseInLocation("2006-01-02", value, time.Local); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid date %q: expected YYYY-MM-DD", value))
+		}
+	}
+
+	holidays, err := svc.Datastore.ListLocalHolidays(ctx, req.Msg.From, req.Msg.To)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &rosterdv1.ListLocalHolidaysResponse{
+		Holidays: make([]*rosterdv1.LocalHoliday, len(holidays)),
+	}
+
+	for idx, hd := range holidays {
+		response.Holidays[idx] = localHolidayToProto(hd)
+	}
+
+	return connect.NewResponse(response), nil
+}
+
+func (svc *RosterService) ListEffectiveHolidays(ctx context.Context, req *connect.Request[rosterdv1.ListEffectiveHolidaysRequest]) (*connect.Response[rosterdv1.ListEffectiveHolidaysResponse], error) {
+	from, err := time.ParseInLocation("2006-01-02", req.Msg.From, time.Local)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid value for from: %w", err))
+	}
+
+	to, err := time.ParseInLocation("2006-01-02", req.Msg.To, time.Local)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid value for to: %w", err))
+	}
+
+	if to.Before(from) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("to must not be before from"))
+	}
+
+	holidays, err := svc.HolidayProvider.For(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&rosterdv1.ListEffectiveHolidaysResponse{
+		Holidays: effectiveHolidays(holidays, req.Msg.From, req.Msg.To),
+	}), nil
+}
+
+// effectiveHolidays returns all holidays between from and to (inclusive,
+// YYYY-MM-DD) sorted by date.
+func effectiveHolidays(holidays holiday.Holidays, from, to string) []*rosterdv1.EffectiveHoliday {
+	dates := maps.Keys(holidays)
+	slices.Sort(dates)
+
+	result := make([]*rosterdv1.EffectiveHoliday, 0, len(dates))
+	for _, date := range dates {
+		if date < from || date > to {
+			continue
+		}
+
+		hd := holidays[date]
+
+		pb := &rosterdv1.EffectiveHoliday{
+			Date:            date,
+			Name:            hd.LocalName,
+			CountsAsWorkday: hd.WorkDay,
+			HolidayShifts:   hd.HolidayShifts,
+			WorkdayFraction: hd.WorkdayFraction,
+		}
+
+		if hd.Local != nil {
+			pb.Local = localHolidayToProto(*hd.Local)
+		}
+
+		result = append(result, pb)
+	}
+
+	return result
+}
+
+var localHolidayKinds = map[rosterdv1.LocalHolidayKind]structs.LocalHolidayKind{
+	rosterdv1.LocalHolidayKind_LOCAL_HOLIDAY_KIND_UNSPECIFIED: structs.LocalHolidayOther,
+	rosterdv1.LocalHolidayKind_LOCAL_HOLIDAY_KIND_CLOSURE:     structs.LocalHolidayClosure,
+	rosterdv1.LocalHolidayKind_LOCAL_HOLIDAY_KIND_HALF_DAY:    structs.LocalHolidayHalfDay,
+	rosterdv1.LocalHolidayKind_LOCAL_HOLIDAY_KIND_REGIONAL:    structs.LocalHolidayRegional,
+	rosterdv1.LocalHolidayKind_LOCAL_HOLIDAY_KIND_OTHER:       structs.LocalHolidayOther,
+}
+
+func localHolidayFromProto(pb *rosterdv1.LocalHoliday) (structs.LocalHoliday, error) {
+	if pb == nil {
+		return structs.LocalHoliday{}, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("missing holiday"))
+	}
+
+	kind, ok := localHolidayKinds[pb.Kind]
+	if !ok {
+		return structs.LocalHoliday{}, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unsupported kind %q", pb.Kind.String()))
+	}
+
+	hd := structs.LocalHoliday{
+		Name:            pb.Name,
+		Description:     pb.Description,
+		Kind:            kind,
+		CountsAsWorkday: pb.CountsAsWorkday,
+		WorkdayFraction: pb.WorkdayFraction,
+		HolidayShifts:   pb.HolidayShifts,
+	}
+
+	if hd.Name == "" {
+		return hd, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name must be set"))
+	}
+
+	if hd.WorkdayFraction < 0 || hd.WorkdayFraction > 1 {
+		return hd, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("workday_fraction must be between 0 and 1"))
+	}
+
+	date, err := time.ParseInLocation("2006-01-02", pb.Date, time.Local)
+	if err != nil {
+		return hd, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid value for date: %w", err))
+	}
+	hd.Date = date.Format("2006-01-02")
+
+	if pb.Id != "" {
+		hd.ID, err = primitive.ObjectIDFromHex(pb.Id)
+		if err != nil {
+			return hd, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid id: %w", err))
+		}
+	}
+
+	return hd, nil
+}
+
+func localHolidayToProto(hd structs.LocalHoliday) *rosterdv1.LocalHoliday {
+	pb := &rosterdv1.LocalHoliday{
+		Id:              hd.ID.Hex(),
+		Date:            hd.Date,
+		Name:            hd.Name,
+		Description:     hd.Description,
+		CountsAsWorkday: hd.CountsAsWorkday,
+		WorkdayFraction: hd.WorkdayFraction,
+		HolidayShifts:   hd.HolidayShifts,
+		CreatorId:       hd.CreatorId,
+	}
+
+	for kind, value := range localHolidayKinds {
+		if value == hd.Kind && kind != rosterdv1.LocalHolidayKind_LOCAL_HOLIDAY_KIND_UNSPECIFIED {
+			pb.Kind = kind
+		}
+	}
+
+	if !hd.CreatedAt.IsZero() {
+		pb.CreatedAt = timestamppb.New(hd.CreatedAt)
+	}
+
+	return pb
+}
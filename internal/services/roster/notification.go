@@ -16,6 +16,7 @@ import (
 	"github.com/tierklinik-dobersberg/apis/pkg/log"
 	"github.com/tierklinik-dobersberg/rosterd/internal/ical"
 	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"golang.org/x/exp/maps"
 	"golang.org/x/exp/slices"
@@ -63,8 +64,6 @@ func (svc *RosterService) sendRosterNotification(ctx context.Context, senderId s
 		targetUsers   = make(map[string]*idmv1.Profile)
 	)
 
-	calendar := new(ical.Calendar)
-
 	workShifts, err := svc.Datastore.ListWorkShifts(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to load work-shift definitions: %w", err)
@@ -82,17 +81,9 @@ func (svc *RosterService) sendRosterNotification(ctx context.Context, senderId s
 	for _, shift := range roster.Shifts {
 		shiftName := wsLm[shift.WorkShiftID.Hex()].Name
 
-		event := ical.Event{
-			Name: shiftName,
-			From: shift.From,
-			To:   shift.To,
-		}
-
 		for _, usrId := range shift.AssignedUserIds {
 			targetUsers[usrId] = userLm[usrId]
 
-			event.Users = append(event.Users, userLm[usrId])
-
 			shiftDate := shift.From.Format("2006-01-02")
 
 			if perUserShifts[usrId] == nil {
@@ -105,8 +96,6 @@ func (svc *RosterService) sendRosterNotification(ctx context.Context, senderId s
 				To:   shift.To.Format(time.RFC3339),
 			})
 		}
-
-		calendar.Events = append(calendar.Events, event)
 	}
 
 	var (
@@ -157,15 +146,7 @@ func (svc *RosterService) sendRosterNotification(ctx context.Context, senderId s
 
 	for _, userId := range userIds {
 		workingDates := perUserShifts[userId]
-		var userWorkTime *rosterv1.WorkTimeAnalysis
-
-		for _, wt := range workTime {
-			if wt.UserId == userId {
-				userWorkTime = wt
-
-				break
-			}
-		}
+		userWorkTime := workTimeFor(workTime, userId)
 
 		diffMaps := make([]any, 0, len(userDiff[userId]))
 		for _, shift := range userDiff[userId] {
@@ -227,30 +208,124 @@ func (svc *RosterService) sendRosterNotification(ctx context.Context, senderId s
 		return nil, err
 	}
 
-	email := &idmv1.EMailMessage{
-		Subject:     subject,
-		Body:        string(templateBody),
-		Attachments: []*idmv1.Attachment{},
+	log.L(ctx).With("targetUsers", userIds).Info("sending roster notification")
+
+	// previews do not contain calendar attachments so a single notification
+	// request is enough unless the personal roster is attached.
+	if isPreview && !svc.Config.AttachUserRosterPDF {
+		return svc.sendRosterMail(ctx, senderId, subject, string(templateBody), nil, userIds, perUserCtx)
 	}
 
+	// calendar events are created per user so they can be updated and
+	// cancelled individually when the roster is superseded.
+	var (
+		rootID   primitive.ObjectID
+		sequence int
+	)
 	if !isPreview {
-		email.Attachments = append(email.Attachments, &idmv1.Attachment{
-			Name:           "Dienstplan.ics",
-			MediaType:      "text/calendar; method=ADD; name=Dienstplan.ics",
-			Content:        []byte(calendar.ToICS(roster.FromTime())),
-			AttachmentType: idmv1.AttachmentType_ATTACHEMNT,
-			ContentId:      "Dienstplan.ics",
+		rootID, sequence, err = svc.rosterLineage(ctx, roster)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var deliveries []*idmv1.DeliveryNotification
+	for _, userId := range userIds {
+		var attachments []*idmv1.Attachment
+
+		if !isPreview {
+			assigned, cancelled := userCalendars(roster, rootID, sequence, userId, userLm[userId], userDiff[userId], wsLm)
+
+			for _, cal := range []struct {
+				name string
+				cal  ical.Calendar
+			}{
+				{"Dienstplan.ics", assigned},
+				{"Abgesagt.ics", cancelled},
+			} {
+				if len(cal.cal.Events) == 0 {
+					continue
+				}
+
+				attachments = append(attachments, &idmv1.Attachment{
+					Name:           cal.name,
+					MediaType:      fmt.Sprintf("text/calendar; method=%s; name=%s", cal.cal.Method(), cal.name),
+					Content:        []byte(cal.cal.ToICS(roster.FromTime())),
+					AttachmentType: idmv1.AttachmentType_ATTACHEMNT,
+					ContentId:      cal.name,
+				})
+			}
+		}
+
+		if svc.Config.AttachUserRosterPDF && userLm[userId] != nil {
+			// a missing attachment should not prevent the notification.
+			if attachment, err := svc.userRosterAttachment(ctx, userLm[userId], roster, workTimeFor(workTime, userId), isPreview); err != nil {
+				log.L(ctx).Error("failed to render personal roster", "user", userId, "error", err)
+			} else {
+				attachments = append(attachments, attachment)
+			}
+		}
+
+		res, err := svc.sendRosterMail(ctx, senderId, subject, string(templateBody), attachments, []string{userId}, map[string]*structpb.Struct{
+			userId: perUserCtx[userId],
 		})
+		if err != nil {
+			return deliveries, err
+		}
+
+		deliveries = append(deliveries, res...)
 	}
 
-	log.L(ctx).With("targetUsers", userIds).Info("sending roster notification")
+	return deliveries, nil
+}
+
+// userRosterAttachment renders the personal roster of the user for roster
+// as a mail attachment.
+func (svc *RosterService) userRosterAttachment(ctx context.Context, profile *idmv1.Profile, roster structs.DutyRoster, workTime *rosterv1.WorkTimeAnalysis, isPreview bool) (*idmv1.Attachment, error) {
+	rc, err := svc.buildUserRoster(ctx, profile, roster.FromTime(), roster.ToTime(), []structs.DutyRoster{roster}, workTime, isPreview)
+	if err != nil {
+		return nil, err
+	}
+
+	blob, err := svc.renderUserRoster(ctx, rc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &idmv1.Attachment{
+		Name:           "Dienstplan.pdf",
+		MediaType:      "application/pdf",
+		Content:        blob,
+		AttachmentType: idmv1.AttachmentType_ATTACHEMNT,
+		ContentId:      "Dienstplan.pdf",
+	}, nil
+}
+
+func workTimeFor(list []*rosterv1.WorkTimeAnalysis, userId string) *rosterv1.WorkTimeAnalysis {
+	for _, wt := range list {
+		if wt.UserId == userId {
+			return wt
+		}
+	}
+
+	return nil
+}
+
+func (svc *RosterService) sendRosterMail(ctx context.Context, senderId, subject, body string, attachments []*idmv1.Attachment, userIds []string, perUserCtx map[string]*structpb.Struct) ([]*idmv1.DeliveryNotification, error) {
+	if attachments == nil {
+		attachments = []*idmv1.Attachment{}
+	}
 
 	req := &idmv1.SendNotificationRequest{
 		TargetUsers:            userIds,
 		PerUserTemplateContext: perUserCtx,
 		SenderUserId:           senderId,
 		Message: &idmv1.SendNotificationRequest_Email{
-			Email: email,
+			Email: &idmv1.EMailMessage{
+				Subject:     subject,
+				Body:        body,
+				Attachments: attachments,
+			},
 		},
 	}
 
@@ -261,3 +336,59 @@ func (svc *RosterService) sendRosterNotification(ctx context.Context, senderId s
 
 	return res.Msg.Deliveries, nil
 }
+
+// sendWebPush sends a web-push notification to all users of perUser using
+// the per-user template context. Errors are only logged.
+func (svc *RosterService) sendWebPush(ctx context.Context, sender string, body string, perUser map[string]*structpb.Struct) {
+	if len(perUser) == 0 {
+		return
+	}
+
+	res, err := svc.Notify.SendNotification(ctx, connect.NewRequest(&idmv1.SendNotificationRequest{
+		Message: &idmv1.SendNotificationRequest_Webpush{
+			Webpush: &idmv1.WebPushNotification{
+				Kind: &idmv1.WebPushNotification_Notification{
+					Notification: &idmv1.ServiceWorkerNotification{
+						Title:               "Tierklinik-Dobersberg",
+						Body:                body,
+						DefaultOperation:    idmv1.Operation_OPERATION_OPEN_WINDOW,
+						DefaultOperationUrl: svc.Config.PublicURL + "/roster",
+					},
+				},
+			},
+		},
+		SenderUserId:           sender,
+		TargetUsers:            maps.Keys(perUser),
+		PerUserTemplateContext: perUser,
+	}))
+	if err != nil {
+		log.L(ctx).Error("failed to send web-push notification", "error", err)
+
+		return
+	}
+
+	for _, d := range res.Msg.Deliveries {
+		if d.Error != "" {
+			log.L(ctx).Error("failed to notify user", "target", d.TargetUser, "errorKind", d.ErrorKind, "error", d.Error)
+		}
+	}
+}
+
+// workShiftName returns the name of the work-shift definition with the given
+// ID or the ID itself if the definition cannot be loaded.
+func (svc *RosterService) workShiftName(ctx context.Context, id primitive.ObjectID) string {
+	definitions, err := svc.Datastore.ListWorkShifts(ctx)
+	if err != nil {
+		log.L(ctx).Error("failed to load work-shift definitions", "error", err)
+
+		return id.Hex()
+	}
+
+	for _, def := range definitions {
+		if def.ID == id {
+			return def.Name
+		}
+	}
+
+	return id.Hex()
+}
@@ -0,0 +1,641 @@
+package roster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	idmv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/idm/v1"
+	"github.com/tierklinik-dobersberg/apis/pkg/auth"
+	"github.com/tierklinik-dobersberg/apis/pkg/data"
+	"github.com/tierklinik-dobersberg/apis/pkg/log"
+	rosterdv1 "github.com/tierklinik-dobersberg/rosterd/gen/go/rosterd/v1"
+	"github.com/tierklinik-dobersberg/rosterd/gen/go/rosterd/v1/rosterdv1connect"
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"golang.org/x/exp/slices"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var _ rosterdv1connect.OpenShiftServiceHandler = (*RosterService)(nil)
+
+func (svc *RosterService) PublishOpenShifts(ctx context.Context, req *connect.Request[rosterdv1.PublishOpenShiftsRequest]) (*connect.Response[rosterdv1.PublishOpenShiftsResponse], error) {
+	remoteUser := auth.From(ctx)
+	if remoteUser == nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, nil)
+	}
+
+	rosterID, err := primitive.ObjectIDFromHex(req.Msg.RosterId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid roster id: %w", err))
+	}
+
+	only := make([]structs.ShiftRef, len(req.Msg.Shifts))
+	for idx, pb := range req.Msg.Shifts {
+		only[idx], err = shiftRefFromProto(pb)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	roster, err := svc.loadApprovedRoster(ctx, rosterID)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := svc.rosterShiftTags(ctx, roster)
+	if err != nil {
+		return nil, err
+	}
+
+	required, definitions, _, _, err := svc.getRequiredShifts(ctx, roster.FromTime(), roster.ToTime(), nil, tags)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get required shifts: %w", err)
+	}
+
+	published, err := svc.Datastore.FindOpenShifts(ctx, "", []structs.OpenShiftState{structs.OpenShiftStateOpen})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load open shifts: %w", err)
+	}
+
+	definitionsByID := data.IndexSlice(definitions, func(e structs.WorkShift) string { return e.ID.Hex() })
+	slots := understaffedSlots(roster, required, definitionsByID, only, published, time.Now())
+
+	var (
+		response = new(rosterdv1.PublishOpenShiftsResponse)
+		perUser  = make(map[string][]string)
+	)
+
+	for _, slot := range slots {
+		os := structs.OpenShift{
+			RosterID: roster.ID,
+			Shift: structs.ShiftRef{
+				WorkShiftID: slot.Required.WorkShiftID,
+				From:        slot.Required.From,
+			},
+			To:                  slot.Required.To,
+			OpenSlots:           slot.Missing,
+			RequireConfirmation: req.Msg.RequireConfirmation,
+			State:               structs.OpenShiftStateOpen,
+			EligibleUserIds:     slot.Eligible,
+			Comment:             req.Msg.Comment,
+			CreatedAt:           time.Now(),
+			CreatorId:           remoteUser.ID,
+		}
+
+		if err := svc.Datastore.CreateOpenShift(ctx, &os); err != nil {
+			return nil, fmt.Errorf("failed to create open shift: %w", err)
+		}
+
+		svc.Audit.Record(ctx, structs.AuditEntityOpenShift, os.ID.Hex(), structs.AuditOperationCreate, nil, os, os.EligibleUserIds...)
+
+		shiftDesc := fmt.Sprintf("%s am %s", definitionsByID[os.Shift.WorkShiftID.Hex()].Name, os.Shift.From.Local().Format("02.01.2006"))
+		for _, user := range os.EligibleUserIds {
+			perUser[user] = append(perUser[user], shiftDesc)
+		}
+
+		response.OpenShifts = append(response.OpenShifts, openShiftToProto(os))
+	}
+
+	notifyCtx := make(map[string]*structpb.Struct, len(perUser))
+	for user, shifts := range perUser {
+		ctxPb, err := structpb.NewStruct(map[string]any{
+			"Count":  len(shifts),
+			"Shifts": strings.Join(shifts, ", "),
+		})
+		if err != nil {
+			log.L(ctx).Error("failed to prepare structpb context", "error", err)
+
+			continue
+		}
+
+		notifyCtx[user] = ctxPb
+	}
+
+	svc.sendWebPush(ctx, remoteUser.ID, "{{ if eq .Count 1.0 }}Ein offener Dienst kann übernommen werden{{ else }}{{ .Count }} offene Dienste können übernommen werden{{ end }}: {{ .Shifts }}", notifyCtx)
+
+	return connect.NewResponse(response), nil
+}
+
+func (svc *RosterService) ListOpenShifts(ctx context.Context, req *connect.Request[rosterdv1.ListOpenShiftsRequest]) (*connect.Response[rosterdv1.ListOpenShiftsResponse], error) {
+	remoteUser := auth.From(ctx)
+	if remoteUser == nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, nil)
+	}
+
+	states := []structs.OpenShiftState{structs.OpenShiftStateOpen}
+	if len(req.Msg.States) > 0 {
+		states = states[:0]
+		for _, s := range req.Msg.States {
+			states = append(states, openShiftStateFromProto(s))
+		}
+	}
+
+	openShifts, err := svc.Datastore.FindOpenShifts(ctx, req.Msg.RosterId, states)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find open shifts: %w", err)
+	}
+
+	response := new(rosterdv1.ListOpenShiftsResponse)
+	for _, os := range openShifts {
+		// users only see open shifts they are eligible for or that they
+		// claimed.
+		if !remoteUser.Admin && !slices.Contains(os.EligibleUserIds, remoteUser.ID) && os.Claim(remoteUser.ID) == nil {
+			continue
+		}
+
+		response.OpenShifts = append(response.OpenShifts, openShiftToProto(os))
+	}
+
+	return connect.NewResponse(response), nil
+}
+
+func (svc *RosterService) ClaimOpenShift(ctx context.Context, req *connect.Request[rosterdv1.ClaimOpenShiftRequest]) (*connect.Response[rosterdv1.ClaimOpenShiftResponse], error) {
+	remoteUser := auth.From(ctx)
+	if remoteUser == nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, nil)
+	}
+
+	os, err := svc.loadOpenShift(ctx, req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	before := *os
+	before.Claims = slices.Clone(os.Claims)
+
+	if os.State != structs.OpenShiftStateOpen {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("open shift is %s", os.State))
+	}
+
+	if os.Claim(remoteUser.ID) != nil {
+		return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("you already claimed this shift"))
+	}
+
+	response := new(rosterdv1.ClaimOpenShiftResponse)
+
+	if os.RequireConfirmation {
+		roster, err := svc.loadApprovedRoster(ctx, os.RosterID)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := svc.checkOpenShiftClaim(ctx, roster, *os, remoteUser.ID); err != nil {
+			return nil, err
+		}
+
+		os.RosterID = roster.ID
+		os.Claims = append(os.Claims, structs.OpenShiftClaim{
+			UserID:    remoteUser.ID,
+			State:     structs.OpenShiftClaimPending,
+			ClaimedAt: time.Now(),
+		})
+
+		if err := svc.updateOpenShift(ctx, os); err != nil {
+			return nil, err
+		}
+
+		if managers, err := svc.rosterManagerIds(ctx); err == nil {
+			svc.sendOpenShiftNotification(ctx, remoteUser.ID, *os, "{{ .Sender | displayName }} möchte den offenen Dienst {{ .Shift }} am {{ .Date }} übernehmen", managers...)
+		} else {
+			log.L(ctx).Error("failed to get roster_manager users", "error", err)
+		}
+	} else {
+		roster, err := svc.assignOpenShift(ctx, os, remoteUser.ID, remoteUser.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		response.Roster = roster.ToProto()
+	}
+
+	svc.Audit.Record(ctx, structs.AuditEntityOpenShift, os.ID.Hex(), structs.AuditOperationUpdate, before, *os, remoteUser.ID)
+
+	response.OpenShift = openShiftToProto(*os)
+
+	return connect.NewResponse(response), nil
+}
+
+func (svc *RosterService) DecideOpenShiftClaim(ctx context.Context, req *connect.Request[rosterdv1.DecideOpenShiftClaimRequest]) (*connect.Response[rosterdv1.DecideOpenShiftClaimResponse], error) {
+	remoteUser := auth.From(ctx)
+	if remoteUser == nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, nil)
+	}
+
+	os, err := svc.loadOpenShift(ctx, req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	before := *os
+	before.Claims = slices.Clone(os.Claims)
+
+	if os.State != structs.OpenShiftStateOpen {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("open shift is %s", os.State))
+	}
+
+	claim := os.Claim(req.Msg.UserId)
+	if claim == nil || claim.State != structs.OpenShiftClaimPending {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("no pending claim for user %q", req.Msg.UserId))
+	}
+
+	response := new(rosterdv1.DecideOpenShiftClaimResponse)
+
+	if req.Msg.Confirm {
+		roster, err := svc.assignOpenShift(ctx, os, req.Msg.UserId, remoteUser.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		response.Roster = roster.ToProto()
+	} else {
+		claim.State = structs.OpenShiftClaimRejected
+		claim.DecidedBy = remoteUser.ID
+		claim.DecidedAt = time.Now()
+
+		if err := svc.updateOpenShift(ctx, os); err != nil {
+			return nil, err
+		}
+	}
+
+	op := structs.AuditOperationReject
+	if req.Msg.Confirm {
+		op = structs.AuditOperationApprove
+	}
+	svc.Audit.Record(ctx, structs.AuditEntityOpenShift, os.ID.Hex(), op, before, *os, req.Msg.UserId)
+
+	svc.sendOpenShiftNotification(ctx, remoteUser.ID, *os, "Deine Übernahme des Dienstes {{ .Shift }} am {{ .Date }} wurde {{ if .Confirmed }}bestätigt{{ else }}abgelehnt{{ end }}", req.Msg.UserId)
+
+	response.OpenShift = openShiftToProto(*os)
+
+	return connect.NewResponse(response), nil
+}
+
+func (svc *RosterService) CloseOpenShift(ctx context.Context, req *connect.Request[rosterdv1.CloseOpenShiftRequest]) (*connect.Response[rosterdv1.CloseOpenShiftResponse], error) {
+	remoteUser := auth.From(ctx)
+	if remoteUser == nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, nil)
+	}
+
+	os, err := svc.loadOpenShift(ctx, req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	before := *os
+	before.Claims = slices.Clone(os.Claims)
+
+	if os.State != structs.OpenShiftStateOpen {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("open shift is %s", os.State))
+	}
+
+	os.State = structs.OpenShiftStateClosed
+	os.RejectPending(remoteUser.ID, time.Now())
+
+	if err := svc.updateOpenShift(ctx, os); err != nil {
+		return nil, err
+	}
+
+	svc.Audit.Record(ctx, structs.AuditEntityOpenShift, os.ID.Hex(), structs.AuditOperationUpdate, before, *os, claimUserIds(before)...)
+
+	return connect.NewResponse(&rosterdv1.CloseOpenShiftResponse{
+		OpenShift: openShiftToProto(*os),
+	}), nil
+}
+
+// assignOpenShift assigns userId to the open shift and updates the roster in
+// place. The open shift is updated first so concurrent claims for the last
+// slot fail.
+func (svc *RosterService) assignOpenShift(ctx context.Context, os *structs.OpenShift, userId string, decidedBy string) (structs.DutyRoster, error) {
+	roster, err := svc.loadApprovedRoster(ctx, os.RosterID)
+	if err != nil {
+		return roster, err
+	}
+
+	patched, err := svc.checkOpenShiftClaim(ctx, roster, *os, userId)
+	if err != nil {
+		return roster, err
+	}
+
+	previous := *os
+	previous.Claims = slices.Clone(os.Claims)
+
+	os.RosterID = roster.ID
+	os.Confirm(userId, decidedBy, time.Now())
+
+	if err := svc.updateOpenShift(ctx, os); err != nil {
+		return roster, err
+	}
+
+	if err := svc.savePatchedRoster(ctx, roster, &patched, decidedBy, []string{userId}); err != nil {
+		previous.Version = os.Version
+		if err := svc.Datastore.UpdateOpenShift(ctx, &previous); err != nil {
+			log.L(ctx).Error("failed to reset open shift", "id", os.ID.Hex(), "error", err)
+		} else {
+			*os = previous
+		}
+
+		return roster, err
+	}
+
+	return patched, nil
+}
+
+// checkOpenShiftClaim checks if userId is eligible for the open shift using
+// the same role, off-time and constraint checks as getRequiredShifts and
+// returns a copy of roster with the user assigned. Assignments that introduce
+// blocking findings are refused.
+func (svc *RosterService) checkOpenShiftClaim(ctx context.Context, roster structs.DutyRoster, os structs.OpenShift, userId string) (structs.DutyRoster, error) {
+	profiles, err := svc.FetchAllUserProfiles(ctx)
+	if err != nil {
+		return roster, fmt.Errorf("failed to fetch user profiles: %w", err)
+	}
+
+	idx := slices.IndexFunc(profiles, func(p *idmv1.Profile) bool { return p.User.Id == userId })
+	if idx < 0 {
+		return roster, connect.NewError(connect.CodeNotFound, fmt.Errorf("user %q not found", userId))
+	}
+
+	tags, err := svc.rosterShiftTags(ctx, roster)
+	if err != nil {
+		return roster, err
+	}
+
+	from := os.Shift.From.Local()
+	day := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.Local)
+
+	userProfiles := []*idmv1.Profile{profiles[idx]}
+	required, definitions, _, _, err := svc.getRequiredShifts(ctx, day, day, &userProfiles, tags)
+	if err != nil {
+		return roster, fmt.Errorf("failed to get required shifts: %w", err)
+	}
+
+	requiredIdx := slices.IndexFunc(required, func(r structs.RequiredShift) bool {
+		return os.Shift.Matches(structs.PlannedShift{WorkShiftID: r.WorkShiftID, From: r.From})
+	})
+	if requiredIdx < 0 {
+		return roster, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("the shift is not required anymore"))
+	}
+
+	if !slices.Contains(required[requiredIdx].EligibleUserIds, userId) {
+		cerr := connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("user %s is not eligible for the shift", userId))
+
+		if violations, ok := required[requiredIdx].Violations[userId]; ok {
+			if detail, err := connect.NewErrorDetail(violations); err == nil {
+				cerr.AddDetail(detail)
+			}
+		}
+
+		return roster, cerr
+	}
+
+	defIdx := slices.IndexFunc(definitions, func(d structs.WorkShift) bool { return d.ID == os.Shift.WorkShiftID })
+	if defIdx < 0 {
+		return roster, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("work shift %s does not exist anymore", os.Shift.WorkShiftID.Hex()))
+	}
+
+	def := definitions[defIdx]
+
+	timeWorth := os.To.Sub(os.Shift.From)
+	if def.MinutesWorth != nil {
+		timeWorth = time.Duration(*def.MinutesWorth) * time.Minute
+	}
+
+	patched, err := assignShift(roster, structs.PlannedShift{
+		From:        os.Shift.From,
+		To:          os.To,
+		WorkShiftID: os.Shift.WorkShiftID,
+		TimeWorth:   timeWorth,
+	}, userId)
+	if err != nil {
+		return roster, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+
+	blocking, err := svc.newPatchFindings(ctx, roster, patched, []string{userId})
+	if err != nil {
+		return roster, err
+	}
+
+	if len(blocking) > 0 {
+		messages := make([]string, len(blocking))
+		for idx, f := range blocking {
+			messages[idx] = f.Message
+		}
+
+		cerr := connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("user cannot be assigned: %s", strings.Join(messages, "; ")))
+
+		if detail, err := connect.NewErrorDetail(&rosterdv1.ValidateRosterResponse{
+			Findings:          blocking,
+			HasBlockingErrors: true,
+		}); err == nil {
+			cerr.AddDetail(detail)
+		}
+
+		return roster, cerr
+	}
+
+	return patched, nil
+}
+
+// assignShift returns a copy of roster with userId assigned to shift. The
+// shift is added to the roster if it does not exist yet.
+func assignShift(roster structs.DutyRoster, shift structs.PlannedShift, userId string) (structs.DutyRoster, error) {
+	roster = cloneRoster(roster)
+
+	ref := structs.ShiftRef{
+		WorkShiftID: shift.WorkShiftID,
+		From:        shift.From,
+	}
+
+	for idx, s := range roster.Shifts {
+		if !ref.Matches(s) {
+			continue
+		}
+
+		if slices.Contains(s.AssignedUserIds, userId) {
+			return roster, fmt.Errorf("user %s is already assigned to the shift", userId)
+		}
+
+		roster.Shifts[idx].AssignedUserIds = append(roster.Shifts[idx].AssignedUserIds, userId)
+
+		return roster, nil
+	}
+
+	shift.AssignedUserIds = []string{userId}
+	roster.Shifts = append(roster.Shifts, shift)
+
+	return roster, nil
+}
+
+// openSlot describes a required shift that has less users assigned than
+// required.
+type openSlot struct {
+	Required structs.RequiredShift
+
+	// Missing is the number of users that are missing.
+	Missing int
+
+	// Eligible holds all eligible users that are not yet assigned.
+	Eligible []string
+}
+
+// understaffedSlots returns all shifts of roster that have less users
+// assigned than required and start after now. Shifts that are already
+// published are skipped. If only is not empty, only the referenced shifts are
+// considered.
+func understaffedSlots(roster structs.DutyRoster, required []structs.RequiredShift, definitions map[string]structs.WorkShift, only []structs.ShiftRef, published []structs.OpenShift, now time.Time) []openSlot {
+	assigned := make(map[string][]string, len(roster.Shifts))
+	for _, shift := range roster.Shifts {
+		key := plannedShiftKey(shift.WorkShiftID.Hex(), shift.From)
+		assigned[key] = append(assigned[key], shift.AssignedUserIds...)
+	}
+
+	var result []openSlot
+	for _, r := range required {
+		if !r.From.After(now) {
+			continue
+		}
+
+		planned := structs.PlannedShift{WorkShiftID: r.WorkShiftID, From: r.From}
+
+		if len(only) > 0 && !slices.ContainsFunc(only, func(ref structs.ShiftRef) bool { return ref.Matches(planned) }) {
+			continue
+		}
+
+		if slices.ContainsFunc(published, func(os structs.OpenShift) bool { return os.Shift.Matches(planned) }) {
+			continue
+		}
+
+		users := assigned[plannedShiftKey(r.WorkShiftID.Hex(), r.From)]
+
+		missing := definitions[r.WorkShiftID.Hex()].RequiredStaffCount - len(users)
+		if missing <= 0 {
+			continue
+		}
+
+		slot := openSlot{
+			Required: r,
+			Missing:  missing,
+		}
+
+		for _, user := range r.EligibleUserIds {
+			if !slices.Contains(users, user) {
+				slot.Eligible = append(slot.Eligible, user)
+			}
+		}
+
+		result = append(result, slot)
+	}
+
+	return result
+}
+
+func (svc *RosterService) loadOpenShift(ctx context.Context, id string) (*structs.OpenShift, error) {
+	os, err := svc.Datastore.GetOpenShift(ctx, id)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("open shift with id %q not found", id))
+		}
+
+		return nil, err
+	}
+
+	return os, nil
+}
+
+func (svc *RosterService) updateOpenShift(ctx context.Context, os *structs.OpenShift) error {
+	if err := svc.Datastore.UpdateOpenShift(ctx, os); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return connect.NewError(connect.CodeAborted, fmt.Errorf("open shift has been modified concurrently"))
+		}
+
+		return fmt.Errorf("failed to update open shift: %w", err)
+	}
+
+	return nil
+}
+
+// sendOpenShiftNotification sends a web-push notification about os to
+// targets. Errors are only logged.
+func (svc *RosterService) sendOpenShiftNotification(ctx context.Context, sender string, os structs.OpenShift, body string, targets ...string) {
+	perUser := make(map[string]*structpb.Struct, len(targets))
+
+	for _, t := range targets {
+		confirmed := false
+		if claim := os.Claim(t); claim != nil {
+			confirmed = claim.State == structs.OpenShiftClaimConfirmed
+		}
+
+		ctxPb, err := structpb.NewStruct(map[string]any{
+			"Shift":     svc.workShiftName(ctx, os.Shift.WorkShiftID),
+			"Date":      os.Shift.From.Local().Format("02.01.2006"),
+			"Confirmed": confirmed,
+		})
+		if err != nil {
+			log.L(ctx).Error("failed to prepare structpb context", "error", err)
+
+			return
+		}
+
+		perUser[t] = ctxPb
+	}
+
+	svc.sendWebPush(ctx, sender, body, perUser)
+}
+
+var openShiftStates = map[structs.OpenShiftState]rosterdv1.OpenShiftState{
+	structs.OpenShiftStateOpen:   rosterdv1.OpenShiftState_OPEN_SHIFT_STATE_OPEN,
+	structs.OpenShiftStateFilled: rosterdv1.OpenShiftState_OPEN_SHIFT_STATE_FILLED,
+	structs.OpenShiftStateClosed: rosterdv1.OpenShiftState_OPEN_SHIFT_STATE_CLOSED,
+}
+
+var openShiftClaimStates = map[structs.OpenShiftClaimState]rosterdv1.OpenShiftClaimState{
+	structs.OpenShiftClaimPending:   rosterdv1.OpenShiftClaimState_OPEN_SHIFT_CLAIM_STATE_PENDING,
+	structs.OpenShiftClaimConfirmed: rosterdv1.OpenShiftClaimState_OPEN_SHIFT_CLAIM_STATE_CONFIRMED,
+	structs.OpenShiftClaimRejected:  rosterdv1.OpenShiftClaimState_OPEN_SHIFT_CLAIM_STATE_REJECTED,
+}
+
+func openShiftStateFromProto(state rosterdv1.OpenShiftState) structs.OpenShiftState {
+	for key, value := range openShiftStates {
+		if value == state {
+			return key
+		}
+	}
+
+	return ""
+}
+
+func openShiftToProto(os structs.OpenShift) *rosterdv1.OpenShift {
+	pb := &rosterdv1.OpenShift{
+		Id:                  os.ID.Hex(),
+		RosterId:            os.RosterID.Hex(),
+		Shift:               shiftRefToProto(os.Shift),
+		To:                  timestamppb.New(os.To),
+		OpenSlots:           int32(os.OpenSlots),
+		RequireConfirmation: os.RequireConfirmation,
+		State:               openShiftStates[os.State],
+		Comment:             os.Comment,
+		CreatedAt:           timestamppb.New(os.CreatedAt),
+		CreatorId:           os.CreatorId,
+		EligibleUserIds:     os.EligibleUserIds,
+	}
+
+	for _, c := range os.Claims {
+		claim := &rosterdv1.OpenShiftClaim{
+			UserId:    c.UserID,
+			State:     openShiftClaimStates[c.State],
+			ClaimedAt: timestamppb.New(c.ClaimedAt),
+			DecidedBy: c.DecidedBy,
+		}
+
+		if !c.DecidedAt.IsZero() {
+			claim.DecidedAt = timestamppb.New(c.DecidedAt)
+		}
+
+		pb.Claims = append(pb.Claims, claim)
+	}
+
+	return pb
+}
@@ -0,0 +1,117 @@
+package roster
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func Test_understaffedSlots(t *testing.T) {
+	var (
+		early = structs.WorkShift{ID: primitive.NewObjectID(), RequiredStaffCount: 2}
+		late  = structs.WorkShift{ID: primitive.NewObjectID(), RequiredStaffCount: 1}
+		day   = time.Date(2024, time.March, 4, 0, 0, 0, 0, time.Local)
+		now   = day.AddDate(0, 0, -1)
+	)
+
+	definitions := map[string]structs.WorkShift{
+		early.ID.Hex(): early,
+		late.ID.Hex():  late,
+	}
+
+	required := []structs.RequiredShift{
+		{WorkShiftID: early.ID, From: day.Add(8 * time.Hour), EligibleUserIds: []string{"alice", "bob", "carol"}},
+		{WorkShiftID: late.ID, From: day.Add(14 * time.Hour), EligibleUserIds: []string{"alice"}},
+		{WorkShiftID: early.ID, From: day.Add(32 * time.Hour), EligibleUserIds: []string{"bob"}},
+	}
+
+	roster := structs.DutyRoster{
+		Shifts: []structs.PlannedShift{
+			{WorkShiftID: early.ID, From: day.Add(8 * time.Hour), AssignedUserIds: []string{"alice"}},
+			{WorkShiftID: late.ID, From: day.Add(14 * time.Hour), AssignedUserIds: []string{"alice"}},
+		},
+	}
+
+	slots := understaffedSlots(roster, required, definitions, nil, nil, now)
+	require.Len(t, slots, 2)
+
+	require.Equal(t, early.ID, slots[0].Required.WorkShiftID)
+	require.Equal(t, 1, slots[0].Missing)
+	require.Equal(t, []string{"bob", "carol"}, slots[0].Eligible)
+
+	// the second early shift is not part of the roster at all
+	require.Equal(t, 2, slots[1].Missing)
+	require.Equal(t, []string{"bob"}, slots[1].Eligible)
+
+	// shifts that already started are skipped
+	require.Len(t, understaffedSlots(roster, required, definitions, nil, nil, day.Add(9*time.Hour)), 1)
+
+	// only the referenced shifts are returned
+	only := []structs.ShiftRef{{WorkShiftID: early.ID, From: day.Add(32 * time.Hour)}}
+	slots = understaffedSlots(roster, required, definitions, only, nil, now)
+	require.Len(t, slots, 1)
+	require.Equal(t, day.Add(32*time.Hour), slots[0].Required.From)
+
+	// already published shifts are skipped
+	published := []structs.OpenShift{{Shift: structs.ShiftRef{WorkShiftID: early.ID, From: day.Add(8 * time.Hour)}}}
+	slots = understaffedSlots(roster, required, definitions, nil, published, now)
+	require.Len(t, slots, 1)
+	require.Equal(t, day.Add(32*time.Hour), slots[0].Required.From)
+}
+
+func Test_assignShift(t *testing.T) {
+	id := primitive.NewObjectID()
+	from := time.Date(2024, time.March, 4, 8, 0, 0, 0, time.Local)
+
+	roster := structs.DutyRoster{
+		Shifts: []structs.PlannedShift{
+			{WorkShiftID: id, From: from, AssignedUserIds: []string{"alice"}},
+		},
+	}
+
+	patched, err := assignShift(roster, structs.PlannedShift{WorkShiftID: id, From: from}, "bob")
+	require.NoError(t, err)
+	require.Equal(t, []string{"alice", "bob"}, patched.Shifts[0].AssignedUserIds)
+	require.Equal(t, []string{"alice"}, roster.Shifts[0].AssignedUserIds)
+
+	_, err = assignShift(roster, structs.PlannedShift{WorkShiftID: id, From: from}, "alice")
+	require.Error(t, err)
+
+	// shifts that are not yet part of the roster are added
+	patched, err = assignShift(roster, structs.PlannedShift{WorkShiftID: id, From: from.AddDate(0, 0, 1)}, "bob")
+	require.NoError(t, err)
+	require.Len(t, patched.Shifts, 2)
+	require.Equal(t, []string{"bob"}, patched.Shifts[1].AssignedUserIds)
+}
+
+func Test_OpenShift_Confirm(t *testing.T) {
+	now := time.Now()
+
+	os := structs.OpenShift{
+		OpenSlots: 2,
+		State:     structs.OpenShiftStateOpen,
+		Claims: []structs.OpenShiftClaim{
+			{UserID: "alice", State: structs.OpenShiftClaimPending},
+			{UserID: "bob", State: structs.OpenShiftClaimPending},
+			{UserID: "carol", State: structs.OpenShiftClaimPending},
+		},
+	}
+
+	os.Confirm("alice", "manager", now)
+	require.Equal(t, 1, os.OpenSlots)
+	require.Equal(t, structs.OpenShiftStateOpen, os.State)
+	require.Equal(t, structs.OpenShiftClaimConfirmed, os.Claim("alice").State)
+
+	// first-come claims do not have a pending claim
+	os.Confirm("dave", "dave", now)
+	require.Equal(t, 0, os.OpenSlots)
+	require.Equal(t, structs.OpenShiftStateFilled, os.State)
+	require.Equal(t, structs.OpenShiftClaimConfirmed, os.Claim("dave").State)
+
+	// remaining pending claims are rejected once the shift is filled
+	require.Equal(t, structs.OpenShiftClaimRejected, os.Claim("bob").State)
+	require.Equal(t, structs.OpenShiftClaimRejected, os.Claim("carol").State)
+}
@@ -0,0 +1,180 @@
+package roster
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	rosterv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/roster/v1"
+	"github.com/tierklinik-dobersberg/apis/pkg/log"
+	rosterdv1 "github.com/tierklinik-dobersberg/rosterd/gen/go/rosterd/v1"
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"golang.org/x/exp/slices"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// savePatchedRoster saves patched, a modified copy of the approved roster,
+// without revoking the approval. The off-time costs of userIds are re-booked
+// and a RosterChangedEvent is published.
+func (svc *RosterService) savePatchedRoster(ctx context.Context, roster structs.DutyRoster, patched *structs.DutyRoster, modifier string, userIds []string) error {
+	patched.LastModifiedBy = modifier
+	patched.UpdatedAt = time.Now()
+
+	if _, err := svc.Datastore.SaveDutyRoster(ctx, patched, &roster.CASIndex); err != nil {
+		return fmt.Errorf("failed to save roster: %w", err)
+	}
+
+	svc.Audit.Record(ctx, structs.AuditEntityRoster, patched.ID.Hex(), structs.AuditOperationUpdate, roster, *patched, userIds...)
+
+	if err := svc.rebookRosterCosts(ctx, *patched, roster.ApproverUserId, userIds); err != nil {
+		// the roster has already been updated, re-approving the roster
+		// will fix the off-time costs.
+		log.L(ctx).Error("failed to re-book off-time costs of patched roster", "roster", patched.ID.Hex(), "error", err)
+	}
+
+	svc.Providers.PublishEvent(&rosterv1.RosterChangedEvent{
+		Roster: patched.ToProto(),
+	}, false)
+
+	return nil
+}
+
+// newPatchFindings validates roster and patched and returns all blocking
+// findings of userIds that are introduced by patched.
+func (svc *RosterService) newPatchFindings(ctx context.Context, roster, patched structs.DutyRoster, userIds []string) ([]*rosterdv1.RosterFinding, error) {
+	before, err := svc.validateRoster(ctx, roster)
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate roster: %w", err)
+	}
+
+	after, err := svc.validateRoster(ctx, patched)
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate roster: %w", err)
+	}
+
+	return newBlockingFindings(before, after, userIds), nil
+}
+
+// rebookRosterCosts re-calculates the overtime and undertime of userIds
+// for an approved roster. Undertime that has been booked as vacation before
+// is kept as vacation.
+func (svc *RosterService) rebookRosterCosts(ctx context.Context, roster structs.DutyRoster, approver string, userIds []string) error {
+	costs, err := svc.Datastore.GetOffTimeCosts(ctx, userIds...)
+	if err != nil {
+		return fmt.Errorf("failed to load off-time costs: %w", err)
+	}
+
+	overtime, err := svc.analyzeOvertime(ctx, roster.RosterTypeName, userIds, roster.From, roster.To, true)
+	if err != nil {
+		return fmt.Errorf("failed to calculate work-time: %w", err)
+	}
+
+	bookable := bookableOvertime(overtime)
+
+	if err := svc.Datastore.DeleteOffTimeCostsByRosterAndUser(ctx, roster.ID.Hex(), userIds...); err != nil {
+		return fmt.Errorf("failed to remove off-time costs bound to the roster: %w", err)
+	}
+
+	return svc.bookWorkTimeCosts(ctx, roster, approver, bookable, keepVacationSplits(roster.ID, bookable, costs))
+}
+
+// keepVacationSplits returns work-time splits that book undertime as vacation
+// up to the amount of vacation that has already been booked for the roster.
+func keepVacationSplits(rosterID primitive.ObjectID, overtime map[string]time.Duration, costs []structs.OffTimeCosts) map[string]*rosterv1.ApproveRosterWorkTimeSplit {
+	vacation := make(map[string]time.Duration)
+	for _, c := range costs {
+		if c.RosterID == rosterID && c.IsVacation {
+			vacation[c.UserID] += c.Costs
+		}
+	}
+
+	splits := make(map[string]*rosterv1.ApproveRosterWorkTimeSplit)
+	for userId, diff := range overtime {
+		booked := vacation[userId]
+
+		if diff >= 0 || booked >= 0 {
+			continue
+		}
+
+		// both values are negative
+		vacationCosts := max(booked, diff)
+
+		splits[userId] = &rosterv1.ApproveRosterWorkTimeSplit{
+			UserId:   userId,
+			Vacation: durationpb.New(vacationCosts),
+			TimeOff:  durationpb.New(diff - vacationCosts),
+		}
+	}
+
+	return splits
+}
+
+// newBlockingFindings returns all blocking findings of after that affect one
+// of userIds and do not exist in before.
+func newBlockingFindings(before, after []*rosterdv1.RosterFinding, userIds []string) []*rosterdv1.RosterFinding {
+	key := func(f *rosterdv1.RosterFinding) string {
+		return fmt.Sprintf("%s/%s/%s/%d/%s", f.Kind, f.UserId, f.WorkShiftId, f.GetFrom().AsTime().Unix(), f.Message)
+	}
+
+	existing := make(map[string]struct{}, len(before))
+	for _, f := range before {
+		existing[key(f)] = struct{}{}
+	}
+
+	var result []*rosterdv1.RosterFinding
+	for _, f := range after {
+		if f.Severity != rosterdv1.FindingSeverity_FINDING_SEVERITY_ERROR || !slices.Contains(userIds, f.UserId) {
+			continue
+		}
+
+		if _, ok := existing[key(f)]; ok {
+			continue
+		}
+
+		result = append(result, f)
+	}
+
+	return result
+}
+
+// loadApprovedRoster loads the roster with the given ID. If the roster has
+// been superseded the latest version is returned. Only approved rosters may be
+// patched in place.
+func (svc *RosterService) loadApprovedRoster(ctx context.Context, rosterID primitive.ObjectID) (structs.DutyRoster, error) {
+	roster, err := svc.loadRoster(ctx, rosterID.Hex())
+	if err != nil {
+		return roster, err
+	}
+
+	for roster.Deleted && !roster.SupersededBy.IsZero() {
+		roster, err = svc.loadRoster(ctx, roster.SupersededBy.Hex())
+		if err != nil {
+			return roster, err
+		}
+	}
+
+	if roster.Deleted {
+		return roster, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("roster %s has been deleted", roster.ID.Hex()))
+	}
+
+	if !roster.IsApproved() {
+		return roster, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("roster %s is not approved", roster.ID.Hex()))
+	}
+
+	return roster, nil
+}
+
+// cloneRoster returns a copy of roster that can be modified without changing
+// the assigned users of the original.
+func cloneRoster(roster structs.DutyRoster) structs.DutyRoster {
+	shifts := make([]structs.PlannedShift, len(roster.Shifts))
+	for idx, shift := range roster.Shifts {
+		shift.AssignedUserIds = slices.Clone(shift.AssignedUserIds)
+		shifts[idx] = shift
+	}
+	roster.Shifts = shifts
+
+	return roster
+}
@@ -0,0 +1,65 @@
+package roster
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	rosterdv1 "github.com/tierklinik-dobersberg/rosterd/gen/go/rosterd/v1"
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func Test_newBlockingFindings(t *testing.T) {
+	finding := func(severity rosterdv1.FindingSeverity, user, msg string) *rosterdv1.RosterFinding {
+		return &rosterdv1.RosterFinding{
+			Severity: severity,
+			Kind:     rosterdv1.FindingKind_FINDING_KIND_LABOUR_RULE,
+			UserId:   user,
+			Message:  msg,
+		}
+	}
+
+	before := []*rosterdv1.RosterFinding{
+		finding(rosterdv1.FindingSeverity_FINDING_SEVERITY_ERROR, "alice", "existing"),
+	}
+
+	after := []*rosterdv1.RosterFinding{
+		finding(rosterdv1.FindingSeverity_FINDING_SEVERITY_ERROR, "alice", "existing"),
+		finding(rosterdv1.FindingSeverity_FINDING_SEVERITY_ERROR, "bob", "new"),
+		finding(rosterdv1.FindingSeverity_FINDING_SEVERITY_WARNING, "bob", "warning"),
+		finding(rosterdv1.FindingSeverity_FINDING_SEVERITY_ERROR, "carol", "other user"),
+	}
+
+	result := newBlockingFindings(before, after, []string{"alice", "bob"})
+	require.Len(t, result, 1)
+	require.Equal(t, "new", result[0].Message)
+}
+
+func Test_keepVacationSplits(t *testing.T) {
+	rosterID := primitive.NewObjectID()
+
+	costs := []structs.OffTimeCosts{
+		{UserID: "alice", RosterID: rosterID, Costs: -4 * time.Hour, IsVacation: true},
+		{UserID: "alice", RosterID: rosterID, Costs: -2 * time.Hour},
+		{UserID: "bob", RosterID: rosterID, Costs: -8 * time.Hour, IsVacation: true},
+		{UserID: "carol", RosterID: primitive.NewObjectID(), Costs: -8 * time.Hour, IsVacation: true},
+	}
+
+	overtime := map[string]time.Duration{
+		"alice": -10 * time.Hour,
+		"bob":   -2 * time.Hour,
+		"carol": -2 * time.Hour,
+		"dave":  time.Hour,
+	}
+
+	splits := keepVacationSplits(rosterID, overtime, costs)
+	require.Len(t, splits, 2)
+
+	require.Equal(t, -4*time.Hour, splits["alice"].Vacation.AsDuration())
+	require.Equal(t, -6*time.Hour, splits["alice"].TimeOff.AsDuration())
+
+	// vacation is capped at the new undertime
+	require.Equal(t, -2*time.Hour, splits["bob"].Vacation.AsDuration())
+	require.Equal(t, time.Duration(0), splits["bob"].TimeOff.AsDuration())
+}
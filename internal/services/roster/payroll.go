@@ -0,0 +1,264 @@
+package roster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	idmv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/idm/v1"
+	"github.com/tierklinik-dobersberg/apis/pkg/auth"
+	"github.com/tierklinik-dobersberg/apis/pkg/data"
+	rosterdv1 "github.com/tierklinik-dobersberg/rosterd/gen/go/rosterd/v1"
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"github.com/tierklinik-dobersberg/rosterd/internal/timecalc"
+	"golang.org/x/exp/maps"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// PayrollSchemaVersion is the version of the JSON payroll export as
+// documented in docs/payroll-export.schema.json.
+const PayrollSchemaVersion = 1
+
+// payrollExport is the JSON representation of a payroll export.
+type payrollExport struct {
+	SchemaVersion int             `json:"schemaVersion"`
+	From          string          `json:"from"`
+	To            string          `json:"to"`
+	NightWindow   string          `json:"nightWindow"`
+	Records       []payrollRecord `json:"records"`
+}
+
+type payrollRecord struct {
+	UserID        string  `json:"userId"`
+	Username      string  `json:"username"`
+	DisplayName   string  `json:"displayName"`
+	Year          int     `json:"year"`
+	Month         int     `json:"month"`
+	PlannedHours  float64 `json:"plannedHours"`
+	WeekdayHours  float64 `json:"weekdayHours"`
+	WeekendHours  float64 `json:"weekendHours"`
+	HolidayHours  float64 `json:"holidayHours"`
+	NightHours    float64 `json:"nightHours"`
+	OvertimeHours float64 `json:"overtimeHours"`
+	VacationHours float64 `json:"vacationHours"`
+	TimeOffHours  float64 `json:"timeOffHours"`
+}
+
+func (svc *RosterService) ExportPayroll(ctx context.Context, req *connect.Request[rosterdv1.ExportPayrollRequest]) (*connect.Response[rosterdv1.ExportPayrollResponse], error) {
+	remoteUser := auth.From(ctx)
+	if remoteUser == nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("missing remote user"))
+	}
+
+	from, to, err := payrollRange(req.Msg.From, req.Msg.To)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	night := svc.Config.PayrollNightWindow
+	if req.Msg.NightWindow != "" {
+		night, err = timecalc.ParseNightWindow(req.Msg.NightWindow)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	}
+
+	var userIds []string
+	if req.Msg.Users == nil || req.Msg.Users.AllUsers {
+		userIds, err = svc.FetchAllUserIds(ctx)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		userIds = req.Msg.Users.UserIds
+	}
+
+	profiles, err := svc.FetchAllUserProfiles(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch user profiles: %w", err)
+	}
+	profileMap := data.IndexSlice(profiles, func(p *idmv1.Profile) string { return p.User.Id })
+
+	fromStr := from.Format("2006-01-02")
+	toStr := to.Format("2006-01-02")
+
+	// fetch all distinct rosters
+	distinctRosters := make(map[string]structs.DutyRoster)
+	for iter := from; !iter.After(to); iter = iter.AddDate(0, 0, 1) {
+		rosters, err := svc.Datastore.DutyRostersByTime(ctx, iter)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch roster for %s: %w", iter, err)
+		}
+
+		for _, roster := range rosters {
+			distinctRosters[roster.ID.Hex()] = roster
+		}
+	}
+
+	holidays, err := svc.HolidayProvider.For(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	hours, err := timecalc.CalculatePayrollHours(maps.Values(distinctRosters), fromStr, toStr, holidays, svc.Config.Weekend, night)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate payroll hours: %w", err)
+	}
+
+	overtime, err := svc.analyzeOvertime(ctx, "", userIds, fromStr, toStr, false)
+	if err != nil {
+		return nil, err
+	}
+
+	costs, err := svc.Datastore.GetOffTimeCosts(ctx, userIds...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get off-time costs: %w", err)
+	}
+	offTimes := timecalc.CalculateOffTimeTaken(costs, from, to)
+
+	overtimeByMonth := make(map[string]map[string]time.Duration, len(overtime))
+	for userId, months := range overtime {
+		overtimeByMonth[userId] = make(map[string]time.Duration, len(months))
+		for _, m := range months {
+			overtimeByMonth[userId][fmt.Sprintf("%04d-%02d", m.Year, m.Month)] = m.Overtime
+		}
+	}
+
+	response := &rosterdv1.ExportPayrollResponse{}
+	export := payrollExport{
+		SchemaVersion: PayrollSchemaVersion,
+		From:          fromStr,
+		To:            toStr,
+		NightWindow:   night.String(),
+		Records:       []payrollRecord{},
+	}
+
+	sort.Slice(userIds, func(i, j int) bool {
+		return userDisplayName(userIds[i], profileMap[userIds[i]]) < userDisplayName(userIds[j], profileMap[userIds[j]])
+	})
+
+	for _, userId := range userIds {
+		for month := from; !month.After(to); month = month.AddDate(0, 1, 0) {
+			key := month.Format("2006-01")
+
+			h := hours[userId][key]
+			taken := offTimes[userId][key]
+			ot := overtimeByMonth[userId][key]
+
+			response.Records = append(response.Records, &rosterdv1.PayrollRecord{
+				UserId:      userId,
+				Year:        int32(month.Year()),
+				Month:       int32(month.Month()),
+				PlannedTime: durationpb.New(h.Total),
+				WeekdayTime: durationpb.New(h.Weekday),
+				WeekendTime: durationpb.New(h.Weekend),
+				HolidayTime: durationpb.New(h.Holiday),
+				NightTime:   durationpb.New(h.Night),
+				Overtime:    durationpb.New(ot),
+				Vacation:    durationpb.New(taken.Vacation),
+				TimeOff:     durationpb.New(taken.TimeOff),
+			})
+
+			export.Records = append(export.Records, payrollRecord{
+				UserID:        userId,
+				Username:      profileMap[userId].GetUser().GetUsername(),
+				DisplayName:   userDisplayName(userId, profileMap[userId]),
+				Year:          month.Year(),
+				Month:         int(month.Month()),
+				PlannedHours:  h.Total.Hours(),
+				WeekdayHours:  h.Weekday.Hours(),
+				WeekendHours:  h.Weekend.Hours(),
+				HolidayHours:  h.Holiday.Hours(),
+				NightHours:    h.Night.Hours(),
+				OvertimeHours: ot.Hours(),
+				VacationHours: taken.Vacation.Hours(),
+				TimeOffHours:  taken.TimeOff.Hours(),
+			})
+		}
+	}
+
+	fileName := fmt.Sprintf("payroll_%s_%s", from.Format("2006-01"), to.Format("2006-01"))
+
+	switch req.Msg.Format {
+	case rosterdv1.PayrollExportFormat_PAYROLL_EXPORT_FORMAT_UNSPECIFIED,
+		rosterdv1.PayrollExportFormat_PAYROLL_EXPORT_FORMAT_CSV:
+		blob, err := encodeCSVTable(payrollTable(export.Records))
+		if err != nil {
+			return nil, err
+		}
+
+		response.Payload = blob
+		response.ContentType = "text/csv"
+		response.FileName = fileName + ".csv"
+
+	case rosterdv1.PayrollExportFormat_PAYROLL_EXPORT_FORMAT_JSON:
+		blob, err := json.MarshalIndent(export, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode payroll export: %w", err)
+		}
+
+		response.Payload = blob
+		response.ContentType = "application/json"
+		response.FileName = fileName + ".json"
+
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown payroll format %q", req.Msg.Format.String()))
+	}
+
+	return connect.NewResponse(response), nil
+}
+
+// payrollRange parses the from and to months (YYYY-MM) of a payroll export
+// and returns the first day of from and the last day of to.
+func payrollRange(from, to string) (time.Time, time.Time, error) {
+	if to == "" {
+		to = from
+	}
+
+	f, err := time.ParseInLocation("2006-01", from, time.Local)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid from value %q: expected YYYY-MM", from)
+	}
+
+	t, err := time.ParseInLocation("2006-01", to, time.Local)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid to value %q: expected YYYY-MM", to)
+	}
+
+	if t.Before(f) {
+		return time.Time{}, time.Time{}, fmt.Errorf("to must not be before from")
+	}
+
+	return f, t.AddDate(0, 1, -1), nil
+}
+
+// payrollTable returns the CSV table for records. The first row holds the
+// column headers.
+func payrollTable(records []payrollRecord) [][]any {
+	rows := [][]any{
+		{"Benutzer-ID", "Benutzername", "Mitarbeiter", "Jahr", "Monat", "Geplant", "Werktag", "Wochenende", "Feiertag", "Nacht", "Überstunden", "Urlaub", "Zeitausgleich"},
+	}
+
+	for _, r := range records {
+		rows = append(rows, []any{
+			r.UserID,
+			r.Username,
+			r.DisplayName,
+			r.Year,
+			r.Month,
+			r.PlannedHours,
+			r.WeekdayHours,
+			r.WeekendHours,
+			r.HolidayHours,
+			r.NightHours,
+			r.OvertimeHours,
+			r.VacationHours,
+			r.TimeOffHours,
+		})
+	}
+
+	return rows
+}
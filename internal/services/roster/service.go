@@ -11,16 +11,19 @@ import (
 
 	"github.com/bufbuild/connect-go"
 	"github.com/mennanov/fmutils"
-	calendarv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/calendar/v1"
 	rosterv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/roster/v1"
 	"github.com/tierklinik-dobersberg/apis/gen/go/tkd/roster/v1/rosterv1connect"
 	"github.com/tierklinik-dobersberg/apis/pkg/auth"
 	"github.com/tierklinik-dobersberg/apis/pkg/data"
 	"github.com/tierklinik-dobersberg/apis/pkg/log"
+	"github.com/tierklinik-dobersberg/rosterd/gen/go/rosterd/v1/rosterdv1connect"
 	"github.com/tierklinik-dobersberg/rosterd/internal/config"
+	"github.com/tierklinik-dobersberg/rosterd/internal/holiday"
 	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"github.com/tierklinik-dobersberg/rosterd/internal/timecalc"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"golang.org/x/exp/maps"
 	"golang.org/x/exp/slices"
 )
 
@@ -30,6 +33,8 @@ type RosterService struct {
 	*config.Providers
 }
 
+var _ rosterdv1connect.RosterServiceHandler = (*RosterService)(nil)
+
 func NewRosterService(p *config.Providers) *RosterService {
 	return &RosterService{
 		Providers: p,
@@ -45,6 +50,7 @@ func (svc *RosterService) ReapplyShiftTimes(ctx context.Context, req *connect.Re
 
 		return nil, err
 	}
+	before := cloneRoster(roster)
 
 	// load all workshift definitions
 	shifts, err := svc.Datastore.ListWorkShifts(ctx)
@@ -83,6 +89,8 @@ func (svc *RosterService) ReapplyShiftTimes(ctx context.Context, req *connect.Re
 		return nil, fmt.Errorf("failed to save roster: %w", err)
 	}
 
+	svc.Audit.Record(ctx, structs.AuditEntityRoster, roster.ID.Hex(), structs.AuditOperationUpdate, before, roster, rosterUserIds(roster)...)
+
 	svc.Providers.PublishEvent(&rosterv1.RosterChangedEvent{
 		Roster: roster.ToProto(),
 	}, false)
@@ -100,6 +108,7 @@ func (svc *RosterService) SaveRoster(ctx context.Context, req *connect.Request[r
 
 	var (
 		roster   structs.DutyRoster
+		before   *structs.DutyRoster
 		casIndex *uint64
 	)
 
@@ -128,6 +137,9 @@ func (svc *RosterService) SaveRoster(ctx context.Context, req *connect.Request[r
 			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("CAS index conflict"))
 		}
 
+		previous := cloneRoster(roster)
+		before = &previous
+
 		casIndex = &req.Msg.CasIndex
 	}
 
@@ -181,35 +193,25 @@ func (svc *RosterService) SaveRoster(ctx context.Context, req *connect.Request[r
 	}
 
 	if roster.IsApproved() && !req.Msg.KeepApproval {
-		// reset approval fields
-		roster.Approved = false
-		roster.ApprovedAt = time.Time{}
-		roster.ApproverUserId = ""
-
-		oldRosterID := roster.ID
-
-		// generate a new id for the roster and reset the CAS index values
-		roster.ID = primitive.NewObjectID()
-		roster.CASIndex = 0
-		casIndex = nil
-
-		log.L(ctx).Info("marking approved roster as superseded", "old", oldRosterID.Hex(), "new", roster.ID.Hex())
-
-		// remove the approval since this roster has been modified
-		if err := svc.Datastore.DeleteOffTimeCostsByRoster(ctx, oldRosterID.Hex()); err != nil {
-			return nil, fmt.Errorf("failed to delete off-time costs for an already approved roster: %w", err)
+		if err := svc.supersedeApprovedRoster(ctx, &roster); err != nil {
+			return nil, err
 		}
 
-		// mark the old duty roster as deleted and superseded by the new roster ID
-		if err := svc.Datastore.DeleteDutyRoster(ctx, oldRosterID.Hex(), roster.ID); err != nil {
-			return nil, fmt.Errorf("failed to mark updated duty roster with id %q as superseded (deleted): %s", oldRosterID.Hex(), err)
-		}
+		casIndex = nil
 	}
 
 	if _, err := svc.Datastore.SaveDutyRoster(ctx, &roster, casIndex); err != nil {
 		return nil, err
 	}
 
+	// a roster that supersedes an approved one is recorded as an update of
+	// the new roster so the ID change is part of the diff.
+	if before == nil {
+		svc.Audit.Record(ctx, structs.AuditEntityRoster, roster.ID.Hex(), structs.AuditOperationCreate, nil, roster, rosterUserIds(roster)...)
+	} else {
+		svc.Audit.Record(ctx, structs.AuditEntityRoster, roster.ID.Hex(), structs.AuditOperationUpdate, before, roster, rosterUserIds(*before, roster)...)
+	}
+
 	// caculate the work-time for the roster
 	analysis, err := svc.analyzeWorkTime(ctx, roster.RosterTypeName, users, roster.From, roster.To, req.Msg.TimeTrackingOnly)
 	if err != nil {
@@ -232,7 +234,47 @@ func (svc *RosterService) SaveRoster(ctx context.Context, req *connect.Request[r
 	return connect.NewResponse(response), nil
 }
 
+// supersedeApprovedRoster turns roster into a new, unapproved roster document
+// that supersedes the approved one. The off-time costs booked for the
+// approved roster are removed. The caller must save roster without a CAS
+// index afterwards.
+func (svc *RosterService) supersedeApprovedRoster(ctx context.Context, roster *structs.DutyRoster) error {
+	// reset approval fields
+	roster.Approved = false
+	roster.ApprovedAt = time.Time{}
+	roster.ApproverUserId = ""
+
+	oldRosterID := roster.ID
+
+	// generate a new id for the roster and reset the CAS index values
+	roster.ID = primitive.NewObjectID()
+	roster.CASIndex = 0
+
+	log.L(ctx).Info("marking approved roster as superseded", "old", oldRosterID.Hex(), "new", roster.ID.Hex())
+
+	// remove the approval since this roster has been modified
+	if err := svc.Datastore.DeleteOffTimeCostsByRoster(ctx, oldRosterID.Hex()); err != nil {
+		return fmt.Errorf("failed to delete off-time costs for an already approved roster: %w", err)
+	}
+
+	// mark the old duty roster as deleted and superseded by the new roster ID
+	if err := svc.Datastore.DeleteDutyRoster(ctx, oldRosterID.Hex(), roster.ID); err != nil {
+		return fmt.Errorf("failed to mark updated duty roster with id %q as superseded (deleted): %s", oldRosterID.Hex(), err)
+	}
+
+	return nil
+}
+
 func (svc *RosterService) DeleteRoster(ctx context.Context, req *connect.Request[rosterv1.DeleteRosterRequest]) (*connect.Response[rosterv1.DeleteRosterResponse], error) {
+	roster, err := svc.Datastore.DutyRosterByID(ctx, req.Msg.Id)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("roster with id %s not found", req.Msg.Id))
+		}
+
+		return nil, err
+	}
+
 	if err := svc.Datastore.DeleteOffTimeCostsByRoster(ctx, req.Msg.Id); err != nil {
 		return nil, fmt.Errorf("failed to delete off-time costs for roster id %s. Please contact your administrator: %w", req.Msg.Id, err)
 	}
@@ -241,6 +283,8 @@ func (svc *RosterService) DeleteRoster(ctx context.Context, req *connect.Request
 		return nil, fmt.Errorf("failed to delete roster with id %s. Please contact your administrator: %w", req.Msg.Id, err)
 	}
 
+	svc.Audit.Record(ctx, structs.AuditEntityRoster, req.Msg.Id, structs.AuditOperationDelete, roster, nil, rosterUserIds(roster)...)
+
 	return connect.NewResponse(&rosterv1.DeleteRosterResponse{}), nil
 }
 
@@ -256,22 +300,39 @@ func (svc *RosterService) ApproveRoster(ctx context.Context, req *connect.Reques
 		return nil, err
 	}
 
+	if svc.Config.ValidateOnApproval {
+		findings, err := svc.validateRoster(ctx, roster)
+		if err != nil {
+			return nil, fmt.Errorf("failed to validate roster: %w", err)
+		}
+
+		if count := countBlockingFindings(findings); count > 0 {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("roster has %d blocking errors, use ValidateAndApproveRoster to force approval", count))
+		}
+	}
+
+	if err := svc.approveRoster(ctx, roster, remoteUser.ID, req.Msg); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&rosterv1.ApproveRosterResponse{}), nil
+}
+
+// approveRoster approves roster and books the overtime and undertime of all
+// users as off-time costs.
+func (svc *RosterService) approveRoster(ctx context.Context, roster structs.DutyRoster, approver string, req *rosterv1.ApproveRosterRequest) error {
 	allUserIds, err := svc.FetchAllUserIds(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get user ids: %w", err)
+		return fmt.Errorf("failed to get user ids: %w", err)
 	}
 
-	fromTime := roster.FromTime()
-
 	// caculate the work-time for the roster. The last parameter specified
 	// that we only want work-time analysis for users with time-tracking enabled.
-	analysis, err := svc.analyzeWorkTime(ctx, roster.RosterTypeName, allUserIds, roster.From, roster.To, true)
+	overtime, err := svc.analyzeOvertime(ctx, roster.RosterTypeName, allUserIds, roster.From, roster.To, true)
 	if err != nil {
-		return nil, fmt.Errorf("failed to calculate work-time: %w", err)
+		return fmt.Errorf("failed to calculate work-time: %w", err)
 	}
 
-	approver := remoteUser.ID
-
 	// Validate off-time costs split first
 	/*
 		for _, an := range analysis {
@@ -279,7 +340,7 @@ func (svc *RosterService) ApproveRoster(ctx context.Context, req *connect.Reques
 
 			if diff < 0 {
 				var split *rosterv1.ApproveRosterWorkTimeSplit
-				for _, splits := range req.Msg.WorkTimeSplit {
+				for _, splits := range req.WorkTimeSplit {
 					if splits.UserId == an.UserId {
 						split = splits
 						break
@@ -297,11 +358,11 @@ func (svc *RosterService) ApproveRoster(ctx context.Context, req *connect.Reques
 				}
 
 				if timeOffCosts > 0 || vacationCosts > 0 {
-					return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid off-time cost split"))
+					return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid off-time cost split"))
 				}
 
 				if (timeOffCosts + vacationCosts) != diff {
-					return nil, fmt.Errorf("invalid off-time cost split, time-off=%q, vacation=%q, sum must equal %q", timeOffCosts, vacationCosts, diff)
+					return fmt.Errorf("invalid off-time cost split, time-off=%q, vacation=%q, sum must equal %q", timeOffCosts, vacationCosts, diff)
 				}
 			}
 		}
@@ -311,42 +372,66 @@ func (svc *RosterService) ApproveRoster(ctx context.Context, req *connect.Reques
 	// costs.
 	if roster.IsApproved() {
 		if err := svc.Datastore.DeleteOffTimeCostsByRoster(ctx, roster.ID.Hex()); err != nil {
-			return nil, fmt.Errorf("failed to remove off-time costs bound to the roster: %w", err)
+			return fmt.Errorf("failed to remove off-time costs bound to the roster: %w", err)
 		}
 	}
 
-	for _, an := range analysis {
-		if an.ExcludeFromTimeTracking {
-			continue
-		}
+	if err := svc.bookWorkTimeCosts(ctx, roster, approver, bookableOvertime(overtime), req.WorkTimeSplit); err != nil {
+		return err
+	}
+
+	if err := svc.Datastore.ApproveDutyRoster(ctx, roster.ID.Hex(), approver); err != nil {
+		return err
+	}
 
-		diff := an.Overtime.AsDuration()
+	approved, err := svc.Datastore.DutyRosterByID(ctx, roster.ID.Hex())
+	if err != nil {
+		log.L(ctx).Error("failed to load approved roster for the audit log", "roster", roster.ID.Hex(), "error", err)
+	} else {
+		svc.Audit.Record(ctx, structs.AuditEntityRoster, roster.ID.Hex(), structs.AuditOperationApprove, roster, approved, rosterUserIds(approved)...)
+	}
+
+	return nil
+}
+
+// bookWorkTimeCosts books the allowance-adjusted overtime and undertime of
+// each user as off-time costs of roster. Splits may be used to book undertime
+// as vacation instead of time-off.
+func (svc *RosterService) bookWorkTimeCosts(ctx context.Context, roster structs.DutyRoster, approver string, overtime map[string]time.Duration, splits map[string]*rosterv1.ApproveRosterWorkTimeSplit) error {
+	fromTime := roster.FromTime()
+
+	// book the costs in a stable order
+	userIds := maps.Keys(overtime)
+	slices.Sort(userIds)
+
+	for _, userId := range userIds {
+		diff := overtime[userId]
 
 		if diff > 0 {
 			// user has more time planned than was expected, add this as some
 			// off-time credits.
 			log.L(ctx).
 				With(
-					"user", an.UserId,
+					"user", userId,
 					"overtime", diff.String(),
 				).
 				Info("adding off-time costs entry for over-time")
 
 			if err := svc.Datastore.AddOffTimeCost(ctx, &structs.OffTimeCosts{
-				UserID:    an.UserId,
+				UserID:    userId,
 				RosterID:  roster.ID,
 				CreatorId: approver,
 				CreatedAt: time.Now(),
 				Costs:     diff,
 				Date:      fromTime,
 			}); err != nil {
-				return nil, fmt.Errorf("failed to add off-time credits for user %s: %w", an.UserId, err)
+				return fmt.Errorf("failed to add off-time credits for user %s: %w", userId, err)
 			}
 		} else if diff < 0 {
 			var split *rosterv1.ApproveRosterWorkTimeSplit
-			for _, splits := range req.Msg.WorkTimeSplit {
-				if splits.UserId == an.UserId {
-					split = splits
+			for _, s := range splits {
+				if s.UserId == userId {
+					split = s
 					break
 				}
 			}
@@ -368,33 +453,33 @@ func (svc *RosterService) ApproveRoster(ctx context.Context, req *connect.Reques
 			if timeOffCosts != 0 {
 				log.L(ctx).
 					With(
-						"user", an.UserId,
+						"user", userId,
 						"change", timeOffCosts.String(),
 					).
 					Info("adding off-time costs entry for undertime")
 
 				if err := svc.Datastore.AddOffTimeCost(ctx, &structs.OffTimeCosts{
-					UserID:    an.UserId,
+					UserID:    userId,
 					RosterID:  roster.ID,
 					CreatorId: approver,
 					CreatedAt: time.Now(),
 					Costs:     timeOffCosts,
 					Date:      fromTime,
 				}); err != nil {
-					return nil, fmt.Errorf("failed to add off-time credits for user %s: %w", an.UserId, err)
+					return fmt.Errorf("failed to add off-time credits for user %s: %w", userId, err)
 				}
 			}
 
 			if vacationCosts < 0 {
 				log.L(ctx).
 					With(
-						"user", an.UserId,
+						"user", userId,
 						"vacation", vacationCosts.String(),
 					).
 					Info("adding off-time costs entry for vacation")
 
 				if err := svc.Datastore.AddOffTimeCost(ctx, &structs.OffTimeCosts{
-					UserID:     an.UserId,
+					UserID:     userId,
 					RosterID:   roster.ID,
 					CreatorId:  approver,
 					CreatedAt:  time.Now(),
@@ -402,17 +487,13 @@ func (svc *RosterService) ApproveRoster(ctx context.Context, req *connect.Reques
 					Date:       fromTime,
 					IsVacation: true,
 				}); err != nil {
-					return nil, fmt.Errorf("failed to add off-time credits for user %s: %w", an.UserId, err)
+					return fmt.Errorf("failed to add off-time credits for user %s: %w", userId, err)
 				}
 			}
 		}
 	}
 
-	if err := svc.Datastore.ApproveDutyRoster(ctx, req.Msg.Id, approver); err != nil {
-		return nil, err
-	}
-
-	return connect.NewResponse(&rosterv1.ApproveRosterResponse{}), nil
+	return nil
 }
 
 func (svc *RosterService) GetWorkingStaff(ctx context.Context, req *connect.Request[rosterv1.GetWorkingStaffRequest]) (*connect.Response[rosterv1.GetWorkingStaffResponse], error) {
@@ -705,40 +786,14 @@ func (svc *RosterService) GetRoster(ctx context.Context, req *connect.Request[ro
 	return connect.NewResponse(response), nil
 }
 
-func (svc *RosterService) getHolidayLookupMap(ctx context.Context, from time.Time, to time.Time) (map[string]*calendarv1.PublicHoliday, error) {
-	holidaysToFetch := []time.Time{from}
-	if from.Year() != to.Year() || from.Month() != to.Month() {
-		holidaysToFetch = append(holidaysToFetch, to)
-	}
-
-	var holidays []*calendarv1.PublicHoliday
-	for _, t := range holidaysToFetch {
-		res, err := svc.Holidays.GetHoliday(ctx, connect.NewRequest(&calendarv1.GetHolidayRequest{
-			Year:  uint64(t.Year()),
-			Month: uint64(t.Month()),
-		}))
-		if err != nil {
-			return nil, fmt.Errorf("failed to fetch holidays for %s", t.Format("2006-01-02"))
-		}
-
-		holidays = append(holidays, res.Msg.Holidays...)
-	}
-	holidayLookupMap := make(map[string]*calendarv1.PublicHoliday, len(holidays))
-	for _, holiday := range holidays {
-		holidayLookupMap[holiday.Date] = holiday
-	}
-
-	return holidayLookupMap, nil
-}
-
-func getWorkDays(_ context.Context, holidays map[string]*calendarv1.PublicHoliday, from time.Time, to time.Time) []*rosterv1.Day {
+func getWorkDays(_ context.Context, holidays holiday.Holidays, from time.Time, to time.Time, weekend timecalc.Weekend) []*rosterv1.Day {
 	var dayTypes []*rosterv1.Day
 
 	until := to.Format("2006-01-02")
 	for iter := from; iter.Format("2006-01-02") != until; iter = iter.AddDate(0, 0, 1) {
 		key := iter.Format("2006-01-02")
 
-		if hd, ok := holidays[key]; ok && hd.Type == calendarv1.HolidayType_PUBLIC {
+		if holidays.IsDayOff(iter) {
 			dayTypes = append(dayTypes, &rosterv1.Day{
 				Date: key,
 				Type: rosterv1.DayType_DAY_TYPE_HOLIDAY,
@@ -747,25 +802,23 @@ func getWorkDays(_ context.Context, holidays map[string]*calendarv1.PublicHolida
 			continue
 		}
 
-		switch iter.Weekday() {
-		case time.Saturday, time.Sunday:
+		if weekend.Contains(iter.Weekday()) {
 			dayTypes = append(dayTypes, &rosterv1.Day{
 				Date: key,
 				Type: rosterv1.DayType_DAY_TYPE_WEEKEND,
 			})
-		default:
+		} else {
 			dayTypes = append(dayTypes, &rosterv1.Day{
 				Date: key,
 				Type: rosterv1.DayType_DAY_TYPE_WORKDAY,
 			})
 		}
-
 	}
 
 	return dayTypes
 }
 
-func daysInMonth(ctx context.Context, holidays map[string]*calendarv1.PublicHoliday, m time.Month, year int, notBefore, notAfter time.Time, includeNotAfter bool) (int, []*rosterv1.Day) {
+func daysInMonth(ctx context.Context, holidays holiday.Holidays, m time.Month, year int, notBefore, notAfter time.Time, includeNotAfter bool, weekend timecalc.Weekend) (int, []*rosterv1.Day) {
 	firstDayInMonth := time.Date(year, m, 1, 0, 0, 0, 0, time.Local)
 
 	var (
@@ -788,8 +841,8 @@ func daysInMonth(ctx context.Context, holidays map[string]*calendarv1.PublicHoli
 			continue
 		}
 
-		if hd, ok := holidays[key]; ok && hd.Type == calendarv1.HolidayType_PUBLIC {
-			// this is a public holiday
+		if hd, ok := holidays.Get(iter); ok && !hd.WorkDay {
+			// this is a holiday that does not count as a working day
 
 			dayTypes = append(dayTypes, &rosterv1.Day{
 				Date: key,
@@ -801,13 +854,12 @@ func daysInMonth(ctx context.Context, holidays map[string]*calendarv1.PublicHoli
 			log.L(ctx).Info("found holiday", "date", iter, "holiday-type", hd.Type.String())
 		}
 
-		switch iter.Weekday() {
-		case time.Saturday, time.Sunday: // FIXME(ppacher): should we make saturday configurable?
+		if weekend.Contains(iter.Weekday()) {
 			dayTypes = append(dayTypes, &rosterv1.Day{
 				Date: key,
 				Type: rosterv1.DayType_DAY_TYPE_WEEKEND,
 			})
-		default:
+		} else {
 			dayTypes = append(dayTypes, &rosterv1.Day{
 				Date: key,
 				Type: rosterv1.DayType_DAY_TYPE_WORKDAY,
@@ -13,7 +13,9 @@ import (
 	"github.com/tierklinik-dobersberg/apis/pkg/auth"
 	"github.com/tierklinik-dobersberg/apis/pkg/data"
 	"github.com/tierklinik-dobersberg/apis/pkg/log"
+	"github.com/tierklinik-dobersberg/rosterd/internal/constraints"
 	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"golang.org/x/exp/maps"
 	"golang.org/x/exp/slices"
@@ -201,12 +203,33 @@ func (svc *RosterService) getRequiredShifts(ctx context.Context, from, to time.T
 	}
 
 	// fetch all holidays
-	holidays, err := svc.getHolidayLookupMap(ctx, from, to)
+	holidays, err := svc.HolidayProvider.For(ctx, from, to)
 	if err != nil {
 		return nil, nil, nil, nil, err
 	}
 
-	workDays := getWorkDays(ctx, holidays, from, to)
+	workDays := getWorkDays(ctx, holidays, from, to, svc.Config.Weekend)
+
+	// load all constraints and the shifts that are already planned so we can
+	// evaluate constraint expressions.
+	constraintList, err := svc.Datastore.FindConstraints(ctx, nil, nil)
+	if err != nil {
+		return nil, nil, nil, nil, fmt.Errorf("failed to load constraints: %w", err)
+	}
+
+	allDefinitions, err := svc.Datastore.ListWorkShifts(ctx)
+	if err != nil {
+		return nil, nil, nil, nil, fmt.Errorf("failed to load workshift definitions: %w", err)
+	}
+	definitionsByID := data.IndexSlice(allDefinitions, func(e structs.WorkShift) string { return e.ID.Hex() })
+
+	labourRules := svc.Config.LabourRules()
+
+	plannedFrom, plannedTo := labourRules.Range(from, to)
+	plannedByUser, err := svc.loadPlannedShiftsByUser(ctx, plannedFrom, plannedTo, primitive.NilObjectID)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
 
 	// generate a list of required shifts
 	var (
@@ -217,7 +240,7 @@ func (svc *RosterService) getRequiredShifts(ctx context.Context, from, to time.T
 	)
 
 	for iter := from; to.After(iter) || to.Equal(iter); iter = iter.AddDate(0, 0, 1) {
-		_, isHoliday := holidays[iter.Format("2006-01-02")]
+		isHoliday := holidays.HolidayShifts(iter)
 
 		currentWorkTimes, err := svc.Datastore.GetCurrentWorkTimes(ctx, iter)
 		if err != nil {
@@ -252,12 +275,17 @@ func (svc *RosterService) getRequiredShifts(ctx context.Context, from, to time.T
 
 			shiftStart, shiftEnd := shift.AtDay(iter)
 
+			timeWorth := shiftEnd.Sub(shiftStart)
+			if shift.MinutesWorth != nil {
+				timeWorth = time.Duration(*shift.MinutesWorth) * time.Minute
+			}
+
 			requiredShift := structs.RequiredShift{
 				From:        shiftStart,
 				To:          shiftEnd,
 				WorkShiftID: shift.ID,
 				OnHoliday:   isHoliday,
-				OnWeekend:   iter.Weekday() == time.Saturday || iter.Weekday() == time.Sunday,
+				OnWeekend:   svc.Config.Weekend.Contains(iter.Weekday()),
 				Violations:  make(map[string]*rosterv1.ConstraintViolationList),
 			}
 
@@ -326,6 +354,47 @@ func (svc *RosterService) getRequiredShifts(ctx context.Context, from, to time.T
 					})
 				}
 
+				// evaluate all constraints that apply to the user
+				var currentWorkTime *structs.WorkTime
+				if ok {
+					currentWorkTime = &wt
+				}
+
+				input := constraints.Input{
+					Shift:       shift,
+					From:        shiftStart,
+					To:          shiftEnd,
+					Holiday:     isHoliday,
+					Weekend:     requiredShift.OnWeekend,
+					UserID:      profile.User.Id,
+					WorkTime:    currentWorkTime,
+					Planned:     otherShifts(plannedByUser[profile.User.Id], shift.ID, shiftStart),
+					Definitions: definitionsByID,
+				}
+
+				constraintViolations, _, blocked := evaluateConstraints(svc.Constraints, constraintList, input, profileRoleIds(profile), false, func(c structs.Constraint, err error) {
+					log.L(ctx).Error("failed to evaluate constraint", "id", c.ID.Hex(), "error", err)
+				})
+
+				if blocked {
+					isEligible = false
+				}
+
+				violations = append(violations, constraintViolations...)
+
+				// check the built-in labour rules
+				candidate := structs.PlannedShift{
+					From:        shiftStart,
+					To:          shiftEnd,
+					WorkShiftID: shift.ID,
+					TimeWorth:   timeWorth,
+				}
+
+				for _, v := range labourRules.Check(candidate, input.Planned) {
+					isEligible = false
+					violations = append(violations, v.ToProto())
+				}
+
 				// check if the user is eligible or not
 				if isEligible {
 					requiredShift.EligibleUserIds = append(requiredShift.EligibleUserIds, profile.User.Id)
@@ -348,3 +417,97 @@ func (svc *RosterService) getRequiredShifts(ctx context.Context, from, to time.T
 
 	return results, shiftDefinitions, maps.Keys(eligibleUsers), workDays, nil
 }
+
+// loadPlannedShiftsByUser returns all shifts that are planned between from and
+// to indexed by the ID of the assigned users. Shifts of the roster with the ID
+// exclude are ignored.
+func (svc *RosterService) loadPlannedShiftsByUser(ctx context.Context, from, to time.Time, exclude primitive.ObjectID) (map[string][]structs.PlannedShift, error) {
+	rosters, err := svc.Datastore.FindRostersWithActiveShiftsInRange(ctx, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load duty rosters: %w", err)
+	}
+
+	result := make(map[string][]structs.PlannedShift)
+	for _, roster := range rosters {
+		if !exclude.IsZero() && roster.ID == exclude {
+			continue
+		}
+
+		for _, shift := range roster.Shifts {
+			if shift.To.Before(from) || shift.From.After(to) {
+				continue
+			}
+
+			for _, user := range shift.AssignedUserIds {
+				result[user] = append(result[user], shift)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// otherShifts returns all shifts from planned except the one with the given
+// work-shift ID starting at from.
+func otherShifts(planned []structs.PlannedShift, workShiftID primitive.ObjectID, from time.Time) []structs.PlannedShift {
+	result := make([]structs.PlannedShift, 0, len(planned))
+	for _, p := range planned {
+		if p.WorkShiftID == workShiftID && p.From.Equal(from) {
+			continue
+		}
+
+		result = append(result, p)
+	}
+
+	return result
+}
+
+// evaluateConstraints evaluates all constraints from list that apply to the
+// user of in and returns the resulting violations, the sum of all penalties and
+// whether or not the user must not be assigned. Roster-only constraints are
+// skipped unless includeRosterOnly is set.
+func evaluateConstraints(evaluator *constraints.Evaluator, list []structs.Constraint, in constraints.Input, roleIds []string, includeRosterOnly bool, onError func(structs.Constraint, error)) ([]*rosterv1.ConstraintViolation, int, bool) {
+	var (
+		violations []*rosterv1.ConstraintViolation
+		penalty    int
+		blocked    bool
+	)
+
+	for _, c := range list {
+		if c.RosterOnly && !includeRosterOnly {
+			continue
+		}
+
+		if !constraints.AppliesTo(c, in.UserID, roleIds) {
+			continue
+		}
+
+		violated, err := evaluator.Evaluate(c, in)
+		if err != nil {
+			onError(c, err)
+			continue
+		}
+
+		if !violated {
+			continue
+		}
+
+		if constraints.IsBlocking(c) {
+			blocked = true
+		}
+
+		penalty += c.Penalty
+		violations = append(violations, constraints.Violation(c))
+	}
+
+	return violations, penalty, blocked
+}
+
+func profileRoleIds(profile *idmv1.Profile) []string {
+	roleIds := make([]string, len(profile.Roles))
+	for idx, role := range profile.Roles {
+		roleIds[idx] = role.Id
+	}
+
+	return roleIds
+}
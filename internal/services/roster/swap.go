@@ -0,0 +1,571 @@
+package roster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	idmv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/idm/v1"
+	"github.com/tierklinik-dobersberg/apis/pkg/auth"
+	"github.com/tierklinik-dobersberg/apis/pkg/log"
+	rosterdv1 "github.com/tierklinik-dobersberg/rosterd/gen/go/rosterd/v1"
+	"github.com/tierklinik-dobersberg/rosterd/gen/go/rosterd/v1/rosterdv1connect"
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"golang.org/x/exp/slices"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var _ rosterdv1connect.ShiftSwapServiceHandler = (*RosterService)(nil)
+
+func (svc *RosterService) CreateShiftSwap(ctx context.Context, req *connect.Request[rosterdv1.CreateShiftSwapRequest]) (*connect.Response[rosterdv1.CreateShiftSwapResponse], error) {
+	remoteUser := auth.From(ctx)
+	if remoteUser == nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, nil)
+	}
+
+	rosterID, err := primitive.ObjectIDFromHex(req.Msg.RosterId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid roster id: %w", err))
+	}
+
+	shift, err := shiftRefFromProto(req.Msg.Shift)
+	if err != nil {
+		return nil, err
+	}
+
+	swap := structs.ShiftSwap{
+		RosterID:     rosterID,
+		Shift:        shift,
+		RequestorID:  remoteUser.ID,
+		TargetUserID: req.Msg.TargetUserId,
+		State:        structs.ShiftSwapStateOffered,
+		Comment:      req.Msg.Comment,
+		CreatedAt:    time.Now(),
+	}
+
+	if req.Msg.ExchangeShift != nil {
+		if swap.TargetUserID == "" {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("target_user_id is required for exchanges"))
+		}
+
+		exchange, err := shiftRefFromProto(req.Msg.ExchangeShift)
+		if err != nil {
+			return nil, err
+		}
+
+		swap.ExchangeShift = &exchange
+	}
+
+	if swap.TargetUserID == remoteUser.ID {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("cannot swap a shift with yourself"))
+	}
+
+	roster, err := svc.loadApprovedRoster(ctx, swap.RosterID)
+	if err != nil {
+		return nil, err
+	}
+	swap.RosterID = roster.ID
+
+	now := time.Now()
+	if !swap.Shift.From.After(now) || (swap.ExchangeShift != nil && !swap.ExchangeShift.From.After(now)) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("shifts that already started cannot be swapped"))
+	}
+
+	// open offers can only be checked once a colleague accepts them.
+	if swap.TargetUserID != "" {
+		if _, err := svc.checkShiftSwap(ctx, roster, swap); err != nil {
+			return nil, err
+		}
+	} else if _, err := applyShiftSwap(roster, swap); err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+
+	if err := svc.Datastore.CreateShiftSwap(ctx, &swap); err != nil {
+		return nil, fmt.Errorf("failed to create shift swap: %w", err)
+	}
+
+	svc.Audit.Record(ctx, structs.AuditEntityShiftSwap, swap.ID.Hex(), structs.AuditOperationCreate, nil, swap, swap.Participants()...)
+
+	if swap.TargetUserID != "" {
+		svc.sendSwapNotification(ctx, remoteUser.ID, swap, "{{ .Sender | displayName }} möchte den Dienst {{ .Shift }} am {{ .Date }} mit dir tauschen", swap.TargetUserID)
+	}
+
+	return connect.NewResponse(&rosterdv1.CreateShiftSwapResponse{
+		Swap: shiftSwapToProto(swap),
+	}), nil
+}
+
+func (svc *RosterService) ListShiftSwaps(ctx context.Context, req *connect.Request[rosterdv1.ListShiftSwapsRequest]) (*connect.Response[rosterdv1.ListShiftSwapsResponse], error) {
+	remoteUser := auth.From(ctx)
+	if remoteUser == nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, nil)
+	}
+
+	filter := structs.ShiftSwapFilter{
+		RosterID: req.Msg.RosterId,
+		UserID:   req.Msg.UserId,
+	}
+
+	if !remoteUser.Admin {
+		filter.UserID = remoteUser.ID
+		filter.IncludeOpenOffers = true
+	}
+
+	for _, state := range req.Msg.States {
+		filter.States = append(filter.States, shiftSwapStateFromProto(state))
+	}
+
+	swaps, err := svc.Datastore.FindShiftSwaps(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find shift swaps: %w", err)
+	}
+
+	response := &rosterdv1.ListShiftSwapsResponse{
+		Swaps: make([]*rosterdv1.ShiftSwap, len(swaps)),
+	}
+
+	for idx, swap := range swaps {
+		response.Swaps[idx] = shiftSwapToProto(swap)
+	}
+
+	return connect.NewResponse(response), nil
+}
+
+func (svc *RosterService) AcceptShiftSwap(ctx context.Context, req *connect.Request[rosterdv1.AcceptShiftSwapRequest]) (*connect.Response[rosterdv1.AcceptShiftSwapResponse], error) {
+	remoteUser := auth.From(ctx)
+	if remoteUser == nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, nil)
+	}
+
+	swap, err := svc.loadShiftSwap(ctx, req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	before := *swap
+
+	if swap.State != structs.ShiftSwapStateOffered {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("shift swap is %s", swap.State))
+	}
+
+	switch {
+	case swap.RequestorID == remoteUser.ID:
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("cannot accept your own shift swap"))
+	case swap.TargetUserID != "" && swap.TargetUserID != remoteUser.ID:
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("shift swap has been proposed to a different user"))
+	}
+
+	swap.TargetUserID = remoteUser.ID
+
+	roster, err := svc.loadApprovedRoster(ctx, swap.RosterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := svc.checkShiftSwap(ctx, roster, *swap); err != nil {
+		return nil, err
+	}
+
+	swap.RosterID = roster.ID
+	swap.State = structs.ShiftSwapStateAccepted
+	swap.AcceptedAt = time.Now()
+
+	if err := svc.updateShiftSwap(ctx, swap, structs.ShiftSwapStateOffered); err != nil {
+		return nil, err
+	}
+
+	svc.Audit.Record(ctx, structs.AuditEntityShiftSwap, swap.ID.Hex(), structs.AuditOperationUpdate, before, *swap, swap.Participants()...)
+
+	svc.sendSwapNotification(ctx, remoteUser.ID, *swap, "{{ .Sender | displayName }} hat deinen Diensttausch für {{ .Shift }} am {{ .Date }} angenommen", swap.RequestorID)
+
+	if managers, err := svc.rosterManagerIds(ctx); err == nil {
+		svc.sendSwapNotification(ctx, remoteUser.ID, *swap, "Ein Diensttausch für {{ .Shift }} am {{ .Date }} wartet auf Freigabe", managers...)
+	} else {
+		log.L(ctx).Error("failed to get roster_manager users", "error", err)
+	}
+
+	return connect.NewResponse(&rosterdv1.AcceptShiftSwapResponse{
+		Swap: shiftSwapToProto(*swap),
+	}), nil
+}
+
+func (svc *RosterService) DeclineShiftSwap(ctx context.Context, req *connect.Request[rosterdv1.DeclineShiftSwapRequest]) (*connect.Response[rosterdv1.DeclineShiftSwapResponse], error) {
+	remoteUser := auth.From(ctx)
+	if remoteUser == nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, nil)
+	}
+
+	swap, err := svc.loadShiftSwap(ctx, req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	before := *swap
+
+	if swap.State != structs.ShiftSwapStateOffered {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("shift swap is %s", swap.State))
+	}
+
+	if swap.TargetUserID != remoteUser.ID {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("only the target user may decline a shift swap"))
+	}
+
+	swap.State = structs.ShiftSwapStateDeclined
+
+	if err := svc.updateShiftSwap(ctx, swap, structs.ShiftSwapStateOffered); err != nil {
+		return nil, err
+	}
+
+	svc.Audit.Record(ctx, structs.AuditEntityShiftSwap, swap.ID.Hex(), structs.AuditOperationUpdate, before, *swap, swap.Participants()...)
+
+	svc.sendSwapNotification(ctx, remoteUser.ID, *swap, "{{ .Sender | displayName }} hat deinen Diensttausch für {{ .Shift }} am {{ .Date }} abgelehnt", swap.RequestorID)
+
+	return connect.NewResponse(&rosterdv1.DeclineShiftSwapResponse{
+		Swap: shiftSwapToProto(*swap),
+	}), nil
+}
+
+func (svc *RosterService) CancelShiftSwap(ctx context.Context, req *connect.Request[rosterdv1.CancelShiftSwapRequest]) (*connect.Response[rosterdv1.CancelShiftSwapResponse], error) {
+	remoteUser := auth.From(ctx)
+	if remoteUser == nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, nil)
+	}
+
+	swap, err := svc.loadShiftSwap(ctx, req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	before := *swap
+
+	if !swap.IsOpen() {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("shift swap is %s", swap.State))
+	}
+
+	if swap.RequestorID != remoteUser.ID && !remoteUser.Admin {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("only the requestor may cancel a shift swap"))
+	}
+
+	swap.State = structs.ShiftSwapStateCancelled
+
+	if err := svc.updateShiftSwap(ctx, swap, before.State); err != nil {
+		return nil, err
+	}
+
+	svc.Audit.Record(ctx, structs.AuditEntityShiftSwap, swap.ID.Hex(), structs.AuditOperationUpdate, before, *swap, swap.Participants()...)
+
+	if swap.TargetUserID != "" {
+		svc.sendSwapNotification(ctx, remoteUser.ID, *swap, "Der Diensttausch für {{ .Shift }} am {{ .Date }} wurde zurückgezogen", swap.TargetUserID)
+	}
+
+	return connect.NewResponse(&rosterdv1.CancelShiftSwapResponse{
+		Swap: shiftSwapToProto(*swap),
+	}), nil
+}
+
+func (svc *RosterService) DecideShiftSwap(ctx context.Context, req *connect.Request[rosterdv1.DecideShiftSwapRequest]) (*connect.Response[rosterdv1.DecideShiftSwapResponse], error) {
+	remoteUser := auth.From(ctx)
+	if remoteUser == nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, nil)
+	}
+
+	swap, err := svc.loadShiftSwap(ctx, req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	before := *swap
+
+	if swap.State != structs.ShiftSwapStateAccepted {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("shift swap is %s", swap.State))
+	}
+
+	swap.DecidedBy = remoteUser.ID
+	swap.DecidedAt = time.Now()
+	swap.DecisionComment = req.Msg.Comment
+
+	response := new(rosterdv1.DecideShiftSwapResponse)
+
+	if !req.Msg.Approve {
+		swap.State = structs.ShiftSwapStateRejected
+
+		if err := svc.updateShiftSwap(ctx, swap, structs.ShiftSwapStateAccepted); err != nil {
+			return nil, err
+		}
+	} else {
+		roster, err := svc.applyApprovedShiftSwap(ctx, swap, remoteUser.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		response.Roster = roster.ToProto()
+	}
+
+	op := structs.AuditOperationReject
+	if req.Msg.Approve {
+		op = structs.AuditOperationApprove
+	}
+	svc.Audit.Record(ctx, structs.AuditEntityShiftSwap, swap.ID.Hex(), op, before, *swap, swap.Participants()...)
+
+	svc.sendSwapNotification(ctx, remoteUser.ID, *swap, "Der Diensttausch für {{ .Shift }} am {{ .Date }} wurde {{ if .Approved }}genehmigt{{ else }}abgelehnt{{ end }}", swap.Participants()...)
+
+	response.Swap = shiftSwapToProto(*swap)
+
+	return connect.NewResponse(response), nil
+}
+
+// applyApprovedShiftSwap marks swap as approved and patches the assigned
+// users of the roster. The approval of the roster is kept and the off-time
+// costs of both participants are re-booked.
+func (svc *RosterService) applyApprovedShiftSwap(ctx context.Context, swap *structs.ShiftSwap, approver string) (structs.DutyRoster, error) {
+	roster, err := svc.loadApprovedRoster(ctx, swap.RosterID)
+	if err != nil {
+		return roster, err
+	}
+
+	patched, err := svc.checkShiftSwap(ctx, roster, *swap)
+	if err != nil {
+		return roster, err
+	}
+
+	// mark the swap as approved first so concurrent decisions or
+	// cancellations fail.
+	swap.RosterID = roster.ID
+	swap.State = structs.ShiftSwapStateApproved
+
+	if err := svc.updateShiftSwap(ctx, swap, structs.ShiftSwapStateAccepted); err != nil {
+		return roster, err
+	}
+
+	if err := svc.savePatchedRoster(ctx, roster, &patched, approver, swap.Participants()); err != nil {
+		swap.State = structs.ShiftSwapStateAccepted
+		if err := svc.Datastore.UpdateShiftSwap(ctx, swap, structs.ShiftSwapStateApproved); err != nil {
+			log.L(ctx).Error("failed to reset shift swap state", "id", swap.ID.Hex(), "error", err)
+		}
+
+		return roster, err
+	}
+
+	return patched, nil
+}
+
+// checkShiftSwap applies swap to roster and validates the result. Swaps that
+// introduce blocking findings for one of the participants are refused.
+func (svc *RosterService) checkShiftSwap(ctx context.Context, roster structs.DutyRoster, swap structs.ShiftSwap) (structs.DutyRoster, error) {
+	patched, err := applyShiftSwap(roster, swap)
+	if err != nil {
+		return patched, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+
+	blocking, err := svc.newPatchFindings(ctx, roster, patched, swap.Participants())
+	if err != nil {
+		return patched, err
+	}
+
+	if len(blocking) == 0 {
+		return patched, nil
+	}
+
+	messages := make([]string, len(blocking))
+	for idx, f := range blocking {
+		messages[idx] = f.Message
+	}
+
+	cerr := connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("shift swap is not allowed: %s", strings.Join(messages, "; ")))
+
+	if detail, err := connect.NewErrorDetail(&rosterdv1.ShiftSwapFindings{
+		Findings: blocking,
+	}); err == nil {
+		cerr.AddDetail(detail)
+	}
+
+	return patched, cerr
+}
+
+// applyShiftSwap returns a copy of roster with the assignments of swap
+// applied. It fails if the assignments changed since the swap was created.
+func applyShiftSwap(roster structs.DutyRoster, swap structs.ShiftSwap) (structs.DutyRoster, error) {
+	roster = cloneRoster(roster)
+
+	if err := reassignShift(roster.Shifts, swap.Shift, swap.RequestorID, swap.TargetUserID); err != nil {
+		return roster, err
+	}
+
+	if swap.ExchangeShift != nil {
+		if err := reassignShift(roster.Shifts, *swap.ExchangeShift, swap.TargetUserID, swap.RequestorID); err != nil {
+			return roster, err
+		}
+	}
+
+	return roster, nil
+}
+
+// reassignShift replaces from with to in the assigned users of the shift
+// referenced by ref. If to is empty the shift is only checked.
+func reassignShift(shifts []structs.PlannedShift, ref structs.ShiftRef, from, to string) error {
+	for idx, shift := range shifts {
+		if !ref.Matches(shift) {
+			continue
+		}
+
+		pos := slices.Index(shift.AssignedUserIds, from)
+		if pos < 0 {
+			return fmt.Errorf("user %s is not assigned to the shift at %s", from, ref.From.Local().Format(time.RFC3339))
+		}
+
+		if to == "" {
+			return nil
+		}
+
+		if slices.Contains(shift.AssignedUserIds, to) {
+			return fmt.Errorf("user %s is already assigned to the shift at %s", to, ref.From.Local().Format(time.RFC3339))
+		}
+
+		shifts[idx].AssignedUserIds[pos] = to
+
+		return nil
+	}
+
+	return fmt.Errorf("roster does not contain a shift with id %s at %s", ref.WorkShiftID.Hex(), ref.From.Local().Format(time.RFC3339))
+}
+
+func (svc *RosterService) loadShiftSwap(ctx context.Context, id string) (*structs.ShiftSwap, error) {
+	swap, err := svc.Datastore.GetShiftSwap(ctx, id)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("shift swap with id %q not found", id))
+		}
+
+		return nil, err
+	}
+
+	return swap, nil
+}
+
+func (svc *RosterService) updateShiftSwap(ctx context.Context, swap *structs.ShiftSwap, expected structs.ShiftSwapState) error {
+	if err := svc.Datastore.UpdateShiftSwap(ctx, swap, expected); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return connect.NewError(connect.CodeAborted, fmt.Errorf("shift swap has been modified concurrently"))
+		}
+
+		return fmt.Errorf("failed to update shift swap: %w", err)
+	}
+
+	return nil
+}
+
+func (svc *RosterService) rosterManagerIds(ctx context.Context) ([]string, error) {
+	res, err := svc.Users.ListUsers(ctx, connect.NewRequest(&idmv1.ListUsersRequest{
+		FilterByRoles: []string{svc.Config.RosterManagerRoleID},
+		FieldMask: &fieldmaskpb.FieldMask{
+			Paths: []string{"users.user.id"},
+		},
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, len(res.Msg.Users))
+	for idx, p := range res.Msg.Users {
+		ids[idx] = p.User.Id
+	}
+
+	return ids, nil
+}
+
+// sendSwapNotification sends a web-push notification about swap to targets.
+// Errors are only logged.
+func (svc *RosterService) sendSwapNotification(ctx context.Context, sender string, swap structs.ShiftSwap, body string, targets ...string) {
+	ctxPb, err := structpb.NewStruct(map[string]any{
+		"Shift":    svc.workShiftName(ctx, swap.Shift.WorkShiftID),
+		"Date":     swap.Shift.From.Local().Format("02.01.2006"),
+		"Approved": swap.State == structs.ShiftSwapStateApproved,
+		"Comment":  swap.DecisionComment,
+	})
+	if err != nil {
+		log.L(ctx).Error("failed to prepare structpb context", "error", err)
+
+		return
+	}
+
+	perUser := make(map[string]*structpb.Struct, len(targets))
+	for _, t := range targets {
+		perUser[t] = ctxPb
+	}
+
+	svc.sendWebPush(ctx, sender, body, perUser)
+}
+
+func shiftRefFromProto(pb *rosterdv1.ShiftReference) (structs.ShiftRef, error) {
+	if pb == nil || pb.From == nil {
+		return structs.ShiftRef{}, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("missing shift reference"))
+	}
+
+	id, err := primitive.ObjectIDFromHex(pb.WorkShiftId)
+	if err != nil {
+		return structs.ShiftRef{}, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid work shift id: %w", err))
+	}
+
+	return structs.ShiftRef{
+		WorkShiftID: id,
+		From:        pb.From.AsTime(),
+	}, nil
+}
+
+func shiftRefToProto(ref structs.ShiftRef) *rosterdv1.ShiftReference {
+	return &rosterdv1.ShiftReference{
+		WorkShiftId: ref.WorkShiftID.Hex(),
+		From:        timestamppb.New(ref.From),
+	}
+}
+
+var shiftSwapStates = map[structs.ShiftSwapState]rosterdv1.ShiftSwapState{
+	structs.ShiftSwapStateOffered:   rosterdv1.ShiftSwapState_SHIFT_SWAP_STATE_OFFERED,
+	structs.ShiftSwapStateAccepted:  rosterdv1.ShiftSwapState_SHIFT_SWAP_STATE_ACCEPTED,
+	structs.ShiftSwapStateApproved:  rosterdv1.ShiftSwapState_SHIFT_SWAP_STATE_APPROVED,
+	structs.ShiftSwapStateRejected:  rosterdv1.ShiftSwapState_SHIFT_SWAP_STATE_REJECTED,
+	structs.ShiftSwapStateDeclined:  rosterdv1.ShiftSwapState_SHIFT_SWAP_STATE_DECLINED,
+	structs.ShiftSwapStateCancelled: rosterdv1.ShiftSwapState_SHIFT_SWAP_STATE_CANCELLED,
+}
+
+func shiftSwapStateFromProto(state rosterdv1.ShiftSwapState) structs.ShiftSwapState {
+	for key, value := range shiftSwapStates {
+		if value == state {
+			return key
+		}
+	}
+
+	return ""
+}
+
+func shiftSwapToProto(swap structs.ShiftSwap) *rosterdv1.ShiftSwap {
+	pb := &rosterdv1.ShiftSwap{
+		Id:              swap.ID.Hex(),
+		RosterId:        swap.RosterID.Hex(),
+		Shift:           shiftRefToProto(swap.Shift),
+		RequestorId:     swap.RequestorID,
+		TargetUserId:    swap.TargetUserID,
+		State:           shiftSwapStates[swap.State],
+		Comment:         swap.Comment,
+		CreatedAt:       timestamppb.New(swap.CreatedAt),
+		DecidedBy:       swap.DecidedBy,
+		DecisionComment: swap.DecisionComment,
+	}
+
+	if swap.ExchangeShift != nil {
+		pb.ExchangeShift = shiftRefToProto(*swap.ExchangeShift)
+	}
+
+	if !swap.AcceptedAt.IsZero() {
+		pb.AcceptedAt = timestamppb.New(swap.AcceptedAt)
+	}
+
+	if !swap.DecidedAt.IsZero() {
+		pb.DecidedAt = timestamppb.New(swap.DecidedAt)
+	}
+
+	return pb
+}
@@ -0,0 +1,87 @@
+package roster
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func Test_applyShiftSwap(t *testing.T) {
+	var (
+		early = primitive.NewObjectID()
+		late  = primitive.NewObjectID()
+		day   = time.Date(2024, time.March, 4, 0, 0, 0, 0, time.Local)
+	)
+
+	roster := structs.DutyRoster{
+		Shifts: []structs.PlannedShift{
+			{WorkShiftID: early, From: day.Add(8 * time.Hour), AssignedUserIds: []string{"alice", "carol"}},
+			{WorkShiftID: late, From: day.Add(14 * time.Hour), AssignedUserIds: []string{"bob"}},
+		},
+	}
+
+	t.Run("hand over", func(t *testing.T) {
+		patched, err := applyShiftSwap(roster, structs.ShiftSwap{
+			Shift:        structs.ShiftRef{WorkShiftID: early, From: day.Add(8 * time.Hour)},
+			RequestorID:  "alice",
+			TargetUserID: "bob",
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"bob", "carol"}, patched.Shifts[0].AssignedUserIds)
+		require.Equal(t, []string{"bob"}, patched.Shifts[1].AssignedUserIds)
+
+		// the original roster must not be modified
+		require.Equal(t, []string{"alice", "carol"}, roster.Shifts[0].AssignedUserIds)
+	})
+
+	t.Run("exchange", func(t *testing.T) {
+		patched, err := applyShiftSwap(roster, structs.ShiftSwap{
+			Shift:         structs.ShiftRef{WorkShiftID: early, From: day.Add(8 * time.Hour)},
+			RequestorID:   "alice",
+			TargetUserID:  "bob",
+			ExchangeShift: &structs.ShiftRef{WorkShiftID: late, From: day.Add(14 * time.Hour)},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"bob", "carol"}, patched.Shifts[0].AssignedUserIds)
+		require.Equal(t, []string{"alice"}, patched.Shifts[1].AssignedUserIds)
+	})
+
+	t.Run("open offer", func(t *testing.T) {
+		patched, err := applyShiftSwap(roster, structs.ShiftSwap{
+			Shift:       structs.ShiftRef{WorkShiftID: early, From: day.Add(8 * time.Hour)},
+			RequestorID: "alice",
+		})
+		require.NoError(t, err)
+		require.Equal(t, roster.Shifts, patched.Shifts)
+	})
+
+	t.Run("requestor not assigned", func(t *testing.T) {
+		_, err := applyShiftSwap(roster, structs.ShiftSwap{
+			Shift:        structs.ShiftRef{WorkShiftID: late, From: day.Add(14 * time.Hour)},
+			RequestorID:  "alice",
+			TargetUserID: "carol",
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("target already assigned", func(t *testing.T) {
+		_, err := applyShiftSwap(roster, structs.ShiftSwap{
+			Shift:        structs.ShiftRef{WorkShiftID: early, From: day.Add(8 * time.Hour)},
+			RequestorID:  "alice",
+			TargetUserID: "carol",
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("unknown shift", func(t *testing.T) {
+		_, err := applyShiftSwap(roster, structs.ShiftSwap{
+			Shift:        structs.ShiftRef{WorkShiftID: early, From: day.Add(9 * time.Hour)},
+			RequestorID:  "alice",
+			TargetUserID: "bob",
+		})
+		require.Error(t, err)
+	})
+}
@@ -17,17 +17,34 @@ func (svc *RosterService) CreateRosterType(ctx context.Context, req *connect.Req
 		OnCallTags: req.Msg.RosterType.OnCallTags,
 	}
 
+	existing, err := svc.Datastore.GetRosterType(ctx, model.UniqueName)
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, err
+	}
+
 	if err := svc.Datastore.SaveRosterType(ctx, model); err != nil {
 		return nil, err
 	}
 
+	// SaveRosterType replaces an existing roster type with the same name.
+	if existing.UniqueName == "" {
+		svc.Audit.Record(ctx, structs.AuditEntityRosterType, model.UniqueName, structs.AuditOperationCreate, nil, model)
+	} else {
+		svc.Audit.Record(ctx, structs.AuditEntityRosterType, model.UniqueName, structs.AuditOperationUpdate, existing, model)
+	}
+
 	return connect.NewResponse(&rosterv1.CreateRosterTypeResponse{
 		RosterType: model.ToProto(),
 	}), nil
 }
 
 func (svc *RosterService) DeleteRosterType(ctx context.Context, req *connect.Request[rosterv1.DeleteRosterTypeRequest]) (*connect.Response[rosterv1.DeleteRosterTypeResponse], error) {
-	if err := svc.Datastore.DeleteRosterType(ctx, req.Msg.UniqueName); err != nil {
+	existing, err := svc.Datastore.GetRosterType(ctx, req.Msg.UniqueName)
+	if err == nil {
+		err = svc.Datastore.DeleteRosterType(ctx, req.Msg.UniqueName)
+	}
+
+	if err != nil {
 		if errors.Is(err, mongo.ErrNoDocuments) {
 			return nil, connect.NewError(connect.CodeNotFound, err)
 		}
@@ -35,6 +52,8 @@ func (svc *RosterService) DeleteRosterType(ctx context.Context, req *connect.Req
 		return nil, err
 	}
 
+	svc.Audit.Record(ctx, structs.AuditEntityRosterType, req.Msg.UniqueName, structs.AuditOperationDelete, existing, nil)
+
 	return connect.NewResponse(&rosterv1.DeleteRosterTypeResponse{}), nil
 }
 
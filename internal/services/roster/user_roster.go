@@ -0,0 +1,299 @@
+package roster
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	idmv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/idm/v1"
+	rosterv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/roster/v1"
+	"github.com/tierklinik-dobersberg/apis/pkg/auth"
+	"github.com/tierklinik-dobersberg/apis/pkg/data"
+	rosterdv1 "github.com/tierklinik-dobersberg/rosterd/gen/go/rosterd/v1"
+	"github.com/tierklinik-dobersberg/rosterd/internal/holiday"
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"github.com/tierklinik-dobersberg/rosterd/internal/timecalc"
+	"github.com/tierklinik-dobersberg/rosterd/templates"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
+func (svc *RosterService) ExportUserRoster(ctx context.Context, req *connect.Request[rosterdv1.ExportUserRosterRequest]) (*connect.Response[rosterv1.ExportRosterResponse], error) {
+	remoteUser := auth.From(ctx)
+	if remoteUser == nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, nil)
+	}
+
+	userId := req.Msg.UserId
+	if userId == "" {
+		userId = remoteUser.ID
+	}
+
+	if userId != remoteUser.ID && !remoteUser.Admin {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("only roster managers may export the roster of other users"))
+	}
+
+	month, err := time.ParseInLocation("2006-01", req.Msg.Month, time.Local)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid month %q: expected YYYY-MM", req.Msg.Month))
+	}
+
+	profiles, err := svc.FetchAllUserProfiles(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch user profiles: %w", err)
+	}
+
+	profile := data.IndexSlice(profiles, func(p *idmv1.Profile) string { return p.User.Id })[userId]
+	if profile == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("no user with id %q found", userId))
+	}
+
+	rc, err := svc.buildUserRoster(ctx, profile, month, month.AddDate(0, 1, -1), nil, nil, false)
+	if err != nil {
+		return nil, err
+	}
+
+	fileName := fmt.Sprintf("%s_%s", month.Format("2006-01"), userDisplayName(userId, profile))
+
+	switch req.Msg.Format {
+	case rosterdv1.UserRosterFormat_USER_ROSTER_FORMAT_HTML:
+		buf, err := templates.RenderUserRosterTemplate(ctx, rc)
+		if err != nil {
+			return nil, err
+		}
+
+		blob, err := io.ReadAll(buf)
+		if err != nil {
+			return nil, err
+		}
+
+		return connect.NewResponse(&rosterv1.ExportRosterResponse{
+			ContentType: "text/html",
+			FileName:    fileName + ".html",
+			Payload:     blob,
+		}), nil
+
+	case rosterdv1.UserRosterFormat_USER_ROSTER_FORMAT_UNSPECIFIED,
+		rosterdv1.UserRosterFormat_USER_ROSTER_FORMAT_PDF:
+		blob, err := svc.renderUserRoster(ctx, rc)
+		if err != nil {
+			return nil, err
+		}
+
+		return connect.NewResponse(&rosterv1.ExportRosterResponse{
+			ContentType: "application/pdf",
+			FileName:    fileName + ".pdf",
+			Payload:     blob,
+		}), nil
+	}
+
+	return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown format %q", req.Msg.Format.String()))
+}
+
+func (svc *RosterService) renderUserRoster(ctx context.Context, rc templates.UserRosterContext) ([]byte, error) {
+	pdf, err := svc.Providers.Renderer.RenderUserRoster(ctx, rc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render PDF: %w", err)
+	}
+	defer pdf.Close()
+
+	content, err := io.ReadAll(pdf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to receive PDF: %w", err)
+	}
+
+	return content, nil
+}
+
+// buildUserRoster loads everything required to render the personal roster of
+// a user between from and to (inclusive). If rosters is nil, all rosters
+// between from and to are used. If workTime is nil, the work time of the
+// user is analyzed.
+func (svc *RosterService) buildUserRoster(ctx context.Context, profile *idmv1.Profile, from, to time.Time, rosters []structs.DutyRoster, workTime *rosterv1.WorkTimeAnalysis, preview bool) (templates.UserRosterContext, error) {
+	userId := profile.GetUser().GetId()
+
+	if rosters == nil {
+		distinct := make(map[string]structs.DutyRoster)
+		for iter := from; !iter.After(to); iter = iter.AddDate(0, 0, 1) {
+			result, err := svc.Datastore.DutyRostersByTime(ctx, iter)
+			if err != nil {
+				return templates.UserRosterContext{}, fmt.Errorf("failed to fetch roster for %s: %w", iter, err)
+			}
+
+			for _, r := range result {
+				distinct[r.ID.Hex()] = r
+			}
+		}
+
+		rosters = maps.Values(distinct)
+	}
+
+	if workTime == nil {
+		result, err := svc.analyzeWorkTime(ctx, "", []string{userId}, from.Format("2006-01-02"), to.Format("2006-01-02"), false)
+		if err != nil {
+			return templates.UserRosterContext{}, fmt.Errorf("failed to analyze work time: %w", err)
+		}
+
+		for _, wt := range result {
+			if wt.UserId == userId {
+				workTime = wt
+			}
+		}
+	}
+
+	definitions, err := svc.Datastore.ListWorkShifts(ctx)
+	if err != nil {
+		return templates.UserRosterContext{}, fmt.Errorf("failed to load work-shift definitions: %w", err)
+	}
+
+	approved := true
+	offTimes, err := svc.Datastore.FindOffTimeRequests(ctx, from, to.AddDate(0, 0, 1), &approved, []string{userId})
+	if err != nil {
+		return templates.UserRosterContext{}, fmt.Errorf("failed to load off-time requests: %w", err)
+	}
+
+	holidays, err := svc.HolidayProvider.For(ctx, from, to)
+	if err != nil {
+		return templates.UserRosterContext{}, err
+	}
+
+	history, err := svc.Datastore.WorkTimeHistoryForStaff(ctx, userId)
+	if err != nil {
+		return templates.UserRosterContext{}, fmt.Errorf("failed to get work-time history: %w", err)
+	}
+
+	costs, err := svc.Datastore.GetOffTimeCosts(ctx, userId)
+	if err != nil {
+		return templates.UserRosterContext{}, fmt.Errorf("failed to get off-time costs: %w", err)
+	}
+
+	return userRosterContext(userRosterData{
+		Profile:     profile,
+		From:        from,
+		To:          to,
+		Rosters:     rosters,
+		Definitions: data.IndexSlice(definitions, func(ws structs.WorkShift) string { return ws.ID.Hex() }),
+		OffTimes:    offTimes,
+		Holidays:    holidays,
+		Weekend:     svc.Config.Weekend,
+		WorkTime:    workTime,
+		History:     history,
+		Balance:     timecalc.CalculateVacationBalance(history, costs, to.AddDate(0, 0, 1)),
+		Preview:     preview,
+	}), nil
+}
+
+// userRosterData holds everything required to build the personal roster of
+// a user.
+type userRosterData struct {
+	Profile     *idmv1.Profile
+	From        time.Time
+	To          time.Time
+	Rosters     []structs.DutyRoster
+	Definitions map[string]structs.WorkShift
+	OffTimes    []structs.OffTimeEntry
+	Holidays    holiday.Holidays
+	Weekend     timecalc.Weekend
+	WorkTime    *rosterv1.WorkTimeAnalysis
+	History     []structs.WorkTime
+	Balance     timecalc.VacationBalance
+	Preview     bool
+}
+
+var germanWeekdays = [...]string{"So", "Mo", "Di", "Mi", "Do", "Fr", "Sa"}
+
+func userRosterContext(d userRosterData) templates.UserRosterContext {
+	userId := d.Profile.GetUser().GetId()
+
+	rc := templates.UserRosterContext{
+		UserName:        userDisplayName(userId, d.Profile),
+		Month:           d.From.Format("01/2006"),
+		Preview:         d.Preview,
+		ExpectedTime:    formatHours(d.WorkTime.GetExpectedTime().AsDuration()),
+		PlannedTime:     formatHours(d.WorkTime.GetPlannedTime().AsDuration()),
+		Overtime:        formatHours(d.WorkTime.GetOvertime().AsDuration()),
+		VacationBalance: formatHours(d.Balance.Vacation),
+		TimeOffBalance:  formatHours(d.Balance.TimeOff),
+	}
+
+	// the work-time that applies at the end of the range decides whether
+	// the user is excluded from time tracking.
+	for _, wt := range d.History {
+		if !wt.ApplicableFrom.After(d.To) {
+			rc.ExcludeFromTimeTracking = wt.ExcludeFromTimeTracking
+		}
+	}
+
+	var shifts []structs.PlannedShift
+	for _, roster := range d.Rosters {
+		for _, shift := range roster.Shifts {
+			if slices.Contains(shift.AssignedUserIds, userId) {
+				shifts = append(shifts, shift)
+			}
+		}
+	}
+	shifts = sortedShifts(shifts)
+
+	for iter := d.From; !iter.After(d.To); iter = iter.AddDate(0, 0, 1) {
+		key := iter.Format("2006-01-02")
+
+		day := templates.UserRosterDay{
+			Date:    iter.Format("02.01.2006"),
+			Weekday: germanWeekdays[iter.Weekday()],
+			Weekend: d.Weekend.Contains(iter.Weekday()),
+		}
+
+		if hd, ok := d.Holidays.Get(iter); ok && (!hd.WorkDay || hd.Local != nil) {
+			day.Holiday = hd.PublicHoliday
+		}
+
+		for _, shift := range shifts {
+			if shift.From.Local().Format("2006-01-02") != key {
+				continue
+			}
+
+			def := d.Definitions[shift.WorkShiftID.Hex()]
+			day.Shifts = append(day.Shifts, templates.UserRosterShift{
+				Name:  def.Name,
+				From:  shift.From.Local().Format("15:04"),
+				To:    shift.To.Local().Format("15:04"),
+				Color: def.Color,
+			})
+		}
+
+		dayEnd := iter.AddDate(0, 0, 1)
+		for _, entry := range d.OffTimes {
+			if entry.RequestorId != userId || entry.Approval == nil || !entry.Approval.Approved {
+				continue
+			}
+
+			if !entry.From.Before(dayEnd) || !entry.To.After(iter) {
+				continue
+			}
+
+			day.OffTime = offTimeEventName(entry.RequestType)
+			if entry.Description != "" {
+				day.OffTime += ": " + entry.Description
+			}
+		}
+
+		rc.Days = append(rc.Days, day)
+	}
+
+	return rc
+}
+
+// formatHours formats d as hours and minutes, e.g. "-12:30 h".
+func formatHours(d time.Duration) string {
+	sign := ""
+	if d < 0 {
+		sign = "-"
+		d = -d
+	}
+
+	d = d.Round(time.Minute)
+
+	return fmt.Sprintf("%s%d:%02d h", sign, int(d.Hours()), int(d.Minutes())%60)
+}
@@ -0,0 +1,105 @@
+package roster
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	calendarv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/calendar/v1"
+	idmv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/idm/v1"
+	rosterv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/roster/v1"
+	"github.com/tierklinik-dobersberg/rosterd/internal/holiday"
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"github.com/tierklinik-dobersberg/rosterd/internal/timecalc"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func Test_userRosterContext(t *testing.T) {
+	var (
+		early = structs.WorkShift{ID: primitive.NewObjectID(), Name: "Früh", Color: "#ff0000"}
+		late  = structs.WorkShift{ID: primitive.NewObjectID(), Name: "Spät", Color: "#00ff00"}
+		at    = func(day, hour int) time.Time {
+			return time.Date(2024, time.May, day, hour, 0, 0, 0, time.Local)
+		}
+	)
+
+	rc := userRosterContext(userRosterData{
+		Profile: &idmv1.Profile{User: &idmv1.User{Id: "alice", DisplayName: "Alice"}},
+		From:    at(1, 0),
+		To:      at(31, 0),
+		Rosters: []structs.DutyRoster{
+			{
+				Shifts: []structs.PlannedShift{
+					{WorkShiftID: late.ID, From: at(2, 14), To: at(2, 20), AssignedUserIds: []string{"alice"}},
+					{WorkShiftID: early.ID, From: at(2, 8), To: at(2, 14), AssignedUserIds: []string{"alice", "bob"}},
+					{WorkShiftID: early.ID, From: at(3, 8), To: at(3, 14), AssignedUserIds: []string{"bob"}},
+				},
+			},
+		},
+		Definitions: map[string]structs.WorkShift{
+			early.ID.Hex(): early,
+			late.ID.Hex():  late,
+		},
+		OffTimes: []structs.OffTimeEntry{
+			{RequestorId: "alice", RequestType: structs.RequestTypeVacation, Description: "Urlaub am Meer", From: at(6, 0), To: at(8, 0), Approval: &structs.Approval{Approved: true}},
+			{RequestorId: "alice", RequestType: structs.RequestTypeTimeOff, From: at(10, 0), To: at(11, 0), Approval: &structs.Approval{Approved: false}},
+		},
+		Holidays: holiday.FromPublicHolidays(map[string]*calendarv1.PublicHoliday{
+			"2024-05-01": {Date: "2024-05-01", LocalName: "Staatsfeiertag", Type: calendarv1.HolidayType_PUBLIC},
+		}),
+		Weekend: timecalc.DefaultWeekend,
+		WorkTime: &rosterv1.WorkTimeAnalysis{
+			ExpectedTime: durationpb.New(160 * time.Hour),
+			PlannedTime:  durationpb.New(12 * time.Hour),
+			Overtime:     durationpb.New(-148 * time.Hour),
+		},
+		History: []structs.WorkTime{
+			{ApplicableFrom: at(1, 0).AddDate(-1, 0, 0), ExcludeFromTimeTracking: true},
+			{ApplicableFrom: at(15, 0)},
+		},
+		Balance: timecalc.VacationBalance{Vacation: 100*time.Hour + 30*time.Minute, TimeOff: 4 * time.Hour},
+	})
+
+	require.Equal(t, "Alice", rc.UserName)
+	require.Equal(t, "05/2024", rc.Month)
+	require.Len(t, rc.Days, 31)
+	require.False(t, rc.ExcludeFromTimeTracking)
+
+	require.Equal(t, "160:00 h", rc.ExpectedTime)
+	require.Equal(t, "12:00 h", rc.PlannedTime)
+	require.Equal(t, "-148:00 h", rc.Overtime)
+	require.Equal(t, "100:30 h", rc.VacationBalance)
+	require.Equal(t, "4:00 h", rc.TimeOffBalance)
+
+	// public holiday
+	require.Equal(t, "Mi", rc.Days[0].Weekday)
+	require.Equal(t, "Staatsfeiertag", rc.Days[0].Holiday.LocalName)
+
+	// shifts are sorted by their start time
+	require.Len(t, rc.Days[1].Shifts, 2)
+	require.Equal(t, "Früh", rc.Days[1].Shifts[0].Name)
+	require.Equal(t, "08:00", rc.Days[1].Shifts[0].From)
+	require.Equal(t, "Spät", rc.Days[1].Shifts[1].Name)
+
+	// not assigned
+	require.Empty(t, rc.Days[2].Shifts)
+
+	// weekend
+	require.True(t, rc.Days[3].Weekend)
+
+	// approved off-time, the last day ends at midnight
+	require.Equal(t, "Urlaub: Urlaub am Meer", rc.Days[5].OffTime)
+	require.Equal(t, "Urlaub: Urlaub am Meer", rc.Days[6].OffTime)
+	require.Empty(t, rc.Days[7].OffTime)
+
+	// not approved
+	require.Empty(t, rc.Days[9].OffTime)
+}
+
+func Test_formatHours(t *testing.T) {
+	require.Equal(t, "0:00 h", formatHours(0))
+	require.Equal(t, "1:30 h", formatHours(90*time.Minute))
+	require.Equal(t, "-2:15 h", formatHours(-135*time.Minute))
+	require.Equal(t, "0:01 h", formatHours(59*time.Second))
+}
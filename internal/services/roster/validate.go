@@ -0,0 +1,402 @@
+package roster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	idmv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/idm/v1"
+	rosterv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/roster/v1"
+	"github.com/tierklinik-dobersberg/apis/pkg/auth"
+	"github.com/tierklinik-dobersberg/apis/pkg/data"
+	"github.com/tierklinik-dobersberg/apis/pkg/log"
+	rosterdv1 "github.com/tierklinik-dobersberg/rosterd/gen/go/rosterd/v1"
+	"github.com/tierklinik-dobersberg/rosterd/internal/constraints"
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (svc *RosterService) ValidateRoster(ctx context.Context, req *connect.Request[rosterdv1.ValidateRosterRequest]) (*connect.Response[rosterdv1.ValidateRosterResponse], error) {
+	var roster structs.DutyRoster
+
+	switch v := req.Msg.Roster.(type) {
+	case *rosterdv1.ValidateRosterRequest_RosterId:
+		var err error
+		roster, err = svc.loadRoster(ctx, v.RosterId)
+		if err != nil {
+			return nil, err
+		}
+
+	case *rosterdv1.ValidateRosterRequest_Unsaved:
+		var err error
+		roster, err = svc.rosterFromProto(ctx, v.Unsaved)
+		if err != nil {
+			return nil, err
+		}
+
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("either roster_id or unsaved must be set"))
+	}
+
+	findings, err := svc.validateRoster(ctx, roster)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&rosterdv1.ValidateRosterResponse{
+		Findings:          findings,
+		HasBlockingErrors: countBlockingFindings(findings) > 0,
+	}), nil
+}
+
+func (svc *RosterService) ValidateAndApproveRoster(ctx context.Context, req *connect.Request[rosterdv1.ValidateAndApproveRosterRequest]) (*connect.Response[rosterdv1.ValidateAndApproveRosterResponse], error) {
+	remoteUser := auth.From(ctx)
+	if remoteUser == nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, nil)
+	}
+
+	if req.Msg.Approval == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("missing approval"))
+	}
+
+	roster, err := svc.loadRoster(ctx, req.Msg.Approval.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	findings, err := svc.validateRoster(ctx, roster)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &rosterdv1.ValidateAndApproveRosterResponse{
+		Findings: findings,
+	}
+
+	if count := countBlockingFindings(findings); count > 0 {
+		if !req.Msg.Force {
+			return connect.NewResponse(response), nil
+		}
+
+		log.L(ctx).Info("approving roster with blocking errors", "id", roster.ID.Hex(), "errors", count, "approver", remoteUser.ID)
+	}
+
+	if err := svc.approveRoster(ctx, roster, remoteUser.ID, req.Msg.Approval); err != nil {
+		return nil, err
+	}
+
+	response.Approved = true
+
+	return connect.NewResponse(response), nil
+}
+
+func (svc *RosterService) loadRoster(ctx context.Context, id string) (structs.DutyRoster, error) {
+	roster, err := svc.Datastore.DutyRosterByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return roster, connect.NewError(connect.CodeNotFound, fmt.Errorf("failed to find roster with id %q", id))
+		}
+
+		return roster, err
+	}
+
+	return roster, nil
+}
+
+// rosterFromProto converts an unsaved roster to it's local model. The
+// time-worth of each shift is calculated from the work-shift definition.
+func (svc *RosterService) rosterFromProto(ctx context.Context, protoRoster *rosterv1.Roster) (structs.DutyRoster, error) {
+	roster := structs.DutyRoster{
+		From:           protoRoster.From,
+		To:             protoRoster.To,
+		RosterTypeName: protoRoster.RosterTypeName,
+		Shifts:         make([]structs.PlannedShift, len(protoRoster.Shifts)),
+	}
+
+	if _, err := time.ParseInLocation("2006-01-02", roster.From, time.Local); err != nil {
+		return roster, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid from value: %w", err))
+	}
+
+	if _, err := time.ParseInLocation("2006-01-02", roster.To, time.Local); err != nil {
+		return roster, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid to value: %w", err))
+	}
+
+	// an unsaved roster might be a modification of an existing one.
+	if protoRoster.Id != "" {
+		var err error
+		roster.ID, err = primitive.ObjectIDFromHex(protoRoster.Id)
+		if err != nil {
+			return roster, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid roster id: %w", err))
+		}
+	}
+
+	definitions, err := svc.Datastore.ListWorkShifts(ctx)
+	if err != nil {
+		return roster, fmt.Errorf("failed to load workshift definitions: %w", err)
+	}
+	definitionsByID := data.IndexSlice(definitions, func(e structs.WorkShift) string { return e.ID.Hex() })
+
+	for idx, shift := range protoRoster.Shifts {
+		var conv structs.PlannedShift
+
+		if err := conv.FromProto(shift); err != nil {
+			return roster, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid shift definition: %w", err))
+		}
+
+		def, ok := definitionsByID[shift.WorkShiftId]
+		if !ok {
+			return roster, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("work shift with id %q does not exist", shift.WorkShiftId))
+		}
+
+		conv.TimeWorth = conv.To.Sub(conv.From)
+		if def.MinutesWorth != nil {
+			conv.TimeWorth = time.Duration(*def.MinutesWorth) * time.Minute
+		}
+
+		roster.Shifts[idx] = conv
+	}
+
+	return roster, nil
+}
+
+// validateRoster checks roster for understaffed shifts and for assignments
+// that violate off-time requests, work-times, eligible roles, constraints or
+// labour rules. Findings with FINDING_SEVERITY_ERROR should block approval.
+func (svc *RosterService) validateRoster(ctx context.Context, roster structs.DutyRoster) ([]*rosterdv1.RosterFinding, error) {
+	from, to := roster.FromTime(), roster.ToTime()
+
+	tags, err := svc.rosterShiftTags(ctx, roster)
+	if err != nil {
+		return nil, err
+	}
+
+	var profiles []*idmv1.Profile
+
+	required, _, _, _, err := svc.getRequiredShifts(ctx, from, to, &profiles, tags)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get required shifts: %w", err)
+	}
+
+	profilesByID := data.IndexSlice(profiles, func(p *idmv1.Profile) string { return p.User.Id })
+
+	constraintList, err := svc.Datastore.FindConstraints(ctx, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load constraints: %w", err)
+	}
+
+	definitions, err := svc.Datastore.ListWorkShifts(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load workshift definitions: %w", err)
+	}
+	definitionsByID := data.IndexSlice(definitions, func(e structs.WorkShift) string { return e.ID.Hex() })
+
+	holidays, err := svc.HolidayProvider.For(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	// shifts planned in other rosters are required to evaluate constraints
+	// and labour rules that look at the surrounding days and weeks.
+	labourRules := svc.Config.LabourRules()
+
+	plannedFrom, plannedTo := labourRules.Range(from, to)
+	plannedByUser, err := svc.loadPlannedShiftsByUser(ctx, plannedFrom, plannedTo, roster.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var findings []*rosterdv1.RosterFinding
+
+	// check for understaffed shifts
+	assigned := make(map[string]int, len(roster.Shifts))
+	for _, shift := range roster.Shifts {
+		assigned[plannedShiftKey(shift.WorkShiftID.Hex(), shift.From)] += len(shift.AssignedUserIds)
+	}
+
+	for _, shift := range required {
+		def := definitionsByID[shift.WorkShiftID.Hex()]
+
+		count := assigned[plannedShiftKey(shift.WorkShiftID.Hex(), shift.From)]
+		if count >= def.RequiredStaffCount {
+			continue
+		}
+
+		findings = append(findings, &rosterdv1.RosterFinding{
+			Severity:    rosterdv1.FindingSeverity_FINDING_SEVERITY_ERROR,
+			Kind:        rosterdv1.FindingKind_FINDING_KIND_UNDERSTAFFED,
+			Message:     fmt.Sprintf("%s on %s has %d of %d required users assigned", def.Name, shift.From.Format("2006-01-02"), count, def.RequiredStaffCount),
+			WorkShiftId: shift.WorkShiftID.Hex(),
+			From:        timestamppb.New(shift.From),
+			To:          timestamppb.New(shift.To),
+		})
+	}
+
+	// check each assignment
+	var (
+		shiftsByUser = make(map[string][]structs.PlannedShift)
+		workTimes    = make(map[string]map[string]structs.WorkTime)
+	)
+
+	for _, shift := range roster.Shifts {
+		def := definitionsByID[shift.WorkShiftID.Hex()]
+		day := shift.From.Format("2006-01-02")
+
+		currentWorkTimes, ok := workTimes[day]
+		if !ok {
+			currentWorkTimes, err = svc.Datastore.GetCurrentWorkTimes(ctx, shift.From)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get current work-times: %w", err)
+			}
+
+			workTimes[day] = currentWorkTimes
+		}
+
+		for _, user := range shift.AssignedUserIds {
+			shiftsByUser[user] = append(shiftsByUser[user], shift)
+
+			profile, ok := profilesByID[user]
+			if !ok || !data.ElemInBothSlicesFunc(def.EligibleRoles, profile.Roles, func(role *idmv1.Role) string { return role.Id }) {
+				findings = append(findings, newShiftFinding(rosterdv1.FindingSeverity_FINDING_SEVERITY_ERROR, rosterdv1.FindingKind_FINDING_KIND_NO_ELIGIBLE_ROLE, user, shift,
+					fmt.Sprintf("user %s is not eligible for %s on %s", user, def.Name, day)))
+			}
+
+			approved := true
+			offTimeRequests, err := svc.Datastore.FindOffTimeRequests(ctx, shift.From, shift.To, &approved, []string{user})
+			if err != nil {
+				return nil, fmt.Errorf("failed to load approved off-time requests for user %s: %w", user, err)
+			}
+
+			for _, offReq := range offTimeRequests {
+				finding := newShiftFinding(rosterdv1.FindingSeverity_FINDING_SEVERITY_ERROR, rosterdv1.FindingKind_FINDING_KIND_OFF_TIME, user, shift,
+					fmt.Sprintf("user %s has approved off-time during %s on %s", user, def.Name, day))
+
+				finding.Violation = &rosterv1.ConstraintViolation{
+					Hard: true,
+					Kind: &rosterv1.ConstraintViolation_OffTime{
+						OffTime: &rosterv1.OffTimeViolation{
+							Entry: offReq.ToProto(),
+						},
+					},
+				}
+
+				findings = append(findings, finding)
+			}
+
+			wt, ok := currentWorkTimes[user]
+			if !ok || (!wt.EndsWith.IsZero() && wt.EndsWith.Before(shift.From)) {
+				findings = append(findings, newShiftFinding(rosterdv1.FindingSeverity_FINDING_SEVERITY_ERROR, rosterdv1.FindingKind_FINDING_KIND_NO_WORK_TIME, user, shift,
+					fmt.Sprintf("user %s does not have a work-time on %s", user, day)))
+			}
+		}
+	}
+
+	// check overlapping shifts and constraints per user
+	userIds := make([]string, 0, len(shiftsByUser))
+	for user := range shiftsByUser {
+		userIds = append(userIds, user)
+	}
+	sort.Strings(userIds)
+
+	for _, user := range userIds {
+		shifts := shiftsByUser[user]
+		sort.SliceStable(shifts, func(i, j int) bool {
+			return shifts[i].From.Before(shifts[j].From)
+		})
+
+		for i := range shifts {
+			for j := i + 1; j < len(shifts) && shifts[j].From.Before(shifts[i].To); j++ {
+				findings = append(findings, newShiftFinding(rosterdv1.FindingSeverity_FINDING_SEVERITY_ERROR, rosterdv1.FindingKind_FINDING_KIND_OVERLAPPING_SHIFTS, user, shifts[j],
+					fmt.Sprintf("user %s is assigned to overlapping shifts %s and %s", user, definitionsByID[shifts[i].WorkShiftID.Hex()].Name, definitionsByID[shifts[j].WorkShiftID.Hex()].Name)))
+			}
+		}
+
+		var (
+			roleIds []string
+			planned = append(append([]structs.PlannedShift{}, plannedByUser[user]...), shifts...)
+		)
+
+		if profile, ok := profilesByID[user]; ok {
+			roleIds = profileRoleIds(profile)
+		}
+
+		for _, shift := range shifts {
+			def := definitionsByID[shift.WorkShiftID.Hex()]
+			day := shift.From.Format("2006-01-02")
+			isHoliday := holidays.HolidayShifts(shift.From)
+
+			var currentWorkTime *structs.WorkTime
+			if wt, ok := workTimes[day][user]; ok {
+				currentWorkTime = &wt
+			}
+
+			input := constraints.Input{
+				Shift:       def,
+				From:        shift.From,
+				To:          shift.To,
+				Holiday:     isHoliday,
+				Weekend:     svc.Config.Weekend.Contains(shift.From.Weekday()),
+				UserID:      user,
+				WorkTime:    currentWorkTime,
+				Planned:     otherShifts(planned, shift.WorkShiftID, shift.From),
+				Definitions: definitionsByID,
+			}
+
+			violations, _, _ := evaluateConstraints(svc.Constraints, constraintList, input, roleIds, true, func(c structs.Constraint, err error) {
+				log.L(ctx).Error("failed to evaluate constraint", "id", c.ID.Hex(), "error", err)
+			})
+
+			for _, violation := range violations {
+				severity := rosterdv1.FindingSeverity_FINDING_SEVERITY_WARNING
+				if violation.Hard {
+					severity = rosterdv1.FindingSeverity_FINDING_SEVERITY_ERROR
+				}
+
+				finding := newShiftFinding(severity, rosterdv1.FindingKind_FINDING_KIND_HARD_CONSTRAINT, user, shift,
+					fmt.Sprintf("user %s violates constraint %q on %s", user, violation.GetEvaluation().GetDescription(), day))
+				finding.Violation = violation
+
+				findings = append(findings, finding)
+			}
+
+			for _, v := range labourRules.Check(shift, input.Planned) {
+				finding := newShiftFinding(rosterdv1.FindingSeverity_FINDING_SEVERITY_ERROR, rosterdv1.FindingKind_FINDING_KIND_LABOUR_RULE, user, shift,
+					fmt.Sprintf("user %s: %s", user, v.Message))
+				finding.Violation = v.ToProto()
+
+				findings = append(findings, finding)
+			}
+		}
+	}
+
+	return findings, nil
+}
+
+func newShiftFinding(severity rosterdv1.FindingSeverity, kind rosterdv1.FindingKind, userId string, shift structs.PlannedShift, msg string) *rosterdv1.RosterFinding {
+	return &rosterdv1.RosterFinding{
+		Severity:    severity,
+		Kind:        kind,
+		Message:     msg,
+		UserId:      userId,
+		WorkShiftId: shift.WorkShiftID.Hex(),
+		From:        timestamppb.New(shift.From),
+		To:          timestamppb.New(shift.To),
+	}
+}
+
+// countBlockingFindings returns the number of findings that block approval
+// of a roster.
+func countBlockingFindings(findings []*rosterdv1.RosterFinding) int {
+	count := 0
+	for _, f := range findings {
+		if f.Severity == rosterdv1.FindingSeverity_FINDING_SEVERITY_ERROR {
+			count++
+		}
+	}
+
+	return count
+}
@@ -0,0 +1,421 @@
+package roster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	rosterv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/roster/v1"
+	"github.com/tierklinik-dobersberg/apis/pkg/auth"
+	rosterdv1 "github.com/tierklinik-dobersberg/rosterd/gen/go/rosterd/v1"
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (svc *RosterService) ListRosterVersions(ctx context.Context, req *connect.Request[rosterdv1.ListRosterVersionsRequest]) (*connect.Response[rosterdv1.ListRosterVersionsResponse], error) {
+	roster, err := svc.loadRoster(ctx, req.Msg.RosterId)
+	if err != nil {
+		return nil, err
+	}
+
+	versions, err := svc.rosterVersions(ctx, roster)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &rosterdv1.ListRosterVersionsResponse{
+		Versions: make([]*rosterdv1.RosterVersion, len(versions)),
+	}
+
+	for idx, v := range versions {
+		response.Versions[idx] = rosterVersionToProto(v, idx, len(versions))
+	}
+
+	return connect.NewResponse(response), nil
+}
+
+func (svc *RosterService) GetRosterVersion(ctx context.Context, req *connect.Request[rosterdv1.GetRosterVersionRequest]) (*connect.Response[rosterdv1.GetRosterVersionResponse], error) {
+	versions, idx, err := svc.findRosterVersion(ctx, req.Msg.Ref)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&rosterdv1.GetRosterVersionResponse{
+		Version: rosterVersionToProto(versions[idx], idx, len(versions)),
+		Roster:  versions[idx].Roster.ToProto(),
+	}), nil
+}
+
+func (svc *RosterService) DiffRosters(ctx context.Context, req *connect.Request[rosterdv1.DiffRostersRequest]) (*connect.Response[rosterdv1.DiffRostersResponse], error) {
+	fromVersions, fromIdx, err := svc.findRosterVersion(ctx, req.Msg.From)
+	if err != nil {
+		return nil, err
+	}
+
+	toVersions, toIdx, err := svc.findRosterVersion(ctx, req.Msg.To)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := compareRosters(fromVersions[fromIdx].Roster, toVersions[toIdx].Roster)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	return connect.NewResponse(rosterChangesToProto(changes)), nil
+}
+
+// DiffRosterVersions returns the assignment changes per user between two
+// roster versions.
+//
+// Deprecated: DiffRosterVersions is a wrapper around DiffRosters that is
+// kept for existing clients.
+func (svc *RosterService) DiffRosterVersions(ctx context.Context, req *connect.Request[rosterdv1.DiffRosterVersionsRequest]) (*connect.Response[rosterdv1.DiffRosterVersionsResponse], error) {
+	res, err := svc.DiffRosters(ctx, connect.NewRequest(&rosterdv1.DiffRostersRequest{
+		From: req.Msg.From,
+		To:   req.Msg.To,
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&rosterdv1.DiffRosterVersionsResponse{
+		Users: userDiffsToProto(res.Msg.Users),
+	}), nil
+}
+
+func (svc *RosterService) RestoreRosterVersion(ctx context.Context, req *connect.Request[rosterdv1.RestoreRosterVersionRequest]) (*connect.Response[rosterdv1.RestoreRosterVersionResponse], error) {
+	remoteUser := auth.From(ctx)
+	if remoteUser == nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, nil)
+	}
+
+	versions, idx, err := svc.findRosterVersion(ctx, req.Msg.Ref)
+	if err != nil {
+		return nil, err
+	}
+
+	if idx == len(versions)-1 {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("the requested version is already the current version of the roster"))
+	}
+
+	// the last version is always the current state of the latest roster
+	// document.
+	current := versions[len(versions)-1].Roster
+	before := cloneRoster(current)
+
+	roster := cloneRoster(current)
+	roster.Shifts = cloneRoster(versions[idx].Roster).Shifts
+	roster.LastModifiedBy = remoteUser.ID
+	roster.UpdatedAt = time.Now()
+
+	casIndex := &current.CASIndex
+	if roster.IsApproved() {
+		if err := svc.supersedeApprovedRoster(ctx, &roster); err != nil {
+			return nil, err
+		}
+
+		casIndex = nil
+	}
+
+	if _, err := svc.Datastore.SaveDutyRoster(ctx, &roster, casIndex); err != nil {
+		return nil, err
+	}
+
+	svc.Audit.Record(ctx, structs.AuditEntityRoster, roster.ID.Hex(), structs.AuditOperationUpdate, before, roster, rosterUserIds(before, roster)...)
+
+	svc.Providers.PublishEvent(&rosterv1.RosterChangedEvent{
+		Roster: roster.ToProto(),
+	}, false)
+
+	return connect.NewResponse(&rosterdv1.RestoreRosterVersionResponse{
+		Roster: roster.ToProto(),
+	}), nil
+}
+
+// findRosterVersion returns all versions of the roster referenced by ref
+// together with the index of the referenced version.
+func (svc *RosterService) findRosterVersion(ctx context.Context, ref *rosterdv1.RosterVersionRef) ([]structs.RosterVersion, int, error) {
+	if ref.GetRosterId() == "" {
+		return nil, 0, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("roster_id is required"))
+	}
+
+	roster, err := svc.loadRoster(ctx, ref.RosterId)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	versions, err := svc.rosterVersions(ctx, roster)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	idx := findVersion(versions, roster.ID, ref.CasIndex)
+	if idx < 0 {
+		return nil, 0, connect.NewError(connect.CodeNotFound, fmt.Errorf("roster %s does not have a version with cas index %d", ref.RosterId, ref.CasIndex))
+	}
+
+	return versions, idx, nil
+}
+
+// rosterVersions returns all versions of roster and the rosters it superseded
+// or has been superseded by, oldest first.
+func (svc *RosterService) rosterVersions(ctx context.Context, roster structs.DutyRoster) ([]structs.RosterVersion, error) {
+	history, err := svc.rosterHistory(ctx, roster)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]primitive.ObjectID, len(history))
+	for idx, r := range history {
+		ids[idx] = r.ID
+	}
+
+	snapshots, err := svc.Datastore.ListRosterVersions(ctx, ids...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load roster versions: %w", err)
+	}
+
+	return mergeRosterVersions(history, snapshots), nil
+}
+
+// rosterHistory follows the chain of superseded rosters in both directions
+// and returns all roster documents, oldest first.
+func (svc *RosterService) rosterHistory(ctx context.Context, roster structs.DutyRoster) ([]structs.DutyRoster, error) {
+	var (
+		history = []structs.DutyRoster{roster}
+		seen    = map[primitive.ObjectID]struct{}{roster.ID: {}}
+	)
+
+	for {
+		old, err := svc.Datastore.GetSupersededDutyRoster(ctx, history[0].ID)
+		if err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				break
+			}
+
+			return nil, fmt.Errorf("failed to load superseded roster: %w", err)
+		}
+
+		if _, ok := seen[old.ID]; ok {
+			return nil, fmt.Errorf("roster %s is part of a superseded cycle", old.ID.Hex())
+		}
+		seen[old.ID] = struct{}{}
+
+		history = append([]structs.DutyRoster{*old}, history...)
+	}
+
+	for next := roster.SupersededBy; !next.IsZero(); next = history[len(history)-1].SupersededBy {
+		if _, ok := seen[next]; ok {
+			return nil, fmt.Errorf("roster %s is part of a superseded cycle", next.Hex())
+		}
+		seen[next] = struct{}{}
+
+		r, err := svc.loadRoster(ctx, next.Hex())
+		if err != nil {
+			return nil, err
+		}
+
+		history = append(history, r)
+	}
+
+	return history, nil
+}
+
+// mergeRosterVersions merges the stored snapshots of the rosters in history,
+// which must be ordered oldest first. The latest version of each roster
+// document is taken from the document itself so changes that do not store a
+// snapshot, like approvals, and rosters saved before snapshots have been
+// introduced are covered as well.
+func mergeRosterVersions(history []structs.DutyRoster, snapshots []structs.RosterVersion) []structs.RosterVersion {
+	byRoster := make(map[primitive.ObjectID][]structs.RosterVersion)
+	for _, s := range snapshots {
+		byRoster[s.RosterID] = append(byRoster[s.RosterID], s)
+	}
+
+	var result []structs.RosterVersion
+	for _, roster := range history {
+		list := byRoster[roster.ID]
+		sort.SliceStable(list, func(i, j int) bool {
+			return list[i].CASIndex < list[j].CASIndex
+		})
+
+		savedAt := roster.UpdatedAt
+		if savedAt.IsZero() {
+			savedAt = roster.CreatedAt
+		}
+
+		for _, s := range list {
+			if s.CASIndex == roster.CASIndex {
+				savedAt = s.SavedAt
+			}
+
+			if s.CASIndex >= roster.CASIndex {
+				continue
+			}
+
+			result = append(result, s)
+		}
+
+		result = append(result, structs.RosterVersion{
+			RosterID: roster.ID,
+			CASIndex: roster.CASIndex,
+			SavedAt:  savedAt,
+			Roster:   roster,
+		})
+	}
+
+	return result
+}
+
+// findVersion returns the index of the version of rosterID with the given
+// CAS index. A CAS index of zero refers to the latest version of the roster
+// document. It returns -1 if there is no such version.
+func findVersion(versions []structs.RosterVersion, rosterID primitive.ObjectID, casIndex uint64) int {
+	result := -1
+
+	for idx, v := range versions {
+		if v.RosterID != rosterID {
+			continue
+		}
+
+		if casIndex == 0 || v.CASIndex == casIndex {
+			result = idx
+		}
+	}
+
+	return result
+}
+
+func rosterVersionToProto(v structs.RosterVersion, idx int, count int) *rosterdv1.RosterVersion {
+	return &rosterdv1.RosterVersion{
+		RosterId: v.RosterID.Hex(),
+		CasIndex: v.CASIndex,
+		Version:  int32(idx + 1),
+		SavedAt:  timestamppb.New(v.SavedAt),
+		SavedBy:  v.Roster.LastModifiedBy,
+		Approved: v.Roster.IsApproved(),
+		Current:  idx == count-1,
+	}
+}
+
+// rosterChangesToProto converts the result of compareRosters to its protobuf
+// representation. Users are ordered by their ID.
+func rosterChangesToProto(changes RosterChanges) *rosterdv1.DiffRostersResponse {
+	optionalTime := func(t time.Time) *timestamppb.Timestamp {
+		if t.IsZero() {
+			return nil
+		}
+
+		return timestamppb.New(t)
+	}
+
+	response := &rosterdv1.DiffRostersResponse{
+		Shifts: make([]*rosterdv1.ShiftChange, len(changes.Shifts)),
+	}
+
+	for idx, c := range changes.Shifts {
+		response.Shifts[idx] = &rosterdv1.ShiftChange{
+			Kind:              shiftChangeKinds[c.Kind],
+			WorkShiftId:       c.WorkShiftID.Hex(),
+			From:              timestamppb.New(c.From),
+			To:                timestamppb.New(c.To),
+			PreviousFrom:      optionalTime(c.PreviousFrom),
+			PreviousTo:        optionalTime(c.PreviousTo),
+			AssignedUserIds:   c.Assigned,
+			UnassignedUserIds: c.Unassigned,
+		}
+	}
+
+	userIds := maps.Keys(changes.Users)
+	slices.Sort(userIds)
+
+	for _, userId := range userIds {
+		user := &rosterdv1.UserRosterChanges{
+			UserId:  userId,
+			Changes: make([]*rosterdv1.UserShiftChange, len(changes.Users[userId])),
+		}
+
+		for idx, c := range changes.Users[userId] {
+			user.Changes[idx] = &rosterdv1.UserShiftChange{
+				Kind:         userShiftChangeKinds[c.Kind],
+				WorkShiftId:  c.WorkShiftID.Hex(),
+				From:         timestamppb.New(c.From),
+				To:           timestamppb.New(c.To),
+				PreviousFrom: optionalTime(c.PreviousFrom),
+				PreviousTo:   optionalTime(c.PreviousTo),
+			}
+		}
+
+		response.Users = append(response.Users, user)
+	}
+
+	return response
+}
+
+// userDiffsToProto converts the user changes of DiffRosters to the
+// assignment changes returned by DiffRosterVersions ordered by shift start.
+// A shift whose times changed is reported as removed at the previous and
+// assigned at the new time.
+func userDiffsToProto(users []*rosterdv1.UserRosterChanges) []*rosterdv1.UserRosterDiff {
+	result := make([]*rosterdv1.UserRosterDiff, 0, len(users))
+	for _, u := range users {
+		user := &rosterdv1.UserRosterDiff{
+			UserId: u.UserId,
+		}
+
+		add := func(id string, from, to *timestamppb.Timestamp, assigned bool) {
+			user.Shifts = append(user.Shifts, &rosterdv1.RosterShiftDiff{
+				WorkShiftId: id,
+				From:        from,
+				To:          to,
+				Assigned:    assigned,
+			})
+		}
+
+		for _, c := range u.Changes {
+			switch c.Kind {
+			case rosterdv1.UserShiftChangeKind_USER_SHIFT_CHANGE_KIND_ASSIGNED:
+				add(c.WorkShiftId, c.From, c.To, true)
+			case rosterdv1.UserShiftChangeKind_USER_SHIFT_CHANGE_KIND_UNASSIGNED:
+				add(c.WorkShiftId, c.From, c.To, false)
+			case rosterdv1.UserShiftChangeKind_USER_SHIFT_CHANGE_KIND_TIMES_CHANGED:
+				add(c.WorkShiftId, c.PreviousFrom, c.PreviousTo, false)
+				add(c.WorkShiftId, c.From, c.To, true)
+			}
+		}
+
+		sort.SliceStable(user.Shifts, func(i, j int) bool {
+			a, b := user.Shifts[i], user.Shifts[j]
+			if !a.From.AsTime().Equal(b.From.AsTime()) {
+				return a.From.AsTime().Before(b.From.AsTime())
+			}
+
+			return a.WorkShiftId < b.WorkShiftId
+		})
+
+		result = append(result, user)
+	}
+
+	return result
+}
+
+var shiftChangeKinds = map[ShiftChangeKind]rosterdv1.ShiftChangeKind{
+	ShiftAdded:              rosterdv1.ShiftChangeKind_SHIFT_CHANGE_KIND_ADDED,
+	ShiftRemoved:            rosterdv1.ShiftChangeKind_SHIFT_CHANGE_KIND_REMOVED,
+	ShiftTimesChanged:       rosterdv1.ShiftChangeKind_SHIFT_CHANGE_KIND_TIMES_CHANGED,
+	ShiftAssignmentsChanged: rosterdv1.ShiftChangeKind_SHIFT_CHANGE_KIND_ASSIGNMENTS_CHANGED,
+}
+
+var userShiftChangeKinds = map[UserChangeKind]rosterdv1.UserShiftChangeKind{
+	UserAssigned:     rosterdv1.UserShiftChangeKind_USER_SHIFT_CHANGE_KIND_ASSIGNED,
+	UserUnassigned:   rosterdv1.UserShiftChangeKind_USER_SHIFT_CHANGE_KIND_UNASSIGNED,
+	UserTimesChanged: rosterdv1.UserShiftChangeKind_USER_SHIFT_CHANGE_KIND_TIMES_CHANGED,
+}
@@ -0,0 +1,138 @@
+package roster
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	rosterdv1 "github.com/tierklinik-dobersberg/rosterd/gen/go/rosterd/v1"
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func Test_mergeRosterVersions(t *testing.T) {
+	savedAt := func(day int) time.Time {
+		return time.Date(2024, time.May, day, 10, 0, 0, 0, time.UTC)
+	}
+
+	first := structs.DutyRoster{
+		ID:             primitive.NewObjectID(),
+		CASIndex:       3,
+		ApprovedAt:     savedAt(4),
+		LastModifiedBy: "alice",
+		Deleted:        true,
+	}
+
+	second := structs.DutyRoster{
+		ID:             primitive.NewObjectID(),
+		CASIndex:       1,
+		UpdatedAt:      savedAt(6),
+		LastModifiedBy: "bob",
+	}
+	first.SupersededBy = second.ID
+
+	// a roster saved before version snapshots have been introduced
+	legacy := structs.DutyRoster{
+		ID:        primitive.NewObjectID(),
+		CASIndex:  5,
+		CreatedAt: savedAt(1),
+	}
+
+	snapshots := []structs.RosterVersion{
+		{RosterID: first.ID, CASIndex: 3, SavedAt: savedAt(3), Roster: structs.DutyRoster{ID: first.ID, CASIndex: 3}},
+		{RosterID: first.ID, CASIndex: 1, SavedAt: savedAt(1), Roster: structs.DutyRoster{ID: first.ID, CASIndex: 1}},
+		{RosterID: first.ID, CASIndex: 2, SavedAt: savedAt(2), Roster: structs.DutyRoster{ID: first.ID, CASIndex: 2}},
+		{RosterID: second.ID, CASIndex: 1, SavedAt: savedAt(5), Roster: structs.DutyRoster{ID: second.ID, CASIndex: 1}},
+	}
+
+	versions := mergeRosterVersions([]structs.DutyRoster{first, second}, snapshots)
+	require.Len(t, versions, 4)
+
+	for idx, expected := range []struct {
+		roster primitive.ObjectID
+		cas    uint64
+		day    int
+	}{
+		{first.ID, 1, 1},
+		{first.ID, 2, 2},
+		{first.ID, 3, 3},
+		{second.ID, 1, 5},
+	} {
+		require.Equal(t, expected.roster, versions[idx].RosterID, "version %d", idx)
+		require.Equal(t, expected.cas, versions[idx].CASIndex, "version %d", idx)
+		require.Equal(t, savedAt(expected.day), versions[idx].SavedAt, "version %d", idx)
+	}
+
+	// the latest version of a document is taken from the document itself
+	require.True(t, versions[2].Roster.IsApproved())
+	require.Equal(t, "alice", versions[2].Roster.LastModifiedBy)
+	require.Equal(t, "bob", versions[3].Roster.LastModifiedBy)
+
+	versions = mergeRosterVersions([]structs.DutyRoster{legacy}, nil)
+	require.Len(t, versions, 1)
+	require.Equal(t, uint64(5), versions[0].CASIndex)
+	require.Equal(t, savedAt(1), versions[0].SavedAt)
+}
+
+func Test_findVersion(t *testing.T) {
+	first, second := primitive.NewObjectID(), primitive.NewObjectID()
+
+	versions := []structs.RosterVersion{
+		{RosterID: first, CASIndex: 1},
+		{RosterID: first, CASIndex: 2},
+		{RosterID: second, CASIndex: 1},
+	}
+
+	require.Equal(t, 0, findVersion(versions, first, 1))
+	require.Equal(t, 1, findVersion(versions, first, 2))
+	require.Equal(t, 1, findVersion(versions, first, 0))
+	require.Equal(t, 2, findVersion(versions, second, 0))
+	require.Equal(t, -1, findVersion(versions, second, 2))
+	require.Equal(t, -1, findVersion(versions, primitive.NewObjectID(), 0))
+}
+
+func Test_userDiffsToProto(t *testing.T) {
+	ts := func(day, hour int) *timestamppb.Timestamp {
+		return timestamppb.New(time.Date(2024, time.May, day, hour, 0, 0, 0, time.UTC))
+	}
+
+	result := userDiffsToProto([]*rosterdv1.UserRosterChanges{
+		{
+			UserId: "alice",
+			Changes: []*rosterdv1.UserShiftChange{
+				{Kind: rosterdv1.UserShiftChangeKind_USER_SHIFT_CHANGE_KIND_ASSIGNED, WorkShiftId: "a", From: ts(1, 8), To: ts(1, 16)},
+			},
+		},
+		{
+			UserId: "bob",
+			Changes: []*rosterdv1.UserShiftChange{
+				{Kind: rosterdv1.UserShiftChangeKind_USER_SHIFT_CHANGE_KIND_ASSIGNED, WorkShiftId: "b", From: ts(2, 8), To: ts(2, 16)},
+				{Kind: rosterdv1.UserShiftChangeKind_USER_SHIFT_CHANGE_KIND_UNASSIGNED, WorkShiftId: "a", From: ts(1, 8), To: ts(1, 16)},
+			},
+		},
+		{
+			UserId: "carol",
+			Changes: []*rosterdv1.UserShiftChange{
+				{Kind: rosterdv1.UserShiftChangeKind_USER_SHIFT_CHANGE_KIND_TIMES_CHANGED, WorkShiftId: "a", From: ts(3, 10), To: ts(3, 18), PreviousFrom: ts(3, 8), PreviousTo: ts(3, 16)},
+			},
+		},
+	})
+
+	require.Len(t, result, 3)
+	require.Equal(t, "alice", result[0].UserId)
+	require.Equal(t, "bob", result[1].UserId)
+
+	require.Len(t, result[1].Shifts, 2)
+	require.Equal(t, "a", result[1].Shifts[0].WorkShiftId)
+	require.False(t, result[1].Shifts[0].Assigned)
+	require.Equal(t, time.Date(2024, time.May, 2, 8, 0, 0, 0, time.UTC), result[1].Shifts[1].From.AsTime())
+	require.True(t, result[1].Shifts[1].Assigned)
+
+	// changed times are reported as removed and assigned
+	require.Len(t, result[2].Shifts, 2)
+	require.False(t, result[2].Shifts[0].Assigned)
+	require.Equal(t, time.Date(2024, time.May, 3, 8, 0, 0, 0, time.UTC), result[2].Shifts[0].From.AsTime())
+	require.True(t, result[2].Shifts[1].Assigned)
+	require.Equal(t, time.Date(2024, time.May, 3, 10, 0, 0, 0, time.UTC), result[2].Shifts[1].From.AsTime())
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"sort"
 	"time"
 
 	"github.com/bufbuild/connect-go"
@@ -12,6 +13,7 @@ import (
 	"github.com/tierklinik-dobersberg/apis/pkg/auth"
 	"github.com/tierklinik-dobersberg/apis/pkg/data"
 	"github.com/tierklinik-dobersberg/apis/pkg/log"
+	rosterdv1 "github.com/tierklinik-dobersberg/rosterd/gen/go/rosterd/v1"
 	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
 	"github.com/tierklinik-dobersberg/rosterd/internal/timecalc"
 	"golang.org/x/exp/maps"
@@ -51,7 +53,127 @@ func (svc *RosterService) AnalyzeWorkTime(ctx context.Context, req *connect.Requ
 	}), nil
 }
 
+func (svc *RosterService) AnalyzeOvertime(ctx context.Context, req *connect.Request[rosterdv1.AnalyzeOvertimeRequest]) (*connect.Response[rosterdv1.AnalyzeOvertimeResponse], error) {
+	remoteUser := auth.From(ctx)
+	if remoteUser == nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("missing remote user"))
+	}
+
+	var (
+		userIds []string
+		err     error
+	)
+	if req.Msg.Users != nil {
+		if req.Msg.Users.AllUsers {
+			userIds, err = svc.FetchAllUserIds(ctx)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			userIds = req.Msg.Users.UserIds
+		}
+	} else {
+		userIds = []string{remoteUser.ID}
+	}
+
+	overtime, err := svc.analyzeOvertime(ctx, "", userIds, req.Msg.From, req.Msg.To, req.Msg.TimeTrackingOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &rosterdv1.AnalyzeOvertimeResponse{
+		Results: make([]*rosterdv1.OvertimeAnalysis, 0, len(overtime)),
+	}
+
+	for userId, months := range overtime {
+		result := &rosterdv1.OvertimeAnalysis{
+			UserId:            userId,
+			OvertimeAllowance: durationpb.New(months.TotalAllowance()),
+			RawOvertime:       durationpb.New(months.TotalRaw()),
+			Overtime:          durationpb.New(months.TotalOvertime()),
+			Months:            make([]*rosterdv1.MonthlyOvertime, len(months)),
+		}
+
+		var expected, planned time.Duration
+		for idx, m := range months {
+			expected += m.Expected
+			planned += m.Planned
+
+			result.Months[idx] = &rosterdv1.MonthlyOvertime{
+				Year:              int32(m.Year),
+				Month:             int32(m.Month),
+				ExpectedTime:      durationpb.New(m.Expected),
+				PlannedTime:       durationpb.New(m.Planned),
+				OvertimeAllowance: durationpb.New(m.Allowance),
+				RawOvertime:       durationpb.New(m.Raw),
+				Overtime:          durationpb.New(m.Overtime),
+			}
+		}
+
+		result.ExpectedTime = durationpb.New(expected)
+		result.PlannedTime = durationpb.New(planned)
+
+		response.Results = append(response.Results, result)
+	}
+
+	sort.Slice(response.Results, func(i, j int) bool {
+		return response.Results[i].UserId < response.Results[j].UserId
+	})
+
+	return connect.NewResponse(response), nil
+}
+
+// analyzeWorkTime analyzes the work time of all users between from and to.
+// The overtime of the result is the difference between the planned and the
+// expected work time, see bookableOvertime for the allowance-adjusted
+// overtime.
 func (svc *RosterService) analyzeWorkTime(ctx context.Context, rosterTypeName string, userIds []string, from, to string, onlyTimeTracking bool) ([]*rosterv1.WorkTimeAnalysis, error) {
+	overtime, err := svc.analyzeOvertime(ctx, rosterTypeName, userIds, from, to, onlyTimeTracking)
+	if err != nil {
+		return nil, err
+	}
+
+	return workTimeAnalysis(overtime), nil
+}
+
+// workTimeAnalysis converts the monthly overtime of all users to work-time
+// analysis results.
+func workTimeAnalysis(overtime map[string]timecalc.MonthlyOvertimeList) []*rosterv1.WorkTimeAnalysis {
+	workTimeResult := make([]*rosterv1.WorkTimeAnalysis, 0, len(overtime))
+
+	for userId, months := range overtime {
+		var expected, planned time.Duration
+		for _, m := range months {
+			expected += m.Expected
+			planned += m.Planned
+		}
+
+		workTimeResult = append(workTimeResult, &rosterv1.WorkTimeAnalysis{
+			UserId:       userId,
+			PlannedTime:  durationpb.New(planned),
+			ExpectedTime: durationpb.New(expected),
+			Overtime:     durationpb.New(months.TotalRaw()),
+		})
+	}
+
+	return workTimeResult
+}
+
+// bookableOvertime returns the allowance-adjusted overtime of all users.
+// Overtime within the monthly overtime allowance is already paid and must
+// not be booked as off-time credits.
+func bookableOvertime(overtime map[string]timecalc.MonthlyOvertimeList) map[string]time.Duration {
+	result := make(map[string]time.Duration, len(overtime))
+	for userId, months := range overtime {
+		result[userId] = months.TotalOvertime()
+	}
+
+	return result
+}
+
+// analyzeOvertime calculates the monthly overtime of all users between from
+// and to.
+func (svc *RosterService) analyzeOvertime(ctx context.Context, rosterTypeName string, userIds []string, from, to string, onlyTimeTracking bool) (map[string]timecalc.MonthlyOvertimeList, error) {
 	log.L(ctx).Info("analyzing work time for users", "from", from, "to", to)
 
 	// parse from and to times
@@ -151,12 +273,12 @@ func (svc *RosterService) analyzeWorkTime(ctx context.Context, rosterTypeName st
 	}
 
 	// get the number of working-days
-	holidays, err := svc.getHolidayLookupMap(ctx, f, t)
+	holidays, err := svc.HolidayProvider.For(ctx, f, t)
 	if err != nil {
 		return nil, err
 	}
 
-	monthlyWorkDays, err := timecalc.GatherWorkDaysByMonth(holidays, from, to)
+	monthlyWorkDays, err := timecalc.GatherWorkDaysByMonth(holidays, from, to, svc.Config.Weekend)
 	if err != nil {
 		return nil, fmt.Errorf("failed to gather monthly work-days: %w", err)
 	}
@@ -172,43 +294,36 @@ func (svc *RosterService) analyzeWorkTime(ctx context.Context, rosterTypeName st
 		perUserWorkTimes[id] = times
 	}
 
-	expectedWorkTimes, err := timecalc.CalculateExpectedWorkTime(ctx, monthlyWorkDays, perUserWorkTimes, from, to)
+	expectedWorkTimes, err := timecalc.CalculateExpectedWorkTime(ctx, monthlyWorkDays, perUserWorkTimes, from, to, svc.Config.Weekend)
 	if err != nil {
 		return nil, fmt.Errorf("failed to calculate expected work time: %w", err)
 	}
 
-	plannedWorkTimes, err := timecalc.CalculatePlannedMonthlyWorkTime(ctx, maps.Values(distinctRosters), from, to, workShifts, perUserWorkTimes)
+	// off-time of approved requests has already been booked as off-time
+	// costs and must not be reported as undertime again.
+	offTimeCosts, err := svc.Datastore.GetOffTimeCosts(ctx, userIds...)
 	if err != nil {
-		return nil, fmt.Errorf("failed to calculate planned work time: %w", err)
+		return nil, fmt.Errorf("failed to get off-time costs: %w", err)
 	}
 
-	workTimeResult := make([]*rosterv1.WorkTimeAnalysis, 0, len(expectedWorkTimes))
-
-	for userId := range expectedWorkTimes {
-		var (
-			expected     time.Duration
-			plannedTotal time.Duration
-		)
-
-		planned := plannedWorkTimes.TotalForUser(userId)
+	perUserCosts := make(map[string][]structs.OffTimeCosts)
+	for _, c := range offTimeCosts {
+		perUserCosts[c.UserID] = append(perUserCosts[c.UserID], c)
+	}
 
-		if onlyTimeTracking {
-			expected = expectedWorkTimes[userId].TotalTrackedWorkTime()
-			plannedTotal = planned.Tracked
-		} else {
-			expected = expectedWorkTimes[userId].TotalWorkTime()
-			plannedTotal = planned.Total()
-		}
+	for userId, list := range expectedWorkTimes {
+		timecalc.DeductOffTime(list, perUserCosts[userId], perUserWorkTimes[userId], f, t)
+	}
 
-		diff := plannedTotal - expected
+	plannedWorkTimes, err := timecalc.CalculatePlannedMonthlyWorkTime(ctx, maps.Values(distinctRosters), from, to, workShifts, perUserWorkTimes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate planned work time: %w", err)
+	}
 
-		workTimeResult = append(workTimeResult, &rosterv1.WorkTimeAnalysis{
-			UserId:       userId,
-			PlannedTime:  durationpb.New(plannedTotal),
-			ExpectedTime: durationpb.New(expected),
-			Overtime:     durationpb.New(diff),
-		})
+	result := make(map[string]timecalc.MonthlyOvertimeList, len(expectedWorkTimes))
+	for userId := range expectedWorkTimes {
+		result[userId] = timecalc.CalculateOvertime(expectedWorkTimes[userId], plannedWorkTimes, userId, onlyTimeTracking)
 	}
 
-	return workTimeResult, nil
+	return result, nil
 }
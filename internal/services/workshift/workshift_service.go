@@ -93,33 +93,19 @@ func (svc *Service) CreateWorkShift(ctx context.Context, req *connect.Request[ro
 		return nil, err
 	}
 
+	svc.Audit.Record(ctx, structs.AuditEntityWorkShift, shift.ID.Hex(), structs.AuditOperationCreate, nil, shift)
+
 	return connect.NewResponse(&rosterv1.CreateWorkShiftResponse{
 		WorkShift: shift.ToProto(),
 	}), nil
 }
 
 func (svc *Service) UpdateWorkShift(ctx context.Context, req *connect.Request[rosterv1.UpdateWorkShiftRequest]) (*connect.Response[rosterv1.UpdateWorkShiftResponse], error) {
-	// load all workshifts
-	// TODO(ppacher): add a method to get work-shift by ID
-	shifts, err := svc.Datastore.ListWorkShifts(ctx)
+	shift, err := svc.findWorkShift(ctx, req.Msg.Id)
 	if err != nil {
 		return nil, err
 	}
-
-	// find the shift that we want to update.
-	var shift structs.WorkShift
-	for _, s := range shifts {
-		if s.ID.Hex() == req.Msg.Id {
-			shift = s
-
-			break
-		}
-	}
-
-	// handle shift-not-found
-	if shift.ID.IsZero() {
-		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("failed to find shift with id %q", req.Msg.Id))
-	}
+	before := shift
 
 	paths := req.Msg.GetWriteMask().GetPaths()
 	if len(paths) > 0 {
@@ -196,15 +182,43 @@ func (svc *Service) UpdateWorkShift(ctx context.Context, req *connect.Request[ro
 		return nil, err
 	}
 
+	// work-shifts that are not updated in place get a new ID, the audit
+	// event is recorded for the original one.
+	svc.Audit.Record(ctx, structs.AuditEntityWorkShift, before.ID.Hex(), structs.AuditOperationUpdate, before, shift)
+
 	return connect.NewResponse(&rosterv1.UpdateWorkShiftResponse{
 		WorkShift: shift.ToProto(),
 	}), nil
 }
 
 func (svc *Service) DeleteWorkShift(ctx context.Context, req *connect.Request[rosterv1.DeleteWorkShiftRequest]) (*connect.Response[rosterv1.DeleteWorkShiftResponse], error) {
+	shift, err := svc.findWorkShift(ctx, req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
 	if err := svc.Datastore.DeleteWorkShift(ctx, req.Msg.Id); err != nil {
 		return nil, err
 	}
 
+	svc.Audit.Record(ctx, structs.AuditEntityWorkShift, shift.ID.Hex(), structs.AuditOperationDelete, shift, nil)
+
 	return connect.NewResponse(&rosterv1.DeleteWorkShiftResponse{}), nil
 }
+
+// findWorkShift returns the work-shift with the given id.
+func (svc *Service) findWorkShift(ctx context.Context, id string) (structs.WorkShift, error) {
+	// TODO(ppacher): add a method to get work-shift by ID
+	shifts, err := svc.Datastore.ListWorkShifts(ctx)
+	if err != nil {
+		return structs.WorkShift{}, err
+	}
+
+	for _, s := range shifts {
+		if s.ID.Hex() == id {
+			return s, nil
+		}
+	}
+
+	return structs.WorkShift{}, connect.NewError(connect.CodeNotFound, fmt.Errorf("failed to find shift with id %q", id))
+}
@@ -0,0 +1,159 @@
+package worktime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/tierklinik-dobersberg/apis/pkg/log"
+	rosterdv1 "github.com/tierklinik-dobersberg/rosterd/gen/go/rosterd/v1"
+	"github.com/tierklinik-dobersberg/rosterd/gen/go/rosterd/v1/rosterdv1connect"
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"go.mongodb.org/mongo-driver/mongo"
+	"golang.org/x/exp/slices"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+var _ rosterdv1connect.WorkTimeServiceHandler = (*Service)(nil)
+
+// SetWorkTimeSchedule creates a new work-time entry with the settings of
+// an existing one and the requested schedule. Like SetWorkTime, changes are
+// versioned by their applicable date so rosters before it keep their
+// expected work time.
+func (svc *Service) SetWorkTimeSchedule(ctx context.Context, req *connect.Request[rosterdv1.SetWorkTimeScheduleRequest]) (*connect.Response[rosterdv1.SetWorkTimeScheduleResponse], error) {
+	base, err := svc.getWorkTime(ctx, req.Msg.WorkTimeId)
+	if err != nil {
+		return nil, err
+	}
+
+	applicableFrom, err := time.ParseInLocation("2006-01-02", req.Msg.ApplicableAfter, time.Local)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid value for field 'applicable_after': %w", err))
+	}
+
+	if !applicableFrom.After(base.ApplicableFrom) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("applicable_after must be after %s", base.ApplicableFrom.Local().Format("2006-01-02")))
+	}
+
+	wt := structs.WorkTime{
+		UserID:                    base.UserID,
+		TimePerWeek:               base.TimePerWeek,
+		ApplicableFrom:            applicableFrom,
+		VacationWeeksPerYear:      base.VacationWeeksPerYear,
+		OvertimeAllowancePerMonth: base.OvertimeAllowancePerMonth,
+		ExcludeFromTimeTracking:   base.ExcludeFromTimeTracking,
+		EndsWith:                  base.EndsWith,
+	}
+
+	for _, day := range req.Msg.GetSchedule().GetDays() {
+		weekday, err := toWeekday(day)
+		if err != nil {
+			return nil, err
+		}
+
+		if slices.Contains(wt.WorkDays, weekday) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("duplicate weekday %s", weekday))
+		}
+
+		wt.WorkDays = append(wt.WorkDays, weekday)
+	}
+
+	if perDay := req.Msg.GetSchedule().GetTimePerWeekday(); len(perDay) > 0 {
+		var sum time.Duration
+
+		wt.TimePerWeekday = make(map[time.Weekday]time.Duration, len(perDay))
+		for day, value := range perDay {
+			weekday, err := toWeekday(day)
+			if err != nil {
+				return nil, err
+			}
+
+			if !slices.Contains(wt.WorkDays, weekday) {
+				return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("time_per_weekday contains %s which is not part of days", weekday))
+			}
+
+			if value.AsDuration() < 0 {
+				return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid work time for %s", weekday))
+			}
+
+			wt.TimePerWeekday[weekday] = value.AsDuration()
+			sum += value.AsDuration()
+		}
+
+		if sum != wt.TimePerWeek {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("sum of time_per_weekday (%s) must match the time per week (%s)", sum, wt.TimePerWeek))
+		}
+	}
+
+	if err := svc.Datastore.SaveWorkTimePerWeek(ctx, &wt); err != nil {
+		return nil, err
+	}
+
+	svc.Audit.Record(ctx, structs.AuditEntityWorkTime, wt.ID.Hex(), structs.AuditOperationCreate, nil, wt, wt.UserID)
+
+	log.L(ctx).Info("created work time with schedule", "id", wt.ID.Hex(), "basedOn", base.ID.Hex(), "userId", wt.UserID, "applicableFrom", wt.ApplicableFrom, "days", wt.WorkDays, "timePerWeekday", wt.TimePerWeekday)
+
+	return connect.NewResponse(&rosterdv1.SetWorkTimeScheduleResponse{
+		WorkTime: worktimeToProto(wt),
+		Schedule: scheduleToProto(wt),
+	}), nil
+}
+
+func (svc *Service) GetWorkTimeSchedule(ctx context.Context, req *connect.Request[rosterdv1.GetWorkTimeScheduleRequest]) (*connect.Response[rosterdv1.GetWorkTimeScheduleResponse], error) {
+	wt, err := svc.getWorkTime(ctx, req.Msg.WorkTimeId)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&rosterdv1.GetWorkTimeScheduleResponse{
+		WorkTime: worktimeToProto(*wt),
+		Schedule: scheduleToProto(*wt),
+	}), nil
+}
+
+func (svc *Service) getWorkTime(ctx context.Context, id string) (*structs.WorkTime, error) {
+	wt, err := svc.Datastore.GetWorktimeByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("no work-time with the given id"))
+		}
+
+		return nil, err
+	}
+
+	return wt, nil
+}
+
+func toWeekday(day int32) (time.Weekday, error) {
+	if day < int32(time.Sunday) || day > int32(time.Saturday) {
+		return 0, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid weekday %d", day))
+	}
+
+	return time.Weekday(day), nil
+}
+
+func scheduleToProto(wt structs.WorkTime) *rosterdv1.WorkTimeSchedule {
+	schedule := &rosterdv1.WorkTimeSchedule{
+		Days: make([]int32, len(wt.WorkDays)),
+	}
+
+	for idx, day := range wt.WorkDays {
+		schedule.Days[idx] = int32(day)
+	}
+
+	sort.Slice(schedule.Days, func(i, j int) bool {
+		return schedule.Days[i] < schedule.Days[j]
+	})
+
+	if len(wt.TimePerWeekday) > 0 {
+		schedule.TimePerWeekday = make(map[int32]*durationpb.Duration, len(wt.TimePerWeekday))
+		for day, value := range wt.TimePerWeekday {
+			schedule.TimePerWeekday[int32(day)] = durationpb.New(value)
+		}
+	}
+
+	return schedule
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/tierklinik-dobersberg/apis/pkg/log"
 	"github.com/tierklinik-dobersberg/rosterd/internal/config"
 	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"github.com/tierklinik-dobersberg/rosterd/internal/timecalc"
 	"go.mongodb.org/mongo-driver/mongo"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -78,6 +79,8 @@ func (svc *Service) SetWorkTime(ctx context.Context, req *connect.Request[roster
 		// finally store the work-time record in the database.
 		if err := svc.Datastore.SaveWorkTimePerWeek(ctx, &model); err != nil {
 			merr.Errors = append(merr.Errors, fmt.Errorf("user_id %q: %w", wt.UserId, err))
+		} else {
+			svc.Audit.Record(ctx, structs.AuditEntityWorkTime, model.ID.Hex(), structs.AuditOperationCreate, nil, model, model.UserID)
 		}
 
 		log.L(ctx).Info("updated work time for user", "userId", model.UserID, "timePerWeek", model.TimePerWeek, "applicableFrom", model.ApplicableFrom)
@@ -198,10 +201,29 @@ func (svc *Service) GetWorkTime(ctx context.Context, req *connect.Request[roster
 }
 
 func (svc *Service) DeleteWorkTime(ctx context.Context, req *connect.Request[rosterv1.DeleteWorkTimeRequest]) (*connect.Response[rosterv1.DeleteWorkTimeResponse], error) {
+	// load the work-times first so the audit log contains the deleted values.
+	existing := make([]*structs.WorkTime, 0, len(req.Msg.Ids))
+	for _, id := range req.Msg.Ids {
+		wt, err := svc.Datastore.GetWorktimeByID(ctx, id)
+		if err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				continue
+			}
+
+			return nil, err
+		}
+
+		existing = append(existing, wt)
+	}
+
 	if err := svc.Datastore.DeleteWorkTime(ctx, req.Msg.Ids...); err != nil {
 		return nil, err
 	}
 
+	for _, wt := range existing {
+		svc.Audit.Record(ctx, structs.AuditEntityWorkTime, wt.ID.Hex(), structs.AuditOperationDelete, wt, nil, wt.UserID)
+	}
+
 	return connect.NewResponse(new(rosterv1.DeleteWorkTimeResponse)), nil
 }
 
@@ -273,105 +295,86 @@ func (svc *Service) GetVacationCreditsLeft(ctx context.Context, req *connect.Req
 			Analysis: &rosterv1.AnalyzeVacation{},
 		}
 
-		var (
-			vacationSum time.Duration
-			timeOffSum  time.Duration
-		)
-
-		// calculate the total amount of vacation hours
-		for idx := 0; idx < len(workHistory); idx++ {
-			iter := workHistory[idx]
-			endsAt := until
+		userCosts := costsByUser[userId]
 
-			// skip this work-time entry if it becomes active after the
-			// requested time-frame.
-			if iter.ApplicableFrom.After(until) {
-				continue
-			}
+		if req.Msg.Analyze {
+			perUser.Analysis.Slices = analyzeVacation(workHistory, userCosts, until)
+		}
 
-			switch {
-			case !iter.EndsWith.IsZero():
-				endsAt = iter.EndsWith
+		balance := timecalc.CalculateVacationBalance(workHistory, userCosts, until)
 
-			case idx+1 < len(workHistory):
-				next := workHistory[idx+1]
-				endsAt = next.ApplicableFrom
-			}
+		perUser.VacationCreditsLeft = durationpb.New(balance.Vacation.Round(time.Minute))
+		perUser.TimeOffCredits = durationpb.New(balance.TimeOff.Round(time.Minute))
 
-			// if there's another work-history entry after this one we need
-			// to update endsAt to the beginning of the next entry.
+		response.Results[idx] = perUser
+	}
 
-			daysUntilEnd := math.Floor(float64(endsAt.Sub(iter.ApplicableFrom)) / float64(time.Hour*24))
+	return connect.NewResponse(response), nil
+}
 
-			vacationWeeksPerDay := float64(iter.VacationWeeksPerYear) / 365.0
-			vacationsPerPeriod := vacationWeeksPerDay * float64(iter.TimePerWeek) * float64(daysUntilEnd)
+// analyzeVacation returns the vacation credits and costs for each work-time
+// entry in workHistory that is applicable before until.
+func analyzeVacation(workHistory []structs.WorkTime, costs []structs.OffTimeCosts, until time.Time) []*rosterv1.AnalyzeVacationSum {
+	var result []*rosterv1.AnalyzeVacationSum
 
-			if !iter.ExcludeFromTimeTracking {
-				vacationSum += time.Duration(vacationsPerPeriod)
-			}
+	for idx := 0; idx < len(workHistory); idx++ {
+		iter := workHistory[idx]
+		endsAt := until
 
-			if req.Msg.Analyze {
-				sl := &rosterv1.AnalyzeVacationSum{
-					WorkTime:            worktimeToProto(iter),
-					EndsAt:              timestamppb.New(endsAt),
-					NumberOfDays:        float64(daysUntilEnd),
-					VacationWeeksPerDay: float64(vacationWeeksPerDay),
-					VacationPerWorkTime: durationpb.New(time.Duration(vacationsPerPeriod)),
-				}
+		// skip this work-time entry if it becomes active after the
+		// requested time-frame.
+		if iter.ApplicableFrom.After(until) {
+			continue
+		}
 
-				userCosts := costsByUser[userId]
-				slSum := time.Duration(0)
-
-				for _, cost := range userCosts {
-					if !cost.IsVacation || cost.Date.After(endsAt) || cost.Date.Before(iter.ApplicableFrom) {
-						continue
-					}
-
-					slSum += cost.Costs
-
-					sl.Costs = append(sl.Costs, &rosterv1.OffTimeCosts{
-						Id:         cost.ID.Hex(),
-						OfftimeId:  cost.OfftimeID.Hex(),
-						RosterId:   cost.RosterID.Hex(),
-						UserId:     cost.UserID,
-						CreatedAt:  timestamppb.New(cost.CreatedAt),
-						CreatorId:  cost.CreatorId,
-						Costs:      durationpb.New(cost.Costs),
-						IsVacation: cost.IsVacation,
-					})
-				}
+		switch {
+		case !iter.EndsWith.IsZero():
+			endsAt = iter.EndsWith
 
-				sl.CostsSum = durationpb.New(slSum)
-				perUser.Analysis.Slices = append(perUser.Analysis.Slices, sl)
-			}
+		case idx+1 < len(workHistory):
+			endsAt = workHistory[idx+1].ApplicableFrom
+		}
 
-			// if this entry ends at or after the maximum time-frame
-			// we can stop now.
-			if endsAt.After(until) || endsAt.Equal(until) {
-				break
-			}
+		sl := &rosterv1.AnalyzeVacationSum{
+			WorkTime:            worktimeToProto(iter),
+			EndsAt:              timestamppb.New(endsAt),
+			NumberOfDays:        math.Floor(float64(endsAt.Sub(iter.ApplicableFrom)) / float64(time.Hour*24)),
+			VacationWeeksPerDay: timecalc.VacationWeeksPerDay(iter),
+			VacationPerWorkTime: durationpb.New(timecalc.VacationCreditsFor(iter, endsAt)),
 		}
 
-		userCosts := costsByUser[userId]
-		for _, cost := range userCosts {
-			if !until.IsZero() && until.Before(cost.Date) {
+		slSum := time.Duration(0)
+
+		for _, cost := range costs {
+			if !cost.IsVacation || cost.Date.After(endsAt) || cost.Date.Before(iter.ApplicableFrom) {
 				continue
 			}
 
-			if cost.IsVacation {
-				vacationSum += cost.Costs // costs is negative
-			} else {
-				timeOffSum += cost.Costs
-			}
+			slSum += cost.Costs
+
+			sl.Costs = append(sl.Costs, &rosterv1.OffTimeCosts{
+				Id:         cost.ID.Hex(),
+				OfftimeId:  cost.OfftimeID.Hex(),
+				RosterId:   cost.RosterID.Hex(),
+				UserId:     cost.UserID,
+				CreatedAt:  timestamppb.New(cost.CreatedAt),
+				CreatorId:  cost.CreatorId,
+				Costs:      durationpb.New(cost.Costs),
+				IsVacation: cost.IsVacation,
+			})
 		}
 
-		perUser.VacationCreditsLeft = durationpb.New(vacationSum.Round(time.Minute))
-		perUser.TimeOffCredits = durationpb.New(timeOffSum.Round(time.Minute))
+		sl.CostsSum = durationpb.New(slSum)
+		result = append(result, sl)
 
-		response.Results[idx] = perUser
+		// if this entry ends at or after the maximum time-frame
+		// we can stop now.
+		if endsAt.After(until) || endsAt.Equal(until) {
+			break
+		}
 	}
 
-	return connect.NewResponse(response), nil
+	return result
 }
 
 func (svc *Service) UpdateWorkTime(ctx context.Context, req *connect.Request[rosterv1.UpdateWorkTimeRequest]) (*connect.Response[rosterv1.UpdateWorkTimeResponse], error) {
@@ -383,6 +386,7 @@ func (svc *Service) UpdateWorkTime(ctx context.Context, req *connect.Request[ros
 
 		return nil, err
 	}
+	before := *wt
 
 	paths := []string{
 		"ends_with",
@@ -418,6 +422,8 @@ func (svc *Service) UpdateWorkTime(ctx context.Context, req *connect.Request[ros
 		return nil, err
 	}
 
+	svc.Audit.Record(ctx, structs.AuditEntityWorkTime, wt.ID.Hex(), structs.AuditOperationUpdate, before, *wt, wt.UserID)
+
 	return connect.NewResponse(&rosterv1.UpdateWorkTimeResponse{
 		Worktime: worktimeToProto(*wt),
 	}), nil
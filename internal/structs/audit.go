@@ -0,0 +1,72 @@
+package structs
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// AuditEntity is the type of entity an audit event refers to.
+type AuditEntity string
+
+const (
+	AuditEntityRoster         = AuditEntity("roster")
+	AuditEntityRosterType     = AuditEntity("roster-type")
+	AuditEntityOffTimeRequest = AuditEntity("offtime-request")
+	AuditEntityOffTimeCosts   = AuditEntity("offtime-costs")
+	AuditEntityOffTimeRule    = AuditEntity("offtime-rule")
+	AuditEntityWorkTime       = AuditEntity("worktime")
+	AuditEntityWorkShift      = AuditEntity("workshift")
+	AuditEntityConstraint     = AuditEntity("constraint")
+	AuditEntityShiftSwap      = AuditEntity("shift-swap")
+	AuditEntityOpenShift      = AuditEntity("open-shift")
+	AuditEntityLocalHoliday   = AuditEntity("local-holiday")
+	AuditEntityCalendarFeed   = AuditEntity("calendar-feed")
+)
+
+// AuditOperation is the operation performed on an entity.
+type AuditOperation string
+
+const (
+	AuditOperationCreate  = AuditOperation("create")
+	AuditOperationUpdate  = AuditOperation("update")
+	AuditOperationDelete  = AuditOperation("delete")
+	AuditOperationApprove = AuditOperation("approve")
+	AuditOperationReject  = AuditOperation("reject")
+)
+
+// AuditChange describes the change of a single field. Before and After hold
+// the JSON encoded values and are empty if the field did not exist.
+type AuditChange struct {
+	Path   string `bson:"path"`
+	Before string `bson:"before,omitempty"`
+	After  string `bson:"after,omitempty"`
+}
+
+// AuditEvent is an entry of the append-only audit log.
+type AuditEvent struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	Time      time.Time          `bson:"time"`
+	ActorID   string             `bson:"actorId"`
+	Entity    AuditEntity        `bson:"entity"`
+	EntityID  string             `bson:"entityId"`
+	Operation AuditOperation     `bson:"operation"`
+
+	// UserIDs holds the users affected by the change, e.g. the requestor of
+	// an off-time request or the users assigned to a roster.
+	UserIDs []string `bson:"userIds,omitempty"`
+
+	Changes []AuditChange `bson:"changes,omitempty"`
+}
+
+// AuditEventFilter is used to search the audit log. Zero values are
+// ignored.
+type AuditEventFilter struct {
+	Entity   AuditEntity
+	EntityID string
+	ActorID  string
+	UserID   string
+	From     time.Time
+	To       time.Time
+	Limit    int64
+}
@@ -0,0 +1,18 @@
+package structs
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// CalendarFeed is the personal iCal subscription feed of a user. Only the
+// SHA-256 hash of the feed token is stored.
+type CalendarFeed struct {
+	ID           primitive.ObjectID `bson:"_id,omitempty"`
+	UserID       string             `bson:"userId"`
+	TokenHash    string             `bson:"tokenHash"`
+	CreatedAt    time.Time          `bson:"createdAt"`
+	CreatorID    string             `bson:"creatorId"`
+	ApprovedOnly bool               `bson:"approvedOnly"`
+}
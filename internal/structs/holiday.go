@@ -0,0 +1,44 @@
+package structs
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// LocalHolidayKind describes the kind of a clinic specific holiday.
+type LocalHolidayKind string
+
+const (
+	LocalHolidayClosure  = LocalHolidayKind("closure")
+	LocalHolidayHalfDay  = LocalHolidayKind("half-day")
+	LocalHolidayRegional = LocalHolidayKind("regional")
+	LocalHolidayOther    = LocalHolidayKind("other")
+)
+
+// LocalHoliday is a clinic specific holiday or closure day that is merged
+// with the public holidays of the calendar service. There may only be one
+// local holiday per date.
+type LocalHoliday struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty"`
+	Date        string             `bson:"date"` // YYYY-MM-DD
+	Name        string             `bson:"name"`
+	Description string             `bson:"description,omitempty"`
+	Kind        LocalHolidayKind   `bson:"kind"`
+
+	// CountsAsWorkday is set if the day still counts as a working day when
+	// calculating the expected work time of employees.
+	CountsAsWorkday bool `bson:"countsAsWorkday"`
+
+	// WorkdayFraction is the part of a regular working day that is expected
+	// from employees if CountsAsWorkday is set, e.g. 0.5 for half days.
+	// Zero counts as a full working day.
+	WorkdayFraction float64 `bson:"workdayFraction,omitempty"`
+
+	// HolidayShifts is set if work-shifts marked with OnHoliday apply
+	// instead of the regular shifts of the weekday.
+	HolidayShifts bool `bson:"holidayShifts"`
+
+	CreatedAt time.Time `bson:"createdAt"`
+	CreatorId string    `bson:"creatorId"`
+}
@@ -0,0 +1,42 @@
+package structs
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// OffTimeRule restricts off-time requests of the affected users. A rule
+// either blocks all off-time requests (blackout) or limits the number of
+// users that may be absent at the same time.
+type OffTimeRule struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty"`
+	Name        string             `bson:"name"`
+	Description string             `bson:"description,omitempty"`
+
+	// From is the first day the rule applies to. If zero, the rule applies
+	// to all days before To.
+	From time.Time `bson:"from,omitempty"`
+
+	// To is the last day (inclusive) the rule applies to. If zero, the rule
+	// applies to all days after From.
+	To time.Time `bson:"to,omitempty"`
+
+	// RoleIDs and UserIDs hold the roles and users the rule applies to. If
+	// both are empty the rule applies to all users.
+	RoleIDs []string `bson:"roleIds,omitempty"`
+	UserIDs []string `bson:"userIds,omitempty"`
+
+	// MaxConcurrent is the maximum number of affected users that may be
+	// absent at the same time. A value of zero blocks all off-time requests
+	// of the affected users.
+	MaxConcurrent int `bson:"maxConcurrent"`
+
+	CreatedAt time.Time `bson:"createdAt"`
+	CreatorId string    `bson:"creatorId"`
+}
+
+// IsBlackout returns true if the rule blocks all off-time requests.
+func (rule OffTimeRule) IsBlackout() bool {
+	return rule.MaxConcurrent <= 0
+}
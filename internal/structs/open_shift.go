@@ -0,0 +1,109 @@
+package structs
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type OpenShiftState string
+
+const (
+	OpenShiftStateOpen   = OpenShiftState("open")
+	OpenShiftStateFilled = OpenShiftState("filled")
+	OpenShiftStateClosed = OpenShiftState("closed")
+)
+
+type OpenShiftClaimState string
+
+const (
+	OpenShiftClaimPending   = OpenShiftClaimState("pending")
+	OpenShiftClaimConfirmed = OpenShiftClaimState("confirmed")
+	OpenShiftClaimRejected  = OpenShiftClaimState("rejected")
+)
+
+// OpenShiftClaim is a claim of a user for an open shift.
+type OpenShiftClaim struct {
+	UserID    string              `bson:"userId"`
+	State     OpenShiftClaimState `bson:"state"`
+	ClaimedAt time.Time           `bson:"claimedAt"`
+	DecidedBy string              `bson:"decidedBy,omitempty"`
+	DecidedAt time.Time           `bson:"decidedAt,omitempty"`
+}
+
+// OpenShift is an understaffed shift of an approved roster that eligible
+// users may claim.
+type OpenShift struct {
+	ID       primitive.ObjectID `bson:"_id,omitempty"`
+	RosterID primitive.ObjectID `bson:"rosterId"`
+	Shift    ShiftRef           `bson:"shift"`
+	To       time.Time          `bson:"to"`
+
+	// OpenSlots is the number of users that may still be assigned.
+	OpenSlots int `bson:"openSlots"`
+
+	// RequireConfirmation is set if claims must be confirmed by a roster
+	// manager.
+	RequireConfirmation bool `bson:"requireConfirmation"`
+
+	State  OpenShiftState   `bson:"state"`
+	Claims []OpenShiftClaim `bson:"claims"`
+
+	// EligibleUserIds holds the users that have been eligible when the
+	// shift has been published.
+	EligibleUserIds []string `bson:"eligibleUserIds"`
+
+	Comment   string    `bson:"comment,omitempty"`
+	CreatedAt time.Time `bson:"createdAt"`
+	CreatorId string    `bson:"creatorId"`
+
+	// Version is incremented each time the open shift is updated.
+	Version uint64 `bson:"version"`
+}
+
+// Claim returns the claim of userId or nil.
+func (os *OpenShift) Claim(userId string) *OpenShiftClaim {
+	for idx := range os.Claims {
+		if os.Claims[idx].UserID == userId {
+			return &os.Claims[idx]
+		}
+	}
+
+	return nil
+}
+
+// Confirm marks the claim of userId as confirmed and decrements the open
+// slots. Once all slots are filled, pending claims are rejected.
+func (os *OpenShift) Confirm(userId string, decidedBy string, at time.Time) {
+	claim := os.Claim(userId)
+	if claim == nil {
+		os.Claims = append(os.Claims, OpenShiftClaim{
+			UserID:    userId,
+			ClaimedAt: at,
+		})
+
+		claim = &os.Claims[len(os.Claims)-1]
+	}
+
+	claim.State = OpenShiftClaimConfirmed
+	claim.DecidedBy = decidedBy
+	claim.DecidedAt = at
+
+	os.OpenSlots--
+	if os.OpenSlots <= 0 {
+		os.OpenSlots = 0
+		os.State = OpenShiftStateFilled
+		os.RejectPending(decidedBy, at)
+	}
+}
+
+// RejectPending rejects all pending claims.
+func (os *OpenShift) RejectPending(decidedBy string, at time.Time) {
+	for idx := range os.Claims {
+		if os.Claims[idx].State == OpenShiftClaimPending {
+			os.Claims[idx].State = OpenShiftClaimRejected
+			os.Claims[idx].DecidedBy = decidedBy
+			os.Claims[idx].DecidedAt = at
+		}
+	}
+}
@@ -0,0 +1,18 @@
+package structs
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// RosterVersion is a snapshot of a duty roster that is stored each time the
+// roster is saved. A version is identified by the ID of the roster document
+// and the CAS index the document had after saving.
+type RosterVersion struct {
+	ID       primitive.ObjectID `bson:"_id,omitempty"`
+	RosterID primitive.ObjectID `bson:"rosterId"`
+	CASIndex uint64             `bson:"casIndex"`
+	SavedAt  time.Time          `bson:"savedAt"`
+	Roster   DutyRoster         `bson:"roster"`
+}
@@ -0,0 +1,87 @@
+package structs
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type ShiftSwapState string
+
+const (
+	ShiftSwapStateOffered   = ShiftSwapState("offered")
+	ShiftSwapStateAccepted  = ShiftSwapState("accepted")
+	ShiftSwapStateApproved  = ShiftSwapState("approved")
+	ShiftSwapStateRejected  = ShiftSwapState("rejected")
+	ShiftSwapStateDeclined  = ShiftSwapState("declined")
+	ShiftSwapStateCancelled = ShiftSwapState("cancelled")
+)
+
+// ShiftRef identifies a planned shift within a duty roster.
+type ShiftRef struct {
+	WorkShiftID primitive.ObjectID `bson:"workShiftId"`
+	From        time.Time          `bson:"from"`
+}
+
+// Matches returns true if ref refers to shift.
+func (ref ShiftRef) Matches(shift PlannedShift) bool {
+	return ref.WorkShiftID == shift.WorkShiftID && ref.From.Equal(shift.From)
+}
+
+// ShiftSwap is a request to hand over a planned shift to a colleague or to
+// exchange shifts with a colleague.
+type ShiftSwap struct {
+	ID       primitive.ObjectID `bson:"_id,omitempty"`
+	RosterID primitive.ObjectID `bson:"rosterId"`
+
+	// Shift is the shift of the requestor that should be handed over.
+	Shift       ShiftRef `bson:"shift"`
+	RequestorID string   `bson:"requestorId"`
+
+	// TargetUserID is the colleague that takes over the shift. It is empty
+	// for open offers until a colleague accepts it.
+	TargetUserID string `bson:"targetUserId,omitempty"`
+
+	// ExchangeShift is the shift of the target user that the requestor
+	// takes over in exchange.
+	ExchangeShift *ShiftRef `bson:"exchangeShift,omitempty"`
+
+	State   ShiftSwapState `bson:"state"`
+	Comment string         `bson:"comment,omitempty"`
+
+	CreatedAt  time.Time `bson:"createdAt"`
+	AcceptedAt time.Time `bson:"acceptedAt,omitempty"`
+
+	DecidedBy       string    `bson:"decidedBy,omitempty"`
+	DecidedAt       time.Time `bson:"decidedAt,omitempty"`
+	DecisionComment string    `bson:"decisionComment,omitempty"`
+}
+
+// IsOpen returns true if the swap has not yet been decided.
+func (swap ShiftSwap) IsOpen() bool {
+	return swap.State == ShiftSwapStateOffered || swap.State == ShiftSwapStateAccepted
+}
+
+// Participants returns the IDs of the requestor and the target user.
+func (swap ShiftSwap) Participants() []string {
+	if swap.TargetUserID == "" {
+		return []string{swap.RequestorID}
+	}
+
+	return []string{swap.RequestorID, swap.TargetUserID}
+}
+
+// ShiftSwapFilter is used to search for shift swaps.
+type ShiftSwapFilter struct {
+	RosterID string
+
+	// UserID matches swaps where the user is either the requestor or the
+	// target.
+	UserID string
+
+	// IncludeOpenOffers also matches open offers that do not have a target
+	// user yet.
+	IncludeOpenOffers bool
+
+	States []ShiftSwapState
+}
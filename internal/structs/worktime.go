@@ -18,6 +18,14 @@ type (
 		// Inclusive!
 		EndsWith                time.Time `json:"endsWith,omitempty" bson:"endsWith,omitempty"`
 		ExcludeFromTimeTracking bool      `json:"excludeFromTimeTracking" bson:"excludeFromTimeTracking"`
+
+		// WorkDays optionally holds the weekdays the user is working on. If
+		// empty, the user works on all working days of the clinic.
+		WorkDays []time.Weekday `json:"workDays,omitempty" bson:"workDays,omitempty"`
+		// TimePerWeekday optionally holds the work time for each weekday. If
+		// set, it takes precedence over WorkDays and the sum must match
+		// TimePerWeek.
+		TimePerWeekday map[time.Weekday]time.Duration `json:"timePerWeekday,omitempty" bson:"timePerWeekday,omitempty"`
 	}
 
 	WorkTimeStatus struct {
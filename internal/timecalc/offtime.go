@@ -0,0 +1,146 @@
+package timecalc
+
+import (
+	"time"
+
+	"github.com/tierklinik-dobersberg/rosterd/internal/holiday"
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+)
+
+// DailyOffTimeCosts describes the costs of an off-time request on a single
+// day.
+type DailyOffTimeCosts struct {
+	Date  time.Time
+	Costs time.Duration
+}
+
+// CalculateOffTimeCosts calculates the costs of an off-time request between
+// from and to for each day. Holidays that are days off, days without an
+// applicable work-time and days without expected work time (like weekend
+// days) are skipped. The costs of a day are the expected work time of the
+// user on that day (see ExpectedTimeOn and ScheduledTimeOn), reduced on
+// holidays that only count as a partial working day.
+//
+// See OffTimeRange for how date-only requests are handled. Days that are
+// only covered partially are charged by the hours requested, capped at the
+// expected work time. If a partial day ends before or starts after noon it
+// is treated as a half day and capped at half of the expected work time.
+func CalculateOffTimeCosts(from, to time.Time, workTimes WorkTimeList, holidays holiday.Holidays, weekend Weekend) []DailyOffTimeCosts {
+	from, to = OffTimeRange(from, to)
+
+	var result []DailyOffTimeCosts
+
+	for day := startOfDay(from); day.Before(to); day = day.AddDate(0, 0, 1) {
+		if holidays.IsDayOff(day) {
+			continue
+		}
+
+		wt, ok := workTimes.FindForDate(day)
+		if !ok {
+			continue
+		}
+
+		expectedOn := ExpectedTimeOn
+		if weekend.Contains(day.Weekday()) {
+			expectedOn = ScheduledTimeOn
+		}
+
+		expected := time.Duration(float64(expectedOn(wt, day.Weekday(), weekend)) * holidays.WorkdayFraction(day))
+		if expected <= 0 {
+			continue
+		}
+
+		next := day.AddDate(0, 0, 1)
+
+		start := day
+		if from.After(start) {
+			start = from
+		}
+
+		end := next
+		if to.Before(end) {
+			end = to
+		}
+
+		costs := expected
+
+		// allow requests to end at 23:59 instead of midnight
+		if start.After(day) || next.Sub(end) >= time.Minute {
+			noon := day.Add(12 * time.Hour)
+
+			limit := expected
+			if !end.After(noon) || !start.Before(noon) {
+				limit = expected / 2
+			}
+
+			costs = min(end.Sub(start), limit)
+		}
+
+		if costs <= 0 {
+			continue
+		}
+
+		result = append(result, DailyOffTimeCosts{
+			Date:  day,
+			Costs: costs,
+		})
+	}
+
+	return result
+}
+
+// OffTimeRange returns the effective time range of an off-time request. If
+// to is exactly at midnight the request is treated as date-only and to is
+// moved to the end of that day.
+func OffTimeRange(from, to time.Time) (time.Time, time.Time) {
+	from = from.Local()
+	to = to.Local()
+
+	if to.Equal(startOfDay(to)) {
+		to = to.AddDate(0, 0, 1)
+	}
+
+	return from, to
+}
+
+// DeductOffTime deducts the costs of approved off-time requests from the
+// expected work time so the time a user is on leave is not reported as
+// undertime. Only costs that are linked to an off-time request and dated
+// between from and to (inclusive) are taken into account.
+func DeductOffTime(expected ExpectedMonthlyWorkTimeList, costs []structs.OffTimeCosts, workTimes WorkTimeList, from, to time.Time) {
+	from = startOfDay(from)
+	to = startOfDay(to).AddDate(0, 0, 1)
+
+	for _, c := range costs {
+		if c.OfftimeID.IsZero() || c.Date.Before(from) || !c.Date.Before(to) {
+			continue
+		}
+
+		wt, ok := workTimes.FindForDate(c.Date)
+		if !ok {
+			continue
+		}
+
+		date := c.Date.Local()
+
+		for idx := range expected {
+			e := &expected[idx]
+			if e.Year != date.Year() || e.Month != date.Month() {
+				continue
+			}
+
+			// costs are booked as negative values
+			if wt.ExcludeFromTimeTracking {
+				e.UntrackedWorkTime = max(e.UntrackedWorkTime+c.Costs, 0)
+			} else {
+				e.TrackedWorkTime = max(e.TrackedWorkTime+c.Costs, 0)
+			}
+		}
+	}
+}
+
+func startOfDay(t time.Time) time.Time {
+	t = t.Local()
+
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.Local)
+}
@@ -0,0 +1,262 @@
+package timecalc_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	calendarv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/calendar/v1"
+	"github.com/tierklinik-dobersberg/rosterd/internal/holiday"
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"github.com/tierklinik-dobersberg/rosterd/internal/timecalc"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func Test_CalculateOffTimeCosts(t *testing.T) {
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2024, time.May, day, hour, minute, 0, 0, time.Local)
+	}
+
+	holidays := holiday.FromPublicHolidays(map[string]*calendarv1.PublicHoliday{
+		"2024-05-01": {
+			Date: "2024-05-01",
+			Type: calendarv1.HolidayType_PUBLIC,
+		},
+	})
+
+	workTimes := timecalc.WorkTimeList{
+		{
+			UserID:         "alice",
+			TimePerWeek:    40 * time.Hour,
+			ApplicableFrom: at(1, 0, 0).AddDate(0, -1, 0),
+		},
+		{
+			UserID:         "alice",
+			TimePerWeek:    20 * time.Hour,
+			ApplicableFrom: at(20, 0, 0),
+		},
+	}
+
+	type dayCosts map[string]time.Duration
+
+	cases := []struct {
+		name     string
+		from, to time.Time
+		expected dayCosts
+	}{
+		{
+			// 2024-05-01 is a public holiday, 04 and 05 are weekend days.
+			name: "date only",
+			from: at(1, 0, 0),
+			to:   at(6, 0, 0),
+			expected: dayCosts{
+				"2024-05-02": 8 * time.Hour,
+				"2024-05-03": 8 * time.Hour,
+				"2024-05-06": 8 * time.Hour,
+			},
+		},
+		{
+			name: "end of day",
+			from: at(2, 0, 0),
+			to:   at(2, 23, 59),
+			expected: dayCosts{
+				"2024-05-02": 8 * time.Hour,
+			},
+		},
+		{
+			name: "hourly",
+			from: at(2, 9, 0),
+			to:   at(2, 11, 30),
+			expected: dayCosts{
+				"2024-05-02": 150 * time.Minute,
+			},
+		},
+		{
+			name: "half day morning",
+			from: at(2, 7, 0),
+			to:   at(2, 12, 0),
+			expected: dayCosts{
+				"2024-05-02": 4 * time.Hour,
+			},
+		},
+		{
+			name: "half day afternoon",
+			from: at(2, 12, 0),
+			to:   at(2, 23, 59),
+			expected: dayCosts{
+				"2024-05-02": 4 * time.Hour,
+			},
+		},
+		{
+			name: "partial day across noon",
+			from: at(2, 10, 0),
+			to:   at(2, 15, 0),
+			expected: dayCosts{
+				"2024-05-02": 5 * time.Hour,
+			},
+		},
+		{
+			name: "partial first and last day",
+			from: at(2, 12, 0),
+			to:   at(3, 10, 0),
+			expected: dayCosts{
+				"2024-05-02": 4 * time.Hour,
+				"2024-05-03": 4 * time.Hour,
+			},
+		},
+		{
+			name: "work-time changes",
+			from: at(17, 0, 0),
+			to:   at(20, 0, 0),
+			expected: dayCosts{
+				"2024-05-17": 8 * time.Hour,
+				"2024-05-20": 4 * time.Hour,
+			},
+		},
+		{
+			name:     "weekend only",
+			from:     at(4, 0, 0),
+			to:       at(5, 0, 0),
+			expected: dayCosts{},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			result := timecalc.CalculateOffTimeCosts(c.from, c.to, workTimes, holidays, timecalc.DefaultWeekend)
+
+			actual := dayCosts{}
+			for _, r := range result {
+				actual[r.Date.Format("2006-01-02")] = r.Costs
+			}
+
+			require.Equal(t, c.expected, actual)
+		})
+	}
+}
+
+func Test_CalculateOffTimeCosts_WorkDays(t *testing.T) {
+	workTimes := timecalc.WorkTimeList{
+		{
+			UserID:         "bob",
+			TimePerWeek:    24 * time.Hour,
+			WorkDays:       []time.Weekday{time.Monday, time.Tuesday, time.Wednesday},
+			ApplicableFrom: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.Local),
+		},
+	}
+
+	// 2024-05-06 is a Monday
+	result := timecalc.CalculateOffTimeCosts(
+		time.Date(2024, time.May, 6, 0, 0, 0, 0, time.Local),
+		time.Date(2024, time.May, 10, 0, 0, 0, 0, time.Local),
+		workTimes,
+		nil,
+		timecalc.DefaultWeekend,
+	)
+
+	require.Len(t, result, 3)
+	for _, r := range result {
+		require.Equal(t, 8*time.Hour, r.Costs)
+	}
+}
+
+func Test_CalculateOffTimeCosts_Saturday(t *testing.T) {
+	workTimes := timecalc.WorkTimeList{
+		{
+			UserID:         "bob",
+			TimePerWeek:    20 * time.Hour,
+			WorkDays:       []time.Weekday{time.Monday, time.Tuesday, time.Thursday, time.Saturday},
+			ApplicableFrom: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.Local),
+		},
+	}
+
+	// 2024-05-11 is a Saturday
+	result := timecalc.CalculateOffTimeCosts(
+		time.Date(2024, time.May, 11, 0, 0, 0, 0, time.Local),
+		time.Date(2024, time.May, 12, 0, 0, 0, 0, time.Local),
+		workTimes,
+		nil,
+		timecalc.DefaultWeekend,
+	)
+
+	require.Len(t, result, 1)
+	require.Equal(t, "2024-05-11", result[0].Date.Format("2006-01-02"))
+	require.Equal(t, 5*time.Hour, result[0].Costs)
+}
+
+func Test_CalculateOffTimeCosts_HalfDay(t *testing.T) {
+	workTimes := timecalc.WorkTimeList{
+		{
+			UserID:         "bob",
+			TimePerWeek:    40 * time.Hour,
+			ApplicableFrom: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.Local),
+		},
+	}
+
+	holidays := holiday.Holidays{
+		"2024-12-24": holiday.FromLocalHoliday(structs.LocalHoliday{Date: "2024-12-24", Name: "Heiliger Abend", CountsAsWorkday: true, WorkdayFraction: 0.5}),
+	}
+
+	result := timecalc.CalculateOffTimeCosts(
+		time.Date(2024, time.December, 23, 0, 0, 0, 0, time.Local),
+		time.Date(2024, time.December, 24, 0, 0, 0, 0, time.Local),
+		workTimes,
+		holidays,
+		timecalc.DefaultWeekend,
+	)
+
+	require.Len(t, result, 2)
+	require.Equal(t, 8*time.Hour, result[0].Costs)
+	require.Equal(t, 4*time.Hour, result[1].Costs)
+}
+
+func Test_DeductOffTime(t *testing.T) {
+	workTimes := timecalc.WorkTimeList{
+		{
+			UserID:         "alice",
+			TimePerWeek:    40 * time.Hour,
+			ApplicableFrom: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.Local),
+		},
+	}
+
+	expected := timecalc.ExpectedMonthlyWorkTimeList{
+		{Year: 2024, Month: time.May, TrackedWorkTime: 160 * time.Hour},
+		{Year: 2024, Month: time.June, TrackedWorkTime: 160 * time.Hour},
+	}
+
+	costs := []structs.OffTimeCosts{
+		{
+			OfftimeID: primitive.NewObjectID(),
+			Date:      time.Date(2024, time.May, 31, 0, 0, 0, 0, time.Local),
+			Costs:     -8 * time.Hour,
+		},
+		{
+			OfftimeID: primitive.NewObjectID(),
+			Date:      time.Date(2024, time.June, 3, 0, 0, 0, 0, time.Local),
+			Costs:     -4 * time.Hour,
+		},
+		// outside of the range
+		{
+			OfftimeID: primitive.NewObjectID(),
+			Date:      time.Date(2024, time.July, 1, 0, 0, 0, 0, time.Local),
+			Costs:     -8 * time.Hour,
+		},
+		// not linked to an off-time request
+		{
+			RosterID: primitive.NewObjectID(),
+			Date:     time.Date(2024, time.May, 2, 0, 0, 0, 0, time.Local),
+			Costs:    -8 * time.Hour,
+		},
+	}
+
+	timecalc.DeductOffTime(
+		expected,
+		costs,
+		workTimes,
+		time.Date(2024, time.May, 1, 0, 0, 0, 0, time.Local),
+		time.Date(2024, time.June, 30, 0, 0, 0, 0, time.Local),
+	)
+
+	require.Equal(t, 152*time.Hour, expected[0].TrackedWorkTime)
+	require.Equal(t, 156*time.Hour, expected[1].TrackedWorkTime)
+}
@@ -0,0 +1,110 @@
+package timecalc
+
+import "time"
+
+// MonthlyOvertime describes the overtime of a single user in a month.
+type MonthlyOvertime struct {
+	Year  int
+	Month time.Month
+
+	// Expected is the expected work time.
+	Expected time.Duration
+
+	// Planned is the planned work time.
+	Planned time.Duration
+
+	// Allowance is the overtime allowance for the month. Overtime up to the
+	// allowance is already paid by the salary of the user.
+	Allowance time.Duration
+
+	// Raw is the difference between the planned and the expected work time.
+	Raw time.Duration
+
+	// Overtime is the overtime after deducting the allowance. Undertime is
+	// not affected by the allowance.
+	Overtime time.Duration
+}
+
+type MonthlyOvertimeList []MonthlyOvertime
+
+// TotalRaw returns the sum of the raw overtime of all months.
+func (lst MonthlyOvertimeList) TotalRaw() time.Duration {
+	var sum time.Duration
+	for _, m := range lst {
+		sum += m.Raw
+	}
+
+	return sum
+}
+
+// TotalOvertime returns the sum of the allowance-adjusted overtime of all
+// months.
+func (lst MonthlyOvertimeList) TotalOvertime() time.Duration {
+	var sum time.Duration
+	for _, m := range lst {
+		sum += m.Overtime
+	}
+
+	return sum
+}
+
+// TotalAllowance returns the sum of the overtime allowance of all months.
+func (lst MonthlyOvertimeList) TotalAllowance() time.Duration {
+	var sum time.Duration
+	for _, m := range lst {
+		sum += m.Allowance
+	}
+
+	return sum
+}
+
+// CalculateOvertime calculates the overtime of a user for each month in
+// expected. The overtime allowance is applied per month so overtime of one
+// month cannot be used to reduce the allowance of another one.
+// If trackedOnly is set, only work time with time-tracking enabled is taken
+// into account.
+func CalculateOvertime(expected ExpectedMonthlyWorkTimeList, planned PlannedMonthlyWorkTimeList, userId string, trackedOnly bool) MonthlyOvertimeList {
+	result := make(MonthlyOvertimeList, len(expected))
+
+	for idx, e := range expected {
+		m := MonthlyOvertime{
+			Year:      e.Year,
+			Month:     e.Month,
+			Expected:  e.TrackedWorkTime,
+			Allowance: e.OvertimeAllowance,
+		}
+
+		if !trackedOnly {
+			m.Expected += e.UntrackedWorkTime
+		}
+
+		for _, p := range planned {
+			if p.Year != e.Year || p.Month != e.Month {
+				continue
+			}
+
+			if ut, ok := p.PerUser[userId]; ok {
+				if trackedOnly {
+					m.Planned += ut.Tracked
+				} else {
+					m.Planned += ut.Total()
+				}
+			}
+		}
+
+		m.Raw = m.Planned - m.Expected
+
+		switch {
+		case m.Raw > m.Allowance:
+			m.Overtime = m.Raw - m.Allowance
+		case m.Raw > 0:
+			m.Overtime = 0
+		default:
+			m.Overtime = m.Raw
+		}
+
+		result[idx] = m
+	}
+
+	return result
+}
@@ -0,0 +1,266 @@
+package timecalc
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/tierklinik-dobersberg/rosterd/internal/holiday"
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+)
+
+// NightWindow describes the daily time window that counts as night work.
+// Start and End are offsets from midnight. If End is before Start the window
+// spans midnight.
+type NightWindow struct {
+	Start time.Duration
+	End   time.Duration
+}
+
+// ParseNightWindow parses a night window in the format HH:MM-HH:MM.
+func ParseNightWindow(val string) (NightWindow, error) {
+	start, end, ok := strings.Cut(val, "-")
+	if !ok {
+		return NightWindow{}, fmt.Errorf("invalid night window %q: expected HH:MM-HH:MM", val)
+	}
+
+	var (
+		w   NightWindow
+		err error
+	)
+
+	if w.Start, err = parseClock(start); err != nil {
+		return NightWindow{}, fmt.Errorf("invalid night window %q: %w", val, err)
+	}
+
+	if w.End, err = parseClock(end); err != nil {
+		return NightWindow{}, fmt.Errorf("invalid night window %q: %w", val, err)
+	}
+
+	return w, nil
+}
+
+// EnvDecode implements envconfig.Decoder.
+func (w *NightWindow) EnvDecode(val string) error {
+	parsed, err := ParseNightWindow(val)
+	if err != nil {
+		return err
+	}
+
+	*w = parsed
+
+	return nil
+}
+
+func (w NightWindow) String() string {
+	format := func(d time.Duration) string {
+		return fmt.Sprintf("%02d:%02d", int(d.Hours()), int(d.Minutes())%60)
+	}
+
+	return format(w.Start) + "-" + format(w.End)
+}
+
+// Overlap returns how much of the time between from and to falls into the
+// night window.
+func (w NightWindow) Overlap(from, to time.Time) time.Duration {
+	if w.Start == w.End || !to.After(from) {
+		return 0
+	}
+
+	var sum time.Duration
+
+	// start one day earlier to catch windows that span midnight
+	for day := startOfDay(from).AddDate(0, 0, -1); day.Before(to); day = day.AddDate(0, 0, 1) {
+		windowStart := atClock(day, w.Start)
+		windowEnd := atClock(day, w.End)
+		if w.End < w.Start {
+			windowEnd = atClock(day.AddDate(0, 0, 1), w.End)
+		}
+
+		start := maxTime(from, windowStart)
+		end := minTime(to, windowEnd)
+
+		if end.After(start) {
+			sum += end.Sub(start)
+		}
+	}
+
+	return sum
+}
+
+// PayrollHours splits planned work time into payroll categories. Weekday,
+// Weekend and Holiday add up to Total while Night overlaps with them.
+type PayrollHours struct {
+	Total   time.Duration
+	Weekday time.Duration
+	Weekend time.Duration
+	Holiday time.Duration
+	Night   time.Duration
+}
+
+// Add adds the hours of other to h.
+func (h *PayrollHours) Add(other PayrollHours) {
+	h.Total += other.Total
+	h.Weekday += other.Weekday
+	h.Weekend += other.Weekend
+	h.Holiday += other.Holiday
+	h.Night += other.Night
+}
+
+// SplitShiftHours splits the time-worth of shift into payroll categories.
+// Shifts that span midnight are split at day boundaries and holidays that are
+// days off take precedence over weekend days. If the time-worth of the shift
+// differs from its duration all categories are weighted accordingly so shifts
+// without a time-worth (like standby duties) do not count at all.
+func SplitShiftHours(shift structs.PlannedShift, holidays holiday.Holidays, weekend Weekend, night NightWindow) PayrollHours {
+	from := shift.From.Local()
+	to := shift.To.Local()
+
+	duration := to.Sub(from)
+	if duration <= 0 || shift.TimeWorth <= 0 {
+		return PayrollHours{}
+	}
+
+	var (
+		result PayrollHours
+		factor = float64(shift.TimeWorth) / float64(duration)
+		weight = func(d time.Duration) time.Duration {
+			return time.Duration(float64(d) * factor)
+		}
+	)
+
+	for segStart := from; segStart.Before(to); {
+		segEnd := minTime(startOfDay(segStart).AddDate(0, 0, 1), to)
+		d := weight(segEnd.Sub(segStart))
+
+		switch {
+		case holidays.IsDayOff(segStart):
+			result.Holiday += d
+		case weekend.Contains(segStart.Weekday()):
+			result.Weekend += d
+		default:
+			result.Weekday += d
+		}
+
+		result.Night += weight(night.Overlap(segStart, segEnd))
+
+		segStart = segEnd
+	}
+
+	// avoid rounding differences between the total and the categories.
+	result.Total = result.Weekday + result.Weekend + result.Holiday
+
+	return result
+}
+
+// CalculatePayrollHours splits the planned work time of all users between
+// from and to (inclusive, YYYY-MM-DD) into payroll categories. Like
+// CalculatePlannedMonthlyWorkTime, shifts are accounted to the month they
+// start in. The result is indexed by user ID and month (YYYY-MM).
+func CalculatePayrollHours(rosters []structs.DutyRoster, from, to string, holidays holiday.Holidays, weekend Weekend, night NightWindow) (map[string]map[string]PayrollHours, error) {
+	fromTime, err := time.ParseInLocation("2006-01-02", from, time.Local)
+	if err != nil {
+		return nil, fmt.Errorf("invalid from value: %w", err)
+	}
+
+	toTime, err := time.ParseInLocation("2006-01-02", to, time.Local)
+	if err != nil {
+		return nil, fmt.Errorf("invalid to value: %w", err)
+	}
+	toTime = toTime.AddDate(0, 0, 1)
+
+	result := make(map[string]map[string]PayrollHours)
+
+	for _, roster := range rosters {
+		for _, shift := range roster.Shifts {
+			if shift.From.Before(fromTime) || !shift.From.Before(toTime) {
+				continue
+			}
+
+			hours := SplitShiftHours(shift, holidays, weekend, night)
+			key := shift.From.Local().Format("2006-01")
+
+			for _, userId := range shift.AssignedUserIds {
+				if result[userId] == nil {
+					result[userId] = make(map[string]PayrollHours)
+				}
+
+				h := result[userId][key]
+				h.Add(hours)
+				result[userId][key] = h
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// OffTimeTaken holds the vacation and compensatory time-off a user has taken.
+type OffTimeTaken struct {
+	Vacation time.Duration
+	TimeOff  time.Duration
+}
+
+// CalculateOffTimeTaken sums up the costs of approved off-time requests per
+// user and month (YYYY-MM). Costs that are not linked to an off-time request,
+// like booked overtime, are ignored.
+func CalculateOffTimeTaken(costs []structs.OffTimeCosts, from, to time.Time) map[string]map[string]OffTimeTaken {
+	from = startOfDay(from)
+	to = startOfDay(to).AddDate(0, 0, 1)
+
+	result := make(map[string]map[string]OffTimeTaken)
+
+	for _, c := range costs {
+		if c.OfftimeID.IsZero() || c.Date.Before(from) || !c.Date.Before(to) {
+			continue
+		}
+
+		key := c.Date.Local().Format("2006-01")
+		if result[c.UserID] == nil {
+			result[c.UserID] = make(map[string]OffTimeTaken)
+		}
+
+		// costs are booked as negative values
+		taken := result[c.UserID][key]
+		if c.IsVacation {
+			taken.Vacation -= c.Costs
+		} else {
+			taken.TimeOff -= c.Costs
+		}
+
+		result[c.UserID][key] = taken
+	}
+
+	return result
+}
+
+func parseClock(val string) (time.Duration, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(val))
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q: expected HH:MM", val)
+	}
+
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// atClock returns the wall-clock time d after midnight on day. Unlike
+// day.Add(d) this is not affected by daylight saving time changes.
+func atClock(day time.Time, d time.Duration) time.Time {
+	return time.Date(day.Year(), day.Month(), day.Day(), int(d.Hours()), int(d.Minutes())%60, 0, 0, time.Local)
+}
+
+func minTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+
+	return b
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+
+	return b
+}
@@ -0,0 +1,195 @@
+package timecalc_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	calendarv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/calendar/v1"
+	"github.com/tierklinik-dobersberg/rosterd/internal/holiday"
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"github.com/tierklinik-dobersberg/rosterd/internal/timecalc"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func Test_ParseNightWindow(t *testing.T) {
+	w, err := timecalc.ParseNightWindow("22:00-06:30")
+	require.NoError(t, err)
+	require.Equal(t, 22*time.Hour, w.Start)
+	require.Equal(t, 6*time.Hour+30*time.Minute, w.End)
+	require.Equal(t, "22:00-06:30", w.String())
+
+	for _, invalid := range []string{"", "22:00", "22-06", "25:00-06:00"} {
+		_, err := timecalc.ParseNightWindow(invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+func Test_NightWindow_Overlap(t *testing.T) {
+	at := func(day, hour int) time.Time {
+		return time.Date(2024, time.May, day, hour, 0, 0, 0, time.Local)
+	}
+
+	night := timecalc.NightWindow{Start: 22 * time.Hour, End: 6 * time.Hour}
+
+	cases := []struct {
+		name     string
+		from, to time.Time
+		expected time.Duration
+	}{
+		{"day shift", at(6, 8), at(6, 16), 0},
+		{"evening", at(6, 18), at(6, 23), time.Hour},
+		{"overnight", at(6, 20), at(7, 8), 8 * time.Hour},
+		{"early morning", at(7, 2), at(7, 10), 4 * time.Hour},
+		{"24 hours", at(6, 8), at(7, 8), 8 * time.Hour},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			require.Equal(t, c.expected, night.Overlap(c.from, c.to))
+		})
+	}
+
+	require.Zero(t, timecalc.NightWindow{}.Overlap(at(6, 0), at(7, 0)))
+
+	day := timecalc.NightWindow{Start: 8 * time.Hour, End: 12 * time.Hour}
+	require.Equal(t, 2*time.Hour, day.Overlap(at(6, 10), at(6, 16)))
+}
+
+func Test_SplitShiftHours(t *testing.T) {
+	at := func(day, hour int) time.Time {
+		return time.Date(2024, time.May, day, hour, 0, 0, 0, time.Local)
+	}
+
+	holidays := holiday.FromPublicHolidays(map[string]*calendarv1.PublicHoliday{
+		// Wednesday
+		"2024-05-01": {Date: "2024-05-01", Type: calendarv1.HolidayType_PUBLIC},
+		// not a public holiday and must be ignored
+		"2024-05-02": {Date: "2024-05-02", Type: calendarv1.HolidayType_SCHOOL},
+	})
+
+	night := timecalc.NightWindow{Start: 22 * time.Hour, End: 6 * time.Hour}
+
+	cases := []struct {
+		name     string
+		shift    structs.PlannedShift
+		expected timecalc.PayrollHours
+	}{
+		{
+			name:  "weekday",
+			shift: structs.PlannedShift{From: at(2, 8), To: at(2, 16), TimeWorth: 8 * time.Hour},
+			expected: timecalc.PayrollHours{
+				Total:   8 * time.Hour,
+				Weekday: 8 * time.Hour,
+			},
+		},
+		{
+			name:  "holiday",
+			shift: structs.PlannedShift{From: at(1, 8), To: at(1, 16), TimeWorth: 8 * time.Hour},
+			expected: timecalc.PayrollHours{
+				Total:   8 * time.Hour,
+				Holiday: 8 * time.Hour,
+			},
+		},
+		{
+			// Friday 20:00 to Saturday 08:00
+			name:  "overnight into weekend",
+			shift: structs.PlannedShift{From: at(3, 20), To: at(4, 8), TimeWorth: 12 * time.Hour},
+			expected: timecalc.PayrollHours{
+				Total:   12 * time.Hour,
+				Weekday: 4 * time.Hour,
+				Weekend: 8 * time.Hour,
+				Night:   8 * time.Hour,
+			},
+		},
+		{
+			// Tuesday 20:00 to Wednesday (holiday) 08:00, worth half of
+			// its duration.
+			name:  "time worth",
+			shift: structs.PlannedShift{From: time.Date(2024, time.April, 30, 20, 0, 0, 0, time.Local), To: at(1, 8), TimeWorth: 6 * time.Hour},
+			expected: timecalc.PayrollHours{
+				Total:   6 * time.Hour,
+				Weekday: 2 * time.Hour,
+				Holiday: 4 * time.Hour,
+				Night:   4 * time.Hour,
+			},
+		},
+		{
+			// standby duties do not have a time-worth and must not be
+			// counted.
+			name:     "zero time worth",
+			shift:    structs.PlannedShift{From: at(3, 20), To: at(4, 8)},
+			expected: timecalc.PayrollHours{},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			require.Equal(t, c.expected, timecalc.SplitShiftHours(c.shift, holidays, timecalc.DefaultWeekend, night))
+		})
+	}
+}
+
+func Test_CalculatePayrollHours(t *testing.T) {
+	at := func(month time.Month, day, hour int) time.Time {
+		return time.Date(2024, month, day, hour, 0, 0, 0, time.Local)
+	}
+
+	rosters := []structs.DutyRoster{
+		{
+			Shifts: []structs.PlannedShift{
+				{From: at(time.April, 30, 20), To: at(time.May, 1, 8), TimeWorth: 12 * time.Hour, AssignedUserIds: []string{"alice"}},
+				{From: at(time.May, 6, 8), To: at(time.May, 6, 16), TimeWorth: 8 * time.Hour, AssignedUserIds: []string{"alice", "bob"}},
+				// standby without time-worth
+				{From: at(time.May, 6, 16), To: at(time.May, 6, 23), AssignedUserIds: []string{"alice"}},
+				{From: at(time.May, 31, 20), To: at(time.June, 1, 8), TimeWorth: 12 * time.Hour, AssignedUserIds: []string{"bob"}},
+				// out of range
+				{From: at(time.June, 3, 8), To: at(time.June, 3, 16), TimeWorth: 8 * time.Hour, AssignedUserIds: []string{"bob"}},
+			},
+		},
+	}
+
+	result, err := timecalc.CalculatePayrollHours(rosters, "2024-04-01", "2024-05-31", nil, timecalc.DefaultWeekend, timecalc.NightWindow{Start: 22 * time.Hour, End: 6 * time.Hour})
+	require.NoError(t, err)
+
+	require.Equal(t, map[string]map[string]timecalc.PayrollHours{
+		"alice": {
+			"2024-04": {Total: 12 * time.Hour, Weekday: 12 * time.Hour, Night: 8 * time.Hour},
+			"2024-05": {Total: 8 * time.Hour, Weekday: 8 * time.Hour},
+		},
+		"bob": {
+			// Friday 20:00 to Saturday 08:00 is accounted to May
+			"2024-05": {Total: 20 * time.Hour, Weekday: 12 * time.Hour, Weekend: 8 * time.Hour, Night: 8 * time.Hour},
+		},
+	}, result)
+
+	_, err = timecalc.CalculatePayrollHours(rosters, "2024-04", "2024-05-31", nil, timecalc.DefaultWeekend, timecalc.NightWindow{})
+	require.Error(t, err)
+}
+
+func Test_CalculateOffTimeTaken(t *testing.T) {
+	at := func(month time.Month, day int) time.Time {
+		return time.Date(2024, month, day, 0, 0, 0, 0, time.Local)
+	}
+
+	offTimeID := primitive.NewObjectID()
+
+	costs := []structs.OffTimeCosts{
+		{UserID: "alice", OfftimeID: offTimeID, Date: at(time.May, 2), Costs: -8 * time.Hour, IsVacation: true},
+		{UserID: "alice", OfftimeID: offTimeID, Date: at(time.May, 3), Costs: -8 * time.Hour, IsVacation: true},
+		{UserID: "alice", OfftimeID: offTimeID, Date: at(time.June, 3), Costs: -4 * time.Hour},
+		// booked overtime is not linked to an off-time request
+		{UserID: "alice", Date: at(time.May, 10), Costs: 10 * time.Hour},
+		// out of range
+		{UserID: "bob", OfftimeID: offTimeID, Date: at(time.July, 1), Costs: -8 * time.Hour, IsVacation: true},
+	}
+
+	result := timecalc.CalculateOffTimeTaken(costs, at(time.May, 1), at(time.June, 30))
+
+	require.Equal(t, map[string]map[string]timecalc.OffTimeTaken{
+		"alice": {
+			"2024-05": {Vacation: 16 * time.Hour},
+			"2024-06": {TimeOff: 4 * time.Hour},
+		},
+	}, result)
+}
@@ -6,8 +6,8 @@ import (
 	stdlog "log"
 	"time"
 
-	calendarv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/calendar/v1"
 	"github.com/tierklinik-dobersberg/apis/pkg/log"
+	"github.com/tierklinik-dobersberg/rosterd/internal/holiday"
 	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
 	"golang.org/x/exp/maps"
 )
@@ -16,13 +16,39 @@ type MonthlyWorkDays struct {
 	Year     int
 	Month    time.Month
 	WorkDays []int
+
+	// WeekendDays holds the days of the clinic weekend that are not days
+	// off. They are not working days of the clinic but users may still be
+	// expected to work on them (see ScheduledTimeOn).
+	WeekendDays []int
+
+	// Fractions holds the part of a regular working day that is expected on
+	// days of WorkDays and WeekendDays that are not full working days (like
+	// half days). Days that are not part of Fractions are full working days.
+	Fractions map[int]float64
+}
+
+// Fraction returns the part of a regular working day that is expected on
+// date.
+func (mwd MonthlyWorkDays) Fraction(date int) float64 {
+	if fraction, ok := mwd.Fractions[date]; ok {
+		return fraction
+	}
+
+	return 1
 }
 
 func (mwd MonthlyWorkDays) String() string {
 	return fmt.Sprintf("WorkDays{for=%02d/%04d days=%d}", mwd.Month, mwd.Year, len(mwd.WorkDays))
 }
 
-func GatherWorkDaysByMonth(holidays map[string]*calendarv1.PublicHoliday, from, to string) ([]MonthlyWorkDays, error) {
+// GatherWorkDaysByMonth returns all working days of the clinic between from
+// and to grouped by month. Holidays that are days off (see
+// holiday.Holidays.IsDayOff) and days that are part of the weekend are not
+// working days. Weekend days that are not days off are returned as
+// WeekendDays. Holidays that only count as a partial working day (see
+// holiday.Holidays.WorkdayFraction) are recorded in Fractions.
+func GatherWorkDaysByMonth(holidays holiday.Holidays, from, to string, weekend Weekend) ([]MonthlyWorkDays, error) {
 	var (
 		result  []MonthlyWorkDays
 		current *MonthlyWorkDays
@@ -58,20 +84,27 @@ func GatherWorkDaysByMonth(holidays map[string]*calendarv1.PublicHoliday, from,
 			}
 		}
 
-		// check if iter is a public holiday and continue to the next if it is
-		if hd, ok := holidays[iter.Format("2006-01-02")]; ok && hd.Type == calendarv1.HolidayType_PUBLIC {
+		// check if iter is a holiday that does not count as a working day
+		// and continue to the next if it is
+		if holidays.IsDayOff(iter) {
 			continue
 		}
 
-		// check the week-day to see if iter is a regular working day
-		switch iter.Weekday() {
-		case time.Saturday, time.Sunday:
-			// Weekend days do not count as regular working days.
-			continue
+		if fraction := holidays.WorkdayFraction(iter); fraction < 1 {
+			if current.Fractions == nil {
+				current.Fractions = make(map[int]float64)
+			}
+
+			current.Fractions[date] = fraction
+		}
 
-		default:
-			current.WorkDays = append(current.WorkDays, date)
+		// Weekend days do not count as regular working days.
+		if weekend.Contains(iter.Weekday()) {
+			current.WeekendDays = append(current.WeekendDays, date)
+			continue
 		}
+
+		current.WorkDays = append(current.WorkDays, date)
 	}
 
 	if current != nil {
@@ -86,6 +119,11 @@ type ExpectedMonthlyWorkTime struct {
 	Month             time.Month
 	TrackedWorkTime   time.Duration
 	UntrackedWorkTime time.Duration
+
+	// OvertimeAllowance holds the overtime allowance of the month. If only
+	// a part of the month is covered, the allowance is prorated by the number
+	// of covered calendar days.
+	OvertimeAllowance time.Duration
 }
 
 func (mwt ExpectedMonthlyWorkTime) String() string {
@@ -114,12 +152,17 @@ func (emwtl ExpectedMonthlyWorkTimeList) TotalWorkTime() time.Duration {
 	return workTime
 }
 
+// CalculateExpectedWorkTime calculates the work time that is expected from
+// each user on the working and weekend days in monthlyWorkDays. See
+// ExpectedTimeOn and ScheduledTimeOn for how the expected time per day is
+// determined.
 func CalculateExpectedWorkTime(
 	ctx context.Context,
 	monthlyWorkDays []MonthlyWorkDays,
 	workTimes map[string]WorkTimeList,
 	from string,
 	to string,
+	weekend Weekend,
 ) (map[string]ExpectedMonthlyWorkTimeList, error) {
 
 	var (
@@ -157,9 +200,24 @@ func CalculateExpectedWorkTime(
 			}
 		}
 
-		for _, date := range mwd.WorkDays {
+		for userId := range workTimes {
+			result[userId][idx].OvertimeAllowance = calculateOvertimeAllowance(workTimes[userId], mwd.Year, mwd.Month, fromTime, toTime)
+		}
+
+		days := make([]int, 0, len(mwd.WorkDays)+len(mwd.WeekendDays))
+		days = append(days, mwd.WorkDays...)
+		days = append(days, mwd.WeekendDays...)
+
+		for dayIdx, date := range days {
 			dateTime := time.Date(mwd.Year, mwd.Month, date, 0, 0, 0, 0, time.Local)
 
+			// weekend days are only worked by users that have them in their
+			// schedule.
+			expectedOn := ExpectedTimeOn
+			if dayIdx >= len(mwd.WorkDays) {
+				expectedOn = ScheduledTimeOn
+			}
+
 			if !fromTime.IsZero() && dateTime.Before(fromTime) {
 				continue
 			}
@@ -177,12 +235,12 @@ func CalculateExpectedWorkTime(
 				}
 
 				// Update the WorkTime for this month
-				timePerWorkDay := float64(wt.TimePerWeek) / 5.0
+				timePerWorkDay := time.Duration(float64(expectedOn(wt, dateTime.Weekday(), weekend)) * mwd.Fraction(date))
 
 				if wt.ExcludeFromTimeTracking {
-					result[userId][idx].UntrackedWorkTime += time.Duration(timePerWorkDay)
+					result[userId][idx].UntrackedWorkTime += timePerWorkDay
 				} else {
-					result[userId][idx].TrackedWorkTime += time.Duration(timePerWorkDay)
+					result[userId][idx].TrackedWorkTime += timePerWorkDay
 				}
 			}
 		}
@@ -191,6 +249,39 @@ func CalculateExpectedWorkTime(
 	return result, nil
 }
 
+// calculateOvertimeAllowance returns the overtime allowance for the given
+// month. Only calendar days between from and to (if set) are taken into
+// account and each day is worth 1/n of the monthly allowance of the work-time
+// that applies to it. Work-times with time-tracking disabled do not have an
+// overtime allowance.
+func calculateOvertimeAllowance(workTimes WorkTimeList, year int, month time.Month, from, to time.Time) time.Duration {
+	var (
+		start       = time.Date(year, month, 1, 0, 0, 0, 0, time.Local)
+		end         = start.AddDate(0, 1, 0)
+		daysInMonth = float64(end.AddDate(0, 0, -1).Day())
+		allowance   float64
+	)
+
+	for iter := start; iter.Before(end); iter = iter.AddDate(0, 0, 1) {
+		if !from.IsZero() && iter.Before(from) {
+			continue
+		}
+
+		if !to.IsZero() && iter.After(to) {
+			continue
+		}
+
+		wt, ok := workTimes.FindForDate(iter)
+		if !ok || wt.ExcludeFromTimeTracking {
+			continue
+		}
+
+		allowance += float64(wt.OvertimeAllowancePerMonth) / daysInMonth
+	}
+
+	return time.Duration(allowance).Round(time.Second)
+}
+
 type WorkTimeList []structs.WorkTime
 
 func (wtl WorkTimeList) FindForDate(t time.Time) (structs.WorkTime, bool) {
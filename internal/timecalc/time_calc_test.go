@@ -10,12 +10,13 @@ import (
 	"github.com/stretchr/testify/require"
 	calendarv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/calendar/v1"
 	"github.com/tierklinik-dobersberg/cis/pkg/daytime"
+	"github.com/tierklinik-dobersberg/rosterd/internal/holiday"
 	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
 	"github.com/tierklinik-dobersberg/rosterd/internal/timecalc"
 )
 
 func Test_GatherWorkDaysByMonth(t *testing.T) {
-	holidays := map[string]*calendarv1.PublicHoliday{
+	holidays := holiday.FromPublicHolidays(map[string]*calendarv1.PublicHoliday{
 		"2024-05-01": {
 			Name: "Staatsfeiertag",
 			Type: calendarv1.HolidayType_PUBLIC,
@@ -36,7 +37,7 @@ func Test_GatherWorkDaysByMonth(t *testing.T) {
 			Name: "Does-Not-Exist",
 			Type: calendarv1.HolidayType_BANK,
 		},
-	}
+	})
 
 	cases := []struct {
 		from                   string
@@ -90,7 +91,7 @@ func Test_GatherWorkDaysByMonth(t *testing.T) {
 	for idx, testCase := range cases {
 		title := fmt.Sprintf("#%d %s to %s (expected %d)", idx, testCase.from, testCase.to, testCase.expectedWorkDays)
 		t.Run(title, func(t *testing.T) {
-			result, err := timecalc.GatherWorkDaysByMonth(holidays, testCase.from, testCase.to)
+			result, err := timecalc.GatherWorkDaysByMonth(holidays, testCase.from, testCase.to, timecalc.DefaultWeekend)
 
 			if testCase.errorExpected {
 				require.Error(t, err)
@@ -293,7 +294,7 @@ func Test_CalculateExpectedWorkTime(t *testing.T) {
 
 			result, err := timecalc.CalculateExpectedWorkTime(context.TODO(), testCase.days, map[string]timecalc.WorkTimeList{
 				"bob": workTimes,
-			}, testCase.from, testCase.to)
+			}, testCase.from, testCase.to, timecalc.DefaultWeekend)
 
 			if testCase.errorExpected {
 				require.Error(t, err)
@@ -353,10 +354,15 @@ func Test_CalculatePlannedMonthlyWorkTime(t *testing.T) {
 		return structs.PlannedShift{
 			From:            from,
 			To:              to,
+			TimeWorth:       to.Sub(from),
 			AssignedUserIds: users,
 		}
 	}
 
+	// standby shifts are configured to be worth nothing
+	standby := makeShift(time.May, 1, "17:00", "23:00", "bob", "alice")
+	standby.TimeWorth = 0
+
 	rosterMay := structs.DutyRoster{
 		From: "2024-05-01",
 		To:   "2024-05-31",
@@ -365,6 +371,7 @@ func Test_CalculatePlannedMonthlyWorkTime(t *testing.T) {
 			makeShift(time.May, 1, "14:00", "17:00", "bob"),
 			makeShift(time.May, 2, "08:00", "12:00", "alice"),
 			makeShift(time.May, 2, "14:00", "17:00", "alice"),
+			standby,
 		},
 	}
 
@@ -468,3 +475,185 @@ func Test_CalculatePlannedMonthlyWorkTime(t *testing.T) {
 	}
 
 }
+
+func Test_CalculateExpectedWorkTime_OvertimeAllowance(t *testing.T) {
+	workTimes := timecalc.WorkTimeList{
+		{
+			UserID:                    "bob",
+			ApplicableFrom:            time.Date(2024, time.May, 1, 0, 0, 0, 0, time.Local),
+			TimePerWeek:               40 * time.Hour,
+			OvertimeAllowancePerMonth: 31 * time.Hour,
+		},
+		{
+			UserID:                  "bob",
+			ApplicableFrom:          time.Date(2024, time.June, 16, 0, 0, 0, 0, time.Local),
+			TimePerWeek:             40 * time.Hour,
+			ExcludeFromTimeTracking: true,
+		},
+	}
+
+	days := []timecalc.MonthlyWorkDays{
+		{Year: 2024, Month: time.May},
+		{Year: 2024, Month: time.June},
+	}
+
+	cases := []struct {
+		from, to string
+		may      time.Duration
+		june     time.Duration
+	}{
+		// whole months, time-tracking is disabled from June 16th
+		{"", "", 31 * time.Hour, 15*time.Hour + 30*time.Minute},
+		// the allowance is prorated by calendar days
+		{"2024-05-01", "2024-05-10", 10 * time.Hour, 0},
+		{"2024-05-22", "2024-06-03", 10 * time.Hour, 3*time.Hour + 6*time.Minute},
+	}
+
+	for idx, testCase := range cases {
+		t.Run(fmt.Sprintf("#%d", idx), func(t *testing.T) {
+			result, err := timecalc.CalculateExpectedWorkTime(context.TODO(), days, map[string]timecalc.WorkTimeList{
+				"bob": workTimes,
+			}, testCase.from, testCase.to, timecalc.DefaultWeekend)
+			require.NoError(t, err)
+
+			require.Equal(t, testCase.may, result["bob"][0].OvertimeAllowance)
+			require.Equal(t, testCase.june, result["bob"][1].OvertimeAllowance)
+		})
+	}
+}
+
+func Test_CalculateOvertime(t *testing.T) {
+	expected := timecalc.ExpectedMonthlyWorkTimeList{
+		{Year: 2024, Month: time.May, TrackedWorkTime: 160 * time.Hour, OvertimeAllowance: 10 * time.Hour},
+		{Year: 2024, Month: time.June, TrackedWorkTime: 160 * time.Hour, OvertimeAllowance: 10 * time.Hour},
+		{Year: 2024, Month: time.July, TrackedWorkTime: 160 * time.Hour, UntrackedWorkTime: 8 * time.Hour, OvertimeAllowance: 10 * time.Hour},
+		{Year: 2024, Month: time.August, TrackedWorkTime: 160 * time.Hour, OvertimeAllowance: 10 * time.Hour},
+	}
+
+	planned := timecalc.PlannedMonthlyWorkTimeList{
+		// more overtime than allowed
+		{Year: 2024, Month: time.May, PerUser: map[string]*timecalc.UserTime{"bob": {Tracked: 175 * time.Hour}}},
+		// overtime within the allowance
+		{Year: 2024, Month: time.June, PerUser: map[string]*timecalc.UserTime{"bob": {Tracked: 165 * time.Hour}}},
+		// undertime is not affected by the allowance
+		{Year: 2024, Month: time.July, PerUser: map[string]*timecalc.UserTime{"bob": {Tracked: 150 * time.Hour, Untracked: 20 * time.Hour}}},
+		// August does not have any planned shifts
+	}
+
+	result := timecalc.CalculateOvertime(expected, planned, "bob", true)
+	require.Len(t, result, 4)
+
+	require.Equal(t, []time.Duration{15 * time.Hour, 5 * time.Hour, -10 * time.Hour, -160 * time.Hour}, []time.Duration{
+		result[0].Raw, result[1].Raw, result[2].Raw, result[3].Raw,
+	})
+
+	require.Equal(t, []time.Duration{5 * time.Hour, 0, -10 * time.Hour, -160 * time.Hour}, []time.Duration{
+		result[0].Overtime, result[1].Overtime, result[2].Overtime, result[3].Overtime,
+	})
+
+	require.Equal(t, -150*time.Hour, result.TotalRaw())
+	require.Equal(t, -165*time.Hour, result.TotalOvertime())
+	require.Equal(t, 40*time.Hour, result.TotalAllowance())
+
+	// untracked time is included if requested
+	result = timecalc.CalculateOvertime(expected, planned, "bob", false)
+	require.Equal(t, 168*time.Hour, result[2].Expected)
+	require.Equal(t, 170*time.Hour, result[2].Planned)
+	require.Equal(t, 0*time.Hour, result[2].Overtime)
+}
+
+func Test_GatherWorkDaysByMonth_LocalHolidays(t *testing.T) {
+	holidays := holiday.Holidays{
+		// closure day, not a working day
+		"2024-05-02": holiday.FromLocalHoliday(structs.LocalHoliday{Date: "2024-05-02", Name: "Betriebsurlaub"}),
+		// half day, still counts as half a working day
+		"2024-05-03": holiday.FromLocalHoliday(structs.LocalHoliday{Date: "2024-05-03", Name: "Halbtag", CountsAsWorkday: true, WorkdayFraction: 0.5}),
+		// counts as a full working day
+		"2024-05-06": holiday.FromLocalHoliday(structs.LocalHoliday{Date: "2024-05-06", Name: "Betriebsausflug", CountsAsWorkday: true}),
+	}
+
+	result, err := timecalc.GatherWorkDaysByMonth(holidays, "2024-05-01", "2024-05-06", timecalc.DefaultWeekend)
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	require.Equal(t, []int{1, 3, 6}, result[0].WorkDays)
+	require.Equal(t, map[int]float64{3: 0.5}, result[0].Fractions)
+	require.Equal(t, 1.0, result[0].Fraction(6))
+}
+
+func Test_CalculateExpectedWorkTime_HalfDay(t *testing.T) {
+	holidays := holiday.Holidays{
+		// 2024-12-24 is a Tuesday
+		"2024-12-24": holiday.FromLocalHoliday(structs.LocalHoliday{Date: "2024-12-24", Name: "Heiliger Abend", Kind: structs.LocalHolidayHalfDay, CountsAsWorkday: true, WorkdayFraction: 0.5}),
+		"2024-12-25": holiday.FromLocalHoliday(structs.LocalHoliday{Date: "2024-12-25", Name: "Christtag"}),
+	}
+
+	days, err := timecalc.GatherWorkDaysByMonth(holidays, "2024-12-23", "2024-12-29", timecalc.DefaultWeekend)
+	require.NoError(t, err)
+
+	result, err := timecalc.CalculateExpectedWorkTime(context.TODO(), days, map[string]timecalc.WorkTimeList{
+		"bob": {
+			{
+				UserID:         "bob",
+				TimePerWeek:    40 * time.Hour,
+				ApplicableFrom: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.Local),
+			},
+		},
+	}, "", "", timecalc.DefaultWeekend)
+	require.NoError(t, err)
+
+	// 3 full days and half a day on christmas eve
+	require.Len(t, result["bob"], 1)
+	require.Equal(t, 28*time.Hour, result["bob"][0].TrackedWorkTime)
+}
+
+func Test_CalculateExpectedWorkTime_WeekendSchedule(t *testing.T) {
+	cases := []struct {
+		name string
+		wt   structs.WorkTime
+	}{
+		{
+			name: "no schedule",
+			wt: structs.WorkTime{
+				TimePerWeek: 20 * time.Hour,
+			},
+		},
+		{
+			name: "time per weekday",
+			wt: structs.WorkTime{
+				TimePerWeek: 20 * time.Hour,
+				TimePerWeekday: map[time.Weekday]time.Duration{
+					time.Monday:   5 * time.Hour,
+					time.Tuesday:  5 * time.Hour,
+					time.Thursday: 5 * time.Hour,
+					time.Saturday: 5 * time.Hour,
+				},
+			},
+		},
+		{
+			name: "work days",
+			wt: structs.WorkTime{
+				TimePerWeek: 20 * time.Hour,
+				WorkDays:    []time.Weekday{time.Monday, time.Tuesday, time.Thursday, time.Saturday},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			c.wt.UserID = "bob"
+			c.wt.ApplicableFrom = time.Date(2024, time.January, 1, 0, 0, 0, 0, time.Local)
+
+			// 2024-05-06 is a Monday
+			days, err := timecalc.GatherWorkDaysByMonth(nil, "2024-05-06", "2024-05-12", timecalc.DefaultWeekend)
+			require.NoError(t, err)
+
+			result, err := timecalc.CalculateExpectedWorkTime(context.TODO(), days, map[string]timecalc.WorkTimeList{
+				"bob": {c.wt},
+			}, "", "", timecalc.DefaultWeekend)
+			require.NoError(t, err)
+
+			require.Len(t, result["bob"], 1)
+			require.Equal(t, 20*time.Hour, result["bob"][0].TrackedWorkTime)
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package timecalc
+
+import (
+	"math"
+	"time"
+
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+)
+
+// VacationBalance holds the vacation and time-off credits of a user.
+type VacationBalance struct {
+	// Vacation holds the vacation credits that are left.
+	Vacation time.Duration
+
+	// TimeOff holds the time-off credits that are left.
+	TimeOff time.Duration
+}
+
+// CalculateVacationBalance calculates the vacation and time-off credits of
+// a user at until. Vacation credits are earned per calendar day based on the
+// VacationWeeksPerYear of the work-time history, which must be sorted by
+// ApplicableFrom. Costs dated after until are ignored.
+func CalculateVacationBalance(workHistory []structs.WorkTime, costs []structs.OffTimeCosts, until time.Time) VacationBalance {
+	var result VacationBalance
+
+	for idx := 0; idx < len(workHistory); idx++ {
+		iter := workHistory[idx]
+		endsAt := until
+
+		// skip this work-time entry if it becomes active after the
+		// requested time-frame.
+		if iter.ApplicableFrom.After(until) {
+			continue
+		}
+
+		// if there's another work-history entry after this one we need
+		// to update endsAt to the beginning of the next entry.
+		switch {
+		case !iter.EndsWith.IsZero():
+			endsAt = iter.EndsWith
+
+		case idx+1 < len(workHistory):
+			endsAt = workHistory[idx+1].ApplicableFrom
+		}
+
+		if !iter.ExcludeFromTimeTracking {
+			result.Vacation += VacationCreditsFor(iter, endsAt)
+		}
+
+		// if this entry ends at or after the maximum time-frame
+		// we can stop now.
+		if endsAt.After(until) || endsAt.Equal(until) {
+			break
+		}
+	}
+
+	for _, cost := range costs {
+		if !until.IsZero() && until.Before(cost.Date) {
+			continue
+		}
+
+		// costs are negative
+		if cost.IsVacation {
+			result.Vacation += cost.Costs
+		} else {
+			result.TimeOff += cost.Costs
+		}
+	}
+
+	return result
+}
+
+// VacationCreditsFor returns the vacation credits earned by wt between its
+// ApplicableFrom and endsAt.
+func VacationCreditsFor(wt structs.WorkTime, endsAt time.Time) time.Duration {
+	days := math.Floor(float64(endsAt.Sub(wt.ApplicableFrom)) / float64(time.Hour*24))
+
+	return time.Duration(VacationWeeksPerDay(wt) * float64(wt.TimePerWeek) * days)
+}
+
+// VacationWeeksPerDay returns the vacation weeks earned per calendar day.
+func VacationWeeksPerDay(wt structs.WorkTime) float64 {
+	return float64(wt.VacationWeeksPerYear) / 365.0
+}
@@ -0,0 +1,55 @@
+package timecalc_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"github.com/tierklinik-dobersberg/rosterd/internal/timecalc"
+)
+
+func Test_CalculateVacationBalance(t *testing.T) {
+	date := func(month time.Month, day int) time.Time {
+		return time.Date(2023, month, day, 0, 0, 0, 0, time.Local)
+	}
+
+	history := []structs.WorkTime{
+		{
+			TimePerWeek:          40 * time.Hour,
+			VacationWeeksPerYear: 5,
+			ApplicableFrom:       date(time.January, 1),
+		},
+		{
+			TimePerWeek:          20 * time.Hour,
+			VacationWeeksPerYear: 5,
+			ApplicableFrom:       date(time.July, 2),
+		},
+	}
+
+	costs := []structs.OffTimeCosts{
+		{Costs: -8 * time.Hour, IsVacation: true, Date: date(time.March, 1)},
+		{Costs: 2 * time.Hour, Date: date(time.April, 1)},
+		{Costs: -8 * time.Hour, IsVacation: true, Date: date(time.December, 1)},
+	}
+
+	credits := func(perWeek time.Duration, days float64) time.Duration {
+		return time.Duration(5.0 / 365.0 * float64(perWeek) * days)
+	}
+
+	// 182 days with 40h and 182 days with 20h
+	balance := timecalc.CalculateVacationBalance(history, costs, date(time.December, 31))
+
+	expected := credits(40*time.Hour, 182) + credits(20*time.Hour, 182) - 16*time.Hour
+
+	require.Equal(t, expected, balance.Vacation)
+	require.Equal(t, 2*time.Hour, balance.TimeOff)
+
+	// costs after until are ignored
+	balance = timecalc.CalculateVacationBalance(history, costs, date(time.July, 2))
+	require.Equal(t, credits(40*time.Hour, 182)-8*time.Hour, balance.Vacation)
+
+	// entries that start after until are ignored
+	balance = timecalc.CalculateVacationBalance(history, nil, date(time.January, 1).AddDate(0, 0, -1))
+	require.Equal(t, time.Duration(0), balance.Vacation)
+}
@@ -0,0 +1,113 @@
+package timecalc
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"golang.org/x/exp/slices"
+)
+
+// Weekend holds the weekdays that are not regular working days of the
+// clinic.
+type Weekend []time.Weekday
+
+// DefaultWeekend is used if no weekend is configured.
+var DefaultWeekend = Weekend{time.Saturday, time.Sunday}
+
+// Contains returns true if day is part of the weekend.
+func (w Weekend) Contains(day time.Weekday) bool {
+	return slices.Contains(w, day)
+}
+
+// WorkDays returns all weekdays that are not part of the weekend, starting
+// with Sunday.
+func (w Weekend) WorkDays() []time.Weekday {
+	result := make([]time.Weekday, 0, 7)
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if !w.Contains(day) {
+			result = append(result, day)
+		}
+	}
+
+	return result
+}
+
+// EnvDecode implements envconfig.Decoder and parses a comma separated list
+// of weekdays. An empty value results in an empty weekend.
+func (w *Weekend) EnvDecode(val string) error {
+	*w = Weekend{}
+
+	for _, name := range strings.Split(val, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+
+		day, err := ParseWeekday(name)
+		if err != nil {
+			return err
+		}
+
+		if !w.Contains(day) {
+			*w = append(*w, day)
+		}
+	}
+
+	return nil
+}
+
+// ParseWeekday parses the english name of a weekday. Abbreviations with at
+// least two characters are supported as well.
+func ParseWeekday(name string) (time.Weekday, error) {
+	name = strings.ToLower(name)
+
+	if len(name) >= 2 {
+		for day := time.Sunday; day <= time.Saturday; day++ {
+			if strings.HasPrefix(strings.ToLower(day.String()), name) {
+				return day, nil
+			}
+		}
+	}
+
+	return 0, fmt.Errorf("invalid weekday %q", name)
+}
+
+// ExpectedTimeOn returns the work time that is expected from a user with
+// the work-time wt on a working day of the clinic. If wt specifies the time
+// per weekday it is used as is. Otherwise the time per week is distributed
+// evenly on the working days of the user, which default to the working
+// days of the clinic.
+func ExpectedTimeOn(wt structs.WorkTime, day time.Weekday, weekend Weekend) time.Duration {
+	if len(wt.TimePerWeekday) > 0 {
+		return wt.TimePerWeekday[day]
+	}
+
+	if len(wt.WorkDays) > 0 {
+		if !slices.Contains(wt.WorkDays, day) {
+			return 0
+		}
+
+		return time.Duration(float64(wt.TimePerWeek) / float64(len(wt.WorkDays)))
+	}
+
+	workDays := len(weekend.WorkDays())
+	if workDays == 0 {
+		return 0
+	}
+
+	return time.Duration(float64(wt.TimePerWeek) / float64(workDays))
+}
+
+// ScheduledTimeOn returns the work time that is expected from a user with
+// the work-time wt on a day that is not a working day of the clinic, like a
+// weekend day. Only users whose schedule includes day are expected to work
+// on it, see ExpectedTimeOn.
+func ScheduledTimeOn(wt structs.WorkTime, day time.Weekday, weekend Weekend) time.Duration {
+	if len(wt.TimePerWeekday) == 0 && len(wt.WorkDays) == 0 {
+		return 0
+	}
+
+	return ExpectedTimeOn(wt, day, weekend)
+}
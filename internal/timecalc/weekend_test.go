@@ -0,0 +1,124 @@
+package timecalc_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tierklinik-dobersberg/rosterd/internal/structs"
+	"github.com/tierklinik-dobersberg/rosterd/internal/timecalc"
+)
+
+func Test_Weekend_EnvDecode(t *testing.T) {
+	var w timecalc.Weekend
+
+	require.NoError(t, w.EnvDecode("Sunday, sa"))
+	require.Equal(t, timecalc.Weekend{time.Sunday, time.Saturday}, w)
+	require.Equal(t, []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}, w.WorkDays())
+
+	require.NoError(t, w.EnvDecode("sun"))
+	require.Len(t, w.WorkDays(), 6)
+
+	require.Error(t, w.EnvDecode("s"))
+	require.Error(t, w.EnvDecode("sunday,funday"))
+}
+
+func Test_ExpectedTimeOn(t *testing.T) {
+	cases := []struct {
+		name     string
+		wt       structs.WorkTime
+		weekend  timecalc.Weekend
+		expected map[time.Weekday]time.Duration
+	}{
+		{
+			name:    "five day week",
+			wt:      structs.WorkTime{TimePerWeek: 40 * time.Hour},
+			weekend: timecalc.DefaultWeekend,
+			expected: map[time.Weekday]time.Duration{
+				time.Monday: 8 * time.Hour,
+				time.Friday: 8 * time.Hour,
+			},
+		},
+		{
+			name:    "six day week",
+			wt:      structs.WorkTime{TimePerWeek: 36 * time.Hour},
+			weekend: timecalc.Weekend{time.Sunday},
+			expected: map[time.Weekday]time.Duration{
+				time.Monday:   6 * time.Hour,
+				time.Saturday: 6 * time.Hour,
+			},
+		},
+		{
+			name: "fixed weekdays",
+			wt: structs.WorkTime{
+				TimePerWeek: 24 * time.Hour,
+				WorkDays:    []time.Weekday{time.Monday, time.Tuesday, time.Thursday},
+			},
+			weekend: timecalc.DefaultWeekend,
+			expected: map[time.Weekday]time.Duration{
+				time.Monday:    8 * time.Hour,
+				time.Tuesday:   8 * time.Hour,
+				time.Wednesday: 0,
+				time.Thursday:  8 * time.Hour,
+				time.Friday:    0,
+			},
+		},
+		{
+			name: "time per weekday",
+			wt: structs.WorkTime{
+				TimePerWeek: 20 * time.Hour,
+				WorkDays:    []time.Weekday{time.Monday},
+				TimePerWeekday: map[time.Weekday]time.Duration{
+					time.Monday:    12 * time.Hour,
+					time.Wednesday: 8 * time.Hour,
+				},
+			},
+			weekend: timecalc.DefaultWeekend,
+			expected: map[time.Weekday]time.Duration{
+				time.Monday:    12 * time.Hour,
+				time.Tuesday:   0,
+				time.Wednesday: 8 * time.Hour,
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			for day, expected := range c.expected {
+				require.Equal(t, expected, timecalc.ExpectedTimeOn(c.wt, day, c.weekend), day.String())
+			}
+		})
+	}
+}
+
+func Test_ScheduledTimeOn(t *testing.T) {
+	// without a schedule users do not work on weekend days
+	require.Equal(t, time.Duration(0), timecalc.ScheduledTimeOn(structs.WorkTime{TimePerWeek: 40 * time.Hour}, time.Saturday, timecalc.DefaultWeekend))
+
+	require.Equal(t, 5*time.Hour, timecalc.ScheduledTimeOn(structs.WorkTime{
+		TimePerWeek: 20 * time.Hour,
+		WorkDays:    []time.Weekday{time.Monday, time.Tuesday, time.Thursday, time.Saturday},
+	}, time.Saturday, timecalc.DefaultWeekend))
+
+	require.Equal(t, time.Duration(0), timecalc.ScheduledTimeOn(structs.WorkTime{
+		TimePerWeek: 20 * time.Hour,
+		WorkDays:    []time.Weekday{time.Monday, time.Tuesday, time.Thursday, time.Saturday},
+	}, time.Sunday, timecalc.DefaultWeekend))
+
+	require.Equal(t, 4*time.Hour, timecalc.ScheduledTimeOn(structs.WorkTime{
+		TimePerWeek: 20 * time.Hour,
+		TimePerWeekday: map[time.Weekday]time.Duration{
+			time.Monday:   16 * time.Hour,
+			time.Saturday: 4 * time.Hour,
+		},
+	}, time.Saturday, timecalc.DefaultWeekend))
+}
+
+func Test_GatherWorkDaysByMonth_CustomWeekend(t *testing.T) {
+	// 2024-05-04 is a Saturday
+	result, err := timecalc.GatherWorkDaysByMonth(nil, "2024-05-01", "2024-05-07", timecalc.Weekend{time.Sunday})
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	require.Equal(t, []int{1, 2, 3, 4, 6, 7}, result[0].WorkDays)
+	require.Equal(t, []int{5}, result[0].WeekendDays)
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/tierklinik-dobersberg/apis/pkg/privacy"
 	apisrv "github.com/tierklinik-dobersberg/apis/pkg/server"
 	"github.com/tierklinik-dobersberg/apis/pkg/spa"
+	"github.com/tierklinik-dobersberg/rosterd/gen/go/rosterd/v1/rosterdv1connect"
 	"github.com/tierklinik-dobersberg/rosterd/internal/config"
 	"github.com/tierklinik-dobersberg/rosterd/internal/services/offtime"
 	"github.com/tierklinik-dobersberg/rosterd/internal/services/roster"
@@ -190,6 +191,9 @@ func prepareConnectServer(p *config.Providers) (public, admin *http.Server) {
 	path, handler := rosterv1connect.NewWorkTimeServiceHandler(workTimeService, interceptors)
 	mux.Handle(path, handler)
 
+	path, handler = rosterdv1connect.NewWorkTimeServiceHandler(workTimeService, interceptors)
+	mux.Handle(path, handler)
+
 	workShiftService := workshift.New(p)
 	path, handler = rosterv1connect.NewWorkShiftServiceHandler(workShiftService, interceptors)
 	mux.Handle(path, handler)
@@ -198,10 +202,34 @@ func prepareConnectServer(p *config.Providers) (public, admin *http.Server) {
 	path, handler = rosterv1connect.NewOffTimeServiceHandler(offTimeService, interceptors)
 	mux.Handle(path, handler)
 
+	path, handler = rosterdv1connect.NewOffTimeServiceHandler(offTimeService, interceptors)
+	mux.Handle(path, handler)
+
 	rosterService := roster.NewRosterService(p)
 	path, handler = rosterv1connect.NewRosterServiceHandler(rosterService, interceptors)
 	mux.Handle(path, handler)
 
+	path, handler = rosterdv1connect.NewRosterServiceHandler(rosterService, interceptors)
+	mux.Handle(path, handler)
+
+	path, handler = rosterdv1connect.NewShiftSwapServiceHandler(rosterService, interceptors)
+	mux.Handle(path, handler)
+
+	path, handler = rosterdv1connect.NewOpenShiftServiceHandler(rosterService, interceptors)
+	mux.Handle(path, handler)
+
+	path, handler = rosterdv1connect.NewCalendarFeedServiceHandler(rosterService, interceptors)
+	mux.Handle(path, handler)
+
+	path, handler = rosterdv1connect.NewHolidayServiceHandler(rosterService, interceptors)
+	mux.Handle(path, handler)
+
+	path, handler = rosterdv1connect.NewAuditServiceHandler(rosterService, interceptors)
+	mux.Handle(path, handler)
+
+	// personal calendar feeds are authenticated using the feed token.
+	mux.Handle(roster.CalendarFeedPath, rosterService.CalendarFeedHandler())
+
 	constraintService := roster.NewConstraintService(p)
 	path, handler = rosterv1connect.NewConstraintServiceHandler(constraintService, interceptors)
 	mux.Handle(path, handler)
@@ -51,6 +51,39 @@ type RosterContext struct {
 	Weeks []RosterWeek
 }
 
+type UserRosterShift struct {
+	Name  string
+	From  string
+	To    string
+	Color string
+}
+
+type UserRosterDay struct {
+	Date    string
+	Weekday string
+	Weekend bool
+	Holiday *calendarv1.PublicHoliday
+	Shifts  []UserRosterShift
+	OffTime string
+}
+
+// UserRosterContext is used to render the personal roster of a single user.
+// All times are already formatted as hours and minutes.
+type UserRosterContext struct {
+	UserName string
+	Month    string
+	Preview  bool
+	Days     []UserRosterDay
+
+	ExpectedTime            string
+	PlannedTime             string
+	Overtime                string
+	ExcludeFromTimeTracking bool
+
+	VacationBalance string
+	TimeOffBalance  string
+}
+
 var temp *template.Template
 
 func init() {
@@ -70,3 +103,12 @@ func RenderRosterTemplate(ctx context.Context, renderContext RosterContext) (io.
 
 	return buf, nil
 }
+
+func RenderUserRosterTemplate(ctx context.Context, renderContext UserRosterContext) (io.Reader, error) {
+	buf := new(bytes.Buffer)
+	if err := temp.ExecuteTemplate(buf, "user-roster", renderContext); err != nil {
+		return nil, fmt.Errorf("failed to execute template: %w", err)
+	}
+
+	return buf, nil
+}
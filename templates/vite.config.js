@@ -7,6 +7,7 @@ export default defineConfig({
             input: {
                 roster: resolve(__dirname, 'roster.html'),
                 rosterTable: resolve(__dirname, 'roster-table.html'),
+                userRoster: resolve(__dirname, 'user-roster.html'),
             }
         }
     }